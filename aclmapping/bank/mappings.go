@@ -10,6 +10,7 @@ import (
 	acltypes "github.com/cosmos/cosmos-sdk/x/accesscontrol/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	acltokenfactorymapping "github.com/sei-protocol/sei-chain/aclmapping/tokenfactory"
 	utils "github.com/sei-protocol/sei-chain/aclmapping/utils"
 )
 
@@ -94,6 +95,14 @@ func MsgSendDependencyGenerator(keeper aclkeeper.Keeper, ctx sdk.Context, msg sd
 		},
 	}
 
+	for _, coin := range msgSend.Amount {
+		denomAccessOps, err := acltokenfactorymapping.GetSendDenomAccessOps(keeper, ctx, msgSend.FromAddress, msgSend.ToAddress, coin)
+		if err != nil {
+			return []sdkacltypes.AccessOperation{}, err
+		}
+		accessOperations = append(accessOperations, denomAccessOps...)
+	}
+
 	// check if the account exists and add additional write dependency if it doesn't
 	toAddr, err := sdk.AccAddressFromBech32(msgSend.ToAddress)
 	if err != nil {
@@ -221,6 +221,11 @@ func DexCancelOrdersDependencyGenerator(_ aclkeeper.Keeper, _ sdk.Context, msg s
 		priceDenom := order.GetPriceDenom()
 		assetDenom := order.GetAssetDenom()
 		aclOps = append(aclOps, GetLongShortOrderBookOps(contractAddr, priceDenom, assetDenom)...)
+		aclOps = append(aclOps, sdkacltypes.AccessOperation{
+			AccessType:         sdkacltypes.AccessType_READ,
+			ResourceType:       sdkacltypes.ResourceType_KV_DEX_ORDER_BOOK,
+			IdentifierTemplate: hex.EncodeToString(dextypes.TriggerOrderBookPrefix(contractAddr, priceDenom, assetDenom)),
+		})
 	}
 
 	// Last Operation should always be a commit
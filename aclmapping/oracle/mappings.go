@@ -18,6 +18,10 @@ var ErrorInvalidMsgType = fmt.Errorf("invalid message received for oracle module
 func GetOracleDependencyGenerator() aclkeeper.DependencyGeneratorMap {
 	dependencyGeneratorMap := make(aclkeeper.DependencyGeneratorMap)
 
+	// prevote
+	prevoteKey := acltypes.GenerateMessageKey(&oracletypes.MsgAggregateExchangeRatePrevote{})
+	dependencyGeneratorMap[prevoteKey] = MsgPrevoteDependencyGenerator
+
 	// vote
 	voteKey := acltypes.GenerateMessageKey(&oracletypes.MsgAggregateExchangeRateVote{})
 	dependencyGeneratorMap[voteKey] = MsgVoteDependencyGenerator
@@ -25,6 +29,43 @@ func GetOracleDependencyGenerator() aclkeeper.DependencyGeneratorMap {
 	return dependencyGeneratorMap
 }
 
+func MsgPrevoteDependencyGenerator(_ aclkeeper.Keeper, _ sdk.Context, msg sdk.Msg) ([]sdkacltypes.AccessOperation, error) {
+	msgPrevote, ok := msg.(*oracletypes.MsgAggregateExchangeRatePrevote)
+	if !ok {
+		return []sdkacltypes.AccessOperation{}, ErrorInvalidMsgType
+	}
+	valAddr, _ := sdk.ValAddressFromBech32(msgPrevote.Validator)
+
+	accessOperations := []sdkacltypes.AccessOperation{
+		// validate feeder
+		// read feeder delegation for val addr - READ
+		{
+			ResourceType:       sdkacltypes.ResourceType_KV_ORACLE_FEEDERS,
+			AccessType:         sdkacltypes.AccessType_READ,
+			IdentifierTemplate: hex.EncodeToString(oracletypes.GetFeederDelegationKey(valAddr)),
+		},
+		// read validator from staking - READ
+		// validator is bonded check - READ
+		// (both covered by below)
+		{
+			ResourceType:       sdkacltypes.ResourceType_KV_STAKING_VALIDATOR,
+			AccessType:         sdkacltypes.AccessType_READ,
+			IdentifierTemplate: hex.EncodeToString(stakingtypes.GetValidatorKey(valAddr)),
+		},
+
+		// set exchange rate prevote - WRITE
+		{
+			ResourceType:       sdkacltypes.ResourceType_KV_ORACLE,
+			AccessType:         sdkacltypes.AccessType_WRITE,
+			IdentifierTemplate: utils.DefaultIDTemplate,
+		},
+
+		// Last Operation should always be a commit
+		*acltypes.CommitAccessOp(),
+	}
+	return accessOperations, nil
+}
+
 func MsgVoteDependencyGenerator(_ aclkeeper.Keeper, _ sdk.Context, msg sdk.Msg) ([]sdkacltypes.AccessOperation, error) {
 	msgVote, ok := msg.(*oracletypes.MsgAggregateExchangeRateVote)
 	if !ok {
@@ -56,11 +97,13 @@ func MsgVoteDependencyGenerator(_ aclkeeper.Keeper, _ sdk.Context, msg sdk.Msg)
 			IdentifierTemplate: utils.DefaultIDTemplate,
 		},
 
+		// delete exchange rate prevote if commit-reveal is enabled - WRITE
 		// set exchange rate vote - WRITE
+		// (both covered by below)
 		{
-			ResourceType:       sdkacltypes.ResourceType_KV_ORACLE_AGGREGATE_VOTES,
+			ResourceType:       sdkacltypes.ResourceType_KV_ORACLE,
 			AccessType:         sdkacltypes.AccessType_WRITE,
-			IdentifierTemplate: hex.EncodeToString(oracletypes.GetAggregateExchangeRateVoteKey(valAddr)),
+			IdentifierTemplate: utils.DefaultIDTemplate,
 		},
 
 		// Last Operation should always be a commit
@@ -125,6 +125,27 @@ func (suite *KeeperTestSuite) TestMsgBurnDependencies() {
 		})
 	}
 }
+func (suite *KeeperTestSuite) TestMsgPrevoteDependencies() {
+	suite.PrepareTest()
+	params := suite.App.OracleKeeper.GetParams(suite.Ctx)
+	params.CommitRevealEnabled = true
+	suite.App.OracleKeeper.SetParams(suite.Ctx, params)
+
+	msg := oracletypes.NewMsgAggregateExchangeRatePrevote(
+		oracletypes.GetAggregateVoteHash("salt", suite.defaultExchangeRate, suite.validator),
+		suite.TestAccs[0],
+		suite.validator,
+	)
+	handlerCtx, cms := utils.CacheTxContext(suite.Ctx)
+	_, err := suite.msgServer.AggregateExchangeRatePrevote(sdk.WrapSDKContext(handlerCtx), msg)
+	suite.Require().NoError(err)
+	depdenencies, err := oracleacl.MsgPrevoteDependencyGenerator(suite.App.AccessControlKeeper, handlerCtx, msg)
+	suite.Require().NoError(err)
+
+	missing := handlerCtx.MsgValidator().ValidateAccessOperations(depdenencies, cms.GetEvents())
+	suite.Require().Empty(missing)
+}
+
 func TestMsgVoteDependencyGenerator(t *testing.T) {
 	tm := time.Now().UTC()
 	valPub := secp256k1.GenPrivKey().PubKey()
@@ -154,9 +175,11 @@ func TestMsgVoteDependencyGeneratorInvalidMsgType(t *testing.T) {
 
 func TestOracleDependencyGenerator(t *testing.T) {
 	oracleDependencyGenerator := oracleacl.GetOracleDependencyGenerator()
-	// verify that there's one entry, for oracle aggregate vote
-	require.Equal(t, 1, len(oracleDependencyGenerator))
-	// check that oracle vote dep generator is in the map
-	_, ok := oracleDependencyGenerator[acltypes.GenerateMessageKey(&oracletypes.MsgAggregateExchangeRateVote{})]
+	// verify that there are two entries, for oracle aggregate prevote and vote
+	require.Equal(t, 2, len(oracleDependencyGenerator))
+	// check that oracle prevote and vote dep generators are in the map
+	_, ok := oracleDependencyGenerator[acltypes.GenerateMessageKey(&oracletypes.MsgAggregateExchangeRatePrevote{})]
+	require.True(t, ok)
+	_, ok = oracleDependencyGenerator[acltypes.GenerateMessageKey(&oracletypes.MsgAggregateExchangeRateVote{})]
 	require.True(t, ok)
 }
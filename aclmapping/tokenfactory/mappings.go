@@ -2,7 +2,9 @@ package acltokenfactorymapping
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkacltypes "github.com/cosmos/cosmos-sdk/types/accesscontrol"
@@ -33,6 +35,7 @@ func TokenFactoryMintDependencyGenerator(keeper aclkeeper.Keeper, _ sdk.Context,
 	}
 	moduleAdr := keeper.AccountKeeper.GetModuleAddress(tfktypes.ModuleName)
 	denom := mintMsg.GetAmount().Denom
+	recipient := mintMsg.GetRecipient()
 
 	denomMetaDataKey := append([]byte(tfktypes.DenomAuthorityMetadataKey), []byte(denom)...)
 	tokenfactoryDenomKey := tfktypes.GetDenomPrefixStore(denom)
@@ -60,6 +63,12 @@ func TokenFactoryMintDependencyGenerator(keeper aclkeeper.Keeper, _ sdk.Context,
 			ResourceType:       sdkacltypes.ResourceType_KV_TOKENFACTORY_DENOM,
 			IdentifierTemplate: hex.EncodeToString(tokenfactoryDenomKey),
 		},
+		// Spends the allowance of a minter
+		{
+			AccessType:         sdkacltypes.AccessType_WRITE,
+			ResourceType:       sdkacltypes.ResourceType_KV_TOKENFACTORY_DENOM,
+			IdentifierTemplate: hex.EncodeToString(tokenfactoryDenomKey),
+		},
 
 		// Gets Module Account information
 		{
@@ -78,16 +87,16 @@ func TokenFactoryMintDependencyGenerator(keeper aclkeeper.Keeper, _ sdk.Context,
 			IdentifierTemplate: hex.EncodeToString(banktypes.CreateAccountBalancesPrefix(moduleAdr)),
 		},
 
-		// Deposit into Sender's Bank Balance
+		// Deposit into Recipient's Bank Balance
 		{
 			AccessType:         sdkacltypes.AccessType_READ,
 			ResourceType:       sdkacltypes.ResourceType_KV_BANK_BALANCES,
-			IdentifierTemplate: hex.EncodeToString(banktypes.CreateAccountBalancesPrefixFromBech32(mintMsg.GetSender())),
+			IdentifierTemplate: hex.EncodeToString(banktypes.CreateAccountBalancesPrefixFromBech32(recipient)),
 		},
 		{
 			AccessType:         sdkacltypes.AccessType_WRITE,
 			ResourceType:       sdkacltypes.ResourceType_KV_BANK_BALANCES,
-			IdentifierTemplate: hex.EncodeToString(banktypes.CreateAccountBalancesPrefixFromBech32(mintMsg.GetSender())),
+			IdentifierTemplate: hex.EncodeToString(banktypes.CreateAccountBalancesPrefixFromBech32(recipient)),
 		},
 
 		// Read and update supply after burn
@@ -105,12 +114,12 @@ func TokenFactoryMintDependencyGenerator(keeper aclkeeper.Keeper, _ sdk.Context,
 		{
 			AccessType:         sdkacltypes.AccessType_READ,
 			ResourceType:       sdkacltypes.ResourceType_KV_AUTH_ADDRESS_STORE,
-			IdentifierTemplate: hex.EncodeToString(authtypes.CreateAddressStoreKeyFromBech32(mintMsg.GetSender())),
+			IdentifierTemplate: hex.EncodeToString(authtypes.CreateAddressStoreKeyFromBech32(recipient)),
 		},
 		{
 			AccessType:         sdkacltypes.AccessType_WRITE,
 			ResourceType:       sdkacltypes.ResourceType_KV_AUTH_ADDRESS_STORE,
-			IdentifierTemplate: hex.EncodeToString(authtypes.CreateAddressStoreKeyFromBech32(mintMsg.GetSender())),
+			IdentifierTemplate: hex.EncodeToString(authtypes.CreateAddressStoreKeyFromBech32(recipient)),
 		},
 		// Last Operation should always be a commit
 		*acltypes.CommitAccessOp(),
@@ -125,6 +134,7 @@ func TokenFactoryBurnDependencyGenerator(keeper aclkeeper.Keeper, _ sdk.Context,
 
 	moduleAdr := keeper.AccountKeeper.GetModuleAddress(tfktypes.ModuleName)
 	denom := burnMsg.GetAmount().Denom
+	holder := burnMsg.GetHolder()
 
 	denomMetaDataKey := append([]byte(tfktypes.DenomAuthorityMetadataKey), []byte(denom)...)
 	tokenfactoryDenomKey := tfktypes.GetDenomPrefixStore(denom)
@@ -145,12 +155,18 @@ func TokenFactoryBurnDependencyGenerator(keeper aclkeeper.Keeper, _ sdk.Context,
 			IdentifierTemplate: hex.EncodeToString(denomMetaDataKey),
 		},
 
-		// Gets Authoritity data related to the denom
+		// Gets Authoritity data, compliance state and burn approvals related to the denom
 		{
 			AccessType:         sdkacltypes.AccessType_READ,
 			ResourceType:       sdkacltypes.ResourceType_KV_TOKENFACTORY_DENOM,
 			IdentifierTemplate: hex.EncodeToString(tokenfactoryDenomKey),
 		},
+		// Spends the burn from allowance approved by the holder
+		{
+			AccessType:         sdkacltypes.AccessType_WRITE,
+			ResourceType:       sdkacltypes.ResourceType_KV_TOKENFACTORY_DENOM,
+			IdentifierTemplate: hex.EncodeToString(tokenfactoryDenomKey),
+		},
 
 		// Gets Module Account Balance
 		{
@@ -169,16 +185,16 @@ func TokenFactoryBurnDependencyGenerator(keeper aclkeeper.Keeper, _ sdk.Context,
 			IdentifierTemplate: hex.EncodeToString(banktypes.CreateAccountBalancesPrefix(moduleAdr)),
 		},
 
-		// Checks balance for receiver
+		// Withdraws from Holder's Bank Balance
 		{
 			AccessType:         sdkacltypes.AccessType_READ,
 			ResourceType:       sdkacltypes.ResourceType_KV_BANK_BALANCES,
-			IdentifierTemplate: hex.EncodeToString(banktypes.CreateAccountBalancesPrefixFromBech32(burnMsg.GetSender())),
+			IdentifierTemplate: hex.EncodeToString(banktypes.CreateAccountBalancesPrefixFromBech32(holder)),
 		},
 		{
 			AccessType:         sdkacltypes.AccessType_WRITE,
 			ResourceType:       sdkacltypes.ResourceType_KV_BANK_BALANCES,
-			IdentifierTemplate: hex.EncodeToString(banktypes.CreateAccountBalancesPrefixFromBech32(burnMsg.GetSender())),
+			IdentifierTemplate: hex.EncodeToString(banktypes.CreateAccountBalancesPrefixFromBech32(holder)),
 		},
 
 		// Read and update supply after burn
@@ -196,15 +212,90 @@ func TokenFactoryBurnDependencyGenerator(keeper aclkeeper.Keeper, _ sdk.Context,
 		{
 			AccessType:         sdkacltypes.AccessType_READ,
 			ResourceType:       sdkacltypes.ResourceType_KV_AUTH_ADDRESS_STORE,
-			IdentifierTemplate: hex.EncodeToString(authtypes.CreateAddressStoreKeyFromBech32(burnMsg.GetSender())),
+			IdentifierTemplate: hex.EncodeToString(authtypes.CreateAddressStoreKeyFromBech32(holder)),
 		},
 		{
 			AccessType:         sdkacltypes.AccessType_WRITE,
 			ResourceType:       sdkacltypes.ResourceType_KV_AUTH_ADDRESS_STORE,
-			IdentifierTemplate: hex.EncodeToString(authtypes.CreateAddressStoreKeyFromBech32(burnMsg.GetSender())),
+			IdentifierTemplate: hex.EncodeToString(authtypes.CreateAddressStoreKeyFromBech32(holder)),
 		},
 
 		// Last Operation should always be a commit
 		*acltypes.CommitAccessOp(),
 	}, nil
 }
+
+// GetSendDenomAccessOps returns the access operations of the bank hooks of a
+// send of the coin: tokenfactory denoms are checked for pauses and frozen
+// addresses unless sent by a module account, and the before send hook
+// contract of the denom is called if it has one. The access operations do not
+// include a commit.
+func GetSendDenomAccessOps(keeper aclkeeper.Keeper, ctx sdk.Context, from string, to string, coin sdk.Coin) ([]sdkacltypes.AccessOperation, error) {
+	if !strings.HasPrefix(coin.Denom, tfktypes.ModuleDenomPrefix+"/") {
+		return nil, nil
+	}
+	accessOps := []sdkacltypes.AccessOperation{
+		{
+			AccessType:         sdkacltypes.AccessType_READ,
+			ResourceType:       sdkacltypes.ResourceType_KV_TOKENFACTORY_DENOM,
+			IdentifierTemplate: hex.EncodeToString(tfktypes.GetDenomPrefixStore(coin.Denom)),
+		},
+		// the sender is checked for being a module account, whose payouts are not restricted
+		{
+			AccessType:         sdkacltypes.AccessType_READ,
+			ResourceType:       sdkacltypes.ResourceType_KV_AUTH_ADDRESS_STORE,
+			IdentifierTemplate: hex.EncodeToString(authtypes.CreateAddressStoreKeyFromBech32(from)),
+		},
+	}
+	hookAccessOps, err := getBeforeSendHookAccessOps(keeper, ctx, from, to, coin)
+	if err != nil {
+		return nil, err
+	}
+	return append(accessOps, hookAccessOps...), nil
+}
+
+// getBeforeSendHookAccessOps returns the access operations of the before send
+// hook contract of a tokenfactory denom, if it has one, from the wasm dependency
+// mapping of the contract for its block_before_send message.
+func getBeforeSendHookAccessOps(keeper aclkeeper.Keeper, ctx sdk.Context, from string, to string, coin sdk.Coin) ([]sdkacltypes.AccessOperation, error) {
+	storeKey, ok := keeper.GetStoreKeyMap(ctx)[tfktypes.StoreKey]
+	if !ok {
+		return nil, nil
+	}
+	cosmwasmAddress := ctx.KVStore(storeKey).Get(tfktypes.GetBeforeSendHookKey(coin.Denom))
+	if cosmwasmAddress == nil {
+		return nil, nil
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(string(cosmwasmAddress))
+	if err != nil {
+		return nil, err
+	}
+
+	wasmMsg, err := json.Marshal(tfktypes.SudoBlockBeforeSendMsg{
+		BlockBeforeSend: tfktypes.BeforeSendMsg{
+			From:   from,
+			To:     to,
+			Amount: coin,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	msgInfo, err := acltypes.NewExecuteMessageInfo(wasmMsg)
+	if err != nil {
+		return nil, err
+	}
+	wasmAccessOps, err := keeper.GetWasmDependencyAccessOps(ctx, contractAddr, from, msgInfo, make(aclkeeper.ContractReferenceLookupMap))
+	if err != nil {
+		return nil, err
+	}
+
+	// the access operations of the message end with a single commit
+	accessOps := []sdkacltypes.AccessOperation{}
+	for _, accessOp := range wasmAccessOps {
+		if accessOp.AccessType != sdkacltypes.AccessType_COMMIT {
+			accessOps = append(accessOps, accessOp)
+		}
+	}
+	return accessOps, nil
+}
@@ -1,9 +1,11 @@
 package acltokenfactorymapping_test
 
 import (
+	"encoding/hex"
 	"fmt"
 	"testing"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,8 +13,10 @@ import (
 	acltypes "github.com/cosmos/cosmos-sdk/x/accesscontrol/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	aclbankmapping "github.com/sei-protocol/sei-chain/aclmapping/bank"
 	tkfactory "github.com/sei-protocol/sei-chain/aclmapping/tokenfactory"
 	aclutils "github.com/sei-protocol/sei-chain/aclmapping/utils"
+	aclwasmmapping "github.com/sei-protocol/sei-chain/aclmapping/wasm"
 	"github.com/sei-protocol/sei-chain/app/apptesting"
 	oracletypes "github.com/sei-protocol/sei-chain/x/oracle/types"
 	tokenfactorykeeper "github.com/sei-protocol/sei-chain/x/tokenfactory/keeper"
@@ -89,6 +93,14 @@ func (suite *KeeperTestSuite) TestMsgBurnDependencies() {
 
 	burnAmount := sdk.NewInt64Coin(suite.testDenom, 10)
 	addr1 := suite.TestAccs[0].String()
+	addr2 := suite.TestAccs[1].String()
+
+	// addr2 approves burns of its balance by the admin
+	_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMintTo(addr1, burnAmount, addr2))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.ApproveBurnFrom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgApproveBurnFrom(addr2, suite.testDenom, addr1, burnAmount.Amount))
+	suite.Require().NoError(err)
+
 	tests := []struct {
 		name          string
 		expectedError error
@@ -101,6 +113,12 @@ func (suite *KeeperTestSuite) TestMsgBurnDependencies() {
 			expectedError: nil,
 			dynamicDep:    true,
 		},
+		{
+			name:          "burn from address",
+			msg:           tokenfactorytypes.NewMsgBurnFrom(addr1, burnAmount, addr2),
+			expectedError: nil,
+			dynamicDep:    true,
+		},
 		{
 			name:          "dont check synchronous",
 			msg:           tokenfactorytypes.NewMsgBurn(addr1, burnAmount),
@@ -143,6 +161,12 @@ func (suite *KeeperTestSuite) TestMsgMintDependencies() {
 
 	burnAmount := sdk.NewInt64Coin(suite.testDenom, 10)
 	addr1 := suite.TestAccs[0].String()
+	addr2 := suite.TestAccs[1].String()
+
+	// addr2 is a minter of the denom, whose mints spend its allowance
+	_, err := suite.msgServer.SetMinter(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetMinter(addr1, suite.testDenom, addr2, sdk.NewInt(100)))
+	suite.Require().NoError(err)
+
 	tests := []struct {
 		name          string
 		expectedError error
@@ -155,6 +179,18 @@ func (suite *KeeperTestSuite) TestMsgMintDependencies() {
 			expectedError: nil,
 			dynamicDep:    true,
 		},
+		{
+			name:          "mint to address",
+			msg:           tokenfactorytypes.NewMsgMintTo(addr1, burnAmount, addr2),
+			expectedError: nil,
+			dynamicDep:    true,
+		},
+		{
+			name:          "mint by minter",
+			msg:           tokenfactorytypes.NewMsgMint(addr2, burnAmount),
+			expectedError: nil,
+			dynamicDep:    true,
+		},
 		{
 			name:          "dont check synchronous",
 			msg:           tokenfactorytypes.NewMsgMint(addr1, burnAmount),
@@ -190,6 +226,119 @@ func (suite *KeeperTestSuite) TestMsgMintDependencies() {
 			suite.Require().Empty(missing)
 		})
 	}
+
+	// the mint of a minter declares the write of its allowance
+	depdenencies, err := tkfactory.TokenFactoryMintDependencyGenerator(
+		suite.App.AccessControlKeeper,
+		suite.Ctx,
+		tokenfactorytypes.NewMsgMint(addr2, burnAmount),
+	)
+	suite.Require().NoError(err)
+	suite.Require().Contains(depdenencies, sdkacltypes.AccessOperation{
+		AccessType:         sdkacltypes.AccessType_WRITE,
+		ResourceType:       sdkacltypes.ResourceType_KV_TOKENFACTORY_DENOM,
+		IdentifierTemplate: hex.EncodeToString(tokenfactorytypes.GetDenomPrefixStore(suite.testDenom)),
+	})
+}
+
+func (suite *KeeperTestSuite) TestMsgSendBeforeSendHookDependencies() {
+	suite.PrepareTest()
+
+	contract := suite.TestAccs[2]
+	sendMsg := banktypes.NewMsgSend(suite.TestAccs[0], suite.TestAccs[1], sdk.NewCoins(sdk.NewInt64Coin(suite.testDenom, 10)))
+	contractOp := sdkacltypes.AccessOperation{
+		AccessType:         sdkacltypes.AccessType_WRITE,
+		ResourceType:       sdkacltypes.ResourceType_KV_WASM_CONTRACT_STORE,
+		IdentifierTemplate: hex.EncodeToString(contract),
+	}
+
+	accessOps, err := aclbankmapping.MsgSendDependencyGenerator(suite.App.AccessControlKeeper, suite.Ctx, sendMsg)
+	suite.Require().NoError(err)
+	suite.Require().NotContains(accessOps, contractOp)
+
+	// the hook is set directly in the store, as the test account is not a contract
+	suite.Ctx.KVStore(suite.App.GetKey(tokenfactorytypes.StoreKey)).Set(tokenfactorytypes.GetBeforeSendHookKey(suite.testDenom), []byte(contract.String()))
+
+	// contracts without a wasm dependency mapping are synchronous
+	accessOps, err = aclbankmapping.MsgSendDependencyGenerator(suite.App.AccessControlKeeper, suite.Ctx, sendMsg)
+	suite.Require().NoError(err)
+	suite.Require().NoError(acltypes.ValidateAccessOps(accessOps))
+	suite.Require().Contains(accessOps, sdkacltypes.SynchronousAccessOps()[0])
+
+	suite.setBeforeSendHookDependencyMapping(contract, contractOp)
+
+	accessOps, err = aclbankmapping.MsgSendDependencyGenerator(suite.App.AccessControlKeeper, suite.Ctx, sendMsg)
+	suite.Require().NoError(err)
+	suite.Require().NoError(acltypes.ValidateAccessOps(accessOps))
+	suite.Require().Contains(accessOps, contractOp)
+	suite.Require().NotContains(accessOps, sdkacltypes.SynchronousAccessOps()[0])
+}
+
+func (suite *KeeperTestSuite) TestMsgExecuteContractBeforeSendHookDependencies() {
+	suite.PrepareTest()
+
+	hookContract := suite.TestAccs[2]
+	executedContract := suite.TestAccs[1]
+	executeMsg := &wasmtypes.MsgExecuteContract{
+		Sender:   suite.TestAccs[0].String(),
+		Contract: executedContract.String(),
+		Msg:      wasmtypes.RawContractMessage([]byte("{\"test\":{}}")),
+		Funds:    sdk.NewCoins(sdk.NewInt64Coin(suite.testDenom, 10), sdk.NewInt64Coin(suite.defaultDenom, 10)),
+	}
+	denomOp := sdkacltypes.AccessOperation{
+		AccessType:         sdkacltypes.AccessType_READ,
+		ResourceType:       sdkacltypes.ResourceType_KV_TOKENFACTORY_DENOM,
+		IdentifierTemplate: hex.EncodeToString(tokenfactorytypes.GetDenomPrefixStore(suite.testDenom)),
+	}
+	contractOp := sdkacltypes.AccessOperation{
+		AccessType:         sdkacltypes.AccessType_WRITE,
+		ResourceType:       sdkacltypes.ResourceType_KV_WASM_CONTRACT_STORE,
+		IdentifierTemplate: hex.EncodeToString(hookContract),
+	}
+	err := suite.App.AccessControlKeeper.SetWasmDependencyMapping(suite.Ctx, sdkacltypes.WasmDependencyMapping{
+		ContractAddress: executedContract.String(),
+		BaseAccessOps: []*sdkacltypes.WasmAccessOperation{
+			{Operation: acltypes.CommitAccessOp(), SelectorType: sdkacltypes.AccessOperationSelectorType_NONE},
+		},
+	})
+	suite.Require().NoError(err)
+
+	generator := aclwasmmapping.NewWasmDependencyGenerator()
+	accessOps, err := generator.WasmExecuteContractGenerator(suite.App.AccessControlKeeper, suite.Ctx, executeMsg)
+	suite.Require().NoError(err)
+	suite.Require().NoError(acltypes.ValidateAccessOps(accessOps))
+	suite.Require().Contains(accessOps, denomOp)
+	suite.Require().NotContains(accessOps, contractOp)
+
+	// the hook is set directly in the store, as the test account is not a contract
+	suite.Ctx.KVStore(suite.App.GetKey(tokenfactorytypes.StoreKey)).Set(tokenfactorytypes.GetBeforeSendHookKey(suite.testDenom), []byte(hookContract.String()))
+	suite.setBeforeSendHookDependencyMapping(hookContract, contractOp)
+
+	accessOps, err = generator.WasmExecuteContractGenerator(suite.App.AccessControlKeeper, suite.Ctx, executeMsg)
+	suite.Require().NoError(err)
+	suite.Require().NoError(acltypes.ValidateAccessOps(accessOps))
+	suite.Require().Contains(accessOps, denomOp)
+	suite.Require().Contains(accessOps, contractOp)
+	suite.Require().NotContains(accessOps, sdkacltypes.SynchronousAccessOps()[0])
+	suite.Require().Equal(*acltypes.CommitAccessOp(), accessOps[len(accessOps)-1])
+}
+
+// setBeforeSendHookDependencyMapping sets the wasm dependency mapping of a before send
+// hook contract, whose block_before_send message has the access operation.
+func (suite *KeeperTestSuite) setBeforeSendHookDependencyMapping(contract sdk.AccAddress, accessOp sdkacltypes.AccessOperation) {
+	err := suite.App.AccessControlKeeper.SetWasmDependencyMapping(suite.Ctx, sdkacltypes.WasmDependencyMapping{
+		ContractAddress: contract.String(),
+		BaseAccessOps: []*sdkacltypes.WasmAccessOperation{
+			{Operation: acltypes.CommitAccessOp(), SelectorType: sdkacltypes.AccessOperationSelectorType_NONE},
+		},
+		ExecuteAccessOps: []*sdkacltypes.WasmAccessOperations{
+			{
+				MessageName:    "block_before_send",
+				WasmOperations: []*sdkacltypes.WasmAccessOperation{{Operation: &accessOp, SelectorType: sdkacltypes.AccessOperationSelectorType_NONE}},
+			},
+		},
+	})
+	suite.Require().NoError(err)
 }
 
 func TestGeneratorInvalidMessageTypes(t *testing.T) {
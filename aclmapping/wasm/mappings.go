@@ -8,6 +8,7 @@ import (
 	sdkacltypes "github.com/cosmos/cosmos-sdk/types/accesscontrol"
 	aclkeeper "github.com/cosmos/cosmos-sdk/x/accesscontrol/keeper"
 	acltypes "github.com/cosmos/cosmos-sdk/x/accesscontrol/types"
+	acltokenfactorymapping "github.com/sei-protocol/sei-chain/aclmapping/tokenfactory"
 )
 
 var (
@@ -50,5 +51,16 @@ func (wasmDepGen WasmDependencyGenerator) WasmExecuteContractGenerator(keeper ac
 	if err != nil {
 		return []sdkacltypes.AccessOperation{}, err
 	}
-	return wasmAccessOps, nil
+
+	// the funds are sent to the contract through the bank hooks of their denoms, whose access
+	// operations come first so that the commit of the contract remains the last operation
+	fundsAccessOps := []sdkacltypes.AccessOperation{}
+	for _, coin := range executeContractMsg.Funds {
+		denomAccessOps, err := acltokenfactorymapping.GetSendDenomAccessOps(keeper, ctx, executeContractMsg.Sender, executeContractMsg.Contract, coin)
+		if err != nil {
+			return []sdkacltypes.AccessOperation{}, err
+		}
+		fundsAccessOps = append(fundsAccessOps, denomAccessOps...)
+	}
+	return append(fundsAccessOps, wasmAccessOps...), nil
 }
@@ -69,7 +69,11 @@ func (app *App) Commit(ctx context.Context) (res *abci.ResponseCommit, err error
 	defer span.End()
 	app.GetBaseApp().TracingInfo.SetContext(context.Background())
 	app.GetBaseApp().TracingInfo.BlockSpan = nil
-	return app.BaseApp.Commit(ctx)
+	res, err = app.BaseApp.Commit(ctx)
+	if err == nil {
+		app.DexStreamer.Publish(app.LastBlockHeight())
+	}
+	return res, err
 }
 
 func (app *App) LoadLatest(ctx context.Context, req *abci.RequestLoadLatest) (*abci.ResponseLoadLatest, error) {
@@ -77,6 +77,29 @@ func (gd GaslessDecorator) AnteDeps(txDeps []sdkacltypes.AccessOperation, tx sdk
 	for _, msg := range tx.GetMsgs() {
 		// Error checking will be handled in AnteHandler
 		switch m := msg.(type) {
+		case *oracletypes.MsgAggregateExchangeRatePrevote:
+			valAddr, _ := sdk.ValAddressFromBech32(m.Validator)
+			deps = append(deps, []sdkacltypes.AccessOperation{
+				// validate feeder
+				// read feeder delegation for val addr - READ
+				{
+					ResourceType:       sdkacltypes.ResourceType_KV_ORACLE_FEEDERS,
+					AccessType:         sdkacltypes.AccessType_READ,
+					IdentifierTemplate: hex.EncodeToString(oracletypes.GetFeederDelegationKey(valAddr)),
+				},
+				// read validator from staking - READ
+				{
+					ResourceType:       sdkacltypes.ResourceType_KV_STAKING_VALIDATOR,
+					AccessType:         sdkacltypes.AccessType_READ,
+					IdentifierTemplate: hex.EncodeToString(stakingtypes.GetValidatorKey(valAddr)),
+				},
+				// check exchange rate prevote exists (prevotes have no dedicated resource type) - READ
+				{
+					ResourceType:       sdkacltypes.ResourceType_KV_ORACLE,
+					AccessType:         sdkacltypes.AccessType_READ,
+					IdentifierTemplate: "*",
+				},
+			}...)
 		case *oracletypes.MsgAggregateExchangeRateVote:
 			valAddr, _ := sdk.ValAddressFromBech32(m.Validator)
 			deps = append(deps, []sdkacltypes.AccessOperation{
@@ -124,6 +147,11 @@ func IsTxGasless(tx sdk.Tx, ctx sdk.Context, oracleKeeper oraclekeeper.Keeper) (
 			if !dexCancelOrdersIsGasless(m) {
 				return false, nil
 			}
+		case *oracletypes.MsgAggregateExchangeRatePrevote:
+			isGasless, err := oraclePrevoteIsGasless(m, ctx, oracleKeeper)
+			if err != nil || !isGasless {
+				return false, err
+			}
 		case *oracletypes.MsgAggregateExchangeRateVote:
 			isGasless, err := oracleVoteIsGasless(m, ctx, oracleKeeper)
 			if err != nil || !isGasless {
@@ -163,6 +191,39 @@ func allSignersWhitelisted(msg *dextypes.MsgCancelOrders) bool {
 	return true
 }
 
+func oraclePrevoteIsGasless(msg *oracletypes.MsgAggregateExchangeRatePrevote, ctx sdk.Context, keeper oraclekeeper.Keeper) (bool, error) {
+	// prevotes are rejected by the msg server when commit-reveal is disabled
+	if !keeper.CommitRevealEnabled(ctx) {
+		return false, nil
+	}
+
+	feederAddr, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return false, err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return false, err
+	}
+
+	err = keeper.ValidateFeeder(ctx, feederAddr, valAddr)
+	if err != nil {
+		return false, err
+	}
+
+	// a prevote of a previous vote period may still be waiting for its reveal, but only
+	// one prevote per vote period is allowed gasless
+	prevote, err := keeper.GetAggregateExchangeRatePrevote(ctx, valAddr)
+	if err == nil {
+		votePeriod := keeper.VotePeriod(ctx)
+		if prevote.SubmitBlock/votePeriod == uint64(ctx.BlockHeight())/votePeriod {
+			return false, sdkerrors.Wrap(oracletypes.ErrAggregatePrevoteExist, valAddr.String())
+		}
+	}
+	return true, nil
+}
+
 func oracleVoteIsGasless(msg *oracletypes.MsgAggregateExchangeRateVote, ctx sdk.Context, keeper oraclekeeper.Keeper) (bool, error) {
 	feederAddr, err := sdk.AccAddressFromBech32(msg.Feeder)
 	if err != nil {
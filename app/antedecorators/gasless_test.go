@@ -200,6 +200,60 @@ func TestOracleVoteGasless(t *testing.T) {
 	require.True(t, gasless)
 }
 
+func TestOraclePrevoteGasless(t *testing.T) {
+	input := oraclekeeper.CreateTestInput(t)
+
+	addr := oraclekeeper.Addrs[0]
+	addr1 := oraclekeeper.Addrs[1]
+	valAddr, val := oraclekeeper.ValAddrs[0], oraclekeeper.ValPubKeys[0]
+	valAddr1, val1 := oraclekeeper.ValAddrs[1], oraclekeeper.ValPubKeys[1]
+	amt := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	sh := staking.NewHandler(input.StakingKeeper)
+	ctx := input.Ctx.WithIsCheckTx(true)
+
+	// Validator created
+	_, err := sh(ctx, oraclekeeper.NewTestMsgCreateValidator(valAddr, val, amt))
+	require.NoError(t, err)
+	_, err = sh(ctx, oraclekeeper.NewTestMsgCreateValidator(valAddr1, val1, amt))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	// with a vote period of 2, height 5 is in the same vote period as height 4 but not as height 3
+	ctx = ctx.WithBlockHeight(5)
+	input.OracleKeeper.SetAggregateExchangeRatePrevote(ctx, valAddr, oracletypes.AggregateExchangeRatePrevote{SubmitBlock: 4})
+	input.OracleKeeper.SetAggregateExchangeRatePrevote(ctx, valAddr1, oracletypes.AggregateExchangeRatePrevote{SubmitBlock: 3})
+
+	prevote1 := oracletypes.MsgAggregateExchangeRatePrevote{
+		Feeder:    addr.String(),
+		Validator: valAddr.String(),
+	}
+
+	prevote2 := oracletypes.MsgAggregateExchangeRatePrevote{
+		Feeder:    addr1.String(),
+		Validator: valAddr1.String(),
+	}
+
+	// prevotes are not gasless while commit-reveal is disabled
+	gasless = true
+	err = CallGaslessDecoratorWithMsg(ctx, &prevote2, input.OracleKeeper)
+	require.NoError(t, err)
+	require.False(t, gasless)
+
+	params := input.OracleKeeper.GetParams(ctx)
+	params.CommitRevealEnabled = true
+	input.OracleKeeper.SetParams(ctx, params)
+
+	// reset gasless
+	err = CallGaslessDecoratorWithMsg(ctx, &prevote1, input.OracleKeeper)
+	require.Error(t, err)
+
+	// reset gasless
+	gasless = true
+	err = CallGaslessDecoratorWithMsg(ctx, &prevote2, input.OracleKeeper)
+	require.NoError(t, err)
+	require.True(t, gasless)
+}
+
 func TestDexPlaceOrderGasless(t *testing.T) {
 	// this needs to be updated if its changed from constant true
 	// reset gasless
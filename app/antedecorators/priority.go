@@ -42,7 +42,7 @@ func isOracleTx(tx sdk.Tx) bool {
 	}
 	for _, msg := range tx.GetMsgs() {
 		switch msg.(type) {
-		case *oracletypes.MsgAggregateExchangeRateVote:
+		case *oracletypes.MsgAggregateExchangeRatePrevote, *oracletypes.MsgAggregateExchangeRateVote:
 			continue
 		default:
 			return false
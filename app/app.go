@@ -45,6 +45,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
+	gogogrpc "github.com/gogo/protobuf/grpc"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	aclmodule "github.com/cosmos/cosmos-sdk/x/accesscontrol"
@@ -126,6 +127,7 @@ import (
 	dexmodule "github.com/sei-protocol/sei-chain/x/dex"
 	dexcache "github.com/sei-protocol/sei-chain/x/dex/cache"
 	dexmodulekeeper "github.com/sei-protocol/sei-chain/x/dex/keeper"
+	dexstream "github.com/sei-protocol/sei-chain/x/dex/stream"
 	dexmoduletypes "github.com/sei-protocol/sei-chain/x/dex/types"
 	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
 
@@ -325,6 +327,8 @@ type App struct {
 	ScopedWasmKeeper     capabilitykeeper.ScopedKeeper
 
 	DexKeeper dexmodulekeeper.Keeper
+	// publishes dex events of committed blocks to streaming subscribers
+	DexStreamer *dexstream.Streamer
 
 	EpochKeeper epochmodulekeeper.Keeper
 
@@ -428,9 +432,12 @@ func New(
 	app.AccountKeeper = authkeeper.NewAccountKeeper(
 		appCodec, keys[authtypes.StoreKey], app.GetSubspace(authtypes.ModuleName), authtypes.ProtoBaseAccount, maccPerms,
 	)
-	app.BankKeeper = bankkeeper.NewBaseKeeperWithDeferredCache(
+	bankKeeper := bankkeeper.NewBaseKeeperWithDeferredCache(
 		appCodec, keys[banktypes.StoreKey], app.AccountKeeper, app.GetSubspace(banktypes.ModuleName), app.ModuleAccountAddrs(), memKeys[banktypes.DeferredCacheStoreKey],
 	)
+	// NOTE: the hooks of the bank keeper are set once the tokenfactory keeper is created
+	hookedBankKeeper := tokenfactorykeeper.NewHookedBankKeeper(bankKeeper)
+	app.BankKeeper = hookedBankKeeper
 	stakingKeeper := stakingkeeper.NewKeeper(
 		appCodec, keys[stakingtypes.StoreKey], app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName),
 	)
@@ -520,7 +527,9 @@ func New(
 		app.keys[tokenfactorytypes.StoreKey],
 		app.GetSubspace(tokenfactorytypes.ModuleName),
 		app.AccountKeeper,
-		app.BankKeeper.(bankkeeper.BaseKeeper).WithMintCoinsRestriction(tokenfactorytypes.NewTokenFactoryDenomMintCoinsRestriction()),
+		// the tokenfactory keeper does not run the bank hooks, so that compliance
+		// actions are not restricted by freezes and pauses
+		bankKeeper.WithMintCoinsRestriction(tokenfactorytypes.NewTokenFactoryDenomMintCoinsRestriction()),
 		app.DistrKeeper,
 	)
 
@@ -575,6 +584,10 @@ func New(
 		wasmOpts...,
 	)
 	app.DexKeeper.SetWasmKeeper(&app.WasmKeeper)
+	// the bank hooks are set once the tokenfactory keeper can call the before
+	// send hook contracts, since the hooks hold a copy of the keeper
+	app.TokenFactoryKeeper.SetContractKeeper(app.WasmKeeper)
+	hookedBankKeeper.SetHooks(app.TokenFactoryKeeper.Hooks())
 	dexModule := dexmodule.NewAppModule(appCodec, app.DexKeeper, app.AccountKeeper, app.BankKeeper, app.WasmKeeper, app.GetBaseApp().TracingInfo)
 	epochModule := epochmodule.NewAppModule(appCodec, app.EpochKeeper, app.AccountKeeper, app.BankKeeper)
 
@@ -587,6 +600,7 @@ func New(
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
 		AddRoute(dexmoduletypes.RouterKey, dexmodule.NewProposalHandler(app.DexKeeper)).
 		AddRoute(minttypes.RouterKey, mint.NewProposalHandler(app.MintKeeper)).
+		AddRoute(oracletypes.RouterKey, oraclemodule.NewProposalHandler(app.OracleKeeper)).
 		AddRoute(tokenfactorytypes.RouterKey, tokenfactorymodule.NewProposalHandler(app.TokenFactoryKeeper)).
 		AddRoute(acltypes.ModuleName, aclmodule.NewProposalHandler(app.AccessControlKeeper))
 	if len(enabledProposals) != 0 {
@@ -624,7 +638,7 @@ func New(
 		aclmodule.NewAppModule(appCodec, app.AccessControlKeeper),
 		auth.NewAppModule(appCodec, app.AccountKeeper, nil),
 		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper),
-		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
+		NewBankAppModule(appCodec, hookedBankKeeper, app.AccountKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
@@ -785,6 +799,13 @@ func New(
 	app.CheckTxMemState = dexcache.NewMemState(app.GetMemKey(dexmoduletypes.MemStoreKey))
 	app.ProcessProposalMemState = dexcache.NewMemState(app.GetMemKey(dexmoduletypes.MemStoreKey))
 	app.MemState = dexcache.NewMemState(app.GetMemKey(dexmoduletypes.MemStoreKey))
+	app.DexStreamer = dexstream.NewStreamer(func(height int64) ([]*dexmoduletypes.StreamEvent, error) {
+		ctx, err := app.CreateQueryContext(height, false)
+		if err != nil {
+			return nil, err
+		}
+		return app.DexKeeper.GetStreamEvents(ctx), nil
+	}, logger)
 
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
@@ -1264,7 +1285,7 @@ func (app *App) PartitionPrioritizedTxs(ctx sdk.Context, txs [][]byte) (prioriti
 	msgLoop:
 		for _, msg := range decodedTx.GetMsgs() {
 			switch msg.(type) {
-			case *oracletypes.MsgAggregateExchangeRateVote:
+			case *oracletypes.MsgAggregateExchangeRatePrevote, *oracletypes.MsgAggregateExchangeRateVote:
 				prioritized = true
 			case *dexmoduletypes.MsgRegisterContract:
 				prioritized = true
@@ -1552,7 +1573,7 @@ func (app *App) GetSubspace(moduleName string) paramstypes.Subspace {
 
 // RegisterAPIRoutes registers all application module routes with the provided
 // API server.
-func (app *App) RegisterAPIRoutes(apiSvr *api.Server, _ config.APIConfig) {
+func (app *App) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig) {
 	clientCtx := apiSvr.ClientCtx
 	rpc.RegisterRoutes(clientCtx, apiSvr.Router)
 	// Register legacy tx routes.
@@ -1565,6 +1586,24 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, _ config.APIConfig) {
 	// Register legacy and grpc-gateway routes for all modules.
 	ModuleBasics.RegisterRESTRoutes(clientCtx, apiSvr.Router)
 	ModuleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+
+	// Register the dex streaming endpoint and start loading the events it streams.
+	app.DexStreamer.Start()
+	apiSvr.Router.Handle(dexstream.WebSocketRoute, dexstream.NewWebSocketHandler(app.DexStreamer, apiConfig.EnableUnsafeCORS))
+}
+
+// RegisterGRPCServer registers the dex streaming service in addition to the query services
+// registered by BaseApp, and starts loading the events it streams.
+func (app *App) RegisterGRPCServer(server gogogrpc.Server) {
+	app.BaseApp.RegisterGRPCServer(server)
+	app.DexStreamer.Start()
+	dexmoduletypes.RegisterStreamServer(server, dexstream.NewGRPCServer(app.DexStreamer))
+}
+
+// Close stops the dex streamer before closing the resources opened by BaseApp.
+func (app *App) Close() error {
+	app.DexStreamer.Close()
+	return app.BaseApp.Close()
 }
 
 // RegisterTxService implements the Application.RegisterTxService method.
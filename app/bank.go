@@ -0,0 +1,35 @@
+package app
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	tokenfactorykeeper "github.com/sei-protocol/sei-chain/x/tokenfactory/keeper"
+)
+
+// BankAppModule wraps the bank module so that its services are served by the
+// hooked bank keeper, while its migrations still run on the base keeper.
+type BankAppModule struct {
+	bank.AppModule
+
+	keeper tokenfactorykeeper.HookedBankKeeper
+}
+
+func NewBankAppModule(cdc codec.Codec, keeper tokenfactorykeeper.HookedBankKeeper, accountKeeper banktypes.AccountKeeper) BankAppModule {
+	return BankAppModule{
+		AppModule: bank.NewAppModule(cdc, keeper, accountKeeper),
+		keeper:    keeper,
+	}
+}
+
+// RegisterServices registers module services.
+func (am BankAppModule) RegisterServices(cfg module.Configurator) {
+	banktypes.RegisterMsgServer(cfg.MsgServer(), bankkeeper.NewMsgServerImpl(am.keeper))
+	banktypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := bankkeeper.NewMigrator(am.keeper.BaseKeeper)
+	cfg.RegisterMigration(banktypes.ModuleName, 1, m.Migrate1to2)
+}
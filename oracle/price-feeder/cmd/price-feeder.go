@@ -19,10 +19,12 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/spf13/cobra"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/credentials"
 
 	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
 	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle"
 	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/client"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/store"
 	v1 "github.com/sei-protocol/sei-chain/oracle/price-feeder/router/v1"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -37,6 +39,8 @@ const (
 	flagLogFormat = "log-format"
 
 	envVariablePass = "PRICE_FEEDER_PASS"
+	// the shared token authenticating the price feeder to its remote signer
+	envVariableSignerToken = "PRICE_FEEDER_SIGNER_TOKEN"
 )
 
 var rootCmd = &cobra.Command{
@@ -57,6 +61,8 @@ func init() {
 	rootCmd.PersistentFlags().String(flagLogFormat, logLevelText, "logging format; must be either json or text")
 
 	rootCmd.AddCommand(getVersionCmd())
+	rootCmd.AddCommand(getReplayCmd())
+	rootCmd.AddCommand(getRemoteSignerCmd())
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
@@ -69,35 +75,11 @@ func Execute() {
 }
 
 func priceFeederCmdHandler(cmd *cobra.Command, args []string) error {
-	logLvlStr, err := cmd.Flags().GetString(flagLogLevel)
-	if err != nil {
-		return err
-	}
-
-	logLvl, err := zerolog.ParseLevel(logLvlStr)
+	logger, err := getLogger(cmd)
 	if err != nil {
 		return err
 	}
 
-	logFormatStr, err := cmd.Flags().GetString(flagLogFormat)
-	if err != nil {
-		return err
-	}
-
-	var logWriter io.Writer
-	switch strings.ToLower(logFormatStr) {
-	case logLevelJSON:
-		logWriter = os.Stderr
-
-	case logLevelText:
-		logWriter = zerolog.ConsoleWriter{Out: os.Stderr}
-
-	default:
-		return fmt.Errorf("invalid logging format: %s", logFormatStr)
-	}
-
-	logger := zerolog.New(logWriter).Level(logLvl).With().Timestamp().Logger()
-
 	cfg, err := config.ParseConfig(args[0])
 	if err != nil {
 		return err
@@ -128,10 +110,12 @@ func priceFeederCmdHandler(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to parse RPC timeout: %w", err)
 	}
 
-	// Gather pass via env variable || std input
-	keyringPass, err := getKeyringPassword()
+	signer, err := newSigner(ctx, cfg)
 	if err != nil {
-		return err
+		return fmt.Errorf("error creating signer: %w", err)
+	}
+	if closer, ok := signer.(io.Closer); ok {
+		defer closer.Close()
 	}
 
 	// Retry creating oracle client for 5 seconds
@@ -141,9 +125,7 @@ func priceFeederCmdHandler(cmd *cobra.Command, args []string) error {
 			ctx,
 			logger,
 			cfg.Account.ChainID,
-			cfg.Keyring.Backend,
-			cfg.Keyring.Dir,
-			keyringPass,
+			signer,
 			cfg.RPC.TMRPCEndpoint,
 			rpcTimeout,
 			cfg.Account.Address,
@@ -169,13 +151,9 @@ func priceFeederCmdHandler(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to parse provider timeout: %w", err)
 	}
 
-	deviations := make(map[string]sdk.Dec, len(cfg.Deviations))
-	for _, deviation := range cfg.Deviations {
-		threshold, err := sdk.NewDecFromStr(deviation.Threshold)
-		if err != nil {
-			return err
-		}
-		deviations[deviation.Base] = threshold
+	deviations, err := parseDeviations(cfg)
+	if err != nil {
+		return err
 	}
 
 	endpoints := make(map[string]config.ProviderEndpoint, len(cfg.ProviderEndpoints))
@@ -183,6 +161,20 @@ func priceFeederCmdHandler(cmd *cobra.Command, args []string) error {
 		endpoints[endpoint.Name] = endpoint
 	}
 
+	genericProviders := make(map[string]config.GenericProvider, len(cfg.GenericProviders))
+	for _, genericProvider := range cfg.GenericProviders {
+		genericProviders[genericProvider.Name] = genericProvider
+	}
+
+	var priceStore *store.PriceStore
+	if cfg.PriceStore.Dir != "" {
+		priceStore, err = openPriceStore(cfg)
+		if err != nil {
+			return err
+		}
+		defer priceStore.Close()
+	}
+
 	oracle := oracle.New(
 		logger,
 		oracleClient,
@@ -190,6 +182,10 @@ func priceFeederCmdHandler(cmd *cobra.Command, args []string) error {
 		providerTimeout,
 		deviations,
 		endpoints,
+		genericProviders,
+		cfg.SeiProviders,
+		priceStore,
+		cfg.ShadowMode,
 		cfg.Healthchecks,
 	)
 
@@ -222,6 +218,94 @@ func priceFeederCmdHandler(cmd *cobra.Command, args []string) error {
 	return g.Wait()
 }
 
+func getLogger(cmd *cobra.Command) (zerolog.Logger, error) {
+	logLvlStr, err := cmd.Flags().GetString(flagLogLevel)
+	if err != nil {
+		return zerolog.Logger{}, err
+	}
+
+	logLvl, err := zerolog.ParseLevel(logLvlStr)
+	if err != nil {
+		return zerolog.Logger{}, err
+	}
+
+	logFormatStr, err := cmd.Flags().GetString(flagLogFormat)
+	if err != nil {
+		return zerolog.Logger{}, err
+	}
+
+	var logWriter io.Writer
+	switch strings.ToLower(logFormatStr) {
+	case logLevelJSON:
+		logWriter = os.Stderr
+
+	case logLevelText:
+		logWriter = zerolog.ConsoleWriter{Out: os.Stderr}
+
+	default:
+		return zerolog.Logger{}, fmt.Errorf("invalid logging format: %s", logFormatStr)
+	}
+
+	return zerolog.New(logWriter).Level(logLvl).With().Timestamp().Logger(), nil
+}
+
+func parseDeviations(cfg config.Config) (map[string]sdk.Dec, error) {
+	deviations := make(map[string]sdk.Dec, len(cfg.Deviations))
+	for _, deviation := range cfg.Deviations {
+		threshold, err := sdk.NewDecFromStr(deviation.Threshold)
+		if err != nil {
+			return nil, err
+		}
+		deviations[deviation.Base] = threshold
+	}
+	return deviations, nil
+}
+
+func openPriceStore(cfg config.Config) (*store.PriceStore, error) {
+	retention, err := time.ParseDuration(cfg.PriceStore.Retention)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse price store retention: %w", err)
+	}
+	return store.Open(cfg.PriceStore.Dir, retention)
+}
+
+// newSigner returns the signer of the transactions of the feeder account
+// defined by the config.
+func newSigner(ctx context.Context, cfg config.Config) (client.Signer, error) {
+	switch cfg.Signer.Type {
+	case config.SignerRemote:
+		timeout, err := time.ParseDuration(cfg.Signer.RemoteTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse remote signer timeout: %w", err)
+		}
+		// the connection is only encrypted if the CA of the remote signer certificate is set
+		var creds credentials.TransportCredentials
+		if len(cfg.Signer.RemoteTLSCA) > 0 {
+			creds, err = credentials.NewClientTLSFromFile(cfg.Signer.RemoteTLSCA, "")
+			if err != nil {
+				return nil, fmt.Errorf("failed to load remote signer CA: %w", err)
+			}
+		}
+		return client.NewRemoteSigner(ctx, cfg.Signer.RemoteAddr, os.Getenv(envVariableSignerToken), creds, timeout)
+
+	case config.SignerFile:
+		return client.NewFileSigner(cfg.Signer.KeyFile)
+
+	default:
+		oracleAddr, err := sdk.AccAddressFromBech32(cfg.Account.Address)
+		if err != nil {
+			return nil, err
+		}
+
+		// Gather pass via env variable || std input
+		keyringPass, err := getKeyringPassword()
+		if err != nil {
+			return nil, err
+		}
+		return client.NewKeyringSigner(cfg.Keyring.Backend, cfg.Keyring.Dir, keyringPass, oracleAddr)
+	}
+}
+
 func getKeyringPassword() (string, error) {
 	reader := bufio.NewReader(os.Stdin)
 
@@ -0,0 +1,155 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/client"
+)
+
+const (
+	flagKeyringBackend = "keyring-backend"
+	flagKeyringDir     = "keyring-dir"
+	flagAddress        = "address"
+	flagChainID        = "chain-id"
+	flagPrefix         = "prefix"
+	flagListenAddr     = "listen-addr"
+	flagValidator      = "validator"
+	flagMaxFee         = "max-fee"
+	flagMaxGas         = "max-gas"
+	flagFeeGranter     = "fee-granter"
+	flagTLSCert        = "tls-cert"
+	flagTLSKey         = "tls-key"
+)
+
+func getRemoteSignerCmd() *cobra.Command {
+	remoteSignerCmd := &cobra.Command{
+		Use:   "remote-signer",
+		Args:  cobra.NoArgs,
+		Short: "Serve the signatures of oracle votes with a key of a local keyring",
+		Long: `Serve a remote signer on the listen address, signing the oracle votes of
+price feeders configured with the remote signer, so that the feeder key is only
+held by the host of the remote signer. Only transactions of the chain whose
+messages are all oracle votes of the validator fed by the feeder account, with a
+fee of at most the max fee, are signed.
+
+Clients are authenticated with the shared token of the PRICE_FEEDER_SIGNER_TOKEN
+environment variable. The connection is only encrypted if a TLS certificate and
+key are set. The keyring password is read from the PRICE_FEEDER_PASS environment
+variable, or prompted if it is not set.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			logger, err := getLogger(cmd)
+			if err != nil {
+				return err
+			}
+
+			backend, _ := cmd.Flags().GetString(flagKeyringBackend)
+			dir, _ := cmd.Flags().GetString(flagKeyringDir)
+			address, _ := cmd.Flags().GetString(flagAddress)
+			chainID, _ := cmd.Flags().GetString(flagChainID)
+			prefix, _ := cmd.Flags().GetString(flagPrefix)
+			listenAddr, _ := cmd.Flags().GetString(flagListenAddr)
+			validator, _ := cmd.Flags().GetString(flagValidator)
+			maxFee, _ := cmd.Flags().GetString(flagMaxFee)
+			maxGas, _ := cmd.Flags().GetUint64(flagMaxGas)
+			feeGranter, _ := cmd.Flags().GetString(flagFeeGranter)
+			tlsCert, _ := cmd.Flags().GetString(flagTLSCert)
+			tlsKey, _ := cmd.Flags().GetString(flagTLSKey)
+
+			oracleAddr, err := sdk.GetFromBech32(address, prefix)
+			if err != nil {
+				return fmt.Errorf("invalid feeder address: %w", err)
+			}
+
+			policy := client.RemoteSignerPolicy{
+				ChainID: chainID,
+				MaxGas:  maxGas,
+			}
+			policy.Validator, err = sdk.GetFromBech32(validator, prefix+sdk.PrefixValidator+sdk.PrefixOperator)
+			if err != nil {
+				return fmt.Errorf("invalid validator address: %w", err)
+			}
+			policy.MaxFee, err = sdk.ParseCoinsNormalized(maxFee)
+			if err != nil {
+				return fmt.Errorf("invalid max fee: %w", err)
+			}
+			if len(feeGranter) > 0 {
+				policy.FeeGranter, err = sdk.GetFromBech32(feeGranter, prefix)
+				if err != nil {
+					return fmt.Errorf("invalid fee granter address: %w", err)
+				}
+			}
+
+			serverOpts := []grpc.ServerOption{}
+			if len(tlsCert) > 0 || len(tlsKey) > 0 {
+				creds, err := credentials.NewServerTLSFromFile(tlsCert, tlsKey)
+				if err != nil {
+					return fmt.Errorf("failed to load TLS certificate: %w", err)
+				}
+				serverOpts = append(serverOpts, grpc.Creds(creds))
+			}
+
+			keyringPass, err := getKeyringPassword()
+			if err != nil {
+				return err
+			}
+
+			signer, err := client.NewKeyringSigner(backend, dir, keyringPass, oracleAddr)
+			if err != nil {
+				return err
+			}
+
+			signerServer, err := client.NewRemoteSignerServer(signer, os.Getenv(envVariableSignerToken), policy)
+			if err != nil {
+				return err
+			}
+
+			listener, err := net.Listen("tcp", listenAddr)
+			if err != nil {
+				return err
+			}
+
+			grpcServer := grpc.NewServer(serverOpts...)
+			signerServer.Register(grpcServer)
+
+			// listen for and trap any OS signal to gracefully shutdown and exit
+			ctx, cancel := context.WithCancel(cmd.Context())
+			defer cancel()
+			trapSignal(cancel, logger)
+
+			go func() {
+				<-ctx.Done()
+				grpcServer.GracefulStop()
+			}()
+
+			logger.Info().Str("listen_addr", listenAddr).Str("address", address).Msg("starting remote signer...")
+			return grpcServer.Serve(listener)
+		},
+	}
+
+	remoteSignerCmd.Flags().String(flagKeyringBackend, "os", "Backend of the keyring holding the feeder key")
+	remoteSignerCmd.Flags().String(flagKeyringDir, "", "Directory of the keyring holding the feeder key")
+	remoteSignerCmd.Flags().String(flagAddress, "", "Address of the feeder account")
+	remoteSignerCmd.Flags().String(flagChainID, "", "Chain ID of the signed transactions")
+	remoteSignerCmd.Flags().String(flagPrefix, "sei", "Bech32 prefix of the feeder address")
+	remoteSignerCmd.Flags().String(flagListenAddr, "127.0.0.1:7272", "Address the remote signer listens on")
+	remoteSignerCmd.Flags().String(flagValidator, "", "Address of the validator fed by the feeder account")
+	remoteSignerCmd.Flags().String(flagMaxFee, "", "Maximum fee of the signed transactions, e.g. 100000usei")
+	remoteSignerCmd.Flags().Uint64(flagMaxGas, 1000000, "Maximum gas limit of the signed transactions")
+	remoteSignerCmd.Flags().String(flagFeeGranter, "", "Address of the fee granter the signed transactions may use")
+	remoteSignerCmd.Flags().String(flagTLSCert, "", "TLS certificate file of the remote signer")
+	remoteSignerCmd.Flags().String(flagTLSKey, "", "TLS key file of the remote signer")
+	_ = remoteSignerCmd.MarkFlagRequired(flagAddress)
+	_ = remoteSignerCmd.MarkFlagRequired(flagChainID)
+	_ = remoteSignerCmd.MarkFlagRequired(flagValidator)
+	_ = remoteSignerCmd.MarkFlagRequired(flagMaxFee)
+
+	return remoteSignerCmd
+}
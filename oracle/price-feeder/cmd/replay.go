@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/store"
+)
+
+const (
+	flagFrom = "from"
+	flagTo   = "to"
+)
+
+// replayResult defines the prices computed from a recorded snapshot.
+type replayResult struct {
+	Timestamp int64              `json:"timestamp"`
+	Prices    map[string]sdk.Dec `json:"prices,omitempty"`
+	Error     string             `json:"error,omitempty"`
+}
+
+func getReplayCmd() *cobra.Command {
+	replayCmd := &cobra.Command{
+		Use:   "replay [config-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Recompute prices from the price store with the settings of a config",
+		Long: `Read the snapshots recorded in the price store of the config and compute
+their prices offline with the currency pairs and deviation thresholds of the
+config, printing one JSON line per snapshot. This allows testing deviation and
+conversion settings against recorded prices.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			logger, err := getLogger(cmd)
+			if err != nil {
+				return err
+			}
+
+			cfg, err := config.ParseConfig(args[0])
+			if err != nil {
+				return err
+			}
+			if cfg.PriceStore.Dir == "" {
+				return fmt.Errorf("the config has no price store")
+			}
+
+			deviations, err := parseDeviations(cfg)
+			if err != nil {
+				return err
+			}
+
+			from, err := getTimeFlag(cmd, flagFrom)
+			if err != nil {
+				return err
+			}
+			to, err := getTimeFlag(cmd, flagTo)
+			if err != nil {
+				return err
+			}
+
+			priceStore, err := openPriceStore(cfg)
+			if err != nil {
+				return err
+			}
+			defer priceStore.Close()
+
+			var printErr error
+			err = priceStore.IterateSnapshots(from, to, func(snapshot store.Snapshot) bool {
+				result := replayResult{Timestamp: snapshot.Timestamp}
+				// snapshots only record the candles that are new since the previous snapshot
+				candles, err := oracle.RecordedCandles(priceStore, snapshot.Timestamp)
+				if err != nil {
+					printErr = err
+					return true
+				}
+				snapshot.Candles = candles
+
+				prices, err := oracle.ComputeRecordedPrices(logger, snapshot, cfg.CurrencyPairs, deviations)
+				if err != nil {
+					result.Error = err.Error()
+				} else {
+					result.Prices = prices
+				}
+
+				bz, err := json.Marshal(result)
+				if err != nil {
+					printErr = err
+					return true
+				}
+				_, printErr = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+				return printErr != nil
+			})
+			if err != nil {
+				return err
+			}
+			return printErr
+		},
+	}
+
+	replayCmd.Flags().String(flagFrom, "", "Replay snapshots recorded from this RFC3339 time")
+	replayCmd.Flags().String(flagTo, "", "Replay snapshots recorded until this RFC3339 time")
+
+	return replayCmd
+}
+
+// getTimeFlag returns the millisecond timestamp of an RFC3339 time flag, or
+// 0 if the flag is not set.
+func getTimeFlag(cmd *cobra.Command, flag string) (int64, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil || value == "" {
+		return 0, err
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s time: %w", flag, err)
+	}
+
+	return t.UnixMilli(), nil
+}
@@ -19,6 +19,10 @@ const (
 	defaultSrvWriteTimeout = 15 * time.Second
 	defaultSrvReadTimeout  = 15 * time.Second
 	defaultProviderTimeout = 100 * time.Millisecond
+	defaultSeiTwapLookback = 10 * time.Minute
+	defaultSeiDexVolume    = "1"
+	defaultStoreRetention  = time.Hour
+	defaultRemoteTimeout   = 5 * time.Second
 
 	// API sources for Sei native oracle price feed - examples include price of BTC, ETH - that applications on Sei can
 	// use
@@ -31,6 +35,15 @@ const (
 	ProviderGate     = "gate"
 	ProviderCoinbase = "coinbase"
 	ProviderMock     = "mock"
+
+	// On-chain sources reading prices of the Sei dex and oracle modules
+	ProviderSeiDex    = "sei-dex"
+	ProviderSeiOracle = "sei-oracle"
+
+	// Signers of the transactions of the feeder account
+	SignerKeyring = "keyring"
+	SignerRemote  = "remote"
+	SignerFile    = "file"
 )
 
 var (
@@ -51,6 +64,9 @@ var (
 		ProviderGate:     {},
 		ProviderCoinbase: {},
 		ProviderMock:     {},
+
+		ProviderSeiDex:    {},
+		ProviderSeiOracle: {},
 	}
 
 	// maxDeviationThreshold is the maxmimum allowed amount of standard
@@ -78,15 +94,20 @@ type (
 		CurrencyPairs     []CurrencyPair     `toml:"currency_pairs" validate:"required,gt=0,dive,required"`
 		Deviations        []Deviation        `toml:"deviation_thresholds"`
 		Account           Account            `toml:"account" validate:"required,gt=0,dive,required"`
-		Keyring           Keyring            `toml:"keyring" validate:"required,gt=0,dive,required"`
+		Keyring           Keyring            `toml:"keyring"`
+		Signer            Signer             `toml:"signer"`
 		RPC               RPC                `toml:"rpc" validate:"required,gt=0,dive,required"`
 		Telemetry         Telemetry          `toml:"telemetry"`
 		GasAdjustment     float64            `toml:"gas_adjustment" validate:"required"`
 		GasPrices         string             `toml:"gas_prices" validate:"required"`
 		ProviderTimeout   string             `toml:"provider_timeout"`
 		ProviderEndpoints []ProviderEndpoint `toml:"provider_endpoints" validate:"dive"`
+		GenericProviders  []GenericProvider  `toml:"generic_providers" validate:"dive"`
+		SeiProviders      SeiProviders       `toml:"sei_providers"`
+		PriceStore        PriceStore         `toml:"price_store"`
 		EnableServer      bool               `toml:"enable_server"`
 		EnableVoter       bool               `toml:"enable_voter"`
+		ShadowMode        bool               `toml:"shadow_mode"`
 		Healthchecks      []Healthchecks     `toml:"healthchecks" validate:"dive"`
 	}
 
@@ -125,10 +146,22 @@ type (
 		Prefix     string `toml:"prefix" validate:"required"`
 	}
 
-	// Keyring defines the required keyring configuration.
+	// Keyring defines the keyring configuration, required by the keyring
+	// signer.
 	Keyring struct {
-		Backend string `toml:"backend" validate:"required"`
-		Dir     string `toml:"dir" validate:"required"`
+		Backend string `toml:"backend"`
+		Dir     string `toml:"dir"`
+	}
+
+	// Signer defines the signer of the transactions of the feeder account:
+	// a key of the keyring, a remote signer server or, for tests, a file
+	// holding a private key.
+	Signer struct {
+		Type          string `toml:"type" validate:"omitempty,oneof=keyring remote file"`
+		RemoteAddr    string `toml:"remote_addr"`
+		RemoteTimeout string `toml:"remote_timeout"`
+		RemoteTLSCA   string `toml:"remote_tls_ca"`
+		KeyFile       string `toml:"key_file"`
 	}
 
 	// RPC defines RPC configuration of both the gRPC and Tendermint nodes.
@@ -182,6 +215,60 @@ type (
 		Websocket string `toml:"websocket"`
 	}
 
+	// GenericProvider defines a REST provider whose JSON ticker responses are read
+	// with the configured paths, so that a venue can be added without code.
+	GenericProvider struct {
+		// Name of the provider, referenced in the providers of currency pairs
+		Name string `toml:"name" validate:"required"`
+
+		// Ticker endpoint, in which {symbol}, {base} and {quote} are replaced with those
+		// of the pair, ex. "https://api.exchange.com/ticker?pair={symbol}"
+		URL string `toml:"url" validate:"required"`
+
+		// Paths of the price, volume and timestamp in the JSON response, as dot separated
+		// object keys and array indices, in which {symbol} is replaced with the symbol of
+		// the pair, ex. "result.{symbol}.c.0"
+		PricePath     string `toml:"price_path" validate:"required"`
+		VolumePath    string `toml:"volume_path" validate:"required"`
+		TimestampPath string `toml:"timestamp_path"`
+
+		// Unit of the timestamp, "s" or "ms" (default)
+		TimestampUnit string `toml:"timestamp_unit" validate:"omitempty,oneof=s ms"`
+
+		// Symbols of the pairs on the provider, ex. { ATOMUSDT = "atom_usdt" }. The symbol
+		// of a pair without mapping is its base followed by its quote, ex. "ATOMUSDT".
+		Symbols map[string]string `toml:"symbols"`
+	}
+
+	// SeiProviders defines the settings of the sei-dex and sei-oracle providers,
+	// which read prices from the chain through the gRPC endpoint.
+	SeiProviders struct {
+		// Address of the dex contract whose pairs are read by the sei-dex provider
+		DexContract string `toml:"dex_contract"`
+
+		// Denoms of the dex pairs by symbol, ex. { ATOM = "uatom" }. A symbol
+		// without mapping is its own denom on the dex.
+		DexDenoms map[string]string `toml:"dex_denoms"`
+
+		// Volume reported with dex prices, which weighs them against exchange
+		// prices (default "1")
+		DexVolume string `toml:"dex_volume"`
+
+		// Lookback of the dex and oracle twaps (default "10m")
+		TwapLookback string `toml:"twap_lookback"`
+	}
+
+	// PriceStore defines an on-disk store of the recent prices and candles of
+	// providers, used to restore candles after a restart and by the replay
+	// command.
+	PriceStore struct {
+		// Directory of the store, which is disabled if empty
+		Dir string `toml:"dir"`
+
+		// Duration for which prices are kept (default "1h")
+		Retention string `toml:"retention"`
+	}
+
 	Healthchecks struct {
 		URL     string `toml:"url" validate:"required"`
 		Timeout string `toml:"timeout" validate:"required"`
@@ -246,6 +333,32 @@ func ParseConfig(configPath string) (Config, error) {
 	if len(cfg.ProviderTimeout) == 0 {
 		cfg.ProviderTimeout = defaultProviderTimeout.String()
 	}
+	if len(cfg.SeiProviders.DexVolume) == 0 {
+		cfg.SeiProviders.DexVolume = defaultSeiDexVolume
+	}
+	if len(cfg.PriceStore.Retention) == 0 {
+		cfg.PriceStore.Retention = defaultStoreRetention.String()
+	}
+	if len(cfg.SeiProviders.TwapLookback) == 0 {
+		cfg.SeiProviders.TwapLookback = defaultSeiTwapLookback.String()
+	}
+	if len(cfg.Signer.Type) == 0 {
+		cfg.Signer.Type = SignerKeyring
+	}
+	if len(cfg.Signer.RemoteTimeout) == 0 {
+		cfg.Signer.RemoteTimeout = defaultRemoteTimeout.String()
+	}
+
+	genericProviders := make(map[string]struct{})
+	for _, gp := range cfg.GenericProviders {
+		if _, ok := SupportedProviders[gp.Name]; ok {
+			return cfg, fmt.Errorf("generic provider name %s is a supported provider", gp.Name)
+		}
+		if _, ok := genericProviders[gp.Name]; ok {
+			return cfg, fmt.Errorf("duplicate generic provider: %s", gp.Name)
+		}
+		genericProviders[gp.Name] = struct{}{}
+	}
 
 	pairs := make(map[string]map[string]struct{})
 	coinQuotes := make(map[string]struct{})
@@ -261,13 +374,27 @@ func ParseConfig(configPath string) (Config, error) {
 		}
 
 		for _, provider := range cp.Providers {
-			if _, ok := SupportedProviders[provider]; !ok {
+			_, supported := SupportedProviders[provider]
+			if _, generic := genericProviders[provider]; !supported && !generic {
 				return cfg, fmt.Errorf("unsupported provider: %s", provider)
 			}
+			if provider == ProviderSeiOracle && strings.ToUpper(cp.Quote) != DenomUSD {
+				return cfg, fmt.Errorf("%s only provides usd quotes: %s", provider, cp.Quote)
+			}
+			if provider == ProviderSeiDex && cfg.SeiProviders.DexContract == "" {
+				return cfg, fmt.Errorf("%s requires a dex contract", provider)
+			}
 			pairs[cp.Base][provider] = struct{}{}
 		}
 	}
 
+	if _, err := sdk.NewDecFromStr(cfg.SeiProviders.DexVolume); err != nil {
+		return cfg, fmt.Errorf("sei dex volume must be numeric: %w", err)
+	}
+	if lookback, err := time.ParseDuration(cfg.SeiProviders.TwapLookback); err != nil || lookback < time.Second {
+		return cfg, fmt.Errorf("invalid sei twap lookback: %s", cfg.SeiProviders.TwapLookback)
+	}
+
 	// Use coinQuotes to ensure that any quotes can be converted to USD.
 	for quote := range coinQuotes {
 		for index, pair := range cfg.CurrencyPairs {
@@ -286,6 +413,32 @@ func ParseConfig(configPath string) (Config, error) {
 		}
 	}
 
+	switch cfg.Signer.Type {
+	case SignerKeyring:
+		if len(cfg.Keyring.Backend) == 0 || len(cfg.Keyring.Dir) == 0 {
+			return cfg, fmt.Errorf("keyring signer requires a keyring backend and dir")
+		}
+	case SignerRemote:
+		if len(cfg.Signer.RemoteAddr) == 0 {
+			return cfg, fmt.Errorf("remote signer requires a remote address")
+		}
+	case SignerFile:
+		if len(cfg.Signer.KeyFile) == 0 {
+			return cfg, fmt.Errorf("file signer requires a key file")
+		}
+	}
+	if timeout, err := time.ParseDuration(cfg.Signer.RemoteTimeout); err != nil || timeout <= 0 {
+		return cfg, fmt.Errorf("invalid remote signer timeout: %s", cfg.Signer.RemoteTimeout)
+	}
+
+	if cfg.ShadowMode && !cfg.EnableVoter {
+		return cfg, fmt.Errorf("shadow mode requires the voter to be enabled")
+	}
+
+	if retention, err := time.ParseDuration(cfg.PriceStore.Retention); err != nil || retention <= 0 {
+		return cfg, fmt.Errorf("invalid price store retention: %s", cfg.PriceStore.Retention)
+	}
+
 	for _, deviation := range cfg.Deviations {
 		threshold, err := sdk.NewDecFromStr(deviation.Threshold)
 		if err != nil {
@@ -1,6 +1,7 @@
 package config_test
 
 import (
+	"fmt"
 	"io/ioutil"
 	"os"
 	"testing"
@@ -225,6 +226,8 @@ timeout = "200ms"
 	require.Len(t, cfg.CurrencyPairs[0].Providers, 3)
 	require.Equal(t, "kraken", cfg.CurrencyPairs[0].Providers[0])
 	require.Equal(t, "binance", cfg.CurrencyPairs[0].Providers[1])
+	require.Empty(t, cfg.PriceStore.Dir)
+	require.Equal(t, "1h0m0s", cfg.PriceStore.Retention)
 }
 
 func TestParseConfig_Valid_NoTelemetry(t *testing.T) {
@@ -341,6 +344,226 @@ providers = [
 	require.Error(t, err)
 }
 
+func TestParseConfig_GenericProvider(t *testing.T) {
+	content := `
+gas_adjustment = 1.5
+gas_prices = "0.00125usei"
+
+[[currency_pairs]]
+base = "ATOM"
+chain_denom = "uatom"
+quote = "USD"
+providers = [
+	"kraken",
+	"binance",
+	"%s"
+]
+
+[[generic_providers]]
+name = "%s"
+url = "https://api.exchange.com/ticker?pair={symbol}"
+price_path = "data.last"
+volume_path = "data.vol"
+timestamp_path = "data.ts"
+timestamp_unit = "%s"
+symbols = { ATOMUSD = "atom_usd" }
+
+[account]
+address = "sei15nejfgcaanqpw25ru4arvfd0fwy6j8clccvwx4"
+validator = "seivalcons14rjlkfzp56733j5l5nfk6fphjxymgf8mj04d5p"
+chain_id = "sei-local-testnet"
+prefix = "sei"
+
+[keyring]
+backend = "test"
+dir = "/Users/username/.sei"
+
+[rpc]
+tmrpc_endpoint = "http://localhost:26657"
+grpc_endpoint = "localhost:9090"
+rpc_timeout = "100ms"
+
+[telemetry]
+enabled = false
+`
+	parse := func(providerName, genericName, timestampUnit string) (config.Config, error) {
+		tmpFile, err := ioutil.TempFile("", "price-feeder.toml")
+		require.NoError(t, err)
+		defer os.Remove(tmpFile.Name())
+
+		_, err = tmpFile.Write([]byte(fmt.Sprintf(content, providerName, genericName, timestampUnit)))
+		require.NoError(t, err)
+
+		return config.ParseConfig(tmpFile.Name())
+	}
+
+	cfg, err := parse("myexchange", "myexchange", "s")
+	require.NoError(t, err)
+	require.Len(t, cfg.GenericProviders, 1)
+	require.Equal(t, "myexchange", cfg.GenericProviders[0].Name)
+	require.Equal(t, "data.last", cfg.GenericProviders[0].PricePath)
+	require.Equal(t, map[string]string{"ATOMUSD": "atom_usd"}, cfg.GenericProviders[0].Symbols)
+
+	// the pair references an undefined provider
+	_, err = parse("foobar", "myexchange", "s")
+	require.Error(t, err)
+
+	// the generic provider shadows a supported provider
+	_, err = parse("kraken", "kraken", "s")
+	require.Error(t, err)
+
+	// invalid timestamp unit
+	_, err = parse("myexchange", "myexchange", "ns")
+	require.Error(t, err)
+}
+
+func TestParseConfig_SeiProviders(t *testing.T) {
+	content := `
+gas_adjustment = 1.5
+gas_prices = "0.00125usei"
+
+[[currency_pairs]]
+base = "ATOM"
+chain_denom = "uatom"
+quote = "%s"
+providers = [
+	"kraken",
+	"sei-dex",
+	"sei-oracle"
+]
+
+[[currency_pairs]]
+base = "USDC"
+chain_denom = "uusdc"
+quote = "USD"
+providers = [
+	"kraken",
+	"binance",
+	"huobi"
+]
+
+[sei_providers]
+dex_contract = "%s"
+dex_denoms = { ATOM = "uatom", USDC = "uusdc" }
+
+[account]
+address = "sei15nejfgcaanqpw25ru4arvfd0fwy6j8clccvwx4"
+validator = "seivalcons14rjlkfzp56733j5l5nfk6fphjxymgf8mj04d5p"
+chain_id = "sei-local-testnet"
+prefix = "sei"
+
+[keyring]
+backend = "test"
+dir = "/Users/username/.sei"
+
+[rpc]
+tmrpc_endpoint = "http://localhost:26657"
+grpc_endpoint = "localhost:9090"
+rpc_timeout = "100ms"
+
+[telemetry]
+enabled = false
+`
+	parse := func(quote, dexContract string) (config.Config, error) {
+		tmpFile, err := ioutil.TempFile("", "price-feeder.toml")
+		require.NoError(t, err)
+		defer os.Remove(tmpFile.Name())
+
+		_, err = tmpFile.Write([]byte(fmt.Sprintf(content, quote, dexContract)))
+		require.NoError(t, err)
+
+		return config.ParseConfig(tmpFile.Name())
+	}
+
+	cfg, err := parse("USD", "sei1contract")
+	require.NoError(t, err)
+	require.Equal(t, "sei1contract", cfg.SeiProviders.DexContract)
+	require.Equal(t, "uatom", cfg.SeiProviders.DexDenoms["ATOM"])
+	require.Equal(t, "1", cfg.SeiProviders.DexVolume)
+	require.Equal(t, "10m0s", cfg.SeiProviders.TwapLookback)
+
+	// the oracle only has usd exchange rates
+	_, err = parse("USDC", "sei1contract")
+	require.Error(t, err)
+
+	// the dex provider requires a contract
+	_, err = parse("USD", "")
+	require.Error(t, err)
+}
+
+func TestParseConfig_Signer(t *testing.T) {
+	content := `
+gas_adjustment = 1.5
+gas_prices = "0.00125usei"
+
+[[currency_pairs]]
+base = "ATOM"
+chain_denom = "uatom"
+quote = "USDT"
+providers = [
+	"kraken",
+	"binance",
+	"huobi"
+]
+
+[[currency_pairs]]
+base = "USDT"
+chain_denom = "uusdt"
+quote = "USD"
+providers = [
+	"kraken",
+	"binance",
+	"huobi"
+]
+
+[account]
+address = "sei15nejfgcaanqpw25ru4arvfd0fwy6j8clccvwx4"
+validator = "seivalcons14rjlkfzp56733j5l5nfk6fphjxymgf8mj04d5p"
+chain_id = "sei-local-testnet"
+prefix = "sei"
+
+%s
+
+[rpc]
+tmrpc_endpoint = "http://localhost:26657"
+grpc_endpoint = "localhost:9090"
+rpc_timeout = "100ms"
+
+[telemetry]
+enabled = false
+`
+	parse := func(signer string) (config.Config, error) {
+		tmpFile, err := ioutil.TempFile("", "price-feeder.toml")
+		require.NoError(t, err)
+		defer os.Remove(tmpFile.Name())
+
+		_, err = tmpFile.Write([]byte(fmt.Sprintf(content, signer)))
+		require.NoError(t, err)
+
+		return config.ParseConfig(tmpFile.Name())
+	}
+
+	cfg, err := parse("[keyring]\nbackend = \"test\"\ndir = \"/root/.sei\"")
+	require.NoError(t, err)
+	require.Equal(t, config.SignerKeyring, cfg.Signer.Type)
+	require.Equal(t, "5s", cfg.Signer.RemoteTimeout)
+
+	// the keyring signer requires a keyring
+	_, err = parse("")
+	require.Error(t, err)
+
+	cfg, err = parse("[signer]\ntype = \"remote\"\nremote_addr = \"10.0.0.2:7272\"")
+	require.NoError(t, err)
+	require.Equal(t, "10.0.0.2:7272", cfg.Signer.RemoteAddr)
+
+	// the remote signer requires an address
+	_, err = parse("[signer]\ntype = \"remote\"")
+	require.Error(t, err)
+
+	_, err = parse("[signer]\ntype = \"ledger\"")
+	require.Error(t, err)
+}
+
 func TestParseConfig_NonUSDQuote(t *testing.T) {
 	tmpFile, err := ioutil.TempFile("", "price-feeder.toml")
 	require.NoError(t, err)
@@ -1,10 +1,8 @@
 package client
 
 import (
-	"bytes"
 	"context"
 	"fmt"
-	"io"
 	"os"
 	"time"
 
@@ -12,7 +10,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/client/tx"
-	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -30,9 +27,7 @@ type (
 	OracleClient struct {
 		Logger              zerolog.Logger
 		ChainID             string
-		KeyringBackend      string
-		KeyringDir          string
-		KeyringPass         string
+		Signer              Signer
 		TMRPC               string
 		RPCTimeout          time.Duration
 		OracleAddr          sdk.AccAddress
@@ -44,26 +39,18 @@ type (
 		GasPrices           string
 		GasAdjustment       float64
 		GRPCEndpoint        string
-		KeyringPassphrase   string
 		BlockHeightEvents   chan int64
 
 		// MockBroadcastTx allows for a basic mock without refactoring this to an interface
 		MockBroadcastTx func(clientCtx client.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error)
 	}
-
-	passReader struct {
-		pass string
-		buf  *bytes.Buffer
-	}
 )
 
 func NewOracleClient(
 	ctx context.Context,
 	logger zerolog.Logger,
 	chainID string,
-	keyringBackend string,
-	keyringDir string,
-	keyringPass string,
+	signer Signer,
 	tmRPC string,
 	rpcTimeout time.Duration,
 	oracleAddrString string,
@@ -78,14 +65,16 @@ func NewOracleClient(
 		return OracleClient{}, err
 	}
 
+	if signerAddr := sdk.AccAddress(signer.PubKey().Address()); !signerAddr.Equals(oracleAddr) {
+		return OracleClient{}, fmt.Errorf("signer address %s does not match feeder address %s", signerAddr, oracleAddr)
+	}
+
 	feegrantAddrErr, _ := sdk.AccAddressFromBech32(feeGranterAddrString)
 
 	oracleClient := OracleClient{
 		Logger:              logger.With().Str("module", "oracle_client").Logger(),
 		ChainID:             chainID,
-		KeyringBackend:      keyringBackend,
-		KeyringDir:          keyringDir,
-		KeyringPass:         keyringPass,
+		Signer:              signer,
 		TMRPC:               tmRPC,
 		RPCTimeout:          rpcTimeout,
 		OracleAddr:          oracleAddr,
@@ -124,24 +113,6 @@ func NewOracleClient(
 	return oracleClient, nil
 }
 
-func newPassReader(pass string) io.Reader {
-	return &passReader{
-		pass: pass,
-		buf:  new(bytes.Buffer),
-	}
-}
-
-func (r *passReader) Read(p []byte) (n int, err error) {
-	n, err = r.buf.Read(p)
-	if err == io.EOF || n == 0 {
-		r.buf.WriteString(r.pass + "\n")
-
-		n, err = r.buf.Read(p)
-	}
-
-	return n, err
-}
-
 // BroadcastTx attempts to broadcast a signed transaction in best effort mode.
 // Retry is not needed since we are doing this for every new block as fast as we could.
 // Ref: https://github.com/terra-money/oracle-feeder/blob/baef2a4a02f57a2ffeaa207932b2e03d7fb0fb25/feeder/src/vote.ts#L230
@@ -180,7 +151,7 @@ func (oc OracleClient) BroadcastTx(
 	}
 
 	// Sign the transaction
-	if err = tx.Sign(txf, clientCtx.GetFromName(), transaction, true); err != nil {
+	if err = signTx(txf, clientCtx.TxConfig, oc.Signer, transaction); err != nil {
 		return nil, err
 	}
 
@@ -210,18 +181,6 @@ func (oc OracleClient) BroadcastTx(
 // CreateClientContext creates an SDK client Context instance used for transaction
 // generation, signing and broadcasting.
 func (oc OracleClient) CreateClientContext() (client.Context, error) {
-	var keyringInput io.Reader
-	if len(oc.KeyringPass) > 0 {
-		keyringInput = newPassReader(oc.KeyringPass)
-	} else {
-		keyringInput = os.Stdin
-	}
-
-	kr, err := keyring.New("sei", oc.KeyringBackend, oc.KeyringDir, keyringInput)
-	if err != nil {
-		return client.Context{}, err
-	}
-
 	httpClient, err := tmjsonclient.DefaultHTTPClient(oc.TMRPC)
 	if err != nil {
 		return client.Context{}, err
@@ -234,11 +193,6 @@ func (oc OracleClient) CreateClientContext() (client.Context, error) {
 		return client.Context{}, err
 	}
 
-	keyInfo, err := kr.KeyByAddress(oc.OracleAddr)
-	if err != nil {
-		return client.Context{}, err
-	}
-
 	clientCtx := client.Context{
 		ChainID:           oc.ChainID,
 		JSONCodec:         oc.Encoding.Marshaler,
@@ -252,10 +206,8 @@ func (oc OracleClient) CreateClientContext() (client.Context, error) {
 		Input:             os.Stdin,
 		NodeURI:           oc.TMRPC,
 		Client:            tmRPC,
-		Keyring:           kr,
 		FromAddress:       oc.OracleAddr,
-		FromName:          keyInfo.GetName(),
-		From:              keyInfo.GetName(),
+		From:              oc.OracleAddrString,
 		OutputFormat:      "json",
 		UseLedger:         false,
 		Simulate:          false,
@@ -282,7 +234,6 @@ func (oc OracleClient) CreateTxFactory() (tx.Factory, error) {
 		WithTxConfig(clientCtx.TxConfig).
 		WithGasAdjustment(oc.GasAdjustment).
 		WithGasPrices(oc.GasPrices).
-		WithKeybase(clientCtx.Keyring).
 		WithSignMode(signing.SignMode_SIGN_MODE_DIRECT).
 		WithSimulateAndExecute(true)
 
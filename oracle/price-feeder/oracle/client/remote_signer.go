@@ -0,0 +1,391 @@
+package client
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	oracletypes "github.com/sei-protocol/sei-chain/x/oracle/types"
+)
+
+// The remote signer protocol is a gRPC service of two methods: PubKey returns
+// the compressed secp256k1 public key of the feeder account, and Sign returns
+// the signature of SIGN_MODE_DIRECT sign bytes. Both methods require the
+// shared token of the server in the authorization metadata of the request.
+const (
+	signerServiceName  = "seiprotocol.pricefeeder.Signer"
+	signerPubKeyMethod = "/" + signerServiceName + "/PubKey"
+	signerSignMethod   = "/" + signerServiceName + "/Sign"
+	signerAuthKey      = "authorization"
+	signerAuthScheme   = "Bearer "
+)
+
+var (
+	_ Signer                        = (*RemoteSigner)(nil)
+	_ remoteSignerServer            = (*RemoteSignerServer)(nil)
+	_ credentials.PerRPCCredentials = tokenCredentials{}
+)
+
+type (
+	// RemoteSigner defines a signer requesting signatures from a remote signer
+	// server over gRPC.
+	RemoteSigner struct {
+		conn    *grpc.ClientConn
+		timeout time.Duration
+		pubKey  cryptotypes.PubKey
+	}
+
+	// RemoteSignerServer defines a remote signer server signing the oracle
+	// votes of a chain with a signer. Sign requests without the token of the
+	// server, or for transactions not allowed by its policy, are rejected.
+	RemoteSignerServer struct {
+		signer  Signer
+		token   string
+		policy  RemoteSignerPolicy
+		address sdk.AccAddress
+	}
+
+	// RemoteSignerPolicy defines the transactions signed by a remote signer
+	// server: oracle votes of the validator on the chain, whose fee is at most
+	// the max fee, gas limit at most the max gas, and fee granter, if any, the
+	// fee granter of the policy.
+	RemoteSignerPolicy struct {
+		ChainID    string
+		Validator  sdk.ValAddress
+		MaxFee     sdk.Coins
+		MaxGas     uint64
+		FeeGranter sdk.AccAddress
+	}
+
+	// tokenCredentials defines the per request credentials of a remote signer
+	// authenticated with a shared token.
+	tokenCredentials struct {
+		token      string
+		requireTLS bool
+	}
+
+	remoteSignerServer interface {
+		PubKey(context.Context, *emptypb.Empty) (*wrapperspb.BytesValue, error)
+		Sign(context.Context, *wrapperspb.BytesValue) (*wrapperspb.BytesValue, error)
+	}
+)
+
+// NewRemoteSigner returns a signer connected to the remote signer server at
+// the address, authenticated with the token, requesting its public key. The
+// connection is secured with the transport credentials, or not encrypted if
+// they are nil, in which case the server must only be reachable over a private
+// network.
+func NewRemoteSigner(
+	ctx context.Context,
+	addr string,
+	token string,
+	creds credentials.TransportCredentials,
+	timeout time.Duration,
+) (*RemoteSigner, error) {
+	if len(token) == 0 {
+		return nil, fmt.Errorf("remote signer requires a token")
+	}
+
+	transportOpt := grpc.WithInsecure()
+	if creds != nil {
+		transportOpt = grpc.WithTransportCredentials(creds)
+	}
+	conn, err := grpc.Dial(
+		addr,
+		transportOpt,
+		grpc.WithPerRPCCredentials(tokenCredentials{token: token, requireTLS: creds != nil}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial remote signer: %w", err)
+	}
+
+	signer := &RemoteSigner{
+		conn:    conn,
+		timeout: timeout,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	resp := &wrapperspb.BytesValue{}
+	if err := conn.Invoke(ctx, signerPubKeyMethod, &emptypb.Empty{}, resp); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to get remote signer public key: %w", err)
+	}
+	if len(resp.Value) != secp256k1.PubKeySize {
+		conn.Close()
+		return nil, fmt.Errorf("invalid remote signer public key size: %d", len(resp.Value))
+	}
+	signer.pubKey = &secp256k1.PubKey{Key: resp.Value}
+
+	return signer, nil
+}
+
+// Close closes the connection to the remote signer server.
+func (s *RemoteSigner) Close() error {
+	return s.conn.Close()
+}
+
+// PubKey implements the Signer interface.
+func (s *RemoteSigner) PubKey() cryptotypes.PubKey {
+	return s.pubKey
+}
+
+// Sign implements the Signer interface.
+func (s *RemoteSigner) Sign(signBytes []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	resp := &wrapperspb.BytesValue{}
+	if err := s.conn.Invoke(ctx, signerSignMethod, wrapperspb.Bytes(signBytes), resp); err != nil {
+		return nil, fmt.Errorf("failed to get remote signature: %w", err)
+	}
+
+	return resp.Value, nil
+}
+
+// GetRequestMetadata implements the PerRPCCredentials interface.
+func (c tokenCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{signerAuthKey: signerAuthScheme + c.token}, nil
+}
+
+// RequireTransportSecurity implements the PerRPCCredentials interface.
+func (c tokenCredentials) RequireTransportSecurity() bool {
+	return c.requireTLS
+}
+
+// NewRemoteSignerServer returns a remote signer server signing the oracle votes
+// allowed by the policy with the signer, which must hold a secp256k1 key, for
+// clients authenticated with the token.
+func NewRemoteSignerServer(signer Signer, token string, policy RemoteSignerPolicy) (*RemoteSignerServer, error) {
+	if _, ok := signer.PubKey().(*secp256k1.PubKey); !ok {
+		return nil, fmt.Errorf("unsupported remote signer key type: %s", signer.PubKey().Type())
+	}
+	if len(token) == 0 {
+		return nil, fmt.Errorf("remote signer server requires a token")
+	}
+	if len(policy.ChainID) == 0 {
+		return nil, fmt.Errorf("remote signer server requires a chain id")
+	}
+	if policy.Validator.Empty() {
+		return nil, fmt.Errorf("remote signer server requires a validator")
+	}
+	if !policy.MaxFee.IsValid() {
+		return nil, fmt.Errorf("invalid remote signer max fee: %s", policy.MaxFee)
+	}
+
+	return &RemoteSignerServer{
+		signer:  signer,
+		token:   token,
+		policy:  policy,
+		address: sdk.AccAddress(signer.PubKey().Address()),
+	}, nil
+}
+
+// Register registers the remote signer service on the gRPC server.
+func (s *RemoteSignerServer) Register(server *grpc.Server) {
+	server.RegisterService(&remoteSignerServiceDesc, s)
+}
+
+// PubKey returns the compressed public key of the signer.
+func (s *RemoteSignerServer) PubKey(ctx context.Context, _ *emptypb.Empty) (*wrapperspb.BytesValue, error) {
+	if err := s.authenticate(ctx); err != nil {
+		return nil, err
+	}
+
+	return wrapperspb.Bytes(s.signer.PubKey().Bytes()), nil
+}
+
+// Sign signs the sign bytes of an oracle vote transaction allowed by the
+// policy of the server.
+func (s *RemoteSignerServer) Sign(ctx context.Context, req *wrapperspb.BytesValue) (*wrapperspb.BytesValue, error) {
+	if err := s.authenticate(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.validateSignDoc(req.Value); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	sig, err := s.signer.Sign(req.Value)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return wrapperspb.Bytes(sig), nil
+}
+
+// authenticate checks the request carries the token of the server.
+func (s *RemoteSignerServer) authenticate(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, auth := range md.Get(signerAuthKey) {
+		if subtle.ConstantTimeCompare([]byte(auth), []byte(signerAuthScheme+s.token)) == 1 {
+			return nil
+		}
+	}
+
+	return status.Error(codes.Unauthenticated, "invalid remote signer token")
+}
+
+// validateSignDoc checks the sign bytes are a SIGN_MODE_DIRECT sign doc of the
+// chain, whose messages are all oracle votes of the validator fed by the signer
+// and whose fee is allowed by the policy of the server.
+func (s *RemoteSignerServer) validateSignDoc(signBytes []byte) error {
+	var signDoc txtypes.SignDoc
+	if err := signDoc.Unmarshal(signBytes); err != nil {
+		return fmt.Errorf("invalid sign doc: %w", err)
+	}
+	if signDoc.ChainId != s.policy.ChainID {
+		return fmt.Errorf("unexpected chain id: %s", signDoc.ChainId)
+	}
+
+	var body txtypes.TxBody
+	if err := body.Unmarshal(signDoc.BodyBytes); err != nil {
+		return fmt.Errorf("invalid tx body: %w", err)
+	}
+	if len(body.Messages) == 0 {
+		return fmt.Errorf("tx has no messages")
+	}
+	for _, msg := range body.Messages {
+		if err := s.validateMsg(msg.TypeUrl, msg.Value); err != nil {
+			return err
+		}
+	}
+
+	var authInfo txtypes.AuthInfo
+	if err := authInfo.Unmarshal(signDoc.AuthInfoBytes); err != nil {
+		return fmt.Errorf("invalid auth info: %w", err)
+	}
+
+	return s.validateAuthInfo(authInfo)
+}
+
+// validateMsg checks the message is a valid oracle vote of the validator of the
+// policy, fed by the signer.
+func (s *RemoteSignerServer) validateMsg(typeURL string, value []byte) error {
+	var (
+		msg               sdk.Msg
+		feeder, validator string
+	)
+	switch typeURL {
+	case sdk.MsgTypeURL(&oracletypes.MsgAggregateExchangeRatePrevote{}):
+		prevote := &oracletypes.MsgAggregateExchangeRatePrevote{}
+		if err := prevote.Unmarshal(value); err != nil {
+			return fmt.Errorf("invalid prevote: %w", err)
+		}
+		msg, feeder, validator = prevote, prevote.Feeder, prevote.Validator
+
+	case sdk.MsgTypeURL(&oracletypes.MsgAggregateExchangeRateVote{}):
+		vote := &oracletypes.MsgAggregateExchangeRateVote{}
+		if err := vote.Unmarshal(value); err != nil {
+			return fmt.Errorf("invalid vote: %w", err)
+		}
+		msg, feeder, validator = vote, vote.Feeder, vote.Validator
+
+	default:
+		return fmt.Errorf("unexpected message type: %s", typeURL)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid %s: %w", typeURL, err)
+	}
+	if feeder != s.address.String() {
+		return fmt.Errorf("unexpected feeder: %s", feeder)
+	}
+	if validator != s.policy.Validator.String() {
+		return fmt.Errorf("unexpected validator: %s", validator)
+	}
+
+	return nil
+}
+
+// validateAuthInfo checks the transaction is only signed by the signer, and its
+// fee is allowed by the policy of the server.
+func (s *RemoteSignerServer) validateAuthInfo(authInfo txtypes.AuthInfo) error {
+	if len(authInfo.SignerInfos) != 1 {
+		return fmt.Errorf("unexpected number of signers: %d", len(authInfo.SignerInfos))
+	}
+	pubKey := authInfo.SignerInfos[0].PublicKey
+	if pubKey == nil || pubKey.TypeUrl != "/"+proto.MessageName(&secp256k1.PubKey{}) {
+		return fmt.Errorf("unexpected signer public key")
+	}
+	var signerKey secp256k1.PubKey
+	if err := signerKey.Unmarshal(pubKey.Value); err != nil || !signerKey.Equals(s.signer.PubKey()) {
+		return fmt.Errorf("unexpected signer public key")
+	}
+
+	fee := authInfo.Fee
+	if fee == nil {
+		return fmt.Errorf("tx has no fee")
+	}
+	if !fee.Amount.IsValid() {
+		return fmt.Errorf("invalid fee: %s", fee.Amount)
+	}
+	if !fee.Amount.IsAllLTE(s.policy.MaxFee) {
+		return fmt.Errorf("fee %s exceeds max fee %s", fee.Amount, s.policy.MaxFee)
+	}
+	if fee.GasLimit > s.policy.MaxGas {
+		return fmt.Errorf("gas limit %d exceeds max gas %d", fee.GasLimit, s.policy.MaxGas)
+	}
+	if len(fee.Payer) > 0 && fee.Payer != s.address.String() {
+		return fmt.Errorf("unexpected fee payer: %s", fee.Payer)
+	}
+	if len(fee.Granter) > 0 && fee.Granter != s.policy.FeeGranter.String() {
+		return fmt.Errorf("unexpected fee granter: %s", fee.Granter)
+	}
+
+	return nil
+}
+
+var remoteSignerServiceDesc = grpc.ServiceDesc{
+	ServiceName: signerServiceName,
+	HandlerType: (*remoteSignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PubKey",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				req := &emptypb.Empty{}
+				if err := dec(req); err != nil {
+					return nil, err
+				}
+				if interceptor == nil {
+					return srv.(remoteSignerServer).PubKey(ctx, req)
+				}
+				info := &grpc.UnaryServerInfo{Server: srv, FullMethod: signerPubKeyMethod}
+				return interceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+					return srv.(remoteSignerServer).PubKey(ctx, req.(*emptypb.Empty))
+				})
+			},
+		},
+		{
+			MethodName: "Sign",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				req := &wrapperspb.BytesValue{}
+				if err := dec(req); err != nil {
+					return nil, err
+				}
+				if interceptor == nil {
+					return srv.(remoteSignerServer).Sign(ctx, req)
+				}
+				info := &grpc.UnaryServerInfo{Server: srv, FullMethod: signerSignMethod}
+				return interceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+					return srv.(remoteSignerServer).Sign(ctx, req.(*wrapperspb.BytesValue))
+				})
+			},
+		},
+	},
+	Streams: []grpc.StreamDesc{},
+}
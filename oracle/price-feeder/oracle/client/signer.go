@@ -0,0 +1,182 @@
+package client
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+var (
+	_ Signer = (*KeyringSigner)(nil)
+	_ Signer = (*PrivKeySigner)(nil)
+)
+
+type (
+	// Signer defines the interface of the signer of the transactions of the
+	// feeder account, so the feeder key does not have to be held by the price
+	// feeder.
+	Signer interface {
+		// PubKey returns the public key of the feeder account.
+		PubKey() cryptotypes.PubKey
+		// Sign returns the signature of the sign bytes of a transaction.
+		Sign(signBytes []byte) ([]byte, error)
+	}
+
+	// KeyringSigner defines a signer with a key of a local keyring.
+	KeyringSigner struct {
+		keyring keyring.Keyring
+		address sdk.AccAddress
+		pubKey  cryptotypes.PubKey
+	}
+
+	// PrivKeySigner defines a signer holding a private key in memory.
+	PrivKeySigner struct {
+		privKey cryptotypes.PrivKey
+	}
+
+	passReader struct {
+		pass string
+		buf  *bytes.Buffer
+	}
+)
+
+// NewKeyringSigner returns a signer with the key of the address in the keyring
+// of the backend and dir. The keyring password is read from stdin if empty.
+func NewKeyringSigner(backend, dir, pass string, address sdk.AccAddress) (*KeyringSigner, error) {
+	var keyringInput io.Reader
+	if len(pass) > 0 {
+		keyringInput = newPassReader(pass)
+	} else {
+		keyringInput = os.Stdin
+	}
+
+	kr, err := keyring.New("sei", backend, dir, keyringInput)
+	if err != nil {
+		return nil, err
+	}
+
+	keyInfo, err := kr.KeyByAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeyringSigner{
+		keyring: kr,
+		address: address,
+		pubKey:  keyInfo.GetPubKey(),
+	}, nil
+}
+
+// PubKey implements the Signer interface.
+func (s *KeyringSigner) PubKey() cryptotypes.PubKey {
+	return s.pubKey
+}
+
+// Sign implements the Signer interface.
+func (s *KeyringSigner) Sign(signBytes []byte) ([]byte, error) {
+	sig, _, err := s.keyring.SignByAddress(s.address, signBytes)
+	return sig, err
+}
+
+// NewPrivKeySigner returns a signer with the private key.
+func NewPrivKeySigner(privKey cryptotypes.PrivKey) *PrivKeySigner {
+	return &PrivKeySigner{privKey: privKey}
+}
+
+// NewFileSigner returns a signer with the hex encoded secp256k1 private key of
+// the file. It keeps the key in memory and is meant for tests.
+func NewFileSigner(path string) (*PrivKeySigner, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key file: %w", err)
+	}
+
+	key, err := hex.DecodeString(strings.TrimSpace(string(bz)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode key file: %w", err)
+	}
+	if len(key) != secp256k1.PrivKeySize {
+		return nil, fmt.Errorf("invalid key size: %d", len(key))
+	}
+
+	return NewPrivKeySigner(&secp256k1.PrivKey{Key: key}), nil
+}
+
+// PubKey implements the Signer interface.
+func (s *PrivKeySigner) PubKey() cryptotypes.PubKey {
+	return s.privKey.PubKey()
+}
+
+// Sign implements the Signer interface.
+func (s *PrivKeySigner) Sign(signBytes []byte) ([]byte, error) {
+	return s.privKey.Sign(signBytes)
+}
+
+func newPassReader(pass string) io.Reader {
+	return &passReader{
+		pass: pass,
+		buf:  new(bytes.Buffer),
+	}
+}
+
+func (r *passReader) Read(p []byte) (n int, err error) {
+	n, err = r.buf.Read(p)
+	if err == io.EOF || n == 0 {
+		r.buf.WriteString(r.pass + "\n")
+
+		n, err = r.buf.Read(p)
+	}
+
+	return n, err
+}
+
+// signTx signs the transaction with the signer, overwriting previous
+// signatures, the way tx.Sign does with a keyring.
+func signTx(txf tx.Factory, txConfig client.TxConfig, signer Signer, txBuilder client.TxBuilder) error {
+	signMode := txf.SignMode()
+	if signMode == signing.SignMode_SIGN_MODE_UNSPECIFIED {
+		signMode = txConfig.SignModeHandler().DefaultMode()
+	}
+
+	signerData := authsigning.SignerData{
+		ChainID:       txf.ChainID(),
+		AccountNumber: txf.AccountNumber(),
+		Sequence:      txf.Sequence(),
+	}
+
+	// the signer infos are part of the sign bytes in SIGN_MODE_DIRECT, so they
+	// are set with an empty signature first
+	sig := signing.SignatureV2{
+		PubKey:   signer.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signMode},
+		Sequence: txf.Sequence(),
+	}
+	if err := txBuilder.SetSignatures(sig); err != nil {
+		return err
+	}
+
+	bytesToSign, err := txConfig.SignModeHandler().GetSignBytes(signMode, signerData, txBuilder.GetTx())
+	if err != nil {
+		return err
+	}
+
+	sigBytes, err := signer.Sign(bytesToSign)
+	if err != nil {
+		return fmt.Errorf("failed to sign transaction: %w", err)
+	}
+
+	sig.Data = &signing.SingleSignatureData{SignMode: signMode, Signature: sigBytes}
+	return txBuilder.SetSignatures(sig)
+}
@@ -0,0 +1,203 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	oracletypes "github.com/sei-protocol/sei-chain/x/oracle/types"
+)
+
+const (
+	testChainID     = "sei-test"
+	testSignerToken = "secret"
+)
+
+// signAndVerify signs a transaction of the messages with the signer and
+// verifies its signature against the public key of the signer.
+func signAndVerify(t *testing.T, signer Signer, msgs ...sdk.Msg) error {
+	return signAndVerifyWith(t, signer, func(sdkclient.TxBuilder) {}, msgs...)
+}
+
+// signAndVerifyWith signs a transaction of the messages, updated by the
+// function before signing, with the signer and verifies its signature.
+func signAndVerifyWith(t *testing.T, signer Signer, update func(sdkclient.TxBuilder), msgs ...sdk.Msg) error {
+	txConfig := simapp.MakeTestEncodingConfig().TxConfig
+	txf := tx.Factory{}.
+		WithChainID(testChainID).
+		WithTxConfig(txConfig).
+		WithSignMode(signing.SignMode_SIGN_MODE_DIRECT).
+		WithAccountNumber(1).
+		WithSequence(2)
+
+	txBuilder, err := txf.BuildUnsignedTx(msgs...)
+	require.NoError(t, err)
+	update(txBuilder)
+
+	if err := signTx(txf, txConfig, signer, txBuilder); err != nil {
+		return err
+	}
+
+	verifySignature(t, txConfig, txBuilder, signer)
+	return nil
+}
+
+func verifySignature(t *testing.T, txConfig sdkclient.TxConfig, txBuilder sdkclient.TxBuilder, signer Signer) {
+	sigs, err := txBuilder.GetTx().GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	require.True(t, signer.PubKey().Equals(sigs[0].PubKey))
+
+	signBytes, err := txConfig.SignModeHandler().GetSignBytes(
+		signing.SignMode_SIGN_MODE_DIRECT,
+		authsigning.SignerData{ChainID: testChainID, AccountNumber: 1, Sequence: 2},
+		txBuilder.GetTx(),
+	)
+	require.NoError(t, err)
+
+	sigData := sigs[0].Data.(*signing.SingleSignatureData)
+	require.True(t, signer.PubKey().VerifySignature(signBytes, sigData.Signature))
+}
+
+func voteMsg(signer Signer) sdk.Msg {
+	addr := sdk.AccAddress(signer.PubKey().Address())
+	return &oracletypes.MsgAggregateExchangeRateVote{
+		ExchangeRates: "1.0uatom",
+		Feeder:        addr.String(),
+		Validator:     sdk.ValAddress(addr).String(),
+	}
+}
+
+func TestPrivKeySigner(t *testing.T) {
+	signer := NewPrivKeySigner(secp256k1.GenPrivKey())
+	require.NoError(t, signAndVerify(t, signer, voteMsg(signer)))
+}
+
+func TestFileSigner(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	path := filepath.Join(t.TempDir(), "key")
+	require.NoError(t, os.WriteFile(path, []byte(hex.EncodeToString(privKey.Bytes())+"\n"), 0o600))
+
+	signer, err := NewFileSigner(path)
+	require.NoError(t, err)
+	require.True(t, privKey.PubKey().Equals(signer.PubKey()))
+	require.NoError(t, signAndVerify(t, signer, voteMsg(signer)))
+
+	require.NoError(t, os.WriteFile(path, []byte("0102"), 0o600))
+	_, err = NewFileSigner(path)
+	require.Error(t, err)
+}
+
+func TestRemoteSigner(t *testing.T) {
+	localSigner := NewPrivKeySigner(secp256k1.GenPrivKey())
+	addr := sdk.AccAddress(localSigner.PubKey().Address())
+	granter := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	policy := RemoteSignerPolicy{
+		ChainID:    testChainID,
+		Validator:  sdk.ValAddress(addr),
+		MaxFee:     sdk.NewCoins(sdk.NewInt64Coin("usei", 1000)),
+		MaxGas:     200000,
+		FeeGranter: granter,
+	}
+	_, err := NewRemoteSignerServer(localSigner, "", policy)
+	require.Error(t, err)
+	signerServer, err := NewRemoteSignerServer(localSigner, testSignerToken, policy)
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer()
+	signerServer.Register(grpcServer)
+	go func() { _ = grpcServer.Serve(listener) }()
+	defer grpcServer.Stop()
+
+	// clients must be authenticated with the token of the server
+	_, err = NewRemoteSigner(context.Background(), listener.Addr().String(), "", nil, 5*time.Second)
+	require.Error(t, err)
+	_, err = NewRemoteSigner(context.Background(), listener.Addr().String(), "other-token", nil, 5*time.Second)
+	require.Equal(t, codes.Unauthenticated, status.Code(errors.Unwrap(err)))
+
+	signer, err := NewRemoteSigner(context.Background(), listener.Addr().String(), testSignerToken, nil, 5*time.Second)
+	require.NoError(t, err)
+	defer signer.Close()
+
+	require.True(t, localSigner.PubKey().Equals(signer.PubKey()))
+	require.NoError(t, signAndVerify(t, signer, voteMsg(signer)))
+
+	// with a fee allowed by the policy
+	withFee := func(fee sdk.Coins, gas uint64, feeGranter sdk.AccAddress) func(sdkclient.TxBuilder) {
+		return func(txBuilder sdkclient.TxBuilder) {
+			txBuilder.SetFeeAmount(fee)
+			txBuilder.SetGasLimit(gas)
+			txBuilder.SetFeeGranter(feeGranter)
+		}
+	}
+	require.NoError(t, signAndVerifyWith(t, signer, withFee(sdk.NewCoins(sdk.NewInt64Coin("usei", 1000)), 200000, granter), voteMsg(signer)))
+	err = signAndVerifyWith(t, signer, withFee(sdk.NewCoins(sdk.NewInt64Coin("usei", 1001)), 200000, nil), voteMsg(signer))
+	require.ErrorContains(t, err, "exceeds max fee")
+	err = signAndVerifyWith(t, signer, withFee(sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)), 200000, nil), voteMsg(signer))
+	require.ErrorContains(t, err, "exceeds max fee")
+	err = signAndVerifyWith(t, signer, withFee(nil, 200001, nil), voteMsg(signer))
+	require.ErrorContains(t, err, "exceeds max gas")
+	err = signAndVerifyWith(t, signer, withFee(nil, 200000, addr), voteMsg(signer))
+	require.ErrorContains(t, err, "unexpected fee granter")
+
+	// of the validator fed by the signer
+	otherAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	otherValidatorVote := voteMsg(signer).(*oracletypes.MsgAggregateExchangeRateVote)
+	otherValidatorVote.Validator = sdk.ValAddress(otherAddr).String()
+	require.ErrorContains(t, signAndVerify(t, signer, otherValidatorVote), "unexpected validator")
+	otherFeederPrevote := oracletypes.NewMsgAggregateExchangeRatePrevote(
+		oracletypes.GetAggregateVoteHash("salt", "1.0uatom", sdk.ValAddress(addr)), otherAddr, sdk.ValAddress(addr),
+	)
+	require.ErrorContains(t, signAndVerify(t, signer, otherFeederPrevote), "unexpected feeder")
+	invalidVote := voteMsg(signer).(*oracletypes.MsgAggregateExchangeRateVote)
+	invalidVote.ExchangeRates = "invalid"
+	require.ErrorContains(t, signAndVerify(t, signer, invalidVote), "failed to parse exchange rates")
+
+	// only oracle votes are signed
+	send := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("usei", 1)))
+	err = signAndVerify(t, signer, voteMsg(signer), send)
+	require.ErrorContains(t, err, "unexpected message type")
+	_, err = signer.Sign([]byte("not a sign doc"))
+	require.Error(t, err)
+
+	// of the chain of the server
+	recorder := &recordingSigner{Signer: localSigner}
+	require.NoError(t, signAndVerify(t, recorder, voteMsg(signer)))
+	require.NoError(t, signerServer.validateSignDoc(recorder.signBytes))
+	otherChainPolicy := policy
+	otherChainPolicy.ChainID = "other-chain"
+	otherChainServer, err := NewRemoteSignerServer(localSigner, testSignerToken, otherChainPolicy)
+	require.NoError(t, err)
+	require.ErrorContains(t, otherChainServer.validateSignDoc(recorder.signBytes), "unexpected chain id")
+}
+
+// recordingSigner records the last sign bytes it signed.
+type recordingSigner struct {
+	Signer
+	signBytes []byte
+}
+
+func (s *recordingSigner) Sign(signBytes []byte) ([]byte, error) {
+	s.signBytes = signBytes
+	return s.Signer.Sign(signBytes)
+}
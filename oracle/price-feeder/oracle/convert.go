@@ -41,6 +41,7 @@ func convertCandlesToUSD(
 	candles provider.AggregatedProviderCandles,
 	providerPairs map[string][]types.CurrencyPair,
 	deviationThresholds map[string]sdk.Dec,
+	computeTVWAP tvwapFunc,
 ) (provider.AggregatedProviderCandles, error) {
 	if len(candles) == 0 {
 		return candles, nil
@@ -79,16 +80,17 @@ func convertCandlesToUSD(
 					return nil, fmt.Errorf("there are no valid conversion rates for %s", pair.Quote)
 				}
 
-				filteredCandles, err := FilterCandleDeviations(
+				filteredCandles, err := filterCandleDeviations(
 					logger,
 					validCandleList,
 					deviationThresholds,
+					computeTVWAP,
 				)
 				if err != nil {
 					return nil, err
 				}
 
-				tvwap, err := ComputeTVWAP(filteredCandles)
+				tvwap, err := computeTVWAP(filteredCandles)
 				if err != nil {
 					return nil, err
 				}
@@ -106,6 +106,7 @@ func TestConvertCandlesToUSD(t *testing.T) {
 		providerCandles,
 		providerPairs,
 		make(map[string]sdk.Dec),
+		ComputeTVWAP,
 	)
 	require.NoError(t, err)
 
@@ -167,6 +168,7 @@ func TestConvertCandlesToUSDFiltering(t *testing.T) {
 		providerCandles,
 		providerPairs,
 		make(map[string]sdk.Dec),
+		ComputeTVWAP,
 	)
 	require.NoError(t, err)
 
@@ -84,6 +84,15 @@ func FilterCandleDeviations(
 	logger zerolog.Logger,
 	candles provider.AggregatedProviderCandles,
 	deviationThresholds map[string]sdk.Dec,
+) (provider.AggregatedProviderCandles, error) {
+	return filterCandleDeviations(logger, candles, deviationThresholds, ComputeTVWAP)
+}
+
+func filterCandleDeviations(
+	logger zerolog.Logger,
+	candles provider.AggregatedProviderCandles,
+	deviationThresholds map[string]sdk.Dec,
+	computeTVWAP tvwapFunc,
 ) (provider.AggregatedProviderCandles, error) {
 	var (
 		filteredCandles = make(provider.AggregatedProviderCandles)
@@ -102,7 +111,7 @@ func FilterCandleDeviations(
 			p[base] = cp
 		}
 
-		tvwap, err := ComputeTVWAP(candlePrices)
+		tvwap, err := computeTVWAP(candlePrices)
 		if err != nil {
 			return nil, err
 		}
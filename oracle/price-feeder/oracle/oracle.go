@@ -2,6 +2,8 @@ package oracle
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"math"
@@ -21,8 +23,10 @@ import (
 	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
 	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/client"
 	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/provider"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/store"
 	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/types"
 	pfsync "github.com/sei-protocol/sei-chain/oracle/price-feeder/pkg/sync"
+	dextypes "github.com/sei-protocol/sei-chain/x/dex/types"
 	oracletypes "github.com/sei-protocol/sei-chain/x/oracle/types"
 )
 
@@ -37,11 +41,17 @@ type Oracle struct {
 	providerPairs      map[string][]types.CurrencyPair
 	chainDenomMapping  map[string]string
 	previousVotePeriod float64
+	previousPrevote    *PreviousPrevote
 	priceProviders     map[string]provider.Provider
 	failedProviders    map[string]error
 	oracleClient       client.OracleClient
 	deviations         map[string]sdk.Dec
 	endpoints          map[string]config.ProviderEndpoint
+	genericProviders   map[string]config.GenericProvider
+	seiProviders       config.SeiProviders
+	priceStore         *store.PriceStore
+	recordedCandles    map[string]map[string]int64
+	shadowMode         bool
 
 	mtx             sync.RWMutex
 	lastPriceSyncTS time.Time
@@ -49,9 +59,18 @@ type Oracle struct {
 	paramCache      ParamCache
 	jailCache       JailCache
 	healthchecks    map[string]http.Client
+	shadowReport    *types.ShadowReport
 	mockSetPrices   func(ctx context.Context) error
 }
 
+// PreviousPrevote defines the exchange rates and salt of the last prevote
+// broadcasted, to be revealed in the next vote period.
+type PreviousPrevote struct {
+	ExchangeRates string
+	Salt          string
+	VotePeriod    float64
+}
+
 // createMappingsFromPairs is a helper function to initialize maps from currencyPairs
 // this is used to by test cases to initialize the oracle client
 func createMappingsFromPairs(currencyPairs []config.CurrencyPair) (
@@ -79,6 +98,10 @@ func New(
 	providerTimeout time.Duration,
 	deviations map[string]sdk.Dec,
 	endpoints map[string]config.ProviderEndpoint,
+	genericProviders map[string]config.GenericProvider,
+	seiProviders config.SeiProviders,
+	priceStore *store.PriceStore,
+	shadowMode bool,
 	healthchecksConfig []config.Healthchecks,
 ) *Oracle {
 
@@ -111,6 +134,10 @@ func New(
 		jailCache:         JailCache{},
 		failedProviders:   make(map[string]error),
 		endpoints:         endpoints,
+		genericProviders:  genericProviders,
+		seiProviders:      seiProviders,
+		priceStore:        priceStore,
+		shadowMode:        shadowMode,
 		healthchecks:      healthchecks,
 	}
 }
@@ -311,6 +338,11 @@ func (o *Oracle) SetPrices(ctx context.Context) error {
 		o.logger.Error().Err(err).Msg("set-prices errgroup returned an error")
 	}
 
+	if o.priceStore != nil {
+		o.restoreCandles(providerCandles)
+		o.recordPrices(providerPrices, providerCandles)
+	}
+
 	computedPrices, err := GetComputedPrices(
 		o.logger,
 		providerCandles,
@@ -344,6 +376,26 @@ func GetComputedPrices(
 	providerPairs map[string][]types.CurrencyPair,
 	deviations map[string]sdk.Dec,
 	requiredRates map[string]struct{},
+) (prices map[string]sdk.Dec, err error) {
+	return getComputedPrices(
+		logger,
+		providerCandles,
+		providerPrices,
+		providerPairs,
+		deviations,
+		requiredRates,
+		ComputeTVWAP,
+	)
+}
+
+func getComputedPrices(
+	logger zerolog.Logger,
+	providerCandles provider.AggregatedProviderCandles,
+	providerPrices provider.AggregatedProviderPrices,
+	providerPairs map[string][]types.CurrencyPair,
+	deviations map[string]sdk.Dec,
+	requiredRates map[string]struct{},
+	computeTVWAP tvwapFunc,
 ) (prices map[string]sdk.Dec, err error) {
 	// only do asset provider map logic is log level is debug
 	if logger.GetLevel() == zerolog.DebugLevel {
@@ -377,23 +429,25 @@ func GetComputedPrices(
 		providerCandles,
 		providerPairs,
 		deviations,
+		computeTVWAP,
 	)
 	if err != nil {
 		return nil, err
 	}
 
 	// filter out any erroneous candles
-	filteredCandles, err := FilterCandleDeviations(
+	filteredCandles, err := filterCandleDeviations(
 		logger,
 		convertedCandles,
 		deviations,
+		computeTVWAP,
 	)
 	if err != nil {
 		return nil, err
 	}
 
 	// attempt to use candles for TVWAP calculations
-	computedPrices, err := ComputeTVWAP(filteredCandles)
+	computedPrices, err := computeTVWAP(filteredCandles)
 	if err != nil {
 		return nil, err
 	}
@@ -535,13 +589,24 @@ func (o *Oracle) getOrSetProvider(ctx context.Context, providerName string) (pro
 
 	priceProvider, ok = o.priceProviders[providerName]
 	if !ok {
-		newProvider, err := NewProvider(
-			ctx,
-			providerName,
-			o.logger,
-			o.endpoints[providerName],
-			o.providerPairs[providerName]...,
+		var (
+			newProvider provider.Provider
+			err         error
 		)
+		switch providerName {
+		case config.ProviderSeiDex, config.ProviderSeiOracle:
+			newProvider, err = o.newSeiProvider(providerName)
+
+		default:
+			newProvider, err = NewProvider(
+				ctx,
+				providerName,
+				o.logger,
+				o.endpoints[providerName],
+				o.genericProviders,
+				o.providerPairs[providerName]...,
+			)
+		}
 		if err != nil {
 			o.failedProviders[providerName] = err
 			return nil, err
@@ -554,14 +619,45 @@ func (o *Oracle) getOrSetProvider(ctx context.Context, providerName string) (pro
 	return priceProvider, nil
 }
 
+// newSeiProvider returns a provider reading prices from the chain through the
+// gRPC endpoint of the oracle client.
+func (o *Oracle) newSeiProvider(providerName string) (provider.Provider, error) {
+	grpcConn, err := grpc.Dial(
+		o.oracleClient.GRPCEndpoint,
+		// the Cosmos SDK doesn't support any transport security mechanism
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(dialerFunc),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial Cosmos gRPC service: %w", err)
+	}
+
+	providerPairs := o.providerPairs[providerName]
+	if providerName == config.ProviderSeiDex {
+		return provider.NewSeiDexProvider(o.logger, dextypes.NewQueryClient(grpcConn), o.seiProviders, providerPairs...)
+	}
+	return provider.NewSeiOracleProvider(
+		o.logger,
+		oracletypes.NewQueryClient(grpcConn),
+		o.seiProviders,
+		o.chainDenomMapping,
+		providerPairs...,
+	)
+}
+
 // Create various providers to pull priace data for oracle price feeds
 func NewProvider(
 	ctx context.Context,
 	providerName string,
 	logger zerolog.Logger,
 	endpoint config.ProviderEndpoint,
+	genericProviders map[string]config.GenericProvider,
 	providerPairs ...types.CurrencyPair,
 ) (provider.Provider, error) {
+	if definition, ok := genericProviders[providerName]; ok {
+		return provider.NewGenericProvider(logger, definition, providerPairs...)
+	}
+
 	switch providerName {
 	case config.ProviderBinance:
 		return provider.NewBinanceProvider(ctx, logger, endpoint, providerPairs...)
@@ -680,11 +776,46 @@ func (o *Oracle) tick(
 	filteredPrices := filterPricesByDenomList(prices, oracleParams.Whitelist)
 	exchangeRatesStr := GenerateExchangeRatesString(filteredPrices)
 
+	// in shadow mode, report the divergence of the vote instead of voting
+	if o.shadowMode {
+		if err := o.reportDivergence(ctx, blockHeight, valAddr, filteredPrices); err != nil {
+			return err
+		}
+		o.previousVotePeriod = currentVotePeriod
+		return nil
+	}
+
 	// otherwise, we're in the next voting period and thus we vote
-	voteMsg := &oracletypes.MsgAggregateExchangeRateVote{
-		ExchangeRates: exchangeRatesStr,
-		Feeder:        o.oracleClient.OracleAddrString,
-		Validator:     valAddr.String(),
+	var msgs []sdk.Msg
+	var salt string
+	if oracleParams.CommitRevealEnabled {
+		// reveal the exchange rates committed to in the previous voting period, and
+		// commit to the current ones with a prevote
+		if o.previousPrevote != nil && o.previousPrevote.VotePeriod == currentVotePeriod-1 {
+			msgs = append(msgs, &oracletypes.MsgAggregateExchangeRateVote{
+				ExchangeRates: o.previousPrevote.ExchangeRates,
+				Salt:          o.previousPrevote.Salt,
+				Feeder:        o.oracleClient.OracleAddrString,
+				Validator:     valAddr.String(),
+			})
+		}
+
+		salt, err = GenerateSalt(32)
+		if err != nil {
+			return err
+		}
+		voteHash := oracletypes.GetAggregateVoteHash(salt, exchangeRatesStr, valAddr)
+		msgs = append(msgs, &oracletypes.MsgAggregateExchangeRatePrevote{
+			Hash:      voteHash.String(),
+			Feeder:    o.oracleClient.OracleAddrString,
+			Validator: valAddr.String(),
+		})
+	} else {
+		msgs = append(msgs, &oracletypes.MsgAggregateExchangeRateVote{
+			ExchangeRates: exchangeRatesStr,
+			Feeder:        o.oracleClient.OracleAddrString,
+			Validator:     valAddr.String(),
+		})
 	}
 
 	o.logger.Debug().
@@ -692,14 +823,15 @@ func (o *Oracle) tick(
 		Msg("pre-filtered prices")
 
 	o.logger.Info().
-		Str("exchange_rates", voteMsg.ExchangeRates).
-		Str("validator", voteMsg.Validator).
-		Str("feeder", voteMsg.Feeder).
+		Str("exchange_rates", exchangeRatesStr).
+		Str("validator", valAddr.String()).
+		Str("feeder", o.oracleClient.OracleAddrString).
+		Bool("commit_reveal", oracleParams.CommitRevealEnabled).
 		Float64("vote_period", currentVotePeriod).
 		Int64("tick_duration", time.Since(startTime).Milliseconds()).
 		Msg("Going to broadcast vote")
 
-	resp, err := o.oracleClient.BroadcastTx(clientCtx, voteMsg)
+	resp, err := o.oracleClient.BroadcastTx(clientCtx, msgs...)
 	if err != nil {
 		o.logResponseError(err, resp, startTime, blockHeight)
 		telemetry.IncrCounter(1, "failure", "broadcast")
@@ -715,6 +847,14 @@ func (o *Oracle) tick(
 	telemetry.IncrCounter(1, "success", "broadcast")
 
 	o.previousVotePeriod = currentVotePeriod
+	o.previousPrevote = nil
+	if oracleParams.CommitRevealEnabled {
+		o.previousPrevote = &PreviousPrevote{
+			ExchangeRates: exchangeRatesStr,
+			Salt:          salt,
+			VotePeriod:    currentVotePeriod,
+		}
+	}
 	o.healthchecksPing()
 
 	return nil
@@ -754,3 +894,18 @@ func GenerateExchangeRatesString(prices sdk.DecCoins) string {
 	prices.Sort()
 	return prices.String()
 }
+
+// GenerateSalt generates a random salt, size length/2, as a hex encoded string.
+func GenerateSalt(length int) (string, error) {
+	if length == 0 {
+		return "", fmt.Errorf("failed to generate salt: zero length")
+	}
+
+	bytes := make([]byte, length/2)
+
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(bytes), nil
+}
@@ -197,6 +197,10 @@ func (ots *OracleTestSuite) SetupSuite() {
 		time.Millisecond*100,
 		make(map[string]sdk.Dec),
 		make(map[string]config.ProviderEndpoint),
+		make(map[string]config.GenericProvider),
+		config.SeiProviders{},
+		nil,
+		false,
 		[]config.Healthchecks{
 			{URL: "https://hc-ping.com/HEALTHCHECK-UUID", Timeout: "200ms"},
 		},
@@ -739,6 +743,71 @@ func TestTickScenarios(t *testing.T) {
 	}
 }
 
+func TestTickCommitReveal(t *testing.T) {
+	validatorAddr := generateValidatorAddr()
+	feederAddr := generateAcctAddr()
+	valAddr, err := sdk.ValAddressFromBech32(validatorAddr)
+	require.NoError(t, err)
+	pairs := []config.CurrencyPair{
+		{Base: "BTC", ChainDenom: "ubtc", Quote: "USD"},
+		{Base: "ETH", ChainDenom: "ueth", Quote: "USD"},
+	}
+	cdm, _ := createMappingsFromPairs(pairs)
+
+	var broadcasted [][]sdk.Msg
+	oracle := &Oracle{
+		mockSetPrices:     func(ctx context.Context) error { return nil },
+		chainDenomMapping: cdm,
+		prices: map[string]sdk.Dec{
+			"BTC": sdk.MustNewDecFromStr("2.2"),
+			"ETH": sdk.MustNewDecFromStr("3.3"),
+		},
+		paramCache: ParamCache{
+			params: &oracletypes.Params{
+				Whitelist:           denomList("ubtc", "ueth"),
+				VotePeriod:          2,
+				CommitRevealEnabled: true,
+			},
+		},
+		oracleClient: client.OracleClient{
+			OracleAddrString:    feederAddr,
+			ValidatorAddrString: validatorAddr,
+			MockBroadcastTx: func(ctx sdkclient.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+				broadcasted = append(broadcasted, msgs)
+				return &sdk.TxResponse{TxHash: "0xhash"}, nil
+			},
+		},
+	}
+	exchangeRates := "2.200000000000000000ubtc,3.300000000000000000ueth"
+
+	// only a prevote is broadcasted in the first voting period
+	require.NoError(t, oracle.tick(context.Background(), sdkclient.Context{}, 1))
+	require.Equal(t, 1, len(broadcasted))
+	require.Equal(t, 1, len(broadcasted[0]))
+	prevoteMsg, ok := broadcasted[0][0].(*oracletypes.MsgAggregateExchangeRatePrevote)
+	require.True(t, ok)
+	require.Equal(t, feederAddr, prevoteMsg.Feeder)
+	require.NoError(t, prevoteMsg.ValidateBasic())
+
+	// the prevote is revealed in the next voting period, along with a new prevote
+	require.NoError(t, oracle.tick(context.Background(), sdkclient.Context{}, 3))
+	require.Equal(t, 2, len(broadcasted))
+	require.Equal(t, 2, len(broadcasted[1]))
+	voteMsg, ok := broadcasted[1][0].(*oracletypes.MsgAggregateExchangeRateVote)
+	require.True(t, ok)
+	require.Equal(t, exchangeRates, voteMsg.ExchangeRates)
+	require.Equal(t, prevoteMsg.Hash, oracletypes.GetAggregateVoteHash(voteMsg.Salt, voteMsg.ExchangeRates, valAddr).String())
+	_, ok = broadcasted[1][1].(*oracletypes.MsgAggregateExchangeRatePrevote)
+	require.True(t, ok)
+
+	// a prevote that was not broadcasted in the previous voting period is not revealed
+	require.NoError(t, oracle.tick(context.Background(), sdkclient.Context{}, 7))
+	require.Equal(t, 3, len(broadcasted))
+	require.Equal(t, 1, len(broadcasted[2]))
+	_, ok = broadcasted[2][0].(*oracletypes.MsgAggregateExchangeRatePrevote)
+	require.True(t, ok)
+}
+
 func TestFilterPricesWithDenomList(t *testing.T) {
 	tests := []struct {
 		name           string
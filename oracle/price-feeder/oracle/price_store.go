@@ -0,0 +1,141 @@
+package oracle
+
+import (
+	"math"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/provider"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/store"
+)
+
+// restoreCandles adds the candles recorded within the TVWAP period to the
+// candles of the configured pairs, when they are older than those reported by
+// the provider. After a restart, this lets TVWAP be computed while providers
+// refill their candles.
+func (o *Oracle) restoreCandles(providerCandles provider.AggregatedProviderCandles) {
+	since := provider.PastUnixTime(tvwapCandlePeriod)
+	recorded, err := o.priceStore.Candles(since, time.Now().UnixMilli())
+	if err != nil {
+		o.logger.Warn().Err(err).Msg("failed to read recorded candles")
+		return
+	}
+
+	for providerName, pairs := range o.providerPairs {
+		for _, pair := range pairs {
+			candles := providerCandles[providerName][pair.Base]
+			oldest := int64(math.MaxInt64)
+			for _, c := range candles {
+				if c.TimeStamp < oldest {
+					oldest = c.TimeStamp
+				}
+			}
+
+			restored := []provider.CandlePrice{}
+			for _, c := range recorded[providerName][pair.Base] {
+				if since < c.TimeStamp && c.TimeStamp < oldest {
+					restored = append(restored, c)
+				}
+			}
+			if len(restored) == 0 {
+				continue
+			}
+
+			if _, ok := providerCandles[providerName]; !ok {
+				providerCandles[providerName] = make(map[string][]provider.CandlePrice)
+			}
+			providerCandles[providerName][pair.Base] = append(restored, candles...)
+		}
+	}
+}
+
+// recordPrices records the ticker prices of providers, and their candles within
+// the TVWAP period that are newer than those recorded by previous snapshots.
+func (o *Oracle) recordPrices(
+	providerPrices provider.AggregatedProviderPrices,
+	providerCandles provider.AggregatedProviderCandles,
+) {
+	// snapshots are keyed by millisecond, so that those recorded within the same
+	// second do not overwrite each other's candles
+	now := time.Now().UnixMilli()
+	since := provider.PastUnixTime(tvwapCandlePeriod)
+
+	newest := make(map[string]map[string]int64)
+	candles := make(provider.AggregatedProviderCandles, len(providerCandles))
+	for providerName, baseCandles := range providerCandles {
+		newest[providerName] = make(map[string]int64, len(baseCandles))
+		candles[providerName] = make(map[string][]provider.CandlePrice, len(baseCandles))
+		for base, cp := range baseCandles {
+			recorded := o.recordedCandles[providerName][base]
+			for _, c := range cp {
+				if since < c.TimeStamp && recorded < c.TimeStamp {
+					candles[providerName][base] = append(candles[providerName][base], c)
+					if newest[providerName][base] < c.TimeStamp {
+						newest[providerName][base] = c.TimeStamp
+					}
+				}
+			}
+		}
+	}
+
+	if err := o.priceStore.Record(store.Snapshot{
+		Timestamp: now,
+		Prices:    providerPrices,
+		Candles:   candles,
+	}); err != nil {
+		o.logger.Warn().Err(err).Msg("failed to record prices")
+		return
+	}
+
+	if o.recordedCandles == nil {
+		o.recordedCandles = make(map[string]map[string]int64)
+	}
+	for providerName, baseNewest := range newest {
+		if _, ok := o.recordedCandles[providerName]; !ok {
+			o.recordedCandles[providerName] = make(map[string]int64)
+		}
+		for base, timestamp := range baseNewest {
+			o.recordedCandles[providerName][base] = timestamp
+		}
+	}
+}
+
+// RecordedCandles returns the candles recorded in the price store within the
+// TVWAP period as of the given timestamp in milliseconds, as used to compute
+// the prices of the snapshot recorded at that time.
+func RecordedCandles(priceStore *store.PriceStore, timestamp int64) (provider.AggregatedProviderCandles, error) {
+	return priceStore.Candles(timestamp-tvwapCandlePeriod.Milliseconds(), timestamp)
+}
+
+// ComputeRecordedPrices computes prices from a recorded snapshot the way they
+// are computed when setting prices, with the TVWAP of candles as of the time of
+// the snapshot. The bases of all currency pairs are required, so that tickers
+// fill the bases without candles.
+func ComputeRecordedPrices(
+	logger zerolog.Logger,
+	snapshot store.Snapshot,
+	currencyPairs []config.CurrencyPair,
+	deviations map[string]sdk.Dec,
+) (map[string]sdk.Dec, error) {
+	_, providerPairs := createMappingsFromPairs(currencyPairs)
+
+	requiredRates := make(map[string]struct{}, len(currencyPairs))
+	for _, pair := range currencyPairs {
+		requiredRates[pair.Base] = struct{}{}
+	}
+
+	return getComputedPrices(
+		logger,
+		snapshot.Candles,
+		snapshot.Prices,
+		providerPairs,
+		deviations,
+		requiredRates,
+		func(candles provider.AggregatedProviderCandles) (map[string]sdk.Dec, error) {
+			return ComputeTVWAPAt(candles, snapshot.Timestamp)
+		},
+	)
+}
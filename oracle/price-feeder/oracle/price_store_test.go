@@ -0,0 +1,97 @@
+package oracle
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/provider"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/store"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/types"
+)
+
+func TestRestoreAndRecordCandles(t *testing.T) {
+	atomPair := types.CurrencyPair{Base: "ATOM", Quote: "USD"}
+	o := &Oracle{
+		logger:     zerolog.Nop(),
+		priceStore: store.New(dbm.NewMemDB(), time.Hour),
+		providerPairs: map[string][]types.CurrencyPair{
+			config.ProviderBinance: {atomPair},
+			config.ProviderKraken:  {atomPair},
+		},
+	}
+	candle := func(price int64, age time.Duration) provider.CandlePrice {
+		return provider.CandlePrice{Price: sdk.NewDec(price), Volume: sdk.OneDec(), TimeStamp: provider.PastUnixTime(age)}
+	}
+	stale, old, recent, live := candle(9, 6*time.Minute), candle(10, 3*time.Minute), candle(11, 2*time.Minute), candle(12, time.Minute)
+
+	// nothing is restored before any snapshot is recorded
+	candles := provider.AggregatedProviderCandles{config.ProviderBinance: {"ATOM": {old, recent}}}
+	o.restoreCandles(candles)
+	require.Equal(t, []provider.CandlePrice{old, recent}, candles[config.ProviderBinance]["ATOM"])
+
+	// candles outside of the tvwap period are not recorded
+	candles[config.ProviderKraken] = map[string][]provider.CandlePrice{"ATOM": {stale, recent}}
+	o.recordPrices(provider.AggregatedProviderPrices{}, candles)
+
+	// after a restart, recorded candles older than those of providers are restored,
+	// including those of providers which did not report candles yet
+	candles = provider.AggregatedProviderCandles{config.ProviderBinance: {"ATOM": {recent, live}}}
+	o.restoreCandles(candles)
+	require.Equal(t, []provider.CandlePrice{old, recent, live}, candles[config.ProviderBinance]["ATOM"])
+	require.Equal(t, []provider.CandlePrice{recent}, candles[config.ProviderKraken]["ATOM"])
+
+	// only candles newer than those already recorded are recorded again
+	time.Sleep(time.Millisecond)
+	o.recordPrices(provider.AggregatedProviderPrices{}, candles)
+	latest, found, err := o.priceStore.Latest()
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, []provider.CandlePrice{live}, latest.Candles[config.ProviderBinance]["ATOM"])
+	require.Empty(t, latest.Candles[config.ProviderKraken]["ATOM"])
+
+	// candles are restored from all snapshots within the tvwap period
+	candles = provider.AggregatedProviderCandles{}
+	o.restoreCandles(candles)
+	require.Equal(t, []provider.CandlePrice{old, recent, live}, candles[config.ProviderBinance]["ATOM"])
+	require.Equal(t, []provider.CandlePrice{recent}, candles[config.ProviderKraken]["ATOM"])
+}
+
+func TestComputeRecordedPrices(t *testing.T) {
+	// candles recorded long ago are used as of the time of the snapshot
+	timestamp := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC).UnixMilli()
+	candle := func(price string) []provider.CandlePrice {
+		return []provider.CandlePrice{{Price: sdk.MustNewDecFromStr(price), Volume: sdk.OneDec(), TimeStamp: timestamp - 1000}}
+	}
+	snapshot := store.Snapshot{
+		Timestamp: timestamp,
+		Prices:    provider.AggregatedProviderPrices{},
+		Candles: provider.AggregatedProviderCandles{
+			config.ProviderBinance: {"ATOM": candle("10")},
+			config.ProviderKraken:  {"ATOM": candle("11")},
+			config.ProviderHuobi:   {"ATOM": candle("12")},
+			config.ProviderOkx:     {"ATOM": candle("40")},
+		},
+	}
+	currencyPairs := []config.CurrencyPair{{
+		Base:       "ATOM",
+		Quote:      "USD",
+		ChainDenom: "uatom",
+		Providers:  []string{config.ProviderBinance, config.ProviderKraken, config.ProviderHuobi, config.ProviderOkx},
+	}}
+
+	// the outlier is filtered out with the default deviation threshold
+	prices, err := ComputeRecordedPrices(zerolog.Nop(), snapshot, currencyPairs, map[string]sdk.Dec{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(11), prices["ATOM"])
+
+	// but not with a higher threshold
+	prices, err = ComputeRecordedPrices(zerolog.Nop(), snapshot, currencyPairs, map[string]sdk.Dec{"ATOM": sdk.NewDec(3)})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(73).QuoInt64(4), prices["ATOM"])
+}
@@ -0,0 +1,270 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/types"
+)
+
+var _ Provider = (*GenericProvider)(nil)
+
+type (
+	// GenericProvider defines a REST provider configured by a definition, which
+	// maps the JSON ticker response of a venue to ticker prices. As the ticker
+	// endpoint does not expose candles, the latest polled ticker is returned as
+	// the only candle, since its volume covers the last 24h rather than a
+	// single candle period.
+	GenericProvider struct {
+		logger     zerolog.Logger
+		definition config.GenericProvider
+		client     *http.Client
+
+		mtx        sync.RWMutex
+		tickers    map[string]CandlePrice // pair => latest polled ticker
+		subscribed map[string]types.CurrencyPair
+	}
+)
+
+// NewGenericProvider returns a new generic REST provider for the definition.
+func NewGenericProvider(
+	logger zerolog.Logger,
+	definition config.GenericProvider,
+	pairs ...types.CurrencyPair,
+) (*GenericProvider, error) {
+	if definition.TimestampUnit == "" {
+		definition.TimestampUnit = "ms"
+	}
+
+	provider := &GenericProvider{
+		logger:     logger.With().Str("provider", definition.Name).Logger(),
+		definition: definition,
+		client:     newDefaultHTTPClient(),
+		tickers:    map[string]CandlePrice{},
+		subscribed: map[string]types.CurrencyPair{},
+	}
+
+	return provider, provider.SubscribeCurrencyPairs(pairs...)
+}
+
+// GetTickerPrices returns the tickerPrices based on the provided pairs. Pairs
+// sharing the same URL are read from a single response.
+func (p *GenericProvider) GetTickerPrices(pairs ...types.CurrencyPair) (map[string]TickerPrice, error) {
+	tickerPrices := make(map[string]TickerPrice, len(pairs))
+	responses := make(map[string]interface{})
+
+	for _, cp := range pairs {
+		symbol := p.symbol(cp)
+		url := p.renderURL(cp, symbol)
+
+		response, ok := responses[url]
+		if !ok {
+			var err error
+			if response, err = p.fetch(url); err != nil {
+				return nil, err
+			}
+			responses[url] = response
+		}
+
+		candle, err := p.readTicker(response, symbol)
+		if err != nil {
+			return nil, err
+		}
+		p.setTicker(cp.String(), candle)
+
+		tickerPrices[cp.String()] = TickerPrice{Price: candle.Price, Volume: candle.Volume}
+	}
+
+	return tickerPrices, nil
+}
+
+// GetCandlePrices returns the latest polled ticker of each pair as a single
+// candle, as MockProvider does. Tickers are polled for pairs which have none
+// within the last candle period.
+func (p *GenericProvider) GetCandlePrices(pairs ...types.CurrencyPair) (map[string][]CandlePrice, error) {
+	missing := []types.CurrencyPair{}
+	staleTime := PastUnixTime(providerCandlePeriod)
+	p.mtx.RLock()
+	for _, cp := range pairs {
+		if ticker, ok := p.tickers[cp.String()]; !ok || ticker.TimeStamp <= staleTime {
+			missing = append(missing, cp)
+		}
+	}
+	p.mtx.RUnlock()
+
+	if len(missing) > 0 {
+		if _, err := p.GetTickerPrices(missing...); err != nil {
+			return nil, err
+		}
+	}
+
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	candlePrices := make(map[string][]CandlePrice, len(pairs))
+	for _, cp := range pairs {
+		if ticker, ok := p.tickers[cp.String()]; ok {
+			candlePrices[cp.String()] = []CandlePrice{ticker}
+		}
+	}
+
+	return candlePrices, nil
+}
+
+// GetAvailablePairs returns the pairs with a symbol mapping, along with the
+// subscribed pairs, as the generic ticker endpoint cannot list markets.
+func (p *GenericProvider) GetAvailablePairs() (map[string]struct{}, error) {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	availablePairs := make(map[string]struct{}, len(p.definition.Symbols)+len(p.subscribed))
+	for pair := range p.definition.Symbols {
+		availablePairs[strings.ToUpper(pair)] = struct{}{}
+	}
+	for pair := range p.subscribed {
+		availablePairs[pair] = struct{}{}
+	}
+
+	return availablePairs, nil
+}
+
+// SubscribeCurrencyPairs adds the pairs to the polled pairs. There is no
+// channel to subscribe to, tickers are polled on every price request.
+func (p *GenericProvider) SubscribeCurrencyPairs(pairs ...types.CurrencyPair) error {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for _, cp := range pairs {
+		p.subscribed[cp.String()] = cp
+	}
+
+	return nil
+}
+
+// symbol returns the symbol of the pair on the provider.
+func (p *GenericProvider) symbol(cp types.CurrencyPair) string {
+	if symbol, ok := p.definition.Symbols[cp.String()]; ok {
+		return symbol
+	}
+	return cp.Base + cp.Quote
+}
+
+func (p *GenericProvider) renderURL(cp types.CurrencyPair, symbol string) string {
+	return strings.NewReplacer(
+		"{symbol}", symbol,
+		"{base}", cp.Base,
+		"{quote}", cp.Quote,
+	).Replace(p.definition.URL)
+}
+
+func (p *GenericProvider) fetch(url string) (interface{}, error) {
+	resp, err := p.client.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make %s request: %w", p.definition.Name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s request to %s failed with status %d", p.definition.Name, url, resp.StatusCode)
+	}
+
+	var response interface{}
+	decoder := json.NewDecoder(resp.Body)
+	decoder.UseNumber()
+	if err := decoder.Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s response: %w", p.definition.Name, err)
+	}
+
+	return response, nil
+}
+
+// readTicker reads the price, volume and timestamp of the symbol from a
+// response. The current time is used when there is no timestamp path.
+func (p *GenericProvider) readTicker(response interface{}, symbol string) (CandlePrice, error) {
+	price, err := p.readValue(response, p.definition.PricePath, symbol)
+	if err != nil {
+		return CandlePrice{}, err
+	}
+	volume, err := p.readValue(response, p.definition.VolumePath, symbol)
+	if err != nil {
+		return CandlePrice{}, err
+	}
+
+	timestamp := time.Now().UnixMilli()
+	if p.definition.TimestampPath != "" {
+		value, err := p.readValue(response, p.definition.TimestampPath, symbol)
+		if err != nil {
+			return CandlePrice{}, err
+		}
+		// timestamps may be returned as floats, ex. 1655391800.123
+		ts, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return CandlePrice{}, fmt.Errorf("failed to parse %s timestamp (%s) for %s", p.definition.Name, value, symbol)
+		}
+		if p.definition.TimestampUnit == "s" {
+			ts *= 1000
+		}
+		timestamp = int64(ts)
+	}
+
+	return newCandlePrice(p.definition.Name, symbol, price, volume, timestamp)
+}
+
+// readValue returns the value at the path in the response. The path is made
+// of dot separated object keys and array indices, and {symbol} is replaced
+// with the symbol. Values must be numbers or strings.
+func (p *GenericProvider) readValue(response interface{}, path string, symbol string) (string, error) {
+	path = strings.ReplaceAll(path, "{symbol}", symbol)
+
+	value := response
+	for _, segment := range strings.Split(path, ".") {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			next, ok := v[segment]
+			if !ok {
+				return "", fmt.Errorf("%s response has no %s for %s", p.definition.Name, path, symbol)
+			}
+			value = next
+
+		case []interface{}:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(v) {
+				return "", fmt.Errorf("%s response has no %s for %s", p.definition.Name, path, symbol)
+			}
+			value = v[index]
+
+		default:
+			return "", fmt.Errorf("%s response has no %s for %s", p.definition.Name, path, symbol)
+		}
+	}
+
+	switch v := value.(type) {
+	case json.Number:
+		return v.String(), nil
+
+	case string:
+		return v, nil
+
+	default:
+		return "", fmt.Errorf("%s value at %s for %s is not a number", p.definition.Name, path, symbol)
+	}
+}
+
+// setTicker records the polled ticker of a pair, unless a more recent ticker
+// was already recorded.
+func (p *GenericProvider) setTicker(pair string, ticker CandlePrice) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if latest, ok := p.tickers[pair]; ok && latest.TimeStamp > ticker.TimeStamp {
+		return
+	}
+	p.tickers[pair] = ticker
+}
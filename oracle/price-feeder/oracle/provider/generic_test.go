@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestGenericProvider(t *testing.T, server *MockProviderServer, definition config.GenericProvider) *GenericProvider {
+	definition.Name = "generic"
+	definition.URL = "https://" + server.GetBaseURL() + definition.URL
+	p, err := NewGenericProvider(zerolog.Nop(), definition)
+	require.NoError(t, err)
+	p.client = server.GetClient()
+	return p
+}
+
+func TestGenericProvider_GetTickerPrices(t *testing.T) {
+	server := NewMockProviderServer()
+	defer server.Close()
+
+	t.Run("valid_request_per_symbol", func(t *testing.T) {
+		server.SetHandler(func(rw http.ResponseWriter, req *http.Request) {
+			require.Equal(t, "/ticker", req.URL.Path)
+			switch req.URL.Query().Get("pair") {
+			case "atom_usdt":
+				rw.Write([]byte(`{"data":{"last":"34.69","vol":2396974.02,"ts":1655391800}}`))
+			case "ETHUSDT":
+				rw.Write([]byte(`{"data":{"last":1100.5,"vol":"10.2","ts":1655391801}}`))
+			default:
+				rw.WriteHeader(http.StatusNotFound)
+			}
+		})
+		p := newTestGenericProvider(t, &server, config.GenericProvider{
+			URL:           "/ticker?pair={symbol}",
+			PricePath:     "data.last",
+			VolumePath:    "data.vol",
+			TimestampPath: "data.ts",
+			TimestampUnit: "s",
+			Symbols:       map[string]string{"ATOMUSDT": "atom_usdt"},
+		})
+
+		atom := types.CurrencyPair{Base: "ATOM", Quote: "USDT"}
+		eth := types.CurrencyPair{Base: "ETH", Quote: "USDT"}
+		prices, err := p.GetTickerPrices(atom, eth)
+		require.NoError(t, err)
+		require.Len(t, prices, 2)
+		require.Equal(t, sdk.MustNewDecFromStr("34.69"), prices["ATOMUSDT"].Price)
+		require.Equal(t, sdk.MustNewDecFromStr("2396974.02"), prices["ATOMUSDT"].Volume)
+		require.Equal(t, sdk.MustNewDecFromStr("1100.5"), prices["ETHUSDT"].Price)
+		require.Equal(t, sdk.MustNewDecFromStr("10.2"), prices["ETHUSDT"].Volume)
+
+		candles, err := p.GetCandlePrices(atom)
+		require.NoError(t, err)
+		require.Equal(t, []CandlePrice{{
+			Price:     sdk.MustNewDecFromStr("34.69"),
+			Volume:    sdk.MustNewDecFromStr("2396974.02"),
+			TimeStamp: 1655391800000,
+		}}, candles["ATOMUSDT"])
+
+		_, err = p.GetTickerPrices(types.CurrencyPair{Base: "FOO", Quote: "BAR"})
+		require.Error(t, err)
+	})
+
+	t.Run("valid_request_shared_response", func(t *testing.T) {
+		requests := 0
+		server.SetHandler(func(rw http.ResponseWriter, req *http.Request) {
+			requests++
+			rw.Write([]byte(`{"result":{"XATOMZUSD":{"c":["34.69","1.0"],"v":["100","2396974.02"]},"XETHZUSD":{"c":["1100.5","1.0"],"v":["1","10.2"]}}}`))
+		})
+		p := newTestGenericProvider(t, &server, config.GenericProvider{
+			URL:        "/tickers",
+			PricePath:  "result.{symbol}.c.0",
+			VolumePath: "result.{symbol}.v.1",
+			Symbols:    map[string]string{"ATOMUSD": "XATOMZUSD", "ETHUSD": "XETHZUSD"},
+		})
+
+		prices, err := p.GetTickerPrices(
+			types.CurrencyPair{Base: "ATOM", Quote: "USD"},
+			types.CurrencyPair{Base: "ETH", Quote: "USD"},
+		)
+		require.NoError(t, err)
+		require.Equal(t, 1, requests)
+		require.Equal(t, sdk.MustNewDecFromStr("34.69"), prices["ATOMUSD"].Price)
+		require.Equal(t, sdk.MustNewDecFromStr("2396974.02"), prices["ATOMUSD"].Volume)
+		require.Equal(t, sdk.MustNewDecFromStr("1100.5"), prices["ETHUSD"].Price)
+
+		availablePairs, err := p.GetAvailablePairs()
+		require.NoError(t, err)
+		require.Equal(t, map[string]struct{}{"ATOMUSD": {}, "ETHUSD": {}}, availablePairs)
+	})
+
+	t.Run("valid_request_latest_candle", func(t *testing.T) {
+		prices := []string{"34.69", "35.12"}
+		requests := 0
+		server.SetHandler(func(rw http.ResponseWriter, req *http.Request) {
+			rw.Write([]byte(`{"last":"` + prices[requests%len(prices)] + `","vol":"2396974.02"}`))
+			requests++
+		})
+		p := newTestGenericProvider(t, &server, config.GenericProvider{
+			URL:        "/ticker",
+			PricePath:  "last",
+			VolumePath: "vol",
+		})
+
+		atom := types.CurrencyPair{Base: "ATOM", Quote: "USDT"}
+		_, err := p.GetTickerPrices(atom)
+		require.NoError(t, err)
+		time.Sleep(2 * time.Millisecond)
+		_, err = p.GetTickerPrices(atom)
+		require.NoError(t, err)
+
+		candles, err := p.GetCandlePrices(atom)
+		require.NoError(t, err)
+		require.Equal(t, 2, requests)
+		require.Len(t, candles["ATOMUSDT"], 1)
+		require.Equal(t, sdk.MustNewDecFromStr("35.12"), candles["ATOMUSDT"][0].Price)
+		require.Equal(t, sdk.MustNewDecFromStr("2396974.02"), candles["ATOMUSDT"][0].Volume)
+	})
+
+	t.Run("invalid_request_bad_path", func(t *testing.T) {
+		server.SetHandler(func(rw http.ResponseWriter, req *http.Request) {
+			rw.Write([]byte(`{"data":[{"last":"34.69"}]}`))
+		})
+		p := newTestGenericProvider(t, &server, config.GenericProvider{
+			URL:        "/ticker",
+			PricePath:  "data.0.last",
+			VolumePath: "data.1.vol",
+		})
+
+		_, err := p.GetTickerPrices(types.CurrencyPair{Base: "ATOM", Quote: "USDT"})
+		require.EqualError(t, err, "generic response has no data.1.vol for ATOMUSDT")
+	})
+}
@@ -55,6 +55,14 @@ func (m *MockProviderServer) GetBaseURL() string {
 	return ""
 }
 
+// GetClient returns an http client trusting the certificate of the server.
+func (m *MockProviderServer) GetClient() *http.Client {
+	if m.server != nil {
+		return m.server.Client()
+	}
+	return nil
+}
+
 func (m *MockProviderServer) GetWebsocketURL() string {
 	if m.server != nil {
 		return "wss" + strings.TrimPrefix(m.server.URL, "https")
@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/types"
+	dextypes "github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+var _ Provider = (*SeiDexProvider)(nil)
+
+type (
+	// SeiDexProvider defines a provider reading the prices of the pairs of a
+	// dex contract on the chain. Tickers are the latest prices of the pairs,
+	// and candles their twaps over the lookback. As the dex does not track
+	// volume, prices are reported with the configured volume.
+	SeiDexProvider struct {
+		logger      zerolog.Logger
+		queryClient dextypes.QueryClient
+		contract    string
+		denoms      map[string]string
+		volume      sdk.Dec
+		lookback    time.Duration
+	}
+)
+
+// NewSeiDexProvider returns a new sei dex provider reading prices with the
+// dex query client.
+func NewSeiDexProvider(
+	logger zerolog.Logger,
+	queryClient dextypes.QueryClient,
+	cfg config.SeiProviders,
+	pairs ...types.CurrencyPair,
+) (*SeiDexProvider, error) {
+	volume, err := sdk.NewDecFromStr(cfg.DexVolume)
+	if err != nil {
+		return nil, err
+	}
+	lookback, err := time.ParseDuration(cfg.TwapLookback)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := &SeiDexProvider{
+		logger:      logger.With().Str("provider", config.ProviderSeiDex).Logger(),
+		queryClient: queryClient,
+		contract:    cfg.DexContract,
+		denoms:      cfg.DexDenoms,
+		volume:      volume,
+		lookback:    lookback,
+	}
+
+	return provider, provider.SubscribeCurrencyPairs(pairs...)
+}
+
+// GetTickerPrices returns the latest dex prices of the provided pairs.
+func (p *SeiDexProvider) GetTickerPrices(pairs ...types.CurrencyPair) (map[string]TickerPrice, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+	defer cancel()
+
+	tickerPrices := make(map[string]TickerPrice, len(pairs))
+	for _, cp := range pairs {
+		resp, err := p.queryClient.GetLatestPrice(ctx, &dextypes.QueryGetLatestPriceRequest{
+			ContractAddr: p.contract,
+			PriceDenom:   p.denom(cp.Quote),
+			AssetDenom:   p.denom(cp.Base),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get sei dex price for %s: %w", cp.String(), err)
+		}
+		// pairs without any trade have an empty price
+		if resp.Price == nil || resp.Price.SnapshotTimestampInSeconds == 0 {
+			return nil, fmt.Errorf("sei dex has no price for %s", cp.String())
+		}
+
+		tickerPrices[cp.String()] = TickerPrice{Price: resp.Price.Price, Volume: p.volume}
+	}
+
+	return tickerPrices, nil
+}
+
+// GetCandlePrices returns the dex twaps of the provided pairs over the
+// lookback as candles of the current time.
+func (p *SeiDexProvider) GetCandlePrices(pairs ...types.CurrencyPair) (map[string][]CandlePrice, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+	defer cancel()
+
+	resp, err := p.queryClient.GetTwaps(ctx, &dextypes.QueryGetTwapsRequest{
+		ContractAddr:    p.contract,
+		LookbackSeconds: uint64(p.lookback.Seconds()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sei dex twaps: %w", err)
+	}
+
+	twaps := make(map[string]sdk.Dec, len(resp.Twaps))
+	for _, twap := range resp.Twaps {
+		if twap.Pair != nil && twap.Twap.IsPositive() {
+			twaps[twap.Pair.AssetDenom+"/"+twap.Pair.PriceDenom] = twap.Twap
+		}
+	}
+
+	now := PastUnixTime(0)
+	candlePrices := make(map[string][]CandlePrice, len(pairs))
+	for _, cp := range pairs {
+		twap, ok := twaps[p.denom(cp.Base)+"/"+p.denom(cp.Quote)]
+		if !ok {
+			return nil, fmt.Errorf("sei dex has no twap for %s", cp.String())
+		}
+
+		candlePrices[cp.String()] = []CandlePrice{{Price: twap, Volume: p.volume, TimeStamp: now}}
+	}
+
+	return candlePrices, nil
+}
+
+// GetAvailablePairs returns the registered pairs of the dex contract whose
+// denoms map to symbols.
+func (p *SeiDexProvider) GetAvailablePairs() (map[string]struct{}, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+	defer cancel()
+
+	resp, err := p.queryClient.GetRegisteredPairs(ctx, &dextypes.QueryRegisteredPairsRequest{
+		ContractAddr: p.contract,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sei dex pairs: %w", err)
+	}
+
+	symbols := make(map[string]string, len(p.denoms))
+	for symbol, denom := range p.denoms {
+		symbols[denom] = symbol
+	}
+	symbol := func(denom string) string {
+		if s, ok := symbols[denom]; ok {
+			return s
+		}
+		return denom
+	}
+
+	availablePairs := make(map[string]struct{}, len(resp.Pairs))
+	for _, pair := range resp.Pairs {
+		cp := types.CurrencyPair{Base: symbol(pair.AssetDenom), Quote: symbol(pair.PriceDenom)}
+		availablePairs[strings.ToUpper(cp.String())] = struct{}{}
+	}
+
+	return availablePairs, nil
+}
+
+// SubscribeCurrencyPairs does nothing, as prices are queried on every price
+// request.
+func (p *SeiDexProvider) SubscribeCurrencyPairs(_ ...types.CurrencyPair) error {
+	return nil
+}
+
+// denom returns the denom of a symbol on the dex.
+func (p *SeiDexProvider) denom(symbol string) string {
+	if denom, ok := p.denoms[symbol]; ok {
+		return denom
+	}
+	return symbol
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/types"
+	dextypes "github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type mockDexQueryClient struct {
+	dextypes.QueryClient
+
+	prices map[string]*dextypes.Price
+	twaps  []*dextypes.Twap
+	pairs  []dextypes.Pair
+}
+
+func (m mockDexQueryClient) GetLatestPrice(_ context.Context, req *dextypes.QueryGetLatestPriceRequest, _ ...grpc.CallOption) (*dextypes.QueryGetLatestPriceResponse, error) {
+	if price, ok := m.prices[req.AssetDenom+"/"+req.PriceDenom]; ok {
+		return &dextypes.QueryGetLatestPriceResponse{Price: price}, nil
+	}
+	return &dextypes.QueryGetLatestPriceResponse{Price: &dextypes.Price{}}, nil
+}
+
+func (m mockDexQueryClient) GetTwaps(_ context.Context, _ *dextypes.QueryGetTwapsRequest, _ ...grpc.CallOption) (*dextypes.QueryGetTwapsResponse, error) {
+	return &dextypes.QueryGetTwapsResponse{Twaps: m.twaps}, nil
+}
+
+func (m mockDexQueryClient) GetRegisteredPairs(_ context.Context, _ *dextypes.QueryRegisteredPairsRequest, _ ...grpc.CallOption) (*dextypes.QueryRegisteredPairsResponse, error) {
+	return &dextypes.QueryRegisteredPairsResponse{Pairs: m.pairs}, nil
+}
+
+func TestSeiDexProvider(t *testing.T) {
+	atomPair := dextypes.Pair{PriceDenom: "uusdc", AssetDenom: "uatom"}
+	queryClient := mockDexQueryClient{
+		prices: map[string]*dextypes.Price{
+			"uatom/uusdc": {SnapshotTimestampInSeconds: 100, Price: sdk.MustNewDecFromStr("34.69"), Pair: &atomPair},
+		},
+		twaps: []*dextypes.Twap{
+			{Pair: &atomPair, Twap: sdk.MustNewDecFromStr("34.5"), LookbackSeconds: 600},
+		},
+		pairs: []dextypes.Pair{atomPair, {PriceDenom: "uusdc", AssetDenom: "ufoo"}},
+	}
+	p, err := NewSeiDexProvider(zerolog.Nop(), queryClient, config.SeiProviders{
+		DexContract:  "sei1contract",
+		DexDenoms:    map[string]string{"ATOM": "uatom", "USDC": "uusdc"},
+		DexVolume:    "10",
+		TwapLookback: "10m",
+	})
+	require.NoError(t, err)
+
+	atom := types.CurrencyPair{Base: "ATOM", Quote: "USDC"}
+	prices, err := p.GetTickerPrices(atom)
+	require.NoError(t, err)
+	require.Equal(t, TickerPrice{Price: sdk.MustNewDecFromStr("34.69"), Volume: sdk.NewDec(10)}, prices["ATOMUSDC"])
+
+	candles, err := p.GetCandlePrices(atom)
+	require.NoError(t, err)
+	require.Len(t, candles["ATOMUSDC"], 1)
+	require.Equal(t, sdk.MustNewDecFromStr("34.5"), candles["ATOMUSDC"][0].Price)
+	require.Equal(t, sdk.NewDec(10), candles["ATOMUSDC"][0].Volume)
+
+	availablePairs, err := p.GetAvailablePairs()
+	require.NoError(t, err)
+	require.Equal(t, map[string]struct{}{"ATOMUSDC": {}, "UFOOUSDC": {}}, availablePairs)
+
+	// pairs without trades have neither a price nor a twap
+	foo := types.CurrencyPair{Base: "ufoo", Quote: "USDC"}
+	_, err = p.GetTickerPrices(foo)
+	require.EqualError(t, err, "sei dex has no price for ufooUSDC")
+	_, err = p.GetCandlePrices(foo)
+	require.EqualError(t, err, "sei dex has no twap for ufooUSDC")
+}
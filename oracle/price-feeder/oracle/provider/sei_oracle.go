@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/types"
+	oracletypes "github.com/sei-protocol/sei-chain/x/oracle/types"
+)
+
+var _ Provider = (*SeiOracleProvider)(nil)
+
+type (
+	// SeiOracleProvider defines a provider reading the twaps of the usd
+	// exchange rates voted on the chain. Its prices are reported without
+	// volume, so they cross-check exchange prices when filtering deviations
+	// but do not weigh in the computed prices.
+	SeiOracleProvider struct {
+		logger      zerolog.Logger
+		queryClient oracletypes.QueryClient
+		chainDenoms map[string]string
+		lookback    time.Duration
+	}
+)
+
+// NewSeiOracleProvider returns a new sei oracle provider reading twaps with
+// the oracle query client. chainDenoms maps the base of pairs to their denom
+// on the chain.
+func NewSeiOracleProvider(
+	logger zerolog.Logger,
+	queryClient oracletypes.QueryClient,
+	cfg config.SeiProviders,
+	chainDenoms map[string]string,
+	pairs ...types.CurrencyPair,
+) (*SeiOracleProvider, error) {
+	lookback, err := time.ParseDuration(cfg.TwapLookback)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := &SeiOracleProvider{
+		logger:      logger.With().Str("provider", config.ProviderSeiOracle).Logger(),
+		queryClient: queryClient,
+		chainDenoms: chainDenoms,
+		lookback:    lookback,
+	}
+
+	return provider, provider.SubscribeCurrencyPairs(pairs...)
+}
+
+// GetTickerPrices returns the oracle twaps of the provided pairs.
+func (p *SeiOracleProvider) GetTickerPrices(pairs ...types.CurrencyPair) (map[string]TickerPrice, error) {
+	twaps, err := p.getTwaps()
+	if err != nil {
+		return nil, err
+	}
+
+	tickerPrices := make(map[string]TickerPrice, len(pairs))
+	for _, cp := range pairs {
+		twap, err := p.pairTwap(twaps, cp)
+		if err != nil {
+			return nil, err
+		}
+
+		tickerPrices[cp.String()] = TickerPrice{Price: twap, Volume: sdk.ZeroDec()}
+	}
+
+	return tickerPrices, nil
+}
+
+// GetCandlePrices returns the oracle twaps of the provided pairs as candles
+// of the current time.
+func (p *SeiOracleProvider) GetCandlePrices(pairs ...types.CurrencyPair) (map[string][]CandlePrice, error) {
+	twaps, err := p.getTwaps()
+	if err != nil {
+		return nil, err
+	}
+
+	now := PastUnixTime(0)
+	candlePrices := make(map[string][]CandlePrice, len(pairs))
+	for _, cp := range pairs {
+		twap, err := p.pairTwap(twaps, cp)
+		if err != nil {
+			return nil, err
+		}
+
+		candlePrices[cp.String()] = []CandlePrice{{Price: twap, Volume: sdk.ZeroDec(), TimeStamp: now}}
+	}
+
+	return candlePrices, nil
+}
+
+// GetAvailablePairs returns the usd pairs of the bases whose chain denom has
+// a twap.
+func (p *SeiOracleProvider) GetAvailablePairs() (map[string]struct{}, error) {
+	twaps, err := p.getTwaps()
+	if err != nil {
+		return nil, err
+	}
+
+	availablePairs := make(map[string]struct{}, len(twaps))
+	for base, denom := range p.chainDenoms {
+		if _, ok := twaps[denom]; ok {
+			cp := types.CurrencyPair{Base: base, Quote: config.DenomUSD}
+			availablePairs[strings.ToUpper(cp.String())] = struct{}{}
+		}
+	}
+
+	return availablePairs, nil
+}
+
+// SubscribeCurrencyPairs does nothing, as twaps are queried on every price
+// request.
+func (p *SeiOracleProvider) SubscribeCurrencyPairs(_ ...types.CurrencyPair) error {
+	return nil
+}
+
+// getTwaps returns the oracle twaps over the lookback by denom.
+func (p *SeiOracleProvider) getTwaps() (map[string]sdk.Dec, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+	defer cancel()
+
+	resp, err := p.queryClient.Twaps(ctx, &oracletypes.QueryTwapsRequest{
+		LookbackSeconds: uint64(p.lookback.Seconds()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sei oracle twaps: %w", err)
+	}
+
+	twaps := make(map[string]sdk.Dec, len(resp.OracleTwaps))
+	for _, twap := range resp.OracleTwaps {
+		twaps[twap.Denom] = twap.Twap
+	}
+
+	return twaps, nil
+}
+
+func (p *SeiOracleProvider) pairTwap(twaps map[string]sdk.Dec, cp types.CurrencyPair) (sdk.Dec, error) {
+	if strings.ToUpper(cp.Quote) != config.DenomUSD {
+		return sdk.Dec{}, fmt.Errorf("sei oracle only provides usd quotes: %s", cp.String())
+	}
+
+	twap, ok := twaps[p.chainDenoms[cp.Base]]
+	if !ok || !twap.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("sei oracle has no twap for %s", cp.String())
+	}
+
+	return twap, nil
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/types"
+	oracletypes "github.com/sei-protocol/sei-chain/x/oracle/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type mockOracleQueryClient struct {
+	oracletypes.QueryClient
+
+	twaps oracletypes.OracleTwaps
+}
+
+func (m mockOracleQueryClient) Twaps(_ context.Context, req *oracletypes.QueryTwapsRequest, _ ...grpc.CallOption) (*oracletypes.QueryTwapsResponse, error) {
+	return &oracletypes.QueryTwapsResponse{OracleTwaps: m.twaps}, nil
+}
+
+func TestSeiOracleProvider(t *testing.T) {
+	queryClient := mockOracleQueryClient{
+		twaps: oracletypes.OracleTwaps{
+			{Denom: "uatom", Twap: sdk.MustNewDecFromStr("34.5"), LookbackSeconds: 600},
+			{Denom: "ueth", Twap: sdk.MustNewDecFromStr("1100"), LookbackSeconds: 600},
+		},
+	}
+	p, err := NewSeiOracleProvider(
+		zerolog.Nop(),
+		queryClient,
+		config.SeiProviders{TwapLookback: "10m"},
+		map[string]string{"ATOM": "uatom", "ETH": "ueth", "SEI": "usei"},
+	)
+	require.NoError(t, err)
+
+	atom := types.CurrencyPair{Base: "ATOM", Quote: "USD"}
+	eth := types.CurrencyPair{Base: "ETH", Quote: "USD"}
+	prices, err := p.GetTickerPrices(atom, eth)
+	require.NoError(t, err)
+	require.Equal(t, TickerPrice{Price: sdk.MustNewDecFromStr("34.5"), Volume: sdk.ZeroDec()}, prices["ATOMUSD"])
+	require.Equal(t, TickerPrice{Price: sdk.NewDec(1100), Volume: sdk.ZeroDec()}, prices["ETHUSD"])
+
+	candles, err := p.GetCandlePrices(atom)
+	require.NoError(t, err)
+	require.Len(t, candles["ATOMUSD"], 1)
+	require.Equal(t, sdk.MustNewDecFromStr("34.5"), candles["ATOMUSD"][0].Price)
+
+	availablePairs, err := p.GetAvailablePairs()
+	require.NoError(t, err)
+	require.Equal(t, map[string]struct{}{"ATOMUSD": {}, "ETHUSD": {}}, availablePairs)
+
+	_, err = p.GetTickerPrices(types.CurrencyPair{Base: "SEI", Quote: "USD"})
+	require.EqualError(t, err, "sei oracle has no twap for SEIUSD")
+	_, err = p.GetTickerPrices(types.CurrencyPair{Base: "ATOM", Quote: "USDT"})
+	require.EqualError(t, err, "sei oracle only provides usd quotes: ATOMUSDT")
+}
@@ -0,0 +1,134 @@
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc"
+
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/types"
+	oracletypes "github.com/sei-protocol/sei-chain/x/oracle/types"
+)
+
+// GetShadowReport returns the last divergence report computed in shadow mode,
+// if any.
+func (o *Oracle) GetShadowReport() (types.ShadowReport, bool) {
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	if o.shadowReport == nil {
+		return types.ShadowReport{}, false
+	}
+	return *o.shadowReport, true
+}
+
+// reportDivergence compares the exchange rates that would have been voted with
+// the on-chain exchange rates and the last vote of the validator, and reports
+// their divergence in place of broadcasting the vote.
+func (o *Oracle) reportDivergence(
+	ctx context.Context,
+	blockHeight int64,
+	valAddr sdk.ValAddress,
+	shadowRates sdk.DecCoins,
+) error {
+	exchangeRates, votedRates, err := o.getOnChainRates(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	report := types.NewShadowReport(blockHeight, shadowRates, exchangeRates, votedRates)
+
+	o.mtx.Lock()
+	o.shadowReport = &report
+	o.mtx.Unlock()
+
+	for denom, divergence := range report.Denoms {
+		setDivergenceMetric(denom, "exchange_rate", divergence.ExchangeRateDivergence)
+		setDivergenceMetric(denom, "vote", divergence.VoteDivergence)
+
+		event := o.logger.Info().
+			Int64("height", blockHeight).
+			Str("denom", denom).
+			Str("shadow_rate", divergence.ShadowRate.String())
+		if divergence.ExchangeRateDivergence != nil {
+			event = event.Str("exchange_rate_divergence", divergence.ExchangeRateDivergence.String())
+		}
+		if divergence.VoteDivergence != nil {
+			event = event.Str("vote_divergence", divergence.VoteDivergence.String())
+		}
+		event.Msg("shadow vote divergence")
+	}
+
+	return nil
+}
+
+// getOnChainRates returns the on-chain exchange rates and the rates of the
+// latest vote of the validator by denom.
+func (o *Oracle) getOnChainRates(
+	ctx context.Context,
+	valAddr sdk.ValAddress,
+) (map[string]sdk.Dec, map[string]sdk.Dec, error) {
+	grpcConn, err := grpc.Dial(
+		o.oracleClient.GRPCEndpoint,
+		// the Cosmos SDK doesn't support any transport security mechanism
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(dialerFunc),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to dial Cosmos gRPC service: %w", err)
+	}
+
+	defer grpcConn.Close()
+	queryClient := oracletypes.NewQueryClient(grpcConn)
+
+	ctx, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+
+	ratesResponse, err := queryClient.ExchangeRates(ctx, &oracletypes.QueryExchangeRatesRequest{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get x/oracle exchange rates: %w", err)
+	}
+
+	exchangeRates := make(map[string]sdk.Dec, len(ratesResponse.DenomOracleExchangeRatePairs))
+	for _, pair := range ratesResponse.DenomOracleExchangeRatePairs {
+		exchangeRates[pair.Denom] = pair.OracleExchangeRate.ExchangeRate
+	}
+
+	resultsResponse, err := queryClient.BallotResults(ctx, &oracletypes.QueryBallotResultsRequest{
+		ValidatorAddr: valAddr.String(),
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get x/oracle ballot results: %w", err)
+	}
+
+	votedRates := make(map[string]sdk.Dec)
+	if results := resultsResponse.BallotResults; len(results) > 0 {
+		for _, rate := range results[len(results)-1].ValidatorExchangeRates(valAddr.String()) {
+			votedRates[rate.Denom] = rate.Amount
+		}
+	}
+
+	return exchangeRates, votedRates, nil
+}
+
+// setDivergenceMetric sets the gauge of the divergence of a denom from the
+// reference, if available.
+func setDivergenceMetric(denom string, reference string, divergence *sdk.Dec) {
+	if divergence == nil {
+		return
+	}
+
+	value, err := divergence.Float64()
+	if err != nil {
+		return
+	}
+
+	telemetry.SetGaugeWithLabels([]string{"shadow", "divergence"}, float32(value), []metrics.Label{
+		{Name: "denom", Value: denom},
+		{Name: "reference", Value: reference},
+	})
+}
@@ -0,0 +1,197 @@
+package store
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/provider"
+)
+
+const dbName = "prices"
+
+type (
+	// Snapshot defines the ticker prices reported by providers when prices
+	// were set, and the candles reported since the previous snapshot.
+	Snapshot struct {
+		// Unix timestamp in milliseconds
+		Timestamp int64                              `json:"timestamp"`
+		Prices    provider.AggregatedProviderPrices  `json:"prices"`
+		Candles   provider.AggregatedProviderCandles `json:"candles"`
+	}
+
+	// PriceStore defines a store of recent snapshots, ordered by timestamp.
+	// Snapshots older than the retention are pruned when recording.
+	PriceStore struct {
+		db        dbm.DB
+		retention time.Duration
+	}
+)
+
+// New returns a new PriceStore backed by the given database.
+func New(db dbm.DB, retention time.Duration) *PriceStore {
+	return &PriceStore{
+		db:        db,
+		retention: retention,
+	}
+}
+
+// Open returns a new PriceStore backed by a LevelDB database in the given
+// directory, which is created if it does not exist.
+func Open(dir string, retention time.Duration) (*PriceStore, error) {
+	db, err := dbm.NewGoLevelDB(dbName, dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open price store: %w", err)
+	}
+
+	return New(db, retention), nil
+}
+
+// Close closes the underlying database.
+func (s *PriceStore) Close() error {
+	return s.db.Close()
+}
+
+// Record stores a snapshot and prunes snapshots older than the retention
+// relative to its timestamp.
+func (s *PriceStore) Record(snapshot Snapshot) error {
+	bz, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	if err := batch.Set(snapshotKey(snapshot.Timestamp), bz); err != nil {
+		return err
+	}
+
+	if cutoff := snapshot.Timestamp - s.retention.Milliseconds(); cutoff > 0 {
+		if err := s.prune(batch, cutoff); err != nil {
+			return err
+		}
+	}
+
+	return batch.Write()
+}
+
+// prune deletes snapshots older than the cutoff in the batch.
+func (s *PriceStore) prune(batch dbm.Batch, cutoff int64) error {
+	iterator, err := s.db.Iterator(nil, snapshotKey(cutoff))
+	if err != nil {
+		return err
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if err := batch.Delete(iterator.Key()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Latest returns the most recent snapshot, if any.
+func (s *PriceStore) Latest() (Snapshot, bool, error) {
+	iterator, err := s.db.ReverseIterator(nil, nil)
+	if err != nil {
+		return Snapshot{}, false, err
+	}
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return Snapshot{}, false, nil
+	}
+
+	var snapshot Snapshot
+	if err := json.Unmarshal(iterator.Value(), &snapshot); err != nil {
+		return Snapshot{}, false, err
+	}
+
+	return snapshot, true, nil
+}
+
+// IterateSnapshots iterates over the snapshots with a timestamp within
+// [from, to], oldest first, until cb returns true. A zero bound is open.
+func (s *PriceStore) IterateSnapshots(from int64, to int64, cb func(Snapshot) (stop bool)) error {
+	var start, end []byte
+	if from > 0 {
+		start = snapshotKey(from)
+	}
+	if to > 0 {
+		end = snapshotKey(to + 1)
+	}
+
+	iterator, err := s.db.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot Snapshot
+		if err := json.Unmarshal(iterator.Value(), &snapshot); err != nil {
+			return err
+		}
+		if cb(snapshot) {
+			break
+		}
+	}
+
+	return nil
+}
+
+// Candles returns the candles with a timestamp within (from, to] recorded by
+// the snapshots within [from, to], ordered by timestamp. A candle recorded by
+// several snapshots is returned once, as last recorded.
+func (s *PriceStore) Candles(from int64, to int64) (provider.AggregatedProviderCandles, error) {
+	byTimestamp := make(map[string]map[string]map[int64]provider.CandlePrice)
+	err := s.IterateSnapshots(from, to, func(snapshot Snapshot) bool {
+		for providerName, baseCandles := range snapshot.Candles {
+			if _, ok := byTimestamp[providerName]; !ok {
+				byTimestamp[providerName] = make(map[string]map[int64]provider.CandlePrice)
+			}
+			for base, candles := range baseCandles {
+				if _, ok := byTimestamp[providerName][base]; !ok {
+					byTimestamp[providerName][base] = make(map[int64]provider.CandlePrice)
+				}
+				for _, c := range candles {
+					if from < c.TimeStamp && c.TimeStamp <= to {
+						byTimestamp[providerName][base][c.TimeStamp] = c
+					}
+				}
+			}
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	candles := make(provider.AggregatedProviderCandles, len(byTimestamp))
+	for providerName, baseCandles := range byTimestamp {
+		candles[providerName] = make(map[string][]provider.CandlePrice, len(baseCandles))
+		for base, cs := range baseCandles {
+			sorted := make([]provider.CandlePrice, 0, len(cs))
+			for _, c := range cs {
+				sorted = append(sorted, c)
+			}
+			sort.Slice(sorted, func(i, j int) bool { return sorted[i].TimeStamp < sorted[j].TimeStamp })
+			candles[providerName][base] = sorted
+		}
+	}
+
+	return candles, nil
+}
+
+func snapshotKey(timestamp int64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(timestamp))
+	return key
+}
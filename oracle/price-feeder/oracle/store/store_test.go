@@ -0,0 +1,91 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/provider"
+)
+
+func snapshotAt(timestamp int64, price string) Snapshot {
+	return Snapshot{
+		Timestamp: timestamp,
+		Prices: provider.AggregatedProviderPrices{
+			config.ProviderBinance: {
+				"ATOM": {Price: sdk.MustNewDecFromStr(price), Volume: sdk.NewDec(10)},
+			},
+		},
+		Candles: provider.AggregatedProviderCandles{
+			config.ProviderBinance: {
+				"ATOM": {{Price: sdk.MustNewDecFromStr(price), Volume: sdk.NewDec(10), TimeStamp: timestamp}},
+			},
+		},
+	}
+}
+
+func TestPriceStore(t *testing.T) {
+	s := New(dbm.NewMemDB(), time.Minute)
+
+	_, found, err := s.Latest()
+	require.NoError(t, err)
+	require.False(t, found)
+
+	require.NoError(t, s.Record(snapshotAt(1000, "10")))
+	require.NoError(t, s.Record(snapshotAt(30_000, "11")))
+	require.NoError(t, s.Record(snapshotAt(60_000, "12")))
+
+	latest, found, err := s.Latest()
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, snapshotAt(60_000, "12"), latest)
+
+	timestamps := func(from, to int64) []int64 {
+		result := []int64{}
+		require.NoError(t, s.IterateSnapshots(from, to, func(snapshot Snapshot) bool {
+			result = append(result, snapshot.Timestamp)
+			return false
+		}))
+		return result
+	}
+	require.Equal(t, []int64{1000, 30_000, 60_000}, timestamps(0, 0))
+	require.Equal(t, []int64{30_000}, timestamps(2000, 30_000))
+	require.Equal(t, []int64{30_000, 60_000}, timestamps(30_000, 0))
+
+	// snapshots older than the retention are pruned
+	require.NoError(t, s.Record(snapshotAt(90_000, "13")))
+	require.Equal(t, []int64{30_000, 60_000, 90_000}, timestamps(0, 0))
+}
+
+func TestPriceStoreCandles(t *testing.T) {
+	s := New(dbm.NewMemDB(), time.Hour)
+
+	candle := func(timestamp int64, price int64) provider.CandlePrice {
+		return provider.CandlePrice{Price: sdk.NewDec(price), Volume: sdk.OneDec(), TimeStamp: timestamp}
+	}
+	record := func(timestamp int64, candles ...provider.CandlePrice) {
+		require.NoError(t, s.Record(Snapshot{
+			Timestamp: timestamp,
+			Candles:   provider.AggregatedProviderCandles{config.ProviderBinance: {"ATOM": candles}},
+		}))
+	}
+	record(1000, candle(500, 10), candle(900, 11))
+	record(2000, candle(1500, 12))
+	// a candle recorded again, e.g. after a restart, is returned as last recorded
+	record(3000, candle(1500, 13), candle(2500, 14))
+
+	candles, err := s.Candles(0, 3000)
+	require.NoError(t, err)
+	require.Equal(t, []provider.CandlePrice{
+		candle(500, 10), candle(900, 11), candle(1500, 13), candle(2500, 14),
+	}, candles[config.ProviderBinance]["ATOM"])
+
+	// candles outside of the range are not returned
+	candles, err = s.Candles(900, 2000)
+	require.NoError(t, err)
+	require.Equal(t, []provider.CandlePrice{candle(1500, 12)}, candles[config.ProviderBinance]["ATOM"])
+}
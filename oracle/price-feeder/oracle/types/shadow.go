@@ -0,0 +1,67 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type (
+	// ShadowReport defines the divergence of the exchange rates computed in
+	// shadow mode from the on-chain exchange rates and the last vote of the
+	// validator, by chain denom.
+	ShadowReport struct {
+		Height int64                      `json:"height"`
+		Denoms map[string]DenomDivergence `json:"denoms"`
+	}
+
+	// DenomDivergence defines the divergence of the exchange rate computed in
+	// shadow mode for a denom. A divergence is the relative difference of the
+	// shadow rate to the compared rate, and is omitted when the compared rate
+	// is not available.
+	DenomDivergence struct {
+		ShadowRate             sdk.Dec  `json:"shadow_rate"`
+		ExchangeRate           *sdk.Dec `json:"exchange_rate,omitempty"`
+		ExchangeRateDivergence *sdk.Dec `json:"exchange_rate_divergence,omitempty"`
+		VotedRate              *sdk.Dec `json:"voted_rate,omitempty"`
+		VoteDivergence         *sdk.Dec `json:"vote_divergence,omitempty"`
+	}
+)
+
+// NewShadowReport returns the divergence of the shadow rates from the on-chain
+// exchange rates and the voted rates, both keyed by chain denom.
+func NewShadowReport(
+	height int64,
+	shadowRates sdk.DecCoins,
+	exchangeRates map[string]sdk.Dec,
+	votedRates map[string]sdk.Dec,
+) ShadowReport {
+	report := ShadowReport{
+		Height: height,
+		Denoms: make(map[string]DenomDivergence, len(shadowRates)),
+	}
+
+	for _, rate := range shadowRates {
+		divergence := DenomDivergence{ShadowRate: rate.Amount}
+		if exchangeRate, ok := exchangeRates[rate.Denom]; ok {
+			divergence.ExchangeRate = &exchangeRate
+			divergence.ExchangeRateDivergence = relativeDivergence(rate.Amount, exchangeRate)
+		}
+		if votedRate, ok := votedRates[rate.Denom]; ok {
+			divergence.VotedRate = &votedRate
+			divergence.VoteDivergence = relativeDivergence(rate.Amount, votedRate)
+		}
+		report.Denoms[rate.Denom] = divergence
+	}
+
+	return report
+}
+
+// relativeDivergence returns (rate - reference) / reference, or nil if the
+// reference is not positive.
+func relativeDivergence(rate, reference sdk.Dec) *sdk.Dec {
+	if !reference.IsPositive() {
+		return nil
+	}
+
+	divergence := rate.Sub(reference).Quo(reference)
+	return &divergence
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewShadowReport(t *testing.T) {
+	shadowRates := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("uatom", sdk.MustNewDecFromStr("10.5")),
+		sdk.NewDecCoinFromDec("ueth", sdk.MustNewDecFromStr("2000")),
+		sdk.NewDecCoinFromDec("usei", sdk.MustNewDecFromStr("0.2")),
+	)
+	exchangeRates := map[string]sdk.Dec{
+		"uatom": sdk.MustNewDecFromStr("10"),
+		"ueth":  sdk.ZeroDec(),
+	}
+	votedRates := map[string]sdk.Dec{
+		"uatom": sdk.MustNewDecFromStr("10.5"),
+		"usei":  sdk.MustNewDecFromStr("0.25"),
+	}
+
+	report := NewShadowReport(10, shadowRates, exchangeRates, votedRates)
+	require.Equal(t, int64(10), report.Height)
+	require.Len(t, report.Denoms, 3)
+
+	atom := report.Denoms["uatom"]
+	require.Equal(t, sdk.MustNewDecFromStr("10.5"), atom.ShadowRate)
+	require.Equal(t, sdk.MustNewDecFromStr("0.05"), *atom.ExchangeRateDivergence)
+	require.Equal(t, sdk.ZeroDec(), *atom.VoteDivergence)
+
+	// a zero exchange rate has no divergence
+	eth := report.Denoms["ueth"]
+	require.NotNil(t, eth.ExchangeRate)
+	require.Nil(t, eth.ExchangeRateDivergence)
+	require.Nil(t, eth.VotedRate)
+	require.Nil(t, eth.VoteDivergence)
+
+	sei := report.Denoms["usei"]
+	require.Nil(t, sei.ExchangeRate)
+	require.Equal(t, sdk.MustNewDecFromStr("-0.2"), *sei.VoteDivergence)
+}
@@ -14,6 +14,10 @@ var minimumTimeWeight = sdk.MustNewDecFromStr("0.2")
 // this lets us mock now for tests
 var mockNow int64
 
+// tvwapFunc computes the TVWAP of candles, as of the current time or of a
+// recorded snapshot
+type tvwapFunc func(provider.AggregatedProviderCandles) (map[string]sdk.Dec, error)
+
 const (
 	// tvwapCandlePeriod represents the time period we use for tvwap in minutes
 	tvwapCandlePeriod = 5 * time.Minute
@@ -74,18 +78,29 @@ func ComputeVWAP(prices provider.AggregatedProviderPrices) (map[string]sdk.Dec,
 //
 // Ref : https://en.wikipedia.org/wiki/Time-weighted_average_price
 func ComputeTVWAP(prices provider.AggregatedProviderCandles) (map[string]sdk.Dec, error) {
-	var (
-		weightedPrices = make(map[string]sdk.Dec)
-		volumeSum      = make(map[string]sdk.Dec)
-		now            = provider.PastUnixTime(0)
-		timePeriod     = provider.PastUnixTime(tvwapCandlePeriod)
-	)
+	now := provider.PastUnixTime(0)
 
 	// this lets us mock now for tests
 	if mockNow > 0 {
 		now = mockNow
 	}
 
+	return computeTVWAPSince(prices, now, provider.PastUnixTime(tvwapCandlePeriod))
+}
+
+// ComputeTVWAPAt computes the time volume weighted average price as of the
+// millisecond timestamp now rather than the current time, so that recorded
+// candles can be replayed.
+func ComputeTVWAPAt(prices provider.AggregatedProviderCandles, now int64) (map[string]sdk.Dec, error) {
+	return computeTVWAPSince(prices, now, now-tvwapCandlePeriod.Milliseconds())
+}
+
+func computeTVWAPSince(prices provider.AggregatedProviderCandles, now int64, timePeriod int64) (map[string]sdk.Dec, error) {
+	var (
+		weightedPrices = make(map[string]sdk.Dec)
+		volumeSum      = make(map[string]sdk.Dec)
+	)
+
 	for _, providerPrices := range prices {
 		for base := range providerPrices {
 			cp := providerPrices[base]
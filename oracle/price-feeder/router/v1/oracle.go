@@ -4,10 +4,13 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/types"
 )
 
 // Oracle defines the Oracle interface contract that the v1 router depends on.
 type Oracle interface {
 	GetLastPriceSyncTimestamp() time.Time
 	GetPrices() sdk.DecCoins
+	GetShadowReport() (types.ShadowReport, bool)
 }
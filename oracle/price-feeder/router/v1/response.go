@@ -5,6 +5,8 @@ import (
 	"net/http"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/types"
 )
 
 // Response constants
@@ -26,6 +28,13 @@ type (
 	PricesResponse struct {
 		Prices map[string]sdk.Dec `json:"prices"`
 	}
+
+	// DivergenceResponse defines the response type for getting the divergence
+	// of the last shadow vote from the on-chain exchange rates and the last vote
+	// of the validator.
+	DivergenceResponse struct {
+		types.ShadowReport
+	}
 )
 
 // errorResponse defines the attributes of a JSON error response.
@@ -70,6 +70,13 @@ func (r *Router) RegisterRoutes(rtr *mux.Router, prefix string) {
 		mChain.ThenFunc(r.pricesHandler()),
 	).Methods(httputil.MethodGET)
 
+	if r.cfg.ShadowMode {
+		v1Router.Handle(
+			"/divergence",
+			mChain.ThenFunc(r.divergenceHandler()),
+		).Methods(httputil.MethodGET)
+	}
+
 	if r.cfg.Telemetry.Enabled {
 		v1Router.Handle(
 			"/metrics",
@@ -104,6 +111,18 @@ func (r *Router) pricesHandler() http.HandlerFunc {
 	}
 }
 
+func (r *Router) divergenceHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		report, ok := r.oracle.GetShadowReport()
+		if !ok {
+			writeErrorResponse(w, http.StatusNotFound, "no divergence reported yet")
+			return
+		}
+
+		httputil.RespondWithJSON(w, http.StatusOK, DivergenceResponse{ShadowReport: report})
+	}
+}
+
 func (r *Router) metricsHandler() http.HandlerFunc {
 	return func(w http.ResponseWriter, req *http.Request) {
 		format := strings.TrimSpace(req.FormValue("format"))
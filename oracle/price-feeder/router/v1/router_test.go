@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/sei-protocol/sei-chain/oracle/price-feeder/config"
+	"github.com/sei-protocol/sei-chain/oracle/price-feeder/oracle/types"
 	v1 "github.com/sei-protocol/sei-chain/oracle/price-feeder/router/v1"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -25,6 +26,13 @@ var (
 		sdk.NewDecCoinFromDec("ATOM", sdk.MustNewDecFromStr("34.84")),
 		sdk.NewDecCoinFromDec("UMEE", sdk.MustNewDecFromStr("4.21")),
 	}
+
+	mockShadowReport = types.NewShadowReport(
+		100,
+		mockPrices,
+		map[string]sdk.Dec{"ATOM": sdk.MustNewDecFromStr("35")},
+		map[string]sdk.Dec{},
+	)
 )
 
 type mockOracle struct{}
@@ -37,6 +45,10 @@ func (m mockOracle) GetPrices() sdk.DecCoins {
 	return mockPrices
 }
 
+func (m mockOracle) GetShadowReport() (types.ShadowReport, bool) {
+	return mockShadowReport, true
+}
+
 type mockMetrics struct{}
 
 func (mockMetrics) Gather(format string) (telemetry.GatherResponse, error) {
@@ -58,6 +70,7 @@ func (rts *RouterTestSuite) SetupSuite() {
 			AllowedOrigins: []string{},
 			VerboseCORS:    false,
 		},
+		ShadowMode: true,
 	}
 
 	r := v1.New(zerolog.Nop(), cfg, mockOracle{}, mockMetrics{})
@@ -103,3 +116,17 @@ func (rts *RouterTestSuite) TestPrices() {
 	rts.Require().Equal(respBody.Prices["UMEE"], mockPrices.AmountOf("UMEE"))
 	rts.Require().Equal(respBody.Prices["FOO"], sdk.Dec{})
 }
+
+func (rts *RouterTestSuite) TestDivergence() {
+	req, err := http.NewRequest("GET", "/api/v1/divergence", nil)
+	rts.Require().NoError(err)
+
+	response := rts.executeRequest(req)
+	rts.Require().Equal(http.StatusOK, response.Code)
+
+	var respBody v1.DivergenceResponse
+	rts.Require().NoError(json.Unmarshal(response.Body.Bytes(), &respBody))
+	rts.Require().Equal(int64(100), respBody.Height)
+	rts.Require().Equal(mockShadowReport.Denoms["ATOM"], respBody.Denoms["ATOM"])
+	rts.Require().Nil(respBody.Denoms["UMEE"].ExchangeRate)
+}
@@ -12,8 +12,13 @@ import (
 // / The (creating contract address, subdenom) pair must be unique.
 // / The created denom's admin is the creating contract address,
 // / but this admin can be changed using the ChangeAdmin binding.
+// / ComplianceEnabled enables force transfers, freezes and pauses of the denom,
+// / and can't be changed later.
+// / The creating contract pays the denom creation fee of the tokenfactory params,
+// / and creation fails once it has created the max denoms per creator.
 type CreateDenom struct {
-	Subdenom string `json:"subdenom"`
+	Subdenom          string `json:"subdenom"`
+	ComplianceEnabled bool   `json:"compliance_enabled,omitempty"`
 }
 
 // / ChangeAdmin changes the admin for a factory denom.
@@ -27,12 +32,20 @@ type SetMetadata struct {
 	Metadata banktypes.Metadata `json:"metadata"`
 }
 
+// / MintTokens mints tokens of a factory denom to the MintToAddress,
+// / or to the contract if it is empty.
 type MintTokens struct {
-	Amount sdk.Coin `json:"amount"`
+	Amount        sdk.Coin `json:"amount"`
+	MintToAddress string   `json:"mint_to_address,omitempty"`
 }
 
+// / BurnTokens burns tokens of a factory denom from the BurnFromAddress,
+// / or from the contract if it is empty. The BurnFromAddress must have approved
+// / burns of the amount by the contract, unless the contract has the compliance
+// / role of the denom.
 type BurnTokens struct {
-	Amount sdk.Coin `json:"amount"`
+	Amount          sdk.Coin `json:"amount"`
+	BurnFromAddress string   `json:"burn_from_address,omitempty"`
 }
 
 // Dex Module msgs
@@ -62,6 +62,17 @@ func (qp QueryPlugin) HandleOracleQuery(ctx sdk.Context, queryData json.RawMessa
 			return nil, oracletypes.ErrEncodingOracleTwaps
 		}
 
+		return bz, nil
+	case parsedQuery.OracleWindowAggregates != nil:
+		res, err := qp.oracleHandler.GetOracleWindowAggregates(ctx, parsedQuery.OracleWindowAggregates)
+		if err != nil {
+			return nil, err
+		}
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, oracletypes.ErrEncodingAggregates
+		}
+
 		return bz, nil
 	default:
 		return nil, oracletypes.ErrUnknownSeiOracleQuery
@@ -128,6 +139,28 @@ func (qp QueryPlugin) HandleDexQuery(ctx sdk.Context, queryData json.RawMessage)
 			return nil, dextypes.ErrEncodingLatestPrice
 		}
 
+		return bz, nil
+	case parsedQuery.GetCandles != nil:
+		res, err := qp.dexHandler.GetCandles(ctx, parsedQuery.GetCandles)
+		if err != nil {
+			return nil, err
+		}
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, dextypes.ErrEncodingCandles
+		}
+
+		return bz, nil
+	case parsedQuery.GetOrderBookDepth != nil:
+		res, err := qp.dexHandler.GetOrderBookDepth(ctx, parsedQuery.GetOrderBookDepth)
+		if err != nil {
+			return nil, err
+		}
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, dextypes.ErrEncodingOrderBookDepth
+		}
+
 		return bz, nil
 	default:
 		return nil, dextypes.ErrUnknownSeiDexQuery
@@ -183,6 +216,17 @@ func (qp QueryPlugin) HandleTokenFactoryQuery(ctx sdk.Context, queryData json.Ra
 			return nil, tokenfactorytypes.ErrEncodingDenomsFromCreator
 		}
 
+		return bz, nil
+	case parsedQuery.DenomCompliance != nil:
+		res, err := qp.tokenfactoryHandler.GetDenomCompliance(ctx, parsedQuery.DenomCompliance)
+		if err != nil {
+			return nil, err
+		}
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, tokenfactorytypes.ErrEncodingDenomCompliance
+		}
+
 		return bz, nil
 	default:
 		return nil, tokenfactorytypes.ErrUnknownSeiTokenFactoryQuery
@@ -124,6 +124,28 @@ func TestEncodeMint(t *testing.T) {
 	require.Equal(t, expectedMsg, *typedDecodedMsg)
 }
 
+func TestEncodeMintTo(t *testing.T) {
+	contractAddr, err := sdk.AccAddressFromBech32("sei1y3pxq5dp900czh0mkudhjdqjq5m8cpmmps8yjw")
+	require.NoError(t, err)
+	msg := bindings.MintTokens{
+		Amount:        sdk.Coin{Amount: sdk.NewInt(100), Denom: "subdenom"},
+		MintToAddress: "sei1hjfwcza3e3uzeznf3qthhakdr9juetl7g6esl4",
+	}
+	serializedMsg, _ := json.Marshal(msg)
+
+	decodedMsgs, err := tokenfactorywasm.EncodeTokenFactoryMint(serializedMsg, contractAddr)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(decodedMsgs))
+	typedDecodedMsg, ok := decodedMsgs[0].(*tokenfactorytypes.MsgMint)
+	require.True(t, ok)
+	expectedMsg := tokenfactorytypes.MsgMint{
+		Sender:        "sei1y3pxq5dp900czh0mkudhjdqjq5m8cpmmps8yjw",
+		Amount:        sdk.Coin{Amount: sdk.NewInt(100), Denom: "subdenom"},
+		MintToAddress: "sei1hjfwcza3e3uzeznf3qthhakdr9juetl7g6esl4",
+	}
+	require.Equal(t, expectedMsg, *typedDecodedMsg)
+}
+
 func TestEncodeBurn(t *testing.T) {
 	contractAddr, err := sdk.AccAddressFromBech32("sei1y3pxq5dp900czh0mkudhjdqjq5m8cpmmps8yjw")
 	require.NoError(t, err)
@@ -144,6 +166,28 @@ func TestEncodeBurn(t *testing.T) {
 	require.Equal(t, expectedMsg, *typedDecodedMsg)
 }
 
+func TestEncodeBurnFrom(t *testing.T) {
+	contractAddr, err := sdk.AccAddressFromBech32("sei1y3pxq5dp900czh0mkudhjdqjq5m8cpmmps8yjw")
+	require.NoError(t, err)
+	msg := bindings.BurnTokens{
+		Amount:          sdk.Coin{Amount: sdk.NewInt(10), Denom: "subdenom"},
+		BurnFromAddress: "sei1hjfwcza3e3uzeznf3qthhakdr9juetl7g6esl4",
+	}
+	serializedMsg, _ := json.Marshal(msg)
+
+	decodedMsgs, err := tokenfactorywasm.EncodeTokenFactoryBurn(serializedMsg, contractAddr)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(decodedMsgs))
+	typedDecodedMsg, ok := decodedMsgs[0].(*tokenfactorytypes.MsgBurn)
+	require.True(t, ok)
+	expectedMsg := tokenfactorytypes.MsgBurn{
+		Sender:          "sei1y3pxq5dp900czh0mkudhjdqjq5m8cpmmps8yjw",
+		Amount:          sdk.Coin{Amount: sdk.NewInt(10), Denom: "subdenom"},
+		BurnFromAddress: "sei1hjfwcza3e3uzeznf3qthhakdr9juetl7g6esl4",
+	}
+	require.Equal(t, expectedMsg, *typedDecodedMsg)
+}
+
 func TestEncodeChangeAdmin(t *testing.T) {
 	contractAddr, err := sdk.AccAddressFromBech32("sei1y3pxq5dp900czh0mkudhjdqjq5m8cpmmps8yjw")
 	require.NoError(t, err)
@@ -0,0 +1,42 @@
+package wasmbinding
+
+import (
+	"encoding/json"
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/app"
+	"github.com/sei-protocol/sei-chain/wasmbinding"
+	"github.com/sei-protocol/sei-chain/wasmbinding/bindings"
+	tokenfactorytypes "github.com/sei-protocol/sei-chain/x/tokenfactory/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWasmCreateDenomMaxDenomsPerCreator(t *testing.T) {
+	testWrapper, _ := SetupWasmbindingTest(t)
+
+	params := tokenfactorytypes.DefaultParams()
+	params.MaxDenomsPerCreator = 1
+	testWrapper.App.TokenFactoryKeeper.SetParams(testWrapper.Ctx, params)
+
+	contractAddr, err := sdk.AccAddressFromBech32(app.TestUser)
+	require.NoError(t, err)
+	createDenom := func(subdenom string) error {
+		createDenomMsg, err := json.Marshal(bindings.CreateDenom{Subdenom: subdenom})
+		require.NoError(t, err)
+		rawMsg, err := json.Marshal(wasmbinding.SeiWasmMessage{CreateDenom: createDenomMsg})
+		require.NoError(t, err)
+
+		msgs, err := wasmbinding.CustomEncoder(contractAddr, rawMsg, wasmvmtypes.MessageInfo{}, wasmtypes.CodeInfo{})
+		require.NoError(t, err)
+		require.Len(t, msgs, 1)
+		handler := testWrapper.App.MsgServiceRouter().Handler(msgs[0])
+		_, err = handler(testWrapper.Ctx, msgs[0])
+		return err
+	}
+
+	require.NoError(t, createDenom("bitcoin"))
+	require.ErrorIs(t, createDenom("litecoin"), tokenfactorytypes.ErrMaxDenomsPerCreator)
+}
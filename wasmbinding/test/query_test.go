@@ -152,6 +152,47 @@ func TestWasmGetOracleTwaps(t *testing.T) {
 	}}, parsedRes2)
 }
 
+func TestWasmGetOracleWindowAggregates(t *testing.T) {
+	testWrapper, customQuerier := SetupWasmbindingTest(t)
+
+	req := oraclebinding.SeiOracleQuery{OracleWindowAggregates: &oracletypes.QueryWindowAggregatesRequest{StartTime: 3650, EndTime: 3700}}
+	queryData, err := json.Marshal(req)
+	require.NoError(t, err)
+	query := wasmbinding.SeiQueryWrapper{Route: wasmbinding.OracleRoute, QueryData: queryData}
+
+	rawQuery, err := json.Marshal(query)
+	require.NoError(t, err)
+
+	testWrapper.Ctx = testWrapper.Ctx.WithBlockHeight(11).WithBlockTime(time.Unix(3600, 0))
+	priceSnapshot := oracletypes.PriceSnapshot{SnapshotTimestamp: 3600, PriceSnapshotItems: oracletypes.PriceSnapshotItems{
+		oracletypes.NewPriceSnapshotItem(oracleutils.MicroAtomDenom, oracletypes.OracleExchangeRate{ExchangeRate: sdk.NewDec(20), LastUpdate: sdk.NewInt(10)}),
+	}}
+	testWrapper.App.OracleKeeper.AddPriceSnapshot(testWrapper.Ctx, priceSnapshot)
+
+	// this should error because the window ends in the future
+	_, err = customQuerier(testWrapper.Ctx, rawQuery)
+	require.Error(t, err)
+
+	testWrapper.Ctx = testWrapper.Ctx.WithBlockHeight(14).WithBlockTime(time.Unix(3700, 0))
+
+	res, err := customQuerier(testWrapper.Ctx, rawQuery)
+	require.NoError(t, err)
+
+	var parsedRes oracletypes.QueryWindowAggregatesResponse
+	err = json.Unmarshal(res, &parsedRes)
+	require.NoError(t, err)
+	require.Equal(t, oracletypes.QueryWindowAggregatesResponse{OracleWindowAggregates: oracletypes.OracleWindowAggregates{
+		oracletypes.OracleWindowAggregate{
+			Denom:    oracleutils.MicroAtomDenom,
+			Twap:     sdk.NewDec(20),
+			Median:   sdk.NewDec(20),
+			Min:      sdk.NewDec(20),
+			Max:      sdk.NewDec(20),
+			Duration: 50,
+		},
+	}}, parsedRes)
+}
+
 func TestWasmGetOracleTwapsErrorHandling(t *testing.T) {
 	testWrapper, customQuerier := SetupWasmbindingTest(t)
 
@@ -288,6 +329,81 @@ func TestWasmGetOrderSimulation(t *testing.T) {
 	require.Equal(t, sdk.NewDec(0), *parsedRes.ExecutedQuantity)
 }
 
+func TestWasmGetCandles(t *testing.T) {
+	testWrapper, customQuerier := SetupWasmbindingTest(t)
+
+	req := dexbinding.SeiDexQuery{GetCandles: &dextypes.QueryGetCandlesRequest{
+		ContractAddr:      app.TestContract,
+		PriceDenom:        "sei",
+		AssetDenom:        "atom",
+		IntervalInSeconds: dextypes.CandleIntervalOneMinute,
+	}}
+	queryData, err := json.Marshal(req)
+	require.NoError(t, err)
+	query := wasmbinding.SeiQueryWrapper{Route: wasmbinding.DexRoute, QueryData: queryData}
+
+	rawQuery, err := json.Marshal(query)
+	require.NoError(t, err)
+
+	testWrapper.Ctx = testWrapper.Ctx.WithBlockHeight(11).WithBlockTime(time.Unix(3600, 0))
+	testWrapper.App.DexKeeper.UpdateCandles(
+		testWrapper.Ctx,
+		app.TestContract,
+		dextypes.Pair{PriceDenom: "sei", AssetDenom: "atom"},
+		sdk.NewDec(20),
+		sdk.NewDec(5),
+	)
+
+	res, err := customQuerier(testWrapper.Ctx, rawQuery)
+	require.NoError(t, err)
+
+	var parsedRes dextypes.QueryGetCandlesResponse
+	err = json.Unmarshal(res, &parsedRes)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(parsedRes.Candles))
+	require.Equal(t, uint64(3600), parsedRes.Candles[0].BeginTimestamp)
+	require.Equal(t, sdk.NewDec(20), *parsedRes.Candles[0].Close)
+	require.Equal(t, sdk.NewDec(5), *parsedRes.Candles[0].Volume)
+}
+
+func TestWasmGetOrderBookDepth(t *testing.T) {
+	testWrapper, customQuerier := SetupWasmbindingTest(t)
+
+	req := dexbinding.SeiDexQuery{GetOrderBookDepth: &dextypes.QueryGetOrderBookDepthRequest{
+		ContractAddr: app.TestContract,
+		PriceDenom:   "sei",
+		AssetDenom:   "atom",
+		Depth:        10,
+	}}
+	queryData, err := json.Marshal(req)
+	require.NoError(t, err)
+	query := wasmbinding.SeiQueryWrapper{Route: wasmbinding.DexRoute, QueryData: queryData}
+
+	rawQuery, err := json.Marshal(query)
+	require.NoError(t, err)
+
+	testWrapper.App.DexKeeper.SetLongBook(testWrapper.Ctx, app.TestContract, dextypes.LongBook{
+		Price: sdk.NewDec(20),
+		Entry: &dextypes.OrderEntry{
+			Price:      sdk.NewDec(20),
+			Quantity:   sdk.NewDec(3),
+			PriceDenom: "sei",
+			AssetDenom: "atom",
+		},
+	})
+
+	res, err := customQuerier(testWrapper.Ctx, rawQuery)
+	require.NoError(t, err)
+
+	var parsedRes dextypes.QueryGetOrderBookDepthResponse
+	err = json.Unmarshal(res, &parsedRes)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(parsedRes.Bids))
+	require.Equal(t, sdk.NewDec(20), parsedRes.Bids[0].Price)
+	require.Equal(t, sdk.NewDec(3), parsedRes.Bids[0].Quantity)
+	require.Empty(t, parsedRes.Asks)
+}
+
 func TestWasmGetEpoch(t *testing.T) {
 	testWrapper, customQuerier := SetupWasmbindingTest(t)
 
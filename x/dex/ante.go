@@ -64,6 +64,9 @@ func (tsmd TickSizeMultipleDecorator) CheckTickSizeMultiple(ctx sdk.Context, msg
 						return sdkerrors.Wrapf(errors.New("ErrPriceNotMultipleOfTickSize"), "price needs to be non-zero and multiple of price tick size")
 					}
 				}
+				if IsStopOrder(order) && !IsDecimalMultipleOf(order.TriggerPrice, priceTickSize) {
+					return sdkerrors.Wrapf(errors.New("ErrTriggerPriceNotMultipleOfTickSize"), "trigger price needs to be non-zero and multiple of price tick size")
+				}
 				quantityTickSize, found := tsmd.dexKeeper.GetQuantityTickSizeForPair(ctx, contractAddr,
 					types.Pair{
 						PriceDenom: order.PriceDenom,
@@ -86,9 +89,15 @@ func (tsmd TickSizeMultipleDecorator) CheckTickSizeMultiple(ctx sdk.Context, msg
 	return nil
 }
 
-// Check whether order is market order type
+// Check whether order is market order type. Stop loss orders become market
+// orders once triggered, so they are treated the same way.
 func IsMarketOrder(order *types.Order) bool {
-	return order.OrderType == types.OrderType_MARKET || order.OrderType == types.OrderType_FOKMARKET || order.OrderType == types.OrderType_FOKMARKETBYVALUE
+	return order.OrderType == types.OrderType_MARKET || order.OrderType == types.OrderType_FOKMARKET || order.OrderType == types.OrderType_FOKMARKETBYVALUE || order.OrderType == types.OrderType_STOPLOSS
+}
+
+// Check whether order is stop loss/limit order type
+func IsStopOrder(order *types.Order) bool {
+	return order.OrderType == types.OrderType_STOPLOSS || order.OrderType == types.OrderType_STOPLIMIT
 }
 
 // Check whether decimal a is multiple of decimal b
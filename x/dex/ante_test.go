@@ -280,4 +280,38 @@ func TestTickSizeMultipleDecorator(t *testing.T) {
 	}
 	_, err = decorator.AnteHandle(ctx, tx, false, terminator)
 	require.NotNil(t, err)
+
+	// Stop loss order with price zero allowed
+	tx = TestTx{
+		msgs: []sdk.Msg{
+			types.NewMsgPlaceOrders("someone", []*types.Order{{
+				ContractAddr: "contract",
+				PriceDenom:   keepertest.TestPair.PriceDenom,
+				AssetDenom:   keepertest.TestPair.AssetDenom,
+				Price:        sdk.ZeroDec(),
+				Quantity:     quantity,
+				OrderType:    types.OrderType_STOPLOSS,
+				TriggerPrice: price,
+			}}, "contract", sdk.NewCoins())},
+		fee: sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(27500))),
+	}
+	_, err = decorator.AnteHandle(ctx, tx, false, terminator)
+	require.Nil(t, err)
+
+	// Stop orders without divisible trigger price
+	tx = TestTx{
+		msgs: []sdk.Msg{
+			types.NewMsgPlaceOrders("someone", []*types.Order{{
+				ContractAddr: "contract",
+				PriceDenom:   keepertest.TestPair.PriceDenom,
+				AssetDenom:   keepertest.TestPair.AssetDenom,
+				Price:        price,
+				Quantity:     quantity,
+				OrderType:    types.OrderType_STOPLIMIT,
+				TriggerPrice: smallerVal,
+			}}, "contract", sdk.NewCoins())},
+		fee: sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(27500))),
+	}
+	_, err = decorator.AnteHandle(ctx, tx, false, terminator)
+	require.NotNil(t, err)
 }
@@ -119,6 +119,11 @@ func (o *BlockOrders) GetLimitOrders(direction types.PositionDirection) []*types
 	return o.getOrdersByCriteria(types.OrderType_LIMIT, direction)
 }
 
+func (o *BlockOrders) GetStopOrders(direction types.PositionDirection) []*types.Order {
+	res := o.getOrdersByCriteria(types.OrderType_STOPLOSS, direction)
+	return append(res, o.getOrdersByCriteria(types.OrderType_STOPLIMIT, direction)...)
+}
+
 func (o *BlockOrders) getOrdersByCriteria(orderType types.OrderType, direction types.PositionDirection) []*types.Order {
 	res := []*types.Order{}
 	iterator := sdk.KVStorePrefixIterator(o.orderStore, []byte{})
@@ -255,3 +255,52 @@ func TestGetSortedMarketOrders(t *testing.T) {
 	require.Equal(t, uint64(13), marketSells[7].Id)
 	require.Equal(t, uint64(19), marketSells[8].Id)
 }
+
+func TestGetStopOrders(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	stateOne := dex.NewMemState(keeper.GetMemStoreKey())
+	blockOrders := stateOne.GetBlockOrders(ctx, types.ContractAddress(TEST_CONTRACT), keepertest.TestPair)
+	blockOrders.Add(&types.Order{
+		Id:                1,
+		Account:           "test",
+		ContractAddr:      TEST_CONTRACT,
+		PositionDirection: types.PositionDirection_LONG,
+		OrderType:         types.OrderType_STOPLOSS,
+	})
+	blockOrders.Add(&types.Order{
+		Id:                2,
+		Account:           "test",
+		ContractAddr:      TEST_CONTRACT,
+		PositionDirection: types.PositionDirection_LONG,
+		OrderType:         types.OrderType_STOPLIMIT,
+	})
+	blockOrders.Add(&types.Order{
+		Id:                3,
+		Account:           "test",
+		ContractAddr:      TEST_CONTRACT,
+		PositionDirection: types.PositionDirection_LONG,
+		OrderType:         types.OrderType_LIMIT,
+	})
+	blockOrders.Add(&types.Order{
+		Id:                4,
+		Account:           "test",
+		ContractAddr:      TEST_CONTRACT,
+		PositionDirection: types.PositionDirection_SHORT,
+		OrderType:         types.OrderType_STOPLOSS,
+	})
+	blockOrders.Add(&types.Order{
+		Id:                5,
+		Account:           "test",
+		ContractAddr:      TEST_CONTRACT,
+		PositionDirection: types.PositionDirection_LONG,
+		OrderType:         types.OrderType_STOPLOSS,
+		Status:            types.OrderStatus_FAILED_TO_PLACE,
+	})
+	longs := blockOrders.GetStopOrders(types.PositionDirection_LONG)
+	require.Equal(t, 2, len(longs))
+	require.Equal(t, uint64(1), longs[0].Id)
+	require.Equal(t, uint64(2), longs[1].Id)
+	shorts := blockOrders.GetStopOrders(types.PositionDirection_SHORT)
+	require.Equal(t, 1, len(shorts))
+	require.Equal(t, uint64(4), shorts[0].Id)
+}
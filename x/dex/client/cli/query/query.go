@@ -41,6 +41,10 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdGetOrdersByID())
 	cmd.AddCommand(CmdGetMatchResult())
 	cmd.AddCommand(CmdGetOrderCount())
+	cmd.AddCommand(CmdGetCandles())
+	cmd.AddCommand(CmdGetOrderBookDepth())
+	cmd.AddCommand(CmdGetAccountTrades())
+	cmd.AddCommand(CmdGetOrderHistory())
 
 	// this line is used by starport scaffolding # 1
 
@@ -0,0 +1,132 @@
+package query
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagPriceDenom = "price-denom"
+	FlagAssetDenom = "asset-denom"
+)
+
+func CmdGetAccountTrades() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-trades [contract-address] [account]",
+		Short: "Query the fills of an account",
+		Long: strings.TrimSpace(`
+			Get the retained fills of an account in the orderbook specified by contract address. Fills can be limited to a single pair with --price-denom and --asset-denom.
+		`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			priceDenom, assetDenom, err := readPairFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetAccountTradesRequest{
+				ContractAddr: args[0],
+				Account:      args[1],
+				PriceDenom:   priceDenom,
+				AssetDenom:   assetDenom,
+				Pagination:   pageReq,
+			}
+
+			res, err := queryClient.GetAccountTrades(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addPairFlags(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdGetOrderHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "order-history [contract-address] [account]",
+		Short: "Query the fill summaries of orders of an account",
+		Long: strings.TrimSpace(`
+			Get the filled quantity, notional and fees of orders of an account in the orderbook specified by contract address, for as long as their fills are retained. Orders can be limited to a single pair with --price-denom and --asset-denom.
+		`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			priceDenom, assetDenom, err := readPairFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetOrderHistoryRequest{
+				ContractAddr: args[0],
+				Account:      args[1],
+				PriceDenom:   priceDenom,
+				AssetDenom:   assetDenom,
+				Pagination:   pageReq,
+			}
+
+			res, err := queryClient.GetOrderHistory(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addPairFlags(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func addPairFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagPriceDenom, "", "Price denom of the pair")
+	cmd.Flags().String(FlagAssetDenom, "", "Asset denom of the pair")
+}
+
+func readPairFlags(cmd *cobra.Command) (string, string, error) {
+	priceDenom, err := cmd.Flags().GetString(FlagPriceDenom)
+	if err != nil {
+		return "", "", err
+	}
+	assetDenom, err := cmd.Flags().GetString(FlagAssetDenom)
+	if err != nil {
+		return "", "", err
+	}
+	return priceDenom, assetDenom, nil
+}
@@ -0,0 +1,84 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagStartTimestamp = "start-timestamp"
+	FlagEndTimestamp   = "end-timestamp"
+)
+
+var candleIntervals = map[string]uint64{
+	"1m": types.CandleIntervalOneMinute,
+	"5m": types.CandleIntervalFiveMinutes,
+	"1h": types.CandleIntervalOneHour,
+	"1d": types.CandleIntervalOneDay,
+}
+
+func CmdGetCandles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "candles [contract-address] [price-denom] [asset-denom] [interval]",
+		Short: "Query OHLCV candles of a pair",
+		Long: strings.TrimSpace(`
+			Get the OHLCV candles of a dex pair specified by the contract-address, price denom and asset denom. The interval must be one of 1m, 5m, 1h or 1d. Candles can be limited to a time range with --start-timestamp and --end-timestamp (unix seconds).
+		`),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			interval, ok := candleIntervals[args[3]]
+			if !ok {
+				return fmt.Errorf("unsupported interval %s, must be one of 1m, 5m, 1h or 1d", args[3])
+			}
+			startTimestamp, err := cmd.Flags().GetUint64(FlagStartTimestamp)
+			if err != nil {
+				return err
+			}
+			endTimestamp, err := cmd.Flags().GetUint64(FlagEndTimestamp)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetCandlesRequest{
+				ContractAddr:      args[0],
+				PriceDenom:        args[1],
+				AssetDenom:        args[2],
+				IntervalInSeconds: interval,
+				StartTimestamp:    startTimestamp,
+				EndTimestamp:      endTimestamp,
+				Pagination:        pageReq,
+			}
+
+			res, err := queryClient.GetCandles(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagStartTimestamp, 0, "Only return candles beginning at or after this unix timestamp")
+	cmd.Flags().Uint64(FlagEndTimestamp, 0, "Only return candles beginning before this unix timestamp")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,61 @@
+package query
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cobra"
+)
+
+const FlagTickSizeMultiplier = "tick-size-multiplier"
+
+func CmdGetOrderBookDepth() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "order-book-depth [contract-address] [price-denom] [asset-denom] [depth]",
+		Short: "Query the aggregated depth of an order book",
+		Long: strings.TrimSpace(`
+			Get the total quantity of the top [depth] price levels on both sides of the order book of a dex pair specified by the contract-address, price denom and asset denom. With --tick-size-multiplier, price levels are bucketed by that multiple of the pair's price tick size.
+		`),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqDepth, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+			tickSizeMultiplier, err := cmd.Flags().GetUint64(FlagTickSizeMultiplier)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetOrderBookDepthRequest{
+				ContractAddr:       args[0],
+				PriceDenom:         args[1],
+				AssetDenom:         args[2],
+				Depth:              reqDepth,
+				TickSizeMultiplier: tickSizeMultiplier,
+			}
+
+			res, err := queryClient.GetOrderBookDepth(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagTickSizeMultiplier, 0, "Bucket price levels by this multiple of the pair's price tick size")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
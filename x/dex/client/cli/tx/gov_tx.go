@@ -58,3 +58,60 @@ func NewAddAssetProposalTxCmd() *cobra.Command {
 
 	return cmd
 }
+
+// NewUpdatePairFeeRatesProposalTxCmd returns a CLI command handler for creating
+// an update pair fee rates proposal governance transaction.
+func NewUpdatePairFeeRatesProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-pair-fee-rates-proposal [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an update pair fee rates proposal",
+		Long: strings.TrimSpace(`
+			Submit a proposal to update the maker and taker fee rates of pairs registered by a contract.
+			A fee rate that is left out removes the fee for that side.
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := cutils.ParseUpdatePairFeeRatesProposalJSON(clientCtx.LegacyAmino, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			pairs := []types.Pair{}
+			for _, feeRates := range proposal.Pairs {
+				pair, err := feeRates.ToPair()
+				if err != nil {
+					return err
+				}
+				pairs = append(pairs, pair)
+			}
+			content := types.UpdatePairFeeRatesProposal{
+				Title:        proposal.Title,
+				Description:  proposal.Description,
+				ContractAddr: proposal.ContractAddr,
+				Pairs:        pairs,
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
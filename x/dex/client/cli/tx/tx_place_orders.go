@@ -15,12 +15,16 @@ import (
 var _ = strconv.Itoa(0)
 
 const (
-	flagAmount = "amount"
+	flagAmount            = "amount"
+	flagExpiryHeight      = "expiry-height"
+	flagExpiryTimestamp   = "expiry-timestamp"
+	flagImmediateOrCancel = "ioc"
+	flagPostOnly          = "post-only"
 )
 
 func CmdPlaceOrders() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "place-orders [contract address] [orders...] --amount [coins,optional]",
+		Use:   "place-orders [contract address] [orders...] --amount [coins,optional] --expiry-height [height,optional] --expiry-timestamp [unix seconds,optional] --ioc --post-only",
 		Short: "Bulk place orders",
 		Long: strings.TrimSpace(`
 			Place orders on an orderbook specified by contract-address. Orders are represented as strings with the order details separated by "?". Cancellation details format is OrderDirection?Quantity?Price?PriceAsset?QuoteAsset?OrderType?OrderData?AdditionalParams.
@@ -29,6 +33,22 @@ func CmdPlaceOrders() *cobra.Command {
 		Args: cobra.MinimumNArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			argContractAddr := args[0]
+			expiryHeight, err := cmd.Flags().GetUint64(flagExpiryHeight)
+			if err != nil {
+				return err
+			}
+			expiryTimestamp, err := cmd.Flags().GetUint64(flagExpiryTimestamp)
+			if err != nil {
+				return err
+			}
+			immediateOrCancel, err := cmd.Flags().GetBool(flagImmediateOrCancel)
+			if err != nil {
+				return err
+			}
+			postOnly, err := cmd.Flags().GetBool(flagPostOnly)
+			if err != nil {
+				return err
+			}
 			orders := []*types.Order{}
 			for _, order := range args[1:] {
 				newOrder := types.Order{}
@@ -63,6 +83,17 @@ func CmdPlaceOrders() *cobra.Command {
 					}
 					newOrder.Nominal = argNominal
 				}
+				if newOrder.OrderType == types.OrderType_STOPLOSS || newOrder.OrderType == types.OrderType_STOPLIMIT {
+					argTriggerPrice, err := sdk.NewDecFromStr(orderDetails[7])
+					if err != nil {
+						return err
+					}
+					newOrder.TriggerPrice = argTriggerPrice
+				}
+				newOrder.ExpiryHeight = expiryHeight
+				newOrder.ExpiryTimestamp = expiryTimestamp
+				newOrder.ImmediateOrCancel = immediateOrCancel
+				newOrder.PostOnly = postOnly
 				orders = append(orders, &newOrder)
 			}
 
@@ -92,6 +123,10 @@ func CmdPlaceOrders() *cobra.Command {
 	}
 
 	cmd.Flags().String(flagAmount, "", "Coins to send to the contract along with command")
+	cmd.Flags().Uint64(flagExpiryHeight, 0, "Block height at which unfilled limit orders get cancelled")
+	cmd.Flags().Uint64(flagExpiryTimestamp, 0, "Block time (unix seconds) at which unfilled limit orders get cancelled")
+	cmd.Flags().Bool(flagImmediateOrCancel, false, "Cancel any quantity of the limit orders that isn't filled immediately")
+	cmd.Flags().Bool(flagPostOnly, false, "Cancel the limit orders if they would match against the order book")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -14,13 +14,16 @@ import (
 
 var _ = strconv.Itoa(0)
 
+const flagChargeFees = "charge-fees"
+
 func CmdRegisterContract() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "register-contract [contract address] [code id] [(deprecated)] [need order matching] [deposit] [dependency1,dependency2,...]",
+		Use:   "register-contract [contract address] [code id] [(deprecated)] [need order matching] [deposit] [dependency1,dependency2,...] --charge-fees",
 		Short: "Register exchange contract",
 		Long: strings.TrimSpace(`
 			Register a contract with the dex module for order matching hooks. The available order matching functions are BulkOrderPlacements, BulkOrderCancellations, Settlement. A deposit can also be specified as the initial rent to allocate for the execution of the order matching.
 			Other orderbooks that are dependencies can also be specified so that dex orderbook processing can be performed in the appropriate order.
+			With --charge-fees, the fee rates of the contract's pairs are charged on its settlements, and the fee totals are transferred from the contract's balance to the fee collector.
 		`),
 		Args: cobra.MinimumNArgs(5),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
@@ -42,6 +45,11 @@ func CmdRegisterContract() *cobra.Command {
 				dependencies = append(dependencies, &types.ContractDependencyInfo{Dependency: dependency})
 			}
 
+			chargeFees, err := cmd.Flags().GetBool(flagChargeFees)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -55,6 +63,7 @@ func CmdRegisterContract() *cobra.Command {
 				dependencies,
 				argDeposit,
 			)
+			msg.Contract.ChargeFees = chargeFees
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -62,6 +71,7 @@ func CmdRegisterContract() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Bool(flagChargeFees, false, "Charge the fee rates of the contract's pairs on its settlements")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -15,6 +15,8 @@ type (
 		AssetDenom       string `json:"asset_denom" yaml:"asset_denom"`
 		PriceTickSize    string `json:"price_tick_size" yaml:"tick_size"`
 		QuantityTickSize string `json:"quantity_tick_size" yaml:"tick_size"`
+		MakerFeeRate     string `json:"maker_fee_rate,omitempty" yaml:"maker_fee_rate"`
+		TakerFeeRate     string `json:"taker_fee_rate,omitempty" yaml:"taker_fee_rate"`
 	}
 
 	TickSizeJSON struct {
@@ -52,6 +54,21 @@ type (
 		AssetList   AssetListJSON `json:"asset_list" yaml:"asset_list"`
 		Deposit     string        `json:"deposit" yaml:"deposit"`
 	}
+
+	PairFeeRatesJSON struct {
+		PriceDenom   string `json:"price_denom" yaml:"price_denom"`
+		AssetDenom   string `json:"asset_denom" yaml:"asset_denom"`
+		MakerFeeRate string `json:"maker_fee_rate" yaml:"maker_fee_rate"`
+		TakerFeeRate string `json:"taker_fee_rate" yaml:"taker_fee_rate"`
+	}
+
+	UpdatePairFeeRatesProposalJSON struct {
+		Title        string             `json:"title" yaml:"title"`
+		Description  string             `json:"description" yaml:"description"`
+		ContractAddr string             `json:"contract_addr" yaml:"contract_addr"`
+		Pairs        []PairFeeRatesJSON `json:"pairs" yaml:"pairs"`
+		Deposit      string             `json:"deposit" yaml:"deposit"`
+	}
 )
 
 // TODO: ADD utils to convert Each type to dex/type (string to denom)
@@ -72,7 +89,51 @@ func NewPair(pair PairJSON) (dextypes.Pair, error) {
 	if quantityTicksize.LTE(sdk.ZeroDec()) {
 		return dextypes.Pair{}, errors.New("quantity ticksize: value cannot be zero or negative")
 	}
-	return dextypes.Pair{PriceDenom: PriceDenom, AssetDenom: AssetDenom, PriceTicksize: &priceTicksize, QuantityTicksize: &quantityTicksize}, nil
+	makerFeeRate, err := parseFeeRate(pair.MakerFeeRate)
+	if err != nil {
+		return dextypes.Pair{}, errors.New("maker fee rate: str to decimal conversion err")
+	}
+	takerFeeRate, err := parseFeeRate(pair.TakerFeeRate)
+	if err != nil {
+		return dextypes.Pair{}, errors.New("taker fee rate: str to decimal conversion err")
+	}
+	return dextypes.Pair{
+		PriceDenom:       PriceDenom,
+		AssetDenom:       AssetDenom,
+		PriceTicksize:    &priceTicksize,
+		QuantityTicksize: &quantityTicksize,
+		MakerFeeRate:     makerFeeRate,
+		TakerFeeRate:     takerFeeRate,
+	}, nil
+}
+
+// fee rates are optional, and an empty string means the pair has no fee rate
+func parseFeeRate(rate string) (*sdk.Dec, error) {
+	if rate == "" {
+		return nil, nil
+	}
+	res, err := sdk.NewDecFromStr(rate)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (fr PairFeeRatesJSON) ToPair() (dextypes.Pair, error) {
+	makerFeeRate, err := parseFeeRate(fr.MakerFeeRate)
+	if err != nil {
+		return dextypes.Pair{}, errors.New("maker fee rate: str to decimal conversion err")
+	}
+	takerFeeRate, err := parseFeeRate(fr.TakerFeeRate)
+	if err != nil {
+		return dextypes.Pair{}, errors.New("taker fee rate: str to decimal conversion err")
+	}
+	return dextypes.Pair{
+		PriceDenom:   fr.PriceDenom,
+		AssetDenom:   fr.AssetDenom,
+		MakerFeeRate: makerFeeRate,
+		TakerFeeRate: takerFeeRate,
+	}, nil
 }
 
 // ToParamChange converts a ParamChangeJSON object to ParamChange.
@@ -201,3 +262,20 @@ func ParseAddAssetMetadataProposalJSON(cdc *codec.LegacyAmino, proposalFile stri
 
 	return proposal, nil
 }
+
+// ParseUpdatePairFeeRatesProposalJSON reads and parses an UpdatePairFeeRatesProposalJSON from
+// a file.
+func ParseUpdatePairFeeRatesProposalJSON(cdc *codec.LegacyAmino, proposalFile string) (UpdatePairFeeRatesProposalJSON, error) {
+	proposal := UpdatePairFeeRatesProposalJSON{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -16,6 +16,7 @@ import (
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/sei-protocol/sei-chain/store/whitelist/multi"
 	seisync "github.com/sei-protocol/sei-chain/sync"
+	"github.com/sei-protocol/sei-chain/utils"
 	"github.com/sei-protocol/sei-chain/utils/datastructures"
 	dexcache "github.com/sei-protocol/sei-chain/x/dex/cache"
 	"github.com/sei-protocol/sei-chain/x/dex/keeper"
@@ -124,6 +125,9 @@ func newEnv(ctx sdk.Context, validContractsInfo []types.ContractInfoV2, keeper *
 		settlementsByContract.Store(contract.ContractAddr, []*types.SettlementEntry{})
 		executionTerminationSignals.Store(contract.ContractAddr, make(chan struct{}, 1))
 		contractPairs := keeper.GetAllRegisteredPairs(ctx, contract.ContractAddr)
+		if !contract.ChargeFees {
+			contractPairs = utils.Map(contractPairs, withoutFeeRates)
+		}
 		registeredPairs.Store(contract.ContractAddr, contractPairs)
 		allContractAndPairs[contract.ContractAddr] = contractPairs
 	}
@@ -150,6 +154,14 @@ func (e *environment) addError(contractAddr string, err error) {
 	e.failedContractAddressesToErrors.Store(contractAddr, err)
 }
 
+// Fee rates of pairs are only charged on the settlements of contracts that opted in, since
+// the fees are collected from the contract's balance.
+func withoutFeeRates(pair types.Pair) types.Pair {
+	pair.MakerFeeRate = nil
+	pair.TakerFeeRate = nil
+	return pair
+}
+
 func cacheContext(ctx sdk.Context, env *environment) (sdk.Context, sdk.CacheMultiStore) {
 	cachedCtx, msCached := store.GetCachedContext(ctx)
 	goCtx := context.WithValue(cachedCtx.Context(), dexcache.CtxKeyExecTermSignal, env.executionTerminationSignals)
@@ -50,10 +50,14 @@ func ExecutePair(
 
 	// First cancel orders
 	cancelForPair(ctx, dexkeeper, typedContractAddr, pair)
-	// Add all limit orders to the orderbook
+	// Add all stop orders to the trigger book
+	AddStopOrdersToTriggerBook(ctx, dexkeeper, typedContractAddr, pair)
+	// Add all limit orders to the orderbook, except post-only orders that would take liquidity
 	orders := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair)
-	limitBuys := orders.GetLimitOrders(types.PositionDirection_LONG)
-	limitSells := orders.GetLimitOrders(types.PositionDirection_SHORT)
+	limitBuys, limitSells := removeCrossingPostOnlyOrders(
+		ctx, dexkeeper, typedContractAddr, pair, orders,
+		orders.GetLimitOrders(types.PositionDirection_LONG), orders.GetLimitOrders(types.PositionDirection_SHORT),
+	)
 	exchange.AddOutstandingLimitOrdersToOrderbook(ctx, dexkeeper, limitBuys, limitSells)
 	// Fill market orders
 	marketOrderOutcome := matchMarketOrderForPair(ctx, typedContractAddr, pair, orderbook)
@@ -63,6 +67,29 @@ func ExecutePair(
 
 	dexkeeperutils.SetPriceStateFromExecutionOutcome(ctx, dexkeeper, typedContractAddr, pair, totalOutcome)
 
+	// Fill stop orders triggered by the last traded price
+	if triggeredOrders := PopTriggeredOrders(ctx, dexkeeper, typedContractAddr, pair); len(triggeredOrders) > 0 {
+		triggeredOrderOutcome := matchTriggeredOrdersForPair(ctx, dexkeeper, typedContractAddr, pair, orderbook, triggeredOrders)
+		totalOutcome = totalOutcome.Merge(&triggeredOrderOutcome)
+		dexkeeperutils.SetPriceStateFromExecutionOutcome(ctx, dexkeeper, typedContractAddr, pair, totalOutcome)
+	}
+
+	// Immediate-or-cancel orders don't rest in the orderbook
+	cancelUnfilledImmediateOrCancelOrders(ctx, dexkeeper, typedContractAddr, pair, orderbook)
+
+	dexkeeperutils.AddFeesToPriceState(ctx, dexkeeper, typedContractAddr, pair, totalOutcome)
+	dexkeeperutils.UpdateCandlesFromExecutionOutcome(ctx, dexkeeper, typedContractAddr, pair, totalOutcome)
+	if len(totalOutcome.Settlements) > 0 {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeSettlement,
+			sdk.NewAttribute(types.AttributeKeyContractAddress, contractAddr),
+			sdk.NewAttribute(types.AttributeKeyPriceDenom, pair.PriceDenom),
+			sdk.NewAttribute(types.AttributeKeyAssetDenom, pair.AssetDenom),
+			sdk.NewAttribute(types.AttributeKeySettlementCount, fmt.Sprint(len(totalOutcome.Settlements))),
+			sdk.NewAttribute(types.AttributeKeyFee, totalOutcome.TotalFees().String()),
+		))
+	}
+
 	return totalOutcome.Settlements
 }
 
@@ -91,6 +118,7 @@ func matchMarketOrderForPair(
 		orderbook.Shorts,
 		types.PositionDirection_LONG,
 		orders,
+		orderbook.Pair,
 	)
 	marketSellOutcome := exchange.MatchMarketOrders(
 		ctx,
@@ -98,6 +126,7 @@ func matchMarketOrderForPair(
 		orderbook.Longs,
 		types.PositionDirection_SHORT,
 		orders,
+		orderbook.Pair,
 	)
 	return marketBuyOutcome.Merge(&marketSellOutcome)
 }
@@ -25,6 +25,12 @@ func PrepareCancelUnfulfilledMarketOrders(
 			Initiator: types.CancellationInitiator_USER,
 		})
 	}
+	for _, limitOrderID := range getCancelledLimitOrderIds(ctx, typedContractAddr, pair) {
+		dexutils.GetMemState(ctx.Context()).GetBlockCancels(ctx, typedContractAddr, pair).Add(&types.Cancellation{
+			Id:        limitOrderID,
+			Initiator: types.CancellationInitiator_USER,
+		})
+	}
 }
 
 func getUnfulfilledPlacedMarketOrderIds(
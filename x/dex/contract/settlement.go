@@ -1,6 +1,9 @@
 package contract
 
 import (
+	"fmt"
+	"sort"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sei-protocol/sei-chain/x/dex/keeper"
 	dexkeeperutils "github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
@@ -13,7 +16,14 @@ func HandleSettlements(
 	dexkeeper *keeper.Keeper,
 	settlements []*types.SettlementEntry,
 ) error {
-	return callSettlementHook(ctx, contractAddr, dexkeeper, settlements)
+	if err := callSettlementHook(ctx, contractAddr, dexkeeper, settlements); err != nil {
+		return err
+	}
+	if err := collectFees(ctx, contractAddr, dexkeeper, settlements); err != nil {
+		return err
+	}
+	dexkeeper.RecordFills(ctx, contractAddr, settlements)
+	return nil
 }
 
 func callSettlementHook(
@@ -37,3 +47,51 @@ func callSettlementHook(
 	}
 	return nil
 }
+
+// Contracts that opted in to fee charging are expected to deduct the fees in settlement entries
+// from the settled accounts' balances, so the module transfers the fee totals from the contract
+// to the fee collector. Settlements of other contracts carry no fee. Fractional amounts are
+// truncated and stay with the contract.
+func collectFees(
+	ctx sdk.Context,
+	contractAddr string,
+	dexkeeper *keeper.Keeper,
+	settlementEntries []*types.SettlementEntry,
+) error {
+	feesByDenom := map[string]sdk.Dec{}
+	for _, settlement := range settlementEntries {
+		fee := settlement.FeeOrZero()
+		if fee.IsZero() {
+			continue
+		}
+		if total, ok := feesByDenom[settlement.PriceDenom]; ok {
+			fee = fee.Add(total)
+		}
+		feesByDenom[settlement.PriceDenom] = fee
+	}
+	denoms := make([]string, 0, len(feesByDenom))
+	for denom := range feesByDenom {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+	fees := sdk.NewCoins()
+	for _, denom := range denoms {
+		if amount := feesByDenom[denom].TruncateInt(); amount.IsPositive() {
+			fees = fees.Add(sdk.NewCoin(denom, amount))
+		}
+	}
+	if fees.IsZero() {
+		return nil
+	}
+	collector := dexkeeper.GetFeeCollectorAddress(ctx)
+	if err := dexkeeper.BankKeeper.SendCoins(ctx, sdk.MustAccAddressFromBech32(contractAddr), collector, fees); err != nil {
+		return fmt.Errorf("error collecting fees %s from %s: %w", fees, contractAddr, err)
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCollectFees,
+		sdk.NewAttribute(types.AttributeKeyContractAddress, contractAddr),
+		sdk.NewAttribute(types.AttributeKeyFees, fees.String()),
+		sdk.NewAttribute(types.AttributeKeyFeeCollector, collector.String()),
+	))
+	return nil
+}
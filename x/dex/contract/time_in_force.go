@@ -0,0 +1,184 @@
+package contract
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	dexcache "github.com/sei-protocol/sei-chain/x/dex/cache"
+	"github.com/sei-protocol/sei-chain/x/dex/exchange"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+)
+
+const PostOnlyCancelledDescription = "post-only order would match against the order book"
+
+// Add cancellations of resting orders whose expiry has been reached to the memstate, so
+// that contracts get notified through the usual cancellation hook and the orders get
+// removed from the order book during order matching. Expiry entries of orders that are
+// no longer in the order book (i.e. filled or cancelled already) are simply removed.
+func CancelExpiredOrders(ctx sdk.Context, dexkeeper *keeper.Keeper, validContractsInfo []types.ContractInfoV2) {
+	memState := dexutils.GetMemState(ctx.Context())
+	for _, contract := range validContractsInfo {
+		if !contract.NeedOrderMatching {
+			continue
+		}
+		typedContractAddr := types.ContractAddress(contract.ContractAddr)
+		hasExpiredOrders := false
+		for _, order := range dexkeeper.GetExpiredOrders(ctx, contract.ContractAddr) {
+			if !isRestingInOrderBook(ctx, dexkeeper, contract.ContractAddr, order) {
+				dexkeeper.RemoveOrderExpiry(ctx, contract.ContractAddr, order)
+				continue
+			}
+			pair := types.Pair{PriceDenom: order.PriceDenom, AssetDenom: order.AssetDenom}
+			memState.GetBlockCancels(ctx, typedContractAddr, pair).Add(&types.Cancellation{
+				Id:                order.Id,
+				Initiator:         types.CancellationInitiator_EXPIRED,
+				Creator:           order.Account,
+				ContractAddr:      contract.ContractAddr,
+				PriceDenom:        order.PriceDenom,
+				AssetDenom:        order.AssetDenom,
+				PositionDirection: order.PositionDirection,
+				Price:             order.Price,
+			})
+			hasExpiredOrders = true
+		}
+		if hasExpiredOrders {
+			memState.SetDownstreamsToProcess(ctx, contract.ContractAddr, dexkeeper.GetContractWithoutGasCharge)
+		}
+	}
+}
+
+// Post-only orders that would match against the resting order book, or against an
+// opposite limit order being added to the order book in the same round of matching, are
+// cancelled instead of being added to it. Returns the orders that can be added to the
+// order book.
+func removeCrossingPostOnlyOrders(
+	ctx sdk.Context,
+	dexkeeper *keeper.Keeper,
+	typedContractAddr types.ContractAddress,
+	pair types.Pair,
+	blockOrders *dexcache.BlockOrders,
+	limitBuys []*types.Order,
+	limitSells []*types.Order,
+) ([]*types.Order, []*types.Order) {
+	// the opposite orders are taken before any of them gets cancelled, so that two
+	// crossing post-only orders are both cancelled
+	bestBuy, bestSell := getBestPrice(limitBuys, types.PositionDirection_LONG), getBestPrice(limitSells, types.PositionDirection_SHORT)
+	filter := func(limitOrders []*types.Order, bestOpposite *sdk.Dec) []*types.Order {
+		res := []*types.Order{}
+		for _, order := range limitOrders {
+			if order.PostOnly && (crossesPrice(order, bestOpposite) || crossesOrderBook(ctx, dexkeeper, typedContractAddr, pair, order)) {
+				order.Status = types.OrderStatus_CANCELLED
+				order.StatusDescription = PostOnlyCancelledDescription
+				blockOrders.Add(order)
+				continue
+			}
+			res = append(res, order)
+		}
+		return res
+	}
+	return filter(limitBuys, bestSell), filter(limitSells, bestBuy)
+}
+
+// Highest price of buy orders or lowest price of sell orders, if any
+func getBestPrice(limitOrders []*types.Order, direction types.PositionDirection) *sdk.Dec {
+	var best *sdk.Dec
+	for _, order := range limitOrders {
+		price := order.Price
+		if best == nil || (direction == types.PositionDirection_LONG && price.GT(*best)) || (direction == types.PositionDirection_SHORT && price.LT(*best)) {
+			best = &price
+		}
+	}
+	return best
+}
+
+func crossesPrice(order *types.Order, oppositePrice *sdk.Dec) bool {
+	if oppositePrice == nil {
+		return false
+	}
+	if order.PositionDirection == types.PositionDirection_LONG {
+		return order.Price.GTE(*oppositePrice)
+	}
+	return order.Price.LTE(*oppositePrice)
+}
+
+func crossesOrderBook(ctx sdk.Context, dexkeeper *keeper.Keeper, typedContractAddr types.ContractAddress, pair types.Pair, order *types.Order) bool {
+	if order.PositionDirection == types.PositionDirection_LONG {
+		bestAsks := dexkeeper.GetTopNShortBooksForPair(ctx, string(typedContractAddr), pair.PriceDenom, pair.AssetDenom, 1)
+		return len(bestAsks) > 0 && order.Price.GTE(bestAsks[0].GetPrice())
+	}
+	bestBids := dexkeeper.GetTopNLongBooksForPair(ctx, string(typedContractAddr), pair.PriceDenom, pair.AssetDenom, 1)
+	return len(bestBids) > 0 && order.Price.LTE(bestBids[0].GetPrice())
+}
+
+// Remove whatever is left of immediate-or-cancel orders placed in the current block from
+// the order book after matching.
+func cancelUnfilledImmediateOrCancelOrders(
+	ctx sdk.Context,
+	dexkeeper *keeper.Keeper,
+	typedContractAddr types.ContractAddress,
+	pair types.Pair,
+	orderbook *types.OrderBook,
+) {
+	blockOrders := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair)
+	cancels := []*types.Cancellation{}
+	for _, order := range blockOrders.Get() {
+		if !order.ImmediateOrCancel || order.OrderType != types.OrderType_LIMIT || order.Status != types.OrderStatus_PLACED {
+			continue
+		}
+		if !isRestingInOrderBook(ctx, dexkeeper, string(typedContractAddr), *order) {
+			continue
+		}
+		cancels = append(cancels, &types.Cancellation{
+			Id:                order.Id,
+			Initiator:         types.CancellationInitiator_USER,
+			Creator:           order.Account,
+			ContractAddr:      string(typedContractAddr),
+			PriceDenom:        order.PriceDenom,
+			AssetDenom:        order.AssetDenom,
+			PositionDirection: order.PositionDirection,
+			Price:             order.Price,
+		})
+		order.Status = types.OrderStatus_CANCELLED
+		blockOrders.Add(order)
+	}
+	if len(cancels) == 0 {
+		return
+	}
+	exchange.CancelOrders(ctx, dexkeeper, typedContractAddr, pair, cancels)
+	// the cached entries may still hold the cancelled allocations
+	orderbook.Longs.Refresh(ctx)
+	orderbook.Shorts.Refresh(ctx)
+}
+
+// IDs of orders placed in the current block that got cancelled during order matching
+// (i.e. post-only orders that would take liquidity and unfilled immediate-or-cancel orders)
+func getCancelledLimitOrderIds(
+	ctx sdk.Context,
+	typedContractAddr types.ContractAddress,
+	pair types.Pair,
+) []uint64 {
+	res := []uint64{}
+	for _, order := range dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair).Get() {
+		if order.OrderType == types.OrderType_LIMIT && order.Status == types.OrderStatus_CANCELLED {
+			res = append(res, order.Id)
+		}
+	}
+	return res
+}
+
+func isRestingInOrderBook(ctx sdk.Context, dexkeeper *keeper.Keeper, contractAddr string, order types.Order) bool {
+	getter := dexkeeper.GetLongOrderBookEntryByPrice
+	if order.PositionDirection == types.PositionDirection_SHORT {
+		getter = dexkeeper.GetShortOrderBookEntryByPrice
+	}
+	entry, found := getter(ctx, contractAddr, order.Price, order.PriceDenom, order.AssetDenom)
+	if !found {
+		return false
+	}
+	for _, allocation := range entry.GetOrderEntry().Allocations {
+		if allocation.OrderId == order.Id {
+			return true
+		}
+	}
+	return false
+}
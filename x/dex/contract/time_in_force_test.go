@@ -0,0 +1,240 @@
+package contract_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/contract"
+	keeperutil "github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutil "github.com/sei-protocol/sei-chain/x/dex/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelExpiredOrders(t *testing.T) {
+	pair := types.Pair{
+		PriceDenom: keepertest.TestPriceDenom,
+		AssetDenom: keepertest.TestAssetDenom,
+	}
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(5).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	contractInfo := types.ContractInfoV2{
+		CodeId:            1,
+		ContractAddr:      keepertest.TestContract,
+		NeedOrderMatching: true,
+	}
+	require.Nil(t, dexkeeper.SetContract(ctx, &contractInfo))
+	dexkeeper.AddRegisteredPair(ctx, keepertest.TestContract, pair)
+	restingOrder := types.Order{
+		Id:                1,
+		Account:           TEST_ACCOUNT,
+		ContractAddr:      keepertest.TestContract,
+		Price:             sdk.NewDec(98),
+		Quantity:          sdk.NewDec(5),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		OrderType:         types.OrderType_LIMIT,
+		PositionDirection: types.PositionDirection_LONG,
+		ExpiryHeight:      5,
+	}
+	dexkeeper.SetLongBook(ctx, keepertest.TestContract, types.LongBook{
+		Price: restingOrder.Price,
+		Entry: &types.OrderEntry{
+			Price:    restingOrder.Price,
+			Quantity: restingOrder.Quantity,
+			Allocations: []*types.Allocation{{
+				OrderId:  restingOrder.Id,
+				Account:  restingOrder.Account,
+				Quantity: restingOrder.Quantity,
+			}},
+			PriceDenom: pair.PriceDenom,
+			AssetDenom: pair.AssetDenom,
+		},
+	})
+	dexkeeper.SetOrderExpiry(ctx, keepertest.TestContract, restingOrder)
+	// an order that has been filled already
+	filledOrder := restingOrder
+	filledOrder.Id = 2
+	dexkeeper.SetOrderExpiry(ctx, keepertest.TestContract, filledOrder)
+	// an order that hasn't expired yet
+	unexpiredOrder := restingOrder
+	unexpiredOrder.Id = 3
+	unexpiredOrder.ExpiryHeight = 6
+	dexkeeper.SetOrderExpiry(ctx, keepertest.TestContract, unexpiredOrder)
+
+	contract.CancelExpiredOrders(ctx, dexkeeper, []types.ContractInfoV2{contractInfo})
+	memState := dexutil.GetMemState(ctx.Context())
+	require.True(t, memState.ContractsToProcessContains(ctx, keepertest.TestContract))
+	cancels := memState.GetBlockCancels(ctx, types.ContractAddress(keepertest.TestContract), pair).Get()
+	require.Equal(t, 1, len(cancels))
+	require.Equal(t, uint64(1), cancels[0].Id)
+	require.Equal(t, types.CancellationInitiator_EXPIRED, cancels[0].Initiator)
+	expiringOrders := dexkeeper.GetAllExpiringOrders(ctx, keepertest.TestContract)
+	require.Equal(t, 2, len(expiringOrders))
+	require.Equal(t, restingOrder.Id, expiringOrders[0].Id)
+	require.Equal(t, unexpiredOrder.Id, expiringOrders[1].Id)
+
+	// the expired order gets removed from the order book during order matching
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(keepertest.TestContract), pair)
+	contract.ExecutePair(ctx, keepertest.TestContract, pair, dexkeeper, orderbook)
+	_, found := dexkeeper.GetLongBookByPrice(ctx, keepertest.TestContract, restingOrder.Price, pair.PriceDenom, pair.AssetDenom)
+	require.False(t, found)
+
+	// its expiry entry is cleaned up in the next block
+	memState.Clear(ctx)
+	ctx = ctx.WithBlockHeight(6)
+	contract.CancelExpiredOrders(ctx, dexkeeper, []types.ContractInfoV2{contractInfo})
+	require.Empty(t, memState.GetBlockCancels(ctx, types.ContractAddress(keepertest.TestContract), pair).Get())
+	require.Empty(t, dexkeeper.GetAllExpiringOrders(ctx, keepertest.TestContract))
+}
+
+func TestExecutePairWithPostOnlyOrders(t *testing.T) {
+	pair := types.Pair{
+		PriceDenom: "USDC",
+		AssetDenom: "ATOM",
+	}
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	dexkeeper.SetShortBook(ctx, TEST_CONTRACT, types.ShortBook{
+		Price: sdk.NewDec(101),
+		Entry: &types.OrderEntry{
+			Price:    sdk.NewDec(101),
+			Quantity: sdk.NewDec(5),
+			Allocations: []*types.Allocation{{
+				OrderId:  7,
+				Account:  "abc",
+				Quantity: sdk.NewDec(5),
+			}},
+			PriceDenom: "USDC",
+			AssetDenom: "ATOM",
+		},
+	})
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(TEST_CONTRACT), pair)
+	blockOrders := dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(TEST_CONTRACT), pair)
+	for id, price := range map[uint64]int64{1: 101, 2: 100} {
+		blockOrders.Add(&types.Order{
+			Id:                id,
+			Account:           TEST_ACCOUNT,
+			ContractAddr:      TEST_CONTRACT,
+			Price:             sdk.NewDec(price),
+			Quantity:          sdk.NewDec(1),
+			PriceDenom:        pair.PriceDenom,
+			AssetDenom:        pair.AssetDenom,
+			OrderType:         types.OrderType_LIMIT,
+			PositionDirection: types.PositionDirection_LONG,
+			PostOnly:          true,
+		})
+	}
+
+	settlements := contract.ExecutePair(ctx, TEST_CONTRACT, pair, dexkeeper, orderbook)
+	require.Empty(t, settlements)
+	// the post-only order that would take liquidity is cancelled
+	require.Equal(t, types.OrderStatus_CANCELLED, blockOrders.GetByID(1).Status)
+	_, found := dexkeeper.GetLongBookByPrice(ctx, TEST_CONTRACT, sdk.NewDec(101), pair.PriceDenom, pair.AssetDenom)
+	require.False(t, found)
+	// the other one rests in the order book
+	require.Equal(t, types.OrderStatus_PLACED, blockOrders.GetByID(2).Status)
+	_, found = dexkeeper.GetLongBookByPrice(ctx, TEST_CONTRACT, sdk.NewDec(100), pair.PriceDenom, pair.AssetDenom)
+	require.True(t, found)
+
+	contract.PrepareCancelUnfulfilledMarketOrders(ctx, types.ContractAddress(TEST_CONTRACT), pair, map[uint64]sdk.Dec{})
+	require.Equal(t, []uint64{1}, dexutil.GetMemState(ctx.Context()).GetBlockCancels(ctx, types.ContractAddress(TEST_CONTRACT), pair).GetIdsToCancel())
+}
+
+func TestExecutePairWithPostOnlyOrderCrossingBlockOrder(t *testing.T) {
+	pair := types.Pair{
+		PriceDenom: "USDC",
+		AssetDenom: "ATOM",
+	}
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(TEST_CONTRACT), pair)
+	blockOrders := dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(TEST_CONTRACT), pair)
+	for _, order := range []struct {
+		id        uint64
+		price     int64
+		direction types.PositionDirection
+		postOnly  bool
+	}{
+		{1, 100, types.PositionDirection_SHORT, false},
+		{2, 101, types.PositionDirection_LONG, true},
+		{3, 99, types.PositionDirection_LONG, false},
+	} {
+		blockOrders.Add(&types.Order{
+			Id:                order.id,
+			Account:           TEST_ACCOUNT,
+			ContractAddr:      TEST_CONTRACT,
+			Price:             sdk.NewDec(order.price),
+			Quantity:          sdk.NewDec(1),
+			PriceDenom:        pair.PriceDenom,
+			AssetDenom:        pair.AssetDenom,
+			OrderType:         types.OrderType_LIMIT,
+			PositionDirection: order.direction,
+			PostOnly:          order.postOnly,
+		})
+	}
+
+	// the post-only order would take the liquidity of the sell order placed in the
+	// same block, so it gets cancelled instead of being matched
+	settlements := contract.ExecutePair(ctx, TEST_CONTRACT, pair, dexkeeper, orderbook)
+	require.Empty(t, settlements)
+	require.Equal(t, types.OrderStatus_CANCELLED, blockOrders.GetByID(2).Status)
+	_, found := dexkeeper.GetLongBookByPrice(ctx, TEST_CONTRACT, sdk.NewDec(101), pair.PriceDenom, pair.AssetDenom)
+	require.False(t, found)
+	_, found = dexkeeper.GetShortBookByPrice(ctx, TEST_CONTRACT, sdk.NewDec(100), pair.PriceDenom, pair.AssetDenom)
+	require.True(t, found)
+	_, found = dexkeeper.GetLongBookByPrice(ctx, TEST_CONTRACT, sdk.NewDec(99), pair.PriceDenom, pair.AssetDenom)
+	require.True(t, found)
+}
+
+func TestExecutePairWithImmediateOrCancelOrder(t *testing.T) {
+	pair := types.Pair{
+		PriceDenom: "USDC",
+		AssetDenom: "ATOM",
+	}
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	dexkeeper.SetShortBook(ctx, TEST_CONTRACT, types.ShortBook{
+		Price: sdk.NewDec(101),
+		Entry: &types.OrderEntry{
+			Price:    sdk.NewDec(101),
+			Quantity: sdk.NewDec(5),
+			Allocations: []*types.Allocation{{
+				OrderId:  7,
+				Account:  "abc",
+				Quantity: sdk.NewDec(5),
+			}},
+			PriceDenom: "USDC",
+			AssetDenom: "ATOM",
+		},
+	})
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(TEST_CONTRACT), pair)
+	blockOrders := dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(TEST_CONTRACT), pair)
+	blockOrders.Add(&types.Order{
+		Id:                1,
+		Account:           TEST_ACCOUNT,
+		ContractAddr:      TEST_CONTRACT,
+		Price:             sdk.NewDec(101),
+		Quantity:          sdk.NewDec(7),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		OrderType:         types.OrderType_LIMIT,
+		PositionDirection: types.PositionDirection_LONG,
+		ImmediateOrCancel: true,
+		ExpiryHeight:      TestHeight + 1,
+	})
+
+	settlements := contract.ExecutePair(ctx, TEST_CONTRACT, pair, dexkeeper, orderbook)
+	require.Equal(t, 2, len(settlements))
+	require.Equal(t, sdk.NewDec(5), settlements[0].Quantity)
+	// the unfilled quantity doesn't rest in the order book
+	require.Equal(t, types.OrderStatus_CANCELLED, blockOrders.GetByID(1).Status)
+	require.Empty(t, dexkeeper.GetAllLongBookForPair(ctx, TEST_CONTRACT, pair.PriceDenom, pair.AssetDenom))
+	require.Empty(t, dexkeeper.GetAllExpiringOrders(ctx, TEST_CONTRACT))
+	require.Nil(t, orderbook.Longs.Next(ctx))
+
+	contract.PrepareCancelUnfulfilledMarketOrders(ctx, types.ContractAddress(TEST_CONTRACT), pair, contract.GetOrderIDToSettledQuantities(settlements))
+	require.Equal(t, []uint64{1}, dexutil.GetMemState(ctx.Context()).GetBlockCancels(ctx, types.ContractAddress(TEST_CONTRACT), pair).GetIdsToCancel())
+}
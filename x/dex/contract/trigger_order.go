@@ -0,0 +1,102 @@
+package contract
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/exchange"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+)
+
+// Store stop loss/limit orders placed in the current block in the trigger book
+func AddStopOrdersToTriggerBook(
+	ctx sdk.Context,
+	dexkeeper *keeper.Keeper,
+	typedContractAddr types.ContractAddress,
+	pair types.Pair,
+) {
+	orders := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair)
+	for _, direction := range []types.PositionDirection{types.PositionDirection_LONG, types.PositionDirection_SHORT} {
+		for _, order := range orders.GetStopOrders(direction) {
+			dexkeeper.SetTriggeredOrder(ctx, string(typedContractAddr), *order)
+		}
+	}
+}
+
+// Remove stop orders whose trigger price has been crossed by the last traded price
+// of the pair from the trigger book and convert them into market/limit orders.
+func PopTriggeredOrders(
+	ctx sdk.Context,
+	dexkeeper *keeper.Keeper,
+	typedContractAddr types.ContractAddress,
+	pair types.Pair,
+) []*types.Order {
+	lastPrice, found := dexkeeper.GetLatestPrice(ctx, string(typedContractAddr), pair)
+	if !found {
+		return []*types.Order{}
+	}
+	triggered := []*types.Order{}
+	for _, order := range dexkeeper.GetAllTriggeredOrdersForPair(ctx, string(typedContractAddr), pair.PriceDenom, pair.AssetDenom) {
+		if !IsTriggered(order, lastPrice.Price) {
+			continue
+		}
+		dexkeeper.RemoveTriggeredOrder(ctx, string(typedContractAddr), order.Id, pair.PriceDenom, pair.AssetDenom)
+		triggeredOrder := order
+		triggeredOrder.TriggerStatus = true
+		if triggeredOrder.OrderType == types.OrderType_STOPLOSS {
+			triggeredOrder.OrderType = types.OrderType_MARKET
+		} else {
+			triggeredOrder.OrderType = types.OrderType_LIMIT
+		}
+		triggered = append(triggered, &triggeredOrder)
+	}
+	return triggered
+}
+
+// A long stop order is triggered when the price rises to its trigger price, and
+// a short stop order is triggered when the price falls to its trigger price.
+func IsTriggered(order types.Order, lastPrice sdk.Dec) bool {
+	if order.PositionDirection == types.PositionDirection_LONG {
+		return lastPrice.GTE(order.TriggerPrice)
+	}
+	return lastPrice.LTE(order.TriggerPrice)
+}
+
+// Match orders that were triggered after the regular matching of the current block.
+// Triggered orders are added to the block's orders so that they are included in
+// the match results and unfulfilled market orders get cancelled.
+func matchTriggeredOrdersForPair(
+	ctx sdk.Context,
+	dexkeeper *keeper.Keeper,
+	typedContractAddr types.ContractAddress,
+	pair types.Pair,
+	orderbook *types.OrderBook,
+	triggeredOrders []*types.Order,
+) exchange.ExecutionOutcome {
+	orders := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair)
+	limitBuys, limitSells, marketBuys, marketSells := []*types.Order{}, []*types.Order{}, []*types.Order{}, []*types.Order{}
+	for _, order := range triggeredOrders {
+		orders.Add(order)
+		switch {
+		case order.OrderType == types.OrderType_LIMIT && order.PositionDirection == types.PositionDirection_LONG:
+			limitBuys = append(limitBuys, order)
+		case order.OrderType == types.OrderType_LIMIT:
+			limitSells = append(limitSells, order)
+		case order.PositionDirection == types.PositionDirection_LONG:
+			marketBuys = append(marketBuys, order)
+		default:
+			marketSells = append(marketSells, order)
+		}
+	}
+	limitBuys, limitSells = removeCrossingPostOnlyOrders(ctx, dexkeeper, typedContractAddr, pair, orders, limitBuys, limitSells)
+	exchange.AddOutstandingLimitOrdersToOrderbook(ctx, dexkeeper, limitBuys, limitSells)
+	// the cached entries were flushed by the previous round of matching and don't
+	// include the newly added limit orders yet
+	orderbook.Longs.Refresh(ctx)
+	orderbook.Shorts.Refresh(ctx)
+	marketBuyOutcome := exchange.MatchMarketOrders(ctx, marketBuys, orderbook.Shorts, types.PositionDirection_LONG, orders, orderbook.Pair)
+	marketSellOutcome := exchange.MatchMarketOrders(ctx, marketSells, orderbook.Longs, types.PositionDirection_SHORT, orders, orderbook.Pair)
+	marketOrderOutcome := marketBuyOutcome.Merge(&marketSellOutcome)
+	limitOrderOutcome := exchange.MatchLimitOrders(ctx, orderbook)
+	return marketOrderOutcome.Merge(&limitOrderOutcome)
+}
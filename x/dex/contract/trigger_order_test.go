@@ -0,0 +1,189 @@
+package contract_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/contract"
+	keeperutil "github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutil "github.com/sei-protocol/sei-chain/x/dex/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsTriggered(t *testing.T) {
+	longStop := types.Order{PositionDirection: types.PositionDirection_LONG, TriggerPrice: sdk.NewDec(100)}
+	require.False(t, contract.IsTriggered(longStop, sdk.NewDec(99)))
+	require.True(t, contract.IsTriggered(longStop, sdk.NewDec(100)))
+	require.True(t, contract.IsTriggered(longStop, sdk.NewDec(101)))
+
+	shortStop := types.Order{PositionDirection: types.PositionDirection_SHORT, TriggerPrice: sdk.NewDec(100)}
+	require.True(t, contract.IsTriggered(shortStop, sdk.NewDec(99)))
+	require.True(t, contract.IsTriggered(shortStop, sdk.NewDec(100)))
+	require.False(t, contract.IsTriggered(shortStop, sdk.NewDec(101)))
+}
+
+func TestExecutePairWithStopOrders(t *testing.T) {
+	pair := types.Pair{
+		PriceDenom: "USDC",
+		AssetDenom: "ATOM",
+	}
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	dexkeeper.SetLongBook(ctx, TEST_CONTRACT, types.LongBook{
+		Price: sdk.NewDec(98),
+		Entry: &types.OrderEntry{
+			Price:    sdk.NewDec(98),
+			Quantity: sdk.NewDec(5),
+			Allocations: []*types.Allocation{{
+				OrderId:  5,
+				Account:  "abc",
+				Quantity: sdk.NewDec(5),
+			}},
+			PriceDenom: "USDC",
+			AssetDenom: "ATOM",
+		},
+	})
+	dexkeeper.SetShortBook(ctx, TEST_CONTRACT, types.ShortBook{
+		Price: sdk.NewDec(101),
+		Entry: &types.OrderEntry{
+			Price:    sdk.NewDec(101),
+			Quantity: sdk.NewDec(5),
+			Allocations: []*types.Allocation{{
+				OrderId:  7,
+				Account:  "abc",
+				Quantity: sdk.NewDec(5),
+			}},
+			PriceDenom: "USDC",
+			AssetDenom: "ATOM",
+		},
+	})
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(TEST_CONTRACT), pair)
+
+	blockOrders := dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(TEST_CONTRACT), pair)
+	// sell stop below the current price
+	blockOrders.Add(&types.Order{
+		Id:                1,
+		Account:           TEST_ACCOUNT,
+		ContractAddr:      TEST_CONTRACT,
+		Price:             sdk.ZeroDec(),
+		Quantity:          sdk.NewDec(1),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		OrderType:         types.OrderType_STOPLOSS,
+		PositionDirection: types.PositionDirection_SHORT,
+		TriggerPrice:      sdk.NewDec(100),
+	})
+	// buy stop limit at the best ask
+	blockOrders.Add(&types.Order{
+		Id:                2,
+		Account:           TEST_ACCOUNT,
+		ContractAddr:      TEST_CONTRACT,
+		Price:             sdk.NewDec(101),
+		Quantity:          sdk.NewDec(1),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		OrderType:         types.OrderType_STOPLIMIT,
+		PositionDirection: types.PositionDirection_LONG,
+		TriggerPrice:      sdk.NewDec(101),
+	})
+	blockOrders.Add(&types.Order{
+		Id:                3,
+		Account:           TEST_ACCOUNT,
+		ContractAddr:      TEST_CONTRACT,
+		Price:             sdk.NewDec(200),
+		Quantity:          sdk.NewDec(1),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		OrderType:         types.OrderType_MARKET,
+		PositionDirection: types.PositionDirection_LONG,
+	})
+
+	// the market buy trades at 101, which triggers the buy stop limit order
+	settlements := contract.ExecutePair(ctx, TEST_CONTRACT, pair, dexkeeper, orderbook)
+	require.Equal(t, 4, len(settlements))
+	require.Equal(t, uint64(7), settlements[0].OrderId)
+	require.Equal(t, uint64(3), settlements[1].OrderId)
+	require.Equal(t, uint64(2), settlements[2].OrderId)
+	require.Equal(t, uint64(7), settlements[3].OrderId)
+	triggeredOrder := blockOrders.GetByID(2)
+	require.Equal(t, types.OrderType_LIMIT, triggeredOrder.OrderType)
+	require.True(t, triggeredOrder.TriggerStatus)
+	remaining := dexkeeper.GetAllTriggeredOrdersForPair(ctx, TEST_CONTRACT, pair.PriceDenom, pair.AssetDenom)
+	require.Equal(t, 1, len(remaining))
+	require.Equal(t, uint64(1), remaining[0].Id)
+	entry, found := dexkeeper.GetShortBookByPrice(ctx, TEST_CONTRACT, sdk.NewDec(101), pair.PriceDenom, pair.AssetDenom)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(3), entry.Entry.Quantity)
+
+	// next block, a market sell trades at 98, which triggers the sell stop order
+	dexutil.GetMemState(ctx.Context()).Clear(ctx)
+	ctx = ctx.WithBlockHeight(int64(TestHeight) + 1).WithBlockTime(time.Unix(int64(TestTimestamp)+1, 0))
+	blockOrders = dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(TEST_CONTRACT), pair)
+	blockOrders.Add(&types.Order{
+		Id:                4,
+		Account:           TEST_ACCOUNT,
+		ContractAddr:      TEST_CONTRACT,
+		Price:             sdk.ZeroDec(),
+		Quantity:          sdk.NewDec(1),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		OrderType:         types.OrderType_MARKET,
+		PositionDirection: types.PositionDirection_SHORT,
+	})
+	settlements = contract.ExecutePair(ctx, TEST_CONTRACT, pair, dexkeeper, orderbook)
+	require.Equal(t, 4, len(settlements))
+	require.Equal(t, uint64(5), settlements[0].OrderId)
+	require.Equal(t, uint64(4), settlements[1].OrderId)
+	require.Equal(t, uint64(5), settlements[2].OrderId)
+	require.Equal(t, uint64(1), settlements[3].OrderId)
+	require.Equal(t, types.OrderType_MARKET, blockOrders.GetByID(1).OrderType)
+	require.Empty(t, dexkeeper.GetAllTriggeredOrdersForPair(ctx, TEST_CONTRACT, pair.PriceDenom, pair.AssetDenom))
+	longEntry, found := dexkeeper.GetLongBookByPrice(ctx, TEST_CONTRACT, sdk.NewDec(98), pair.PriceDenom, pair.AssetDenom)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(3), longEntry.Entry.Quantity)
+	price, found := dexkeeper.GetLatestPrice(ctx, TEST_CONTRACT, pair)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(98), price.Price)
+}
+
+func TestCancelUntriggeredStopOrder(t *testing.T) {
+	pair := types.Pair{
+		PriceDenom: "USDC",
+		AssetDenom: "ATOM",
+	}
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(TEST_CONTRACT), pair)
+	dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(TEST_CONTRACT), pair).Add(&types.Order{
+		Id:                1,
+		Account:           TEST_ACCOUNT,
+		ContractAddr:      TEST_CONTRACT,
+		Price:             sdk.ZeroDec(),
+		Quantity:          sdk.NewDec(1),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		OrderType:         types.OrderType_STOPLOSS,
+		PositionDirection: types.PositionDirection_LONG,
+		TriggerPrice:      sdk.NewDec(100),
+	})
+	contract.ExecutePair(ctx, TEST_CONTRACT, pair, dexkeeper, orderbook)
+	_, found := dexkeeper.GetTriggeredOrderByID(ctx, TEST_CONTRACT, 1, pair.PriceDenom, pair.AssetDenom)
+	require.True(t, found)
+
+	dexutil.GetMemState(ctx.Context()).Clear(ctx)
+	dexutil.GetMemState(ctx.Context()).GetBlockCancels(ctx, types.ContractAddress(TEST_CONTRACT), pair).Add(&types.Cancellation{
+		Id:                1,
+		Creator:           TEST_ACCOUNT,
+		ContractAddr:      TEST_CONTRACT,
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		PositionDirection: types.PositionDirection_LONG,
+		Price:             sdk.ZeroDec(),
+	})
+	contract.ExecutePair(ctx, TEST_CONTRACT, pair, dexkeeper, orderbook)
+	_, found = dexkeeper.GetTriggeredOrderByID(ctx, TEST_CONTRACT, 1, pair.PriceDenom, pair.AssetDenom)
+	require.False(t, found)
+}
@@ -12,11 +12,14 @@ import (
 var DexWhitelistedKeys = []string{
 	types.LongBookKey,
 	types.ShortBookKey,
+	types.TriggerBookKey,
+	types.OrderExpiryKey,
 	types.OrderKey,
 	types.AccountActiveOrdersKey,
 	types.CancelKey,
 	types.TwapKey,
 	types.PriceKey,
+	types.CandleKey,
 	types.NextOrderIDKey,
 	types.MatchResultKey,
 	types.LongOrderCountKey,
@@ -24,6 +27,12 @@ var DexWhitelistedKeys = []string{
 	keeper.ContractPrefixKey,
 }
 
+// Keys of contract state that isn't prefixed by pair (e.g. order expiries, which are
+// indexed by expiry first), which the execution of any pair of the contract may write.
+var DexPairAgnosticWhitelistedKeys = []string{
+	types.OrderExpiryKey,
+}
+
 var DexMemWhitelistedKeys = []string{
 	types.MemOrderKey,
 	types.MemDepositKey,
@@ -75,11 +84,15 @@ func GetWasmWhitelistedPrefixes(contractAddr string) []string {
 }
 
 func GetDexPerPairWhitelistedPrefixes(contractAddr string, pair types.Pair) []string {
-	return utils.Map(DexWhitelistedKeys, func(key string) string {
+	return append(utils.Map(DexWhitelistedKeys, func(key string) string {
 		return string(append(append(
 			types.KeyPrefix(key), types.AddressKeyPrefix(contractAddr)...,
 		), types.PairPrefix(pair.PriceDenom, pair.AssetDenom)...))
-	})
+	}), utils.Map(DexPairAgnosticWhitelistedKeys, func(key string) string {
+		return string(append(
+			types.KeyPrefix(key), types.AddressKeyPrefix(contractAddr)...,
+		))
+	})...)
 }
 
 func GetDexMemPerPairWhitelistedPrefixes(contractAddr string, pair types.Pair) []string {
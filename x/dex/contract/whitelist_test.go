@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sei-protocol/sei-chain/x/dex/contract"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -26,3 +27,18 @@ func TestGetDexPrefixes(t *testing.T) {
 		require.Equal(t, string(prefix), dexWhitelistedPrefixes[i])
 	}
 }
+
+func TestGetDexPerPairPrefixes(t *testing.T) {
+	contractAddr := "sei14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sh9m79m"
+	pair := types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"}
+	dexWhitelistedPrefixes := contract.GetDexPerPairWhitelistedPrefixes(contractAddr, pair)
+	require.Equal(t, len(contract.DexWhitelistedKeys)+len(contract.DexPairAgnosticWhitelistedKeys), len(dexWhitelistedPrefixes))
+	for i, dexKeys := range contract.DexWhitelistedKeys {
+		prefix := append(types.ContractKeyPrefix(dexKeys, contractAddr), types.PairPrefix(pair.PriceDenom, pair.AssetDenom)...)
+		require.Equal(t, string(prefix), dexWhitelistedPrefixes[i])
+	}
+	// order expiries of any pair of the contract can be written
+	for i, dexKeys := range contract.DexPairAgnosticWhitelistedKeys {
+		require.Equal(t, string(types.ContractKeyPrefix(dexKeys, contractAddr)), dexWhitelistedPrefixes[len(contract.DexWhitelistedKeys)+i])
+	}
+}
@@ -18,6 +18,11 @@ func CancelOrders(
 }
 
 func cancelOrder(ctx sdk.Context, keeper *keeper.Keeper, cancellation *types.Cancellation, contract types.ContractAddress, pair types.Pair) {
+	// stop orders that haven't been triggered yet live in the trigger book instead of the order book
+	if _, found := keeper.GetTriggeredOrderByID(ctx, string(contract), cancellation.Id, pair.PriceDenom, pair.AssetDenom); found {
+		keeper.RemoveTriggeredOrder(ctx, string(contract), cancellation.Id, pair.PriceDenom, pair.AssetDenom)
+		return
+	}
 	getter, setter, deleter := keeper.GetLongOrderBookEntryByPrice, keeper.SetLongOrderBookEntry, keeper.RemoveLongBookByPrice
 	if cancellation.PositionDirection == types.PositionDirection_SHORT {
 		getter, setter, deleter = keeper.GetShortOrderBookEntryByPrice, keeper.SetShortOrderBookEntry, keeper.RemoveShortBookByPrice
@@ -103,3 +103,54 @@ func TestCancelOrder(t *testing.T) {
 		Quantity: sdk.NewDec(7),
 	}}, entry.GetOrderEntry().Allocations)
 }
+
+func TestCancelTriggeredOrder(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	dexkeeper.SetLongBook(ctx, "test", types.LongBook{
+		Price: sdk.NewDec(98),
+		Entry: &types.OrderEntry{
+			Price:    sdk.NewDec(98),
+			Quantity: sdk.NewDec(5),
+			Allocations: []*types.Allocation{{
+				OrderId:  5,
+				Account:  "abc",
+				Quantity: sdk.NewDec(5),
+			}},
+			PriceDenom: "USDC",
+			AssetDenom: "ATOM",
+		},
+	})
+	dexkeeper.SetTriggeredOrder(ctx, "test", types.Order{
+		Id:                6,
+		Account:           "abc",
+		ContractAddr:      "test",
+		Price:             sdk.NewDec(98),
+		Quantity:          sdk.NewDec(2),
+		PriceDenom:        "USDC",
+		AssetDenom:        "ATOM",
+		OrderType:         types.OrderType_STOPLIMIT,
+		PositionDirection: types.PositionDirection_LONG,
+		TriggerPrice:      sdk.NewDec(99),
+	})
+
+	cancellation := types.Cancellation{
+		Id:                6,
+		Creator:           "abc",
+		ContractAddr:      "test",
+		PriceDenom:        "USDC",
+		AssetDenom:        "ATOM",
+		PositionDirection: types.PositionDirection_LONG,
+		Price:             sdk.NewDec(98),
+	}
+	exchange.CancelOrders(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"},
+		[]*types.Cancellation{&cancellation},
+	)
+
+	_, found := dexkeeper.GetTriggeredOrderByID(ctx, "test", 6, "USDC", "ATOM")
+	require.False(t, found)
+	// the order book is untouched
+	entry, found := dexkeeper.GetLongBookByPrice(ctx, "test", sdk.NewDec(98), "USDC", "ATOM")
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(5), entry.GetOrderEntry().Quantity)
+}
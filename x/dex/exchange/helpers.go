@@ -11,14 +11,45 @@ type ExecutionOutcome struct {
 	Settlements   []*types.SettlementEntry
 	MinPrice      sdk.Dec // deprecate?
 	MaxPrice      sdk.Dec // deprecate?
+	FirstPrice    sdk.Dec // price of the first execution
+	LastPrice     sdk.Dec // price of the last execution
 }
 
+// Merge combines the outcome with the outcome of executions that happened after it. Prices of
+// an outcome without executions are ignored.
 func (o *ExecutionOutcome) Merge(other *ExecutionOutcome) ExecutionOutcome {
-	return ExecutionOutcome{
+	merged := ExecutionOutcome{
 		TotalNotional: o.TotalNotional.Add(other.TotalNotional),
 		TotalQuantity: o.TotalQuantity.Add(other.TotalQuantity),
 		Settlements:   append(o.Settlements, other.Settlements...),
-		MinPrice:      sdk.MinDec(o.MinPrice, other.MinPrice),
-		MaxPrice:      sdk.MaxDec(o.MaxPrice, other.MaxPrice),
 	}
+	switch {
+	case other.TotalQuantity.IsZero():
+		merged.MinPrice, merged.MaxPrice, merged.FirstPrice, merged.LastPrice = o.MinPrice, o.MaxPrice, o.FirstPrice, o.LastPrice
+	case o.TotalQuantity.IsZero():
+		merged.MinPrice, merged.MaxPrice, merged.FirstPrice, merged.LastPrice = other.MinPrice, other.MaxPrice, other.FirstPrice, other.LastPrice
+	default:
+		merged.MinPrice, merged.MaxPrice = sdk.MinDec(o.MinPrice, other.MinPrice), sdk.MaxDec(o.MaxPrice, other.MaxPrice)
+		merged.FirstPrice, merged.LastPrice = o.FirstPrice, other.LastPrice
+	}
+	return merged
+}
+
+// TotalFees returns the sum of fees charged across all settlements of the outcome
+func (o *ExecutionOutcome) TotalFees() sdk.Dec {
+	total := sdk.ZeroDec()
+	for _, settlement := range o.Settlements {
+		total = total.Add(settlement.FeeOrZero())
+	}
+	return total
+}
+
+// Volume returns the traded quantity of the outcome. Every fill settles both a buy and a
+// sell side, so settled quantities add up to twice the traded quantity.
+func (o *ExecutionOutcome) Volume() sdk.Dec {
+	total := sdk.ZeroDec()
+	for _, settlement := range o.Settlements {
+		total = total.Add(settlement.Quantity)
+	}
+	return total.QuoInt64(2)
 }
@@ -44,6 +44,8 @@ func TestMergeExecutionOutcomes(t *testing.T) {
 		Settlements:   []*types.SettlementEntry{&s1, &s2, &s1},
 		MinPrice:      sdk.MustNewDecFromStr("1"),
 		MaxPrice:      sdk.MustNewDecFromStr("4"),
+		FirstPrice:    sdk.MustNewDecFromStr("2"),
+		LastPrice:     sdk.MustNewDecFromStr("1"),
 	}
 
 	e2 := exchange.ExecutionOutcome{
@@ -52,6 +54,8 @@ func TestMergeExecutionOutcomes(t *testing.T) {
 		Settlements:   []*types.SettlementEntry{&s1, &s2},
 		MinPrice:      sdk.MustNewDecFromStr("0.5"),
 		MaxPrice:      sdk.MustNewDecFromStr("3"),
+		FirstPrice:    sdk.MustNewDecFromStr("3"),
+		LastPrice:     sdk.MustNewDecFromStr("0.5"),
 	}
 
 	outcome := e1.Merge(&e2)
@@ -61,4 +65,23 @@ func TestMergeExecutionOutcomes(t *testing.T) {
 	require.Equal(t, len(outcome.Settlements), 5)
 	require.Equal(t, outcome.MinPrice, sdk.MustNewDecFromStr("0.5"))
 	require.Equal(t, outcome.MaxPrice, sdk.MustNewDecFromStr("4"))
+	require.Equal(t, outcome.FirstPrice, sdk.MustNewDecFromStr("2"))
+	require.Equal(t, outcome.LastPrice, sdk.MustNewDecFromStr("0.5"))
+
+	// prices of an outcome without executions are ignored
+	empty := exchange.ExecutionOutcome{
+		TotalNotional: sdk.ZeroDec(),
+		TotalQuantity: sdk.ZeroDec(),
+		Settlements:   []*types.SettlementEntry{},
+		MinPrice:      sdk.OneDec().Neg(),
+		MaxPrice:      sdk.OneDec().Neg(),
+		FirstPrice:    sdk.OneDec().Neg(),
+		LastPrice:     sdk.OneDec().Neg(),
+	}
+	for _, outcome := range []exchange.ExecutionOutcome{empty.Merge(&e2), e2.Merge(&empty)} {
+		require.Equal(t, outcome.MinPrice, sdk.MustNewDecFromStr("0.5"))
+		require.Equal(t, outcome.MaxPrice, sdk.MustNewDecFromStr("3"))
+		require.Equal(t, outcome.FirstPrice, sdk.MustNewDecFromStr("3"))
+		require.Equal(t, outcome.LastPrice, sdk.MustNewDecFromStr("0.5"))
+	}
 }
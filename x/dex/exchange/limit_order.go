@@ -15,6 +15,7 @@ func MatchLimitOrders(
 	settlements := []*types.SettlementEntry{}
 	totalExecuted, totalPrice := sdk.ZeroDec(), sdk.ZeroDec()
 	minPrice, maxPrice := sdk.OneDec().Neg(), sdk.OneDec().Neg()
+	firstPrice, lastPrice := sdk.OneDec().Neg(), sdk.OneDec().Neg()
 
 	for longEntry, shortEntry := orderbook.Longs.Next(ctx), orderbook.Shorts.Next(ctx); longEntry != nil && shortEntry != nil && longEntry.GetPrice().GTE(shortEntry.GetPrice()); longEntry, shortEntry = orderbook.Longs.Next(ctx), orderbook.Shorts.Next(ctx) {
 		var executed sdk.Dec
@@ -33,6 +34,11 @@ func MatchLimitOrders(
 			minPrice = shortEntry.GetPrice()
 		}
 		maxPrice = sdk.MaxDec(maxPrice, longEntry.GetPrice())
+		// a match executes at the average of the long and short prices
+		lastPrice = longEntry.GetPrice().Add(shortEntry.GetPrice()).QuoInt64(2)
+		if firstPrice.IsNegative() {
+			firstPrice = lastPrice
+		}
 
 		newSettlements := SettleFromBook(
 			ctx,
@@ -52,6 +58,8 @@ func MatchLimitOrders(
 		Settlements:   settlements,
 		MinPrice:      minPrice,
 		MaxPrice:      maxPrice,
+		FirstPrice:    firstPrice,
+		LastPrice:     lastPrice,
 	}
 }
 
@@ -87,6 +95,10 @@ func addOrderToOrderBookEntry(
 	if err != nil {
 		ctx.Logger().Error(fmt.Sprintf("error increasing order count: %s", err))
 	}
+
+	if !order.ImmediateOrCancel && (order.ExpiryHeight > 0 || order.ExpiryTimestamp > 0) {
+		keeper.SetOrderExpiry(ctx, order.ContractAddr, *order)
+	}
 }
 
 func AddOutstandingLimitOrdersToOrderbook(
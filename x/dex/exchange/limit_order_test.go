@@ -1055,6 +1055,8 @@ func TestMatchMultipleOrderFromMultipleLongBook(t *testing.T) {
 	assert.Equal(t, totalExecuted, sdk.NewDec(12))
 	assert.Equal(t, minPrice, sdk.NewDec(96))
 	assert.Equal(t, maxPrice, sdk.NewDec(110))
+	assert.Equal(t, outcome.FirstPrice, sdk.NewDec(103))
+	assert.Equal(t, outcome.LastPrice, sdk.NewDec(100))
 	longBook = dexkeeper.GetAllLongBookForPair(ctx, "test", "USDC", "ATOM")
 	shortBook = dexkeeper.GetAllShortBookForPair(ctx, "test", "USDC", "ATOM")
 	assert.Equal(t, len(longBook), 1)
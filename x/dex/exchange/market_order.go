@@ -12,6 +12,7 @@ func MatchMarketOrders(
 	orderBookEntries *types.CachedSortedOrderBookEntries,
 	direction types.PositionDirection,
 	blockOrders *cache.BlockOrders,
+	pair types.Pair,
 ) ExecutionOutcome {
 	totalExecuted, totalPrice := sdk.ZeroDec(), sdk.ZeroDec()
 	minPrice, maxPrice := sdk.OneDec().Neg(), sdk.OneDec().Neg()
@@ -21,13 +22,13 @@ func MatchMarketOrders(
 		switch marketOrder.OrderType {
 		case types.OrderType_FOKMARKETBYVALUE:
 			settlements, allTakerSettlements = MatchByValueFOKMarketOrder(
-				ctx, marketOrder, orderBookEntries, direction, &totalExecuted, &totalPrice, &minPrice, &maxPrice, settlements, allTakerSettlements, blockOrders)
+				ctx, marketOrder, orderBookEntries, direction, &totalExecuted, &totalPrice, &minPrice, &maxPrice, settlements, allTakerSettlements, blockOrders, pair)
 		case types.OrderType_FOKMARKET:
 			settlements, allTakerSettlements = MatchFOKMarketOrder(
-				ctx, marketOrder, orderBookEntries, direction, &totalExecuted, &totalPrice, &minPrice, &maxPrice, settlements, allTakerSettlements, blockOrders)
+				ctx, marketOrder, orderBookEntries, direction, &totalExecuted, &totalPrice, &minPrice, &maxPrice, settlements, allTakerSettlements, blockOrders, pair)
 		default:
 			settlements, allTakerSettlements = MatchMarketOrder(
-				ctx, marketOrder, orderBookEntries, direction, &totalExecuted, &totalPrice, &minPrice, &maxPrice, settlements, allTakerSettlements, blockOrders)
+				ctx, marketOrder, orderBookEntries, direction, &totalExecuted, &totalPrice, &minPrice, &maxPrice, settlements, allTakerSettlements, blockOrders, pair)
 		}
 	}
 
@@ -39,12 +40,15 @@ func MatchMarketOrders(
 		minPrice, maxPrice = clearingPrice, clearingPrice
 		settlements = append(settlements, allTakerSettlements...)
 	}
+	// all market orders execute at the clearing price
 	return ExecutionOutcome{
 		TotalNotional: totalPrice,
 		TotalQuantity: totalExecuted,
 		Settlements:   settlements,
 		MinPrice:      minPrice,
 		MaxPrice:      maxPrice,
+		FirstPrice:    minPrice,
+		LastPrice:     maxPrice,
 	}
 }
 
@@ -60,6 +64,7 @@ func MatchMarketOrder(
 	settlements []*types.SettlementEntry,
 	allTakerSettlements []*types.SettlementEntry,
 	blockOrders *cache.BlockOrders,
+	pair types.Pair,
 ) ([]*types.SettlementEntry, []*types.SettlementEntry) {
 	remainingQuantity := marketOrder.Quantity
 	for entry := orderBookEntries.Next(ctx); entry != nil; entry = orderBookEntries.Next(ctx) {
@@ -96,6 +101,7 @@ func MatchMarketOrder(
 			orderBookEntries,
 			marketOrder.Price,
 			entry.GetPrice(),
+			pair,
 		)
 		// update the status of order in the memState
 		UpdateOrderData(marketOrder, executed, blockOrders)
@@ -124,12 +130,16 @@ func MatchFOKMarketOrder(
 	settlements []*types.SettlementEntry,
 	allTakerSettlements []*types.SettlementEntry,
 	blockOrders *cache.BlockOrders,
+	pair types.Pair,
 ) ([]*types.SettlementEntry, []*types.SettlementEntry) {
-	// check if there is enough liquidity for fill-or-kill market order, if not skip them
+	// check if there is enough liquidity for fill-or-kill market order, if not skip them.
+	// The order is matched against a snapshot of the order book so that an order that
+	// can't be fully filled leaves the order book untouched.
+	snapshot := orderBookEntries.Snapshot()
 	remainingQuantity := marketOrder.Quantity
 	newSettlements, newTakerSettlements := []*types.SettlementEntry{}, []*types.SettlementEntry{}
 	orders, executedQuantities, entryPrices := []*types.Order{}, []sdk.Dec{}, []sdk.Dec{}
-	for entry := orderBookEntries.Next(ctx); entry != nil; entry = orderBookEntries.Next(ctx) {
+	for entry := snapshot.Next(ctx); entry != nil; entry = snapshot.Next(ctx) {
 		if !marketOrder.Price.IsZero() {
 			if (direction == types.PositionDirection_LONG && marketOrder.Price.LT(entry.GetPrice())) ||
 				(direction == types.PositionDirection_SHORT && marketOrder.Price.GT(entry.GetPrice())) {
@@ -149,9 +159,10 @@ func MatchFOKMarketOrder(
 			ctx,
 			marketOrder,
 			executed,
-			orderBookEntries,
+			snapshot,
 			marketOrder.Price,
 			entry.GetPrice(),
+			pair,
 		)
 		newSettlements = append(newSettlements, makerSettlements...)
 		newTakerSettlements = append(newTakerSettlements, takerSettlements...)
@@ -165,9 +176,10 @@ func MatchFOKMarketOrder(
 	}
 
 	if remainingQuantity.IsZero() {
+		orderBookEntries.Apply(snapshot)
 		orderBookEntries.Flush(ctx)
 		settlements = append(settlements, newSettlements...)
-		allTakerSettlements = append(allTakerSettlements, newTakerSettlements...)
+		allTakerSettlements = append(allTakerSettlements, MergeByNominalTakerSettlements(newTakerSettlements)...)
 		for i, order := range orders {
 			UpdateOrderData(order, executedQuantities[i], blockOrders)
 			*totalExecuted = totalExecuted.Add(executedQuantities[i])
@@ -179,8 +191,6 @@ func MatchFOKMarketOrder(
 			}
 			*maxPrice = sdk.MaxDec(*maxPrice, entryPrices[i])
 		}
-	} else {
-		orderBookEntries.Refresh(ctx)
 	}
 
 	return settlements, allTakerSettlements
@@ -198,12 +208,15 @@ func MatchByValueFOKMarketOrder(
 	settlements []*types.SettlementEntry,
 	allTakerSettlements []*types.SettlementEntry,
 	blockOrders *cache.BlockOrders,
+	pair types.Pair,
 ) ([]*types.SettlementEntry, []*types.SettlementEntry) {
+	// same as MatchFOKMarketOrder, the order is matched against a snapshot of the order book
+	snapshot := orderBookEntries.Snapshot()
 	remainingFund := marketOrder.Nominal
 	remainingQuantity := marketOrder.Quantity
 	newSettlements, newTakerSettlements := []*types.SettlementEntry{}, []*types.SettlementEntry{}
 	orders, executedQuantities, entryPrices := []*types.Order{}, []sdk.Dec{}, []sdk.Dec{}
-	for entry := orderBookEntries.Next(ctx); entry != nil; entry = orderBookEntries.Next(ctx) {
+	for entry := snapshot.Next(ctx); entry != nil; entry = snapshot.Next(ctx) {
 		if !marketOrder.Price.IsZero() {
 			if (direction == types.PositionDirection_LONG && marketOrder.Price.LT(entry.GetPrice())) ||
 				(direction == types.PositionDirection_SHORT && marketOrder.Price.GT(entry.GetPrice())) {
@@ -224,12 +237,13 @@ func MatchByValueFOKMarketOrder(
 			ctx,
 			marketOrder,
 			executed,
-			orderBookEntries,
+			snapshot,
 			marketOrder.Price,
 			entry.GetPrice(),
+			pair,
 		)
 		newSettlements = append(newSettlements, makerSettlements...)
-		newTakerSettlements = MergeByNominalTakerSettlements(append(newTakerSettlements, takerSettlements...))
+		newTakerSettlements = append(newTakerSettlements, takerSettlements...)
 		orders = append(orders, marketOrder)
 		executedQuantities = append(executedQuantities, executed)
 		entryPrices = append(entryPrices, entry.GetPrice())
@@ -240,9 +254,10 @@ func MatchByValueFOKMarketOrder(
 
 	// settle orders only when all fund are used
 	if remainingFund.IsZero() && remainingQuantity.GTE(sdk.ZeroDec()) {
+		orderBookEntries.Apply(snapshot)
 		orderBookEntries.Flush(ctx)
 		settlements = append(settlements, newSettlements...)
-		allTakerSettlements = append(allTakerSettlements, newTakerSettlements...)
+		allTakerSettlements = append(allTakerSettlements, MergeByNominalTakerSettlements(newTakerSettlements)...)
 		for i, order := range orders {
 			UpdateOrderData(order, executedQuantities[i], blockOrders)
 			*totalExecuted = totalExecuted.Add(executedQuantities[i])
@@ -254,19 +269,26 @@ func MatchByValueFOKMarketOrder(
 			}
 			*maxPrice = sdk.MaxDec(*maxPrice, entryPrices[i])
 		}
-	} else {
-		orderBookEntries.Refresh(ctx)
 	}
 
 	return settlements, allTakerSettlements
 }
 
+// Merge the taker settlements of a single fill-or-kill order into one settlement entry
 func MergeByNominalTakerSettlements(settlements []*types.SettlementEntry) []*types.SettlementEntry {
+	if len(settlements) == 0 {
+		return []*types.SettlementEntry{}
+	}
 	aggregatedSettlement := types.SettlementEntry{Quantity: sdk.ZeroDec()}
 	for _, settlement := range settlements {
 		quantity := settlement.Quantity.Add(aggregatedSettlement.Quantity)
+		fee := aggregatedSettlement.Fee
+		if !settlement.Fee.IsNil() {
+			fee = settlement.Fee.Add(aggregatedSettlement.FeeOrZero())
+		}
 		aggregatedSettlement = *settlement
 		aggregatedSettlement.Quantity = quantity
+		aggregatedSettlement.Fee = fee
 	}
 
 	return []*types.SettlementEntry{&aggregatedSettlement}
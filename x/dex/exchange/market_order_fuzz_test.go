@@ -9,6 +9,7 @@ import (
 	"github.com/sei-protocol/sei-chain/testutil/fuzzing"
 	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
 	"github.com/sei-protocol/sei-chain/x/dex/exchange"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
 	keeperutil "github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
 	"github.com/sei-protocol/sei-chain/x/dex/types"
 	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
@@ -87,6 +88,104 @@ func fuzzTargetMatchMarketOrders(
 		if takerLong {
 			book = orderbook.Shorts
 		}
-		exchange.MatchMarketOrders(TestFuzzMarketCtx, orders, book, direction, blockOrders)
+		exchange.MatchMarketOrders(TestFuzzMarketCtx, orders, book, direction, blockOrders, orderbook.Pair)
 	})
 }
+
+func FuzzMatchFOKMarketOrder(f *testing.F) {
+	f.Fuzz(fuzzTargetMatchFOKMarketOrder)
+}
+
+func fuzzTargetMatchFOKMarketOrder(
+	t *testing.T,
+	takerLong bool,
+	byValue bool,
+	price byte,
+	quantity byte,
+	nominal uint16,
+	entryWeights []byte,
+	accountIndices []byte,
+	allocationWeights []byte,
+) {
+	dexkeeper, TestFuzzMarketCtx := keepertest.DexKeeper(t)
+	TestFuzzMarketCtx = TestFuzzMarketCtx.WithBlockHeight(1).WithBlockTime(time.Now())
+	blockOrders := dexutils.GetMemState(TestFuzzMarketCtx.Context()).GetBlockOrders(TestFuzzMarketCtx, "testAccount", types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
+	entries := fuzzing.GetOrderBookEntries(!takerLong, keepertest.TestPriceDenom, keepertest.TestAssetDenom, entryWeights, accountIndices, allocationWeights)
+	direction := types.PositionDirection_SHORT
+	if takerLong {
+		direction = types.PositionDirection_LONG
+	}
+	orderbook := keeperutil.PopulateOrderbook(TestFuzzMarketCtx, dexkeeper, types.ContractAddress(keepertest.TestContract), types.Pair{PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom})
+	book, getBook := orderbook.Longs, dexkeeper.GetAllLongBookForPair
+	if takerLong {
+		book, getBook = orderbook.Shorts, dexkeeper.GetAllShortBookForPair
+	}
+	for _, entry := range entries {
+		if takerLong {
+			dexkeeper.SetShortOrderBookEntry(TestFuzzMarketCtx, keepertest.TestContract, entry)
+		} else {
+			dexkeeper.SetLongOrderBookEntry(TestFuzzMarketCtx, keepertest.TestContract, entry)
+		}
+		require.Nil(t, dexkeeper.SetOrderCount(TestFuzzMarketCtx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, types.OppositePositionDirection[direction], entry.GetPrice(), uint64(len(entry.GetOrderEntry().Allocations))))
+	}
+	orderType := types.OrderType_FOKMARKET
+	if byValue {
+		orderType = types.OrderType_FOKMARKETBYVALUE
+	}
+	orders := fuzzing.GetPlacedOrders(direction, orderType, keepertest.TestPair, []byte{price}, []byte{quantity})
+	orders[0].Nominal = sdk.NewDec(int64(nominal))
+	blockOrders.Add(orders[0])
+
+	bookBefore := getBook(TestFuzzMarketCtx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	orderCountsBefore := getOrderCounts(TestFuzzMarketCtx, dexkeeper, types.OppositePositionDirection[direction], bookBefore)
+
+	var outcome exchange.ExecutionOutcome
+	require.NotPanics(t, func() {
+		outcome = exchange.MatchMarketOrders(TestFuzzMarketCtx, orders, book, direction, blockOrders, orderbook.Pair)
+	})
+
+	bookAfter := getBook(TestFuzzMarketCtx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	if len(outcome.Settlements) == 0 {
+		// a rejected FOK order should leave the order book untouched
+		require.True(t, outcome.TotalQuantity.IsZero())
+		require.Equal(t, bookBefore, bookAfter)
+		require.Equal(t, orderCountsBefore, getOrderCounts(TestFuzzMarketCtx, dexkeeper, types.OppositePositionDirection[direction], bookBefore))
+		// the cached entries should also be unaffected
+		freshOrderbook := keeperutil.PopulateOrderbook(TestFuzzMarketCtx, dexkeeper, types.ContractAddress(keepertest.TestContract), types.Pair{PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom})
+		freshBook := freshOrderbook.Longs
+		if takerLong {
+			freshBook = freshOrderbook.Shorts
+		}
+		require.Equal(t, freshBook.Next(TestFuzzMarketCtx), book.Next(TestFuzzMarketCtx))
+		return
+	}
+	// an accepted FOK order should be fully filled and have a single taker settlement
+	if !byValue {
+		require.Equal(t, orders[0].Quantity, outcome.TotalQuantity)
+	}
+	takerSettlements := 0
+	for _, settlement := range outcome.Settlements {
+		if settlement.PositionDirection == types.GetContractPositionDirection(direction) {
+			takerSettlements++
+			require.Equal(t, outcome.TotalQuantity, settlement.Quantity)
+		}
+	}
+	require.Equal(t, 1, takerSettlements)
+	require.Equal(t, getTotalQuantity(bookBefore).Sub(outcome.TotalQuantity), getTotalQuantity(bookAfter))
+}
+
+func getOrderCounts(ctx sdk.Context, dexkeeper *keeper.Keeper, direction types.PositionDirection, entries []types.OrderBookEntry) []uint64 {
+	res := []uint64{}
+	for _, entry := range entries {
+		res = append(res, dexkeeper.GetOrderCountState(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, direction, entry.GetPrice()))
+	}
+	return res
+}
+
+func getTotalQuantity(entries []types.OrderBookEntry) sdk.Dec {
+	res := sdk.ZeroDec()
+	for _, entry := range entries {
+		res = res.Add(entry.GetOrderEntry().Quantity)
+	}
+	return res
+}
@@ -14,6 +14,7 @@ func Settle(
 	orderbook *types.CachedSortedOrderBookEntries,
 	worstPrice sdk.Dec,
 	makerPrice sdk.Dec,
+	pair types.Pair,
 ) ([]*types.SettlementEntry, []*types.SettlementEntry) {
 	// settlement of one liquidity taker's order is allocated on a FIFO basis
 	takerSettlements := []*types.SettlementEntry{}
@@ -23,7 +24,9 @@ func Settle(
 	}
 	newToSettle, _ := orderbook.SettleQuantity(ctx, quantityTaken)
 	for _, toSettle := range newToSettle {
-		takerSettlements = append(takerSettlements, types.NewSettlementEntry(
+		// fees are charged on the notional at the maker's price, which is what the fill is executed at
+		notional := toSettle.Amount.Mul(makerPrice)
+		takerSettlement := types.NewSettlementEntry(
 			ctx,
 			takerOrder.Id,
 			takerOrder.Account,
@@ -34,8 +37,9 @@ func Settle(
 			worstPrice,
 			worstPrice,
 			takerOrder.OrderType,
-		))
-		makerSettlements = append(makerSettlements, types.NewSettlementEntry(
+		)
+		chargeFee(takerSettlement, notional, pair.TakerFeeRate)
+		makerSettlement := types.NewSettlementEntry(
 			ctx,
 			toSettle.OrderID,
 			toSettle.Account,
@@ -46,7 +50,10 @@ func Settle(
 			makerPrice,
 			makerPrice,
 			types.OrderType_LIMIT,
-		))
+		)
+		chargeFee(makerSettlement, notional, pair.MakerFeeRate)
+		takerSettlements = append(takerSettlements, takerSettlement)
+		makerSettlements = append(makerSettlements, makerSettlement)
 	}
 
 	return takerSettlements, makerSettlements
@@ -93,7 +100,7 @@ func SettleFromBook(
 		} else {
 			quantity = shortToSettle.Amount
 		}
-		settlements = append(settlements, types.NewSettlementEntry(
+		longSettlement := types.NewSettlementEntry(
 			ctx,
 			longToSettle.OrderID,
 			longToSettle.Account,
@@ -104,7 +111,8 @@ func SettleFromBook(
 			avgPrice,
 			longPrice,
 			types.OrderType_LIMIT,
-		), types.NewSettlementEntry(
+		)
+		shortSettlement := types.NewSettlementEntry(
 			ctx,
 			shortToSettle.OrderID,
 			shortToSettle.Account,
@@ -115,7 +123,12 @@ func SettleFromBook(
 			avgPrice,
 			shortPrice,
 			types.OrderType_LIMIT,
-		))
+		)
+		// both sides were resting in the order book, so both are charged the maker fee
+		notional := quantity.Mul(avgPrice)
+		chargeFee(longSettlement, notional, orderbook.Pair.MakerFeeRate)
+		chargeFee(shortSettlement, notional, orderbook.Pair.MakerFeeRate)
+		settlements = append(settlements, longSettlement, shortSettlement)
 		newLongToSettle[longPtr] = types.ToSettle{Account: longToSettle.Account, Amount: longToSettle.Amount.Sub(quantity), OrderID: longToSettle.OrderID}
 		newShortToSettle[shortPtr] = types.ToSettle{Account: shortToSettle.Account, Amount: shortToSettle.Amount.Sub(quantity), OrderID: shortToSettle.OrderID}
 		if newLongToSettle[longPtr].Amount.IsZero() {
@@ -127,3 +140,10 @@ func SettleFromBook(
 	}
 	return settlements
 }
+
+func chargeFee(settlement *types.SettlementEntry, notional sdk.Dec, feeRate *sdk.Dec) {
+	if feeRate == nil {
+		return
+	}
+	settlement.Fee = notional.Mul(*feeRate)
+}
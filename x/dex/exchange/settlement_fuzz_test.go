@@ -69,7 +69,7 @@ func fuzzTargetSettle(
 	}
 	for i, entry := range entries {
 		require.NotPanics(t, func() {
-			exchange.Settle(ctx, orders[i], quantity, book, price, entry.GetPrice())
+			exchange.Settle(ctx, orders[i], quantity, book, price, entry.GetPrice(), orderbook.Pair)
 		})
 	}
 }
@@ -0,0 +1,129 @@
+package exchange_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/exchange"
+	keeperutil "github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSettleWithFees(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	dexkeeper.SetShortOrderBookEntry(ctx, "test", &types.ShortBook{
+		Price: sdk.NewDec(100),
+		Entry: &types.OrderEntry{
+			Price:    sdk.NewDec(100),
+			Quantity: sdk.NewDec(5),
+			Allocations: []*types.Allocation{{
+				OrderId:  5,
+				Account:  "def",
+				Quantity: sdk.NewDec(5),
+			}},
+			PriceDenom: "USDC",
+			AssetDenom: "ATOM",
+		},
+	})
+	makerFeeRate, takerFeeRate := sdk.MustNewDecFromStr("0.001"), sdk.MustNewDecFromStr("0.002")
+	pair := types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM", MakerFeeRate: &makerFeeRate, TakerFeeRate: &takerFeeRate}
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), pair)
+	entry := orderbook.Shorts.Next(ctx)
+	takerOrder := &types.Order{
+		Id:                1,
+		Account:           "abc",
+		Price:             sdk.NewDec(110),
+		Quantity:          sdk.NewDec(2),
+		PriceDenom:        "USDC",
+		AssetDenom:        "ATOM",
+		OrderType:         types.OrderType_MARKET,
+		PositionDirection: types.PositionDirection_LONG,
+	}
+
+	takerSettlements, makerSettlements := exchange.Settle(ctx, takerOrder, sdk.NewDec(2), orderbook.Shorts, takerOrder.Price, entry.GetPrice(), orderbook.Pair)
+	require.Equal(t, 1, len(takerSettlements))
+	require.Equal(t, 1, len(makerSettlements))
+	// fees are charged on the notional at the maker's price
+	require.Equal(t, sdk.MustNewDecFromStr("0.4"), takerSettlements[0].Fee)
+	require.Equal(t, sdk.MustNewDecFromStr("0.2"), makerSettlements[0].Fee)
+}
+
+func TestSettleWithoutFeeRates(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	dexkeeper.SetShortOrderBookEntry(ctx, "test", &types.ShortBook{
+		Price: sdk.NewDec(100),
+		Entry: &types.OrderEntry{
+			Price:    sdk.NewDec(100),
+			Quantity: sdk.NewDec(5),
+			Allocations: []*types.Allocation{{
+				OrderId:  5,
+				Account:  "def",
+				Quantity: sdk.NewDec(5),
+			}},
+			PriceDenom: "USDC",
+			AssetDenom: "ATOM",
+		},
+	})
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
+	entry := orderbook.Shorts.Next(ctx)
+	takerOrder := &types.Order{
+		Id:                1,
+		Account:           "abc",
+		Quantity:          sdk.NewDec(2),
+		PriceDenom:        "USDC",
+		AssetDenom:        "ATOM",
+		OrderType:         types.OrderType_MARKET,
+		PositionDirection: types.PositionDirection_LONG,
+	}
+
+	takerSettlements, makerSettlements := exchange.Settle(ctx, takerOrder, sdk.NewDec(2), orderbook.Shorts, sdk.ZeroDec(), entry.GetPrice(), orderbook.Pair)
+	require.True(t, takerSettlements[0].FeeOrZero().IsZero())
+	require.True(t, makerSettlements[0].FeeOrZero().IsZero())
+}
+
+func TestSettleFromBookWithFees(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	dexkeeper.SetLongOrderBookEntry(ctx, "test", &types.LongBook{
+		Price: sdk.NewDec(102),
+		Entry: &types.OrderEntry{
+			Price:    sdk.NewDec(102),
+			Quantity: sdk.NewDec(3),
+			Allocations: []*types.Allocation{{
+				OrderId:  1,
+				Account:  "abc",
+				Quantity: sdk.NewDec(3),
+			}},
+			PriceDenom: "USDC",
+			AssetDenom: "ATOM",
+		},
+	})
+	dexkeeper.SetShortOrderBookEntry(ctx, "test", &types.ShortBook{
+		Price: sdk.NewDec(98),
+		Entry: &types.OrderEntry{
+			Price:    sdk.NewDec(98),
+			Quantity: sdk.NewDec(3),
+			Allocations: []*types.Allocation{{
+				OrderId:  2,
+				Account:  "def",
+				Quantity: sdk.NewDec(3),
+			}},
+			PriceDenom: "USDC",
+			AssetDenom: "ATOM",
+		},
+	})
+	makerFeeRate, takerFeeRate := sdk.MustNewDecFromStr("0.001"), sdk.MustNewDecFromStr("0.002")
+	pair := types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM", MakerFeeRate: &makerFeeRate, TakerFeeRate: &takerFeeRate}
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), pair)
+
+	outcome := exchange.MatchLimitOrders(ctx, orderbook)
+	require.Equal(t, 2, len(outcome.Settlements))
+	// both orders were resting in the order book, and are charged the maker fee on
+	// the notional at the average price of 100
+	require.Equal(t, sdk.MustNewDecFromStr("0.3"), outcome.Settlements[0].Fee)
+	require.Equal(t, sdk.MustNewDecFromStr("0.3"), outcome.Settlements[1].Fee)
+	require.Equal(t, sdk.MustNewDecFromStr("0.6"), outcome.TotalFees())
+}
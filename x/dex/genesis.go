@@ -32,6 +32,14 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			k.SetShortBook(ctx, contractState.ContractInfo.ContractAddr, elem)
 		}
 
+		for _, elem := range contractState.TriggeredOrdersList {
+			k.SetTriggeredOrder(ctx, contractState.ContractInfo.ContractAddr, elem)
+		}
+
+		for _, elem := range contractState.ExpiringOrdersList {
+			k.SetOrderExpiry(ctx, contractState.ContractInfo.ContractAddr, elem)
+		}
+
 		for _, elem := range contractState.PriceList {
 			for _, priceElem := range elem.Prices {
 				k.SetPriceState(ctx, *priceElem, contractState.ContractInfo.ContractAddr)
@@ -68,12 +76,14 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 			})
 		}
 		contractStates[i] = types.ContractState{
-			ContractInfo:  contractInfo,
-			LongBookList:  k.GetAllLongBook(ctx, contractAddr),
-			ShortBookList: k.GetAllShortBook(ctx, contractAddr),
-			PairList:      registeredPairs,
-			PriceList:     contractPrices,
-			NextOrderId:   k.GetNextOrderID(ctx, contractAddr),
+			ContractInfo:        contractInfo,
+			LongBookList:        k.GetAllLongBook(ctx, contractAddr),
+			ShortBookList:       k.GetAllShortBook(ctx, contractAddr),
+			TriggeredOrdersList: k.GetAllTriggeredOrders(ctx, contractAddr),
+			PairList:            registeredPairs,
+			PriceList:           contractPrices,
+			NextOrderId:         k.GetNextOrderID(ctx, contractAddr),
+			ExpiringOrdersList:  k.GetAllExpiringOrders(ctx, contractAddr),
 		}
 	}
 	genesis.ContractState = contractStates
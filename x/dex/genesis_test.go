@@ -74,6 +74,37 @@ func TestGenesis(t *testing.T) {
 				},
 			},
 		},
+		TriggeredOrdersList: []types.Order{
+			{
+				Id:                5,
+				Account:           "test",
+				ContractAddr:      contractInfo.ContractAddr,
+				Price:             sdk.ZeroDec(),
+				Quantity:          sdk.NewDec(1),
+				PriceDenom:        "USDC",
+				AssetDenom:        "SEI",
+				OrderType:         types.OrderType_STOPLOSS,
+				PositionDirection: types.PositionDirection_LONG,
+				Nominal:           sdk.ZeroDec(),
+				TriggerPrice:      sdk.NewDec(110),
+			},
+		},
+		ExpiringOrdersList: []types.Order{
+			{
+				Id:                6,
+				Account:           "test",
+				ContractAddr:      contractInfo.ContractAddr,
+				Price:             sdk.NewDec(1),
+				Quantity:          sdk.NewDec(1),
+				PriceDenom:        "USDC",
+				AssetDenom:        "SEI",
+				OrderType:         types.OrderType_LIMIT,
+				PositionDirection: types.PositionDirection_LONG,
+				Nominal:           sdk.ZeroDec(),
+				TriggerPrice:      sdk.ZeroDec(),
+				ExpiryHeight:      100,
+			},
+		},
 		ContractInfo: contractInfo,
 		PairList:     pairList,
 		PriceList:    priceList,
@@ -95,6 +126,8 @@ func TestGenesis(t *testing.T) {
 
 	require.ElementsMatch(t, genesisState.ContractState[0].LongBookList, got.ContractState[0].LongBookList)
 	require.ElementsMatch(t, genesisState.ContractState[0].ShortBookList, got.ContractState[0].ShortBookList)
+	require.ElementsMatch(t, genesisState.ContractState[0].TriggeredOrdersList, got.ContractState[0].TriggeredOrdersList)
+	require.ElementsMatch(t, genesisState.ContractState[0].ExpiringOrdersList, got.ContractState[0].ExpiringOrdersList)
 	require.ElementsMatch(t, genesisState.ContractState[0].PairList, got.ContractState[0].PairList)
 	require.Equal(t, genesisState.ContractState[0].ContractInfo.CodeId, got.ContractState[0].ContractInfo.CodeId)
 	require.Equal(t, genesisState.ContractState[0].ContractInfo.ContractAddr, got.ContractState[0].ContractInfo.ContractAddr)
@@ -12,3 +12,12 @@ func HandleAddAssetMetadataProposal(ctx sdk.Context, k *keeper.Keeper, p *types.
 	}
 	return nil
 }
+
+func HandleUpdatePairFeeRatesProposal(ctx sdk.Context, k *keeper.Keeper, p *types.UpdatePairFeeRatesProposal) error {
+	for _, pair := range p.Pairs {
+		if err := k.SetFeeRatesForPair(ctx, p.ContractAddr, pair, pair.MakerFeeRate, pair.TakerFeeRate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -60,6 +60,8 @@ func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
 		switch c := content.(type) {
 		case *types.AddAssetMetadataProposal:
 			return HandleAddAssetMetadataProposal(ctx, &k, c)
+		case *types.UpdatePairFeeRatesProposal:
+			return HandleUpdatePairFeeRatesProposal(ctx, &k, c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized dex proposal content type: %T", c)
 		}
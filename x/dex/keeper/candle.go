@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// UpdateCandles records a trade of `volume` at `price` in the current candle of every
+// supported interval of a pair, opening new candles as needed.
+func (k Keeper) UpdateCandles(ctx sdk.Context, contractAddr string, pair types.Pair, price sdk.Dec, volume sdk.Dec) {
+	k.UpdateCandlesWithPrices(ctx, contractAddr, pair, price, price, price, price, volume)
+}
+
+// UpdateCandlesWithPrices records trades of `volume` in total, opening at `openPrice`,
+// closing at `closePrice` and ranging from `lowPrice` to `highPrice`, in the current
+// candle of every supported interval of a pair, opening new candles as needed.
+func (k Keeper) UpdateCandlesWithPrices(ctx sdk.Context, contractAddr string, pair types.Pair, openPrice, highPrice, lowPrice, closePrice, volume sdk.Dec) {
+	timestamp := uint64(ctx.BlockTime().Unix())
+	for _, interval := range types.SupportedCandleIntervals {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CandlePrefix(contractAddr, pair.PriceDenom, pair.AssetDenom, interval))
+		beginTimestamp := timestamp - timestamp%interval
+		key := GetKeyForTs(beginTimestamp)
+		candle := types.PriceCandlestick{}
+		if b := store.Get(key); b != nil {
+			k.Cdc.MustUnmarshal(b, &candle)
+			if highPrice.GT(*candle.High) {
+				candle.High = &highPrice
+			}
+			if lowPrice.LT(*candle.Low) {
+				candle.Low = &lowPrice
+			}
+			candle.Close = &closePrice
+			totalVolume := candle.Volume.Add(volume)
+			candle.Volume = &totalVolume
+		} else {
+			candle = types.PriceCandlestick{
+				BeginTimestamp: beginTimestamp,
+				EndTimestamp:   beginTimestamp + interval,
+				Open:           &openPrice,
+				High:           &highPrice,
+				Low:            &lowPrice,
+				Close:          &closePrice,
+				Volume:         &volume,
+			}
+			if retention := types.CandleRetentionCount * interval; beginTimestamp >= retention {
+				pruneCandlesBefore(store, beginTimestamp-retention+interval)
+			}
+		}
+		store.Set(key, k.Cdc.MustMarshal(&candle))
+	}
+}
+
+func (k Keeper) GetCandle(ctx sdk.Context, contractAddr string, pair types.Pair, intervalInSeconds uint64, beginTimestamp uint64) (types.PriceCandlestick, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CandlePrefix(contractAddr, pair.PriceDenom, pair.AssetDenom, intervalInSeconds))
+	res := types.PriceCandlestick{}
+	b := store.Get(GetKeyForTs(beginTimestamp))
+	if b == nil {
+		return res, false
+	}
+	k.Cdc.MustUnmarshal(b, &res)
+	return res, true
+}
+
+// GetCandlesPaginated returns candles of a pair whose begin timestamp is within
+// [startTimestamp, endTimestamp), in ascending order of begin timestamp. A zero
+// endTimestamp means no upper bound.
+func (k Keeper) GetCandlesPaginated(
+	ctx sdk.Context,
+	contractAddr string,
+	pair types.Pair,
+	intervalInSeconds uint64,
+	startTimestamp uint64,
+	endTimestamp uint64,
+	page *query.PageRequest,
+) (list []*types.PriceCandlestick, pageRes *query.PageResponse, err error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CandlePrefix(contractAddr, pair.PriceDenom, pair.AssetDenom, intervalInSeconds))
+
+	pageRes, err = query.FilteredPaginate(store, page, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		beginTimestamp := binary.BigEndian.Uint64(key)
+		if beginTimestamp < startTimestamp || (endTimestamp > 0 && beginTimestamp >= endTimestamp) {
+			return false, nil
+		}
+		if accumulate {
+			var candle types.PriceCandlestick
+			if err := k.Cdc.Unmarshal(value, &candle); err != nil {
+				return false, err
+			}
+			list = append(list, &candle)
+		}
+		return true, nil
+	})
+
+	return
+}
+
+func (k Keeper) RemoveAllCandlesForContract(ctx sdk.Context, contractAddr string) {
+	k.removeAllForPrefix(ctx, types.ContractKeyPrefix(types.CandleKey, contractAddr))
+}
+
+func pruneCandlesBefore(store sdk.KVStore, timestamp uint64) {
+	keys := [][]byte{}
+	iterator := store.Iterator(nil, GetKeyForTs(timestamp))
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateCandles(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	for i, trade := range []struct {
+		timestamp int64
+		price     int64
+		volume    int64
+	}{
+		{60, 100, 1},
+		{80, 105, 2},
+		{100, 95, 3},
+		{119, 98, 4},
+		{120, 110, 5},
+	} {
+		ctx = ctx.WithBlockHeight(int64(i)).WithBlockTime(time.Unix(trade.timestamp, 0))
+		keeper.UpdateCandles(ctx, keepertest.TestContract, keepertest.TestPair, sdk.NewDec(trade.price), sdk.NewDec(trade.volume))
+	}
+
+	candle, found := keeper.GetCandle(ctx, keepertest.TestContract, keepertest.TestPair, types.CandleIntervalOneMinute, 60)
+	require.True(t, found)
+	require.Equal(t, uint64(120), candle.EndTimestamp)
+	require.Equal(t, sdk.NewDec(100), *candle.Open)
+	require.Equal(t, sdk.NewDec(105), *candle.High)
+	require.Equal(t, sdk.NewDec(95), *candle.Low)
+	require.Equal(t, sdk.NewDec(98), *candle.Close)
+	require.Equal(t, sdk.NewDec(10), *candle.Volume)
+
+	candle, found = keeper.GetCandle(ctx, keepertest.TestContract, keepertest.TestPair, types.CandleIntervalOneMinute, 120)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(110), *candle.Open)
+	require.Equal(t, sdk.NewDec(5), *candle.Volume)
+
+	candle, found = keeper.GetCandle(ctx, keepertest.TestContract, keepertest.TestPair, types.CandleIntervalFiveMinutes, 0)
+	require.True(t, found)
+	require.Equal(t, uint64(300), candle.EndTimestamp)
+	require.Equal(t, sdk.NewDec(100), *candle.Open)
+	require.Equal(t, sdk.NewDec(110), *candle.High)
+	require.Equal(t, sdk.NewDec(95), *candle.Low)
+	require.Equal(t, sdk.NewDec(110), *candle.Close)
+	require.Equal(t, sdk.NewDec(15), *candle.Volume)
+}
+
+func TestUpdateCandlesWithPrices(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(60, 0))
+	keeper.UpdateCandlesWithPrices(ctx, keepertest.TestContract, keepertest.TestPair, sdk.NewDec(100), sdk.NewDec(110), sdk.NewDec(90), sdk.NewDec(95), sdk.NewDec(3))
+	keeper.UpdateCandlesWithPrices(ctx, keepertest.TestContract, keepertest.TestPair, sdk.NewDec(96), sdk.NewDec(105), sdk.NewDec(85), sdk.NewDec(101), sdk.NewDec(2))
+
+	candle, found := keeper.GetCandle(ctx, keepertest.TestContract, keepertest.TestPair, types.CandleIntervalOneMinute, 60)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(100), *candle.Open)
+	require.Equal(t, sdk.NewDec(110), *candle.High)
+	require.Equal(t, sdk.NewDec(85), *candle.Low)
+	require.Equal(t, sdk.NewDec(101), *candle.Close)
+	require.Equal(t, sdk.NewDec(5), *candle.Volume)
+}
+
+func TestUpdateCandlesPrunesOldCandles(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	interval := types.CandleIntervalOneMinute
+	ctx = ctx.WithBlockTime(time.Unix(int64(interval), 0))
+	keeper.UpdateCandles(ctx, keepertest.TestContract, keepertest.TestPair, sdk.NewDec(100), sdk.NewDec(1))
+	ctx = ctx.WithBlockTime(time.Unix(int64(interval*types.CandleRetentionCount), 0))
+	keeper.UpdateCandles(ctx, keepertest.TestContract, keepertest.TestPair, sdk.NewDec(100), sdk.NewDec(1))
+	_, found := keeper.GetCandle(ctx, keepertest.TestContract, keepertest.TestPair, interval, interval)
+	require.True(t, found)
+
+	ctx = ctx.WithBlockTime(time.Unix(int64(interval*(types.CandleRetentionCount+1)), 0))
+	keeper.UpdateCandles(ctx, keepertest.TestContract, keepertest.TestPair, sdk.NewDec(100), sdk.NewDec(1))
+	_, found = keeper.GetCandle(ctx, keepertest.TestContract, keepertest.TestPair, interval, interval)
+	require.False(t, found)
+	_, found = keeper.GetCandle(ctx, keepertest.TestContract, keepertest.TestPair, interval, interval*types.CandleRetentionCount)
+	require.True(t, found)
+}
+
+func TestGetCandlesPaginated(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	for _, timestamp := range []int64{0, 60, 120, 180, 240} {
+		ctx = ctx.WithBlockTime(time.Unix(timestamp, 0))
+		keeper.UpdateCandles(ctx, keepertest.TestContract, keepertest.TestPair, sdk.NewDec(100), sdk.NewDec(1))
+	}
+
+	candles, _, err := keeper.GetCandlesPaginated(ctx, keepertest.TestContract, keepertest.TestPair, types.CandleIntervalOneMinute, 60, 240, nil)
+	require.Nil(t, err)
+	require.Equal(t, 3, len(candles))
+	require.Equal(t, uint64(60), candles[0].BeginTimestamp)
+	require.Equal(t, uint64(180), candles[2].BeginTimestamp)
+
+	candles, pageRes, err := keeper.GetCandlesPaginated(ctx, keepertest.TestContract, keepertest.TestPair, types.CandleIntervalOneMinute, 60, 0, &query.PageRequest{Limit: 2})
+	require.Nil(t, err)
+	require.Equal(t, 2, len(candles))
+	require.Equal(t, uint64(60), candles[0].BeginTimestamp)
+	require.NotNil(t, pageRes.NextKey)
+
+	candles, _, err = keeper.GetCandlesPaginated(ctx, keepertest.TestContract, keepertest.TestPair, types.CandleIntervalOneMinute, 60, 0, &query.PageRequest{Key: pageRes.NextKey, Limit: 2})
+	require.Nil(t, err)
+	require.Equal(t, 2, len(candles))
+	require.Equal(t, uint64(180), candles[0].BeginTimestamp)
+	require.Equal(t, uint64(240), candles[1].BeginTimestamp)
+}
@@ -144,7 +144,10 @@ func (k Keeper) DoUnregisterContract(ctx sdk.Context, contract types.ContractInf
 	k.ClearDependenciesForContract(ctx, contract)
 	k.RemoveAllLongBooksForContract(ctx, contract.ContractAddr)
 	k.RemoveAllShortBooksForContract(ctx, contract.ContractAddr)
+	k.RemoveAllTriggeredOrdersForContract(ctx, contract.ContractAddr)
+	k.RemoveAllOrderExpiriesForContract(ctx, contract.ContractAddr)
 	k.RemoveAllPricesForContract(ctx, contract.ContractAddr)
+	k.RemoveAllCandlesForContract(ctx, contract.ContractAddr)
 	k.DeleteMatchResultState(ctx, contract.ContractAddr)
 	k.DeleteNextOrderID(ctx, contract.ContractAddr)
 	k.DeleteAllRegisteredPairsForContract(ctx, contract.ContractAddr)
@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// RecordFills indexes settlement entries of a contract by account and pair, and updates
+// the fill summaries of the settled orders. Nothing is recorded if fill retention is 0.
+func (k Keeper) RecordFills(ctx sdk.Context, contractAddr string, settlements []*types.SettlementEntry) {
+	if k.GetFillRetention(ctx) == 0 {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	for i, settlement := range settlements {
+		key := append(
+			types.AccountFillPairPrefix(settlement.Account, contractAddr, settlement.PriceDenom, settlement.AssetDenom),
+			getFillKey(settlement.Height, uint64(i))...,
+		)
+		store.Set(key, k.Cdc.MustMarshal(settlement))
+		summaryKey := k.addFillToOrderSummary(ctx, contractAddr, settlement)
+		// index fills by timestamp for pruning
+		store.Set(append(getFillTimestampPrefix(settlement.Timestamp), key...), summaryKey)
+	}
+}
+
+// returns the key of the updated summary
+func (k Keeper) addFillToOrderSummary(ctx sdk.Context, contractAddr string, settlement *types.SettlementEntry) []byte {
+	summary, found := k.GetOrderFillSummary(ctx, settlement.Account, contractAddr, settlement.PriceDenom, settlement.AssetDenom, settlement.OrderId)
+	if !found {
+		summary = types.OrderFillSummary{
+			OrderId:           settlement.OrderId,
+			Account:           settlement.Account,
+			ContractAddr:      contractAddr,
+			PriceDenom:        settlement.PriceDenom,
+			AssetDenom:        settlement.AssetDenom,
+			PositionDirection: settlement.PositionDirection,
+			OrderType:         settlement.OrderType,
+			FilledQuantity:    sdk.ZeroDec(),
+			FilledNotional:    sdk.ZeroDec(),
+			TotalFees:         sdk.ZeroDec(),
+			FirstFillHeight:   settlement.Height,
+		}
+	}
+	summary.FilledQuantity = summary.FilledQuantity.Add(settlement.Quantity)
+	summary.FilledNotional = summary.FilledNotional.Add(settlement.Quantity.Mul(settlement.ExecutionCostOrProceed))
+	summary.TotalFees = summary.TotalFees.Add(settlement.FeeOrZero())
+	summary.FillCount++
+	summary.LastFillHeight = settlement.Height
+	summary.LastFillTimestamp = settlement.Timestamp
+	key := append(
+		types.OrderFillSummaryPairPrefix(settlement.Account, contractAddr, settlement.PriceDenom, settlement.AssetDenom),
+		GetKeyForOrderID(settlement.OrderId)...,
+	)
+	ctx.KVStore(k.storeKey).Set(key, k.Cdc.MustMarshal(&summary))
+	return key
+}
+
+func (k Keeper) GetOrderFillSummary(ctx sdk.Context, account string, contractAddr string, priceDenom string, assetDenom string, orderID uint64) (types.OrderFillSummary, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OrderFillSummaryPairPrefix(account, contractAddr, priceDenom, assetDenom))
+	res := types.OrderFillSummary{}
+	b := store.Get(GetKeyForOrderID(orderID))
+	if b == nil {
+		return res, false
+	}
+	k.Cdc.MustUnmarshal(b, &res)
+	return res, true
+}
+
+// PruneFills removes fills older than the fill retention, along with summaries of orders
+// whose latest fill is removed. All fills are removed if fill retention is 0.
+func (k Keeper) PruneFills(ctx sdk.Context) {
+	now, retention := uint64(ctx.BlockTime().Unix()), k.GetFillRetention(ctx)
+	if retention >= now {
+		return
+	}
+	cutoff := now - retention
+	store := ctx.KVStore(k.storeKey)
+	timestampStore := prefix.NewStore(store, types.KeyPrefix(types.FillTimestampKey))
+	iterator := timestampStore.Iterator(nil, GetKeyForTs(cutoff))
+	indexKeys, summaryKeys := [][]byte{}, [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		indexKeys = append(indexKeys, iterator.Key())
+		summaryKeys = append(summaryKeys, iterator.Value())
+	}
+	iterator.Close()
+
+	for i, indexKey := range indexKeys {
+		timestampStore.Delete(indexKey)
+		// the rest of the index key is the key of the fill
+		store.Delete(indexKey[8:])
+		b := store.Get(summaryKeys[i])
+		if b == nil {
+			continue
+		}
+		var summary types.OrderFillSummary
+		k.Cdc.MustUnmarshal(b, &summary)
+		if summary.LastFillTimestamp < cutoff {
+			store.Delete(summaryKeys[i])
+		}
+	}
+}
+
+// GetAccountFillsPaginated returns fills of an account in a contract, ordered by pair and
+// then by block height. Only fills of the specified pair are returned if both denoms are set.
+func (k Keeper) GetAccountFillsPaginated(
+	ctx sdk.Context,
+	account string,
+	contractAddr string,
+	priceDenom string,
+	assetDenom string,
+	page *query.PageRequest,
+) (list []*types.SettlementEntry, pageRes *query.PageResponse, err error) {
+	prefixKey := types.AccountFillPrefix(account, contractAddr)
+	if priceDenom != "" && assetDenom != "" {
+		prefixKey = types.AccountFillPairPrefix(account, contractAddr, priceDenom, assetDenom)
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), prefixKey)
+
+	pageRes, err = query.Paginate(store, page, func(key []byte, value []byte) error {
+		var settlement types.SettlementEntry
+		if err := k.Cdc.Unmarshal(value, &settlement); err != nil {
+			return err
+		}
+		list = append(list, &settlement)
+		return nil
+	})
+
+	return
+}
+
+// GetOrderFillSummariesPaginated returns fill summaries of orders of an account in a contract,
+// ordered by pair and then by order ID. Only orders of the specified pair are returned if both
+// denoms are set.
+func (k Keeper) GetOrderFillSummariesPaginated(
+	ctx sdk.Context,
+	account string,
+	contractAddr string,
+	priceDenom string,
+	assetDenom string,
+	page *query.PageRequest,
+) (list []*types.OrderFillSummary, pageRes *query.PageResponse, err error) {
+	prefixKey := types.OrderFillSummaryPrefix(account, contractAddr)
+	if priceDenom != "" && assetDenom != "" {
+		prefixKey = types.OrderFillSummaryPairPrefix(account, contractAddr, priceDenom, assetDenom)
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), prefixKey)
+
+	pageRes, err = query.Paginate(store, page, func(key []byte, value []byte) error {
+		var summary types.OrderFillSummary
+		if err := k.Cdc.Unmarshal(value, &summary); err != nil {
+			return err
+		}
+		list = append(list, &summary)
+		return nil
+	})
+
+	return
+}
+
+func getFillKey(height uint64, index uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(height), sdk.Uint64ToBigEndian(index)...)
+}
+
+func getFillTimestampPrefix(timestamp uint64) []byte {
+	return append(types.KeyPrefix(types.FillTimestampKey), GetKeyForTs(timestamp)...)
+}
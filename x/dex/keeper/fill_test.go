@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func settleAt(ctx sdk.Context, orderID uint64, quantity int64, price int64) *types.SettlementEntry {
+	return types.NewSettlementEntry(
+		ctx,
+		orderID,
+		keepertest.TestAccount,
+		types.PositionDirection_LONG,
+		keepertest.TestPriceDenom,
+		keepertest.TestAssetDenom,
+		sdk.NewDec(quantity),
+		sdk.NewDec(price),
+		sdk.NewDec(price),
+		types.OrderType_LIMIT,
+	)
+}
+
+func TestRecordFillsDisabled(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	keeper.RecordFills(ctx, keepertest.TestContract, []*types.SettlementEntry{settleAt(ctx, 1, 1, 100)})
+	fills, _, err := keeper.GetAccountFillsPaginated(ctx, keepertest.TestAccount, keepertest.TestContract, "", "", nil)
+	require.Nil(t, err)
+	require.Empty(t, fills)
+}
+
+func TestRecordAndPruneFills(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	params := keeper.GetParams(ctx)
+	params.FillRetention = 100
+	keeper.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(time.Unix(1000, 0))
+	fee := sdk.MustNewDecFromStr("0.5")
+	first := settleAt(ctx, 1, 2, 100)
+	first.Fee = fee
+	keeper.RecordFills(ctx, keepertest.TestContract, []*types.SettlementEntry{first, settleAt(ctx, 2, 1, 100)})
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(time.Unix(1050, 0))
+	keeper.RecordFills(ctx, keepertest.TestContract, []*types.SettlementEntry{settleAt(ctx, 1, 3, 110)})
+
+	fills, _, err := keeper.GetAccountFillsPaginated(ctx, keepertest.TestAccount, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, nil)
+	require.Nil(t, err)
+	require.Equal(t, 3, len(fills))
+	require.Equal(t, uint64(1), fills[0].OrderId)
+	require.Equal(t, uint64(2), fills[1].OrderId)
+	require.Equal(t, uint64(2), fills[2].Height)
+
+	summary, found := keeper.GetOrderFillSummary(ctx, keepertest.TestAccount, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, 1)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(5), summary.FilledQuantity)
+	require.Equal(t, sdk.NewDec(530), summary.FilledNotional)
+	require.Equal(t, fee, summary.TotalFees)
+	require.Equal(t, uint64(2), summary.FillCount)
+	require.Equal(t, uint64(1), summary.FirstFillHeight)
+	require.Equal(t, uint64(2), summary.LastFillHeight)
+
+	// fills of the first block are pruned, as is the summary of order 2 which has no fills left
+	ctx = ctx.WithBlockTime(time.Unix(1101, 0))
+	keeper.PruneFills(ctx)
+	fills, _, err = keeper.GetAccountFillsPaginated(ctx, keepertest.TestAccount, keepertest.TestContract, "", "", nil)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(fills))
+	require.Equal(t, uint64(2), fills[0].Height)
+	summaries, _, err := keeper.GetOrderFillSummariesPaginated(ctx, keepertest.TestAccount, keepertest.TestContract, "", "", &query.PageRequest{CountTotal: true})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(summaries))
+	require.Equal(t, uint64(1), summaries[0].OrderId)
+
+	ctx = ctx.WithBlockTime(time.Unix(1151, 0))
+	keeper.PruneFills(ctx)
+	fills, _, err = keeper.GetAccountFillsPaginated(ctx, keepertest.TestAccount, keepertest.TestContract, "", "", nil)
+	require.Nil(t, err)
+	require.Empty(t, fills)
+	_, found = keeper.GetOrderFillSummary(ctx, keepertest.TestAccount, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, 1)
+	require.False(t, found)
+}
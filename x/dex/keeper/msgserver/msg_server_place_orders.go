@@ -58,6 +58,12 @@ func (k msgServer) PlaceOrders(goCtx context.Context, msg *types.MsgPlaceOrders)
 	idsInResp := []uint64{}
 	maxOrderPerPrice := k.GetMaxOrderPerPrice(ctx)
 	for _, order := range msg.GetOrders() {
+		if order.ExpiryHeight > 0 && order.ExpiryHeight <= uint64(ctx.BlockHeight()) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order expiry height %d is not after the current height %d", order.ExpiryHeight, ctx.BlockHeight())
+		}
+		if order.ExpiryTimestamp > 0 && order.ExpiryTimestamp <= uint64(ctx.BlockTime().Unix()) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order expiry timestamp %d is not after the current block time %d", order.ExpiryTimestamp, ctx.BlockTime().Unix())
+		}
 		if k.GetOrderCountState(ctx, msg.GetContractAddr(), order.PriceDenom, order.AssetDenom, order.PositionDirection, order.Price) >= maxOrderPerPrice {
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order book already has more than %d orders for %s-%s-%s %s at %s", maxOrderPerPrice, msg.GetContractAddr(), order.PriceDenom, order.AssetDenom, order.PositionDirection, order.Price)
 		}
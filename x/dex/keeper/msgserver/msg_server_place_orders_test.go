@@ -3,6 +3,7 @@ package msgserver_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
@@ -334,3 +335,47 @@ func TestPlaceOrderExceedingLimit(t *testing.T) {
 	_, err := server.PlaceOrders(wctx, msg)
 	require.NotNil(t, err)
 }
+
+func TestPlaceOrderWithExpiry(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+	keeper.AddRegisteredPair(ctx, TestContract, keepertest.TestPair)
+	keeper.SetPriceTickSizeForPair(ctx, TestContract, keepertest.TestPair, *keepertest.TestPair.PriceTicksize)
+	keeper.SetQuantityTickSizeForPair(ctx, TestContract, keepertest.TestPair, *keepertest.TestPair.QuantityTicksize)
+	wctx := sdk.WrapSDKContext(ctx)
+	server := msgserver.NewMsgServerImpl(*keeper)
+	for _, tc := range []struct {
+		expiryHeight    uint64
+		expiryTimestamp uint64
+		valid           bool
+	}{
+		{expiryHeight: 10, valid: false},
+		{expiryHeight: 11, valid: true},
+		{expiryTimestamp: 1000, valid: false},
+		{expiryTimestamp: 1001, valid: true},
+	} {
+		msg := &types.MsgPlaceOrders{
+			Creator:      TestCreator,
+			ContractAddr: TestContract,
+			Orders: []*types.Order{
+				{
+					Price:             sdk.MustNewDecFromStr("10"),
+					Quantity:          sdk.MustNewDecFromStr("10"),
+					Data:              "",
+					PositionDirection: types.PositionDirection_LONG,
+					OrderType:         types.OrderType_LIMIT,
+					PriceDenom:        keepertest.TestPriceDenom,
+					AssetDenom:        keepertest.TestAssetDenom,
+					ExpiryHeight:      tc.expiryHeight,
+					ExpiryTimestamp:   tc.expiryTimestamp,
+				},
+			},
+		}
+		_, err := server.PlaceOrders(wctx, msg)
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+var (
+	expiryHeightSubprefix    = []byte{0}
+	expiryTimestampSubprefix = []byte{1}
+)
+
+// SetOrderExpiry indexes a resting order by its expiry height and/or timestamp so
+// that it can be cancelled once the expiry is reached. Entries are keyed by expiry
+// first so that the orders due in a block can be found without going through every
+// pair of the contract.
+func (k Keeper) SetOrderExpiry(ctx sdk.Context, contractAddr string, order types.Order) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ContractKeyPrefix(types.OrderExpiryKey, contractAddr))
+	b := k.Cdc.MustMarshal(&order)
+	if order.ExpiryHeight > 0 {
+		store.Set(getOrderExpiryKey(expiryHeightSubprefix, order.ExpiryHeight, order), b)
+	}
+	if order.ExpiryTimestamp > 0 {
+		store.Set(getOrderExpiryKey(expiryTimestampSubprefix, order.ExpiryTimestamp, order), b)
+	}
+}
+
+func (k Keeper) RemoveOrderExpiry(ctx sdk.Context, contractAddr string, order types.Order) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ContractKeyPrefix(types.OrderExpiryKey, contractAddr))
+	if order.ExpiryHeight > 0 {
+		store.Delete(getOrderExpiryKey(expiryHeightSubprefix, order.ExpiryHeight, order))
+	}
+	if order.ExpiryTimestamp > 0 {
+		store.Delete(getOrderExpiryKey(expiryTimestampSubprefix, order.ExpiryTimestamp, order))
+	}
+}
+
+// GetExpiredOrders returns orders of a contract whose expiry height or timestamp has
+// been reached by the current block, ordered by order ID. Only the entries that are
+// due are iterated.
+func (k Keeper) GetExpiredOrders(ctx sdk.Context, contractAddr string) []types.Order {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ContractKeyPrefix(types.OrderExpiryKey, contractAddr))
+	expired := map[uint64]types.Order{}
+	collect := func(subprefix []byte, current uint64) {
+		iterator := prefix.NewStore(store, subprefix).Iterator(nil, sdk.Uint64ToBigEndian(current+1))
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			var val types.Order
+			k.Cdc.MustUnmarshal(iterator.Value(), &val)
+			expired[val.Id] = val
+		}
+	}
+	collect(expiryHeightSubprefix, uint64(ctx.BlockHeight()))
+	collect(expiryTimestampSubprefix, uint64(ctx.BlockTime().Unix()))
+	return sortedOrders(expired)
+}
+
+// GetAllExpiringOrders returns all indexed orders of a contract, ordered by order ID
+func (k Keeper) GetAllExpiringOrders(ctx sdk.Context, contractAddr string) []types.Order {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ContractKeyPrefix(types.OrderExpiryKey, contractAddr))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	orders := map[uint64]types.Order{}
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Order
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		orders[val.Id] = val
+	}
+
+	return sortedOrders(orders)
+}
+
+func (k Keeper) RemoveAllOrderExpiriesForContract(ctx sdk.Context, contractAddr string) {
+	k.removeAllForPrefix(ctx, types.ContractKeyPrefix(types.OrderExpiryKey, contractAddr))
+}
+
+// subprefix + expiry + price denom + asset denom + order ID
+func getOrderExpiryKey(subprefix []byte, expiry uint64, order types.Order) []byte {
+	key := append([]byte{}, subprefix...)
+	key = append(key, sdk.Uint64ToBigEndian(expiry)...)
+	key = append(key, types.PairPrefix(order.PriceDenom, order.AssetDenom)...)
+	return append(key, GetKeyForOrderID(order.Id)...)
+}
+
+func sortedOrders(orders map[uint64]types.Order) []types.Order {
+	res := make([]types.Order, 0, len(orders))
+	for _, order := range orders {
+		res = append(res, order)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Id < res[j].Id })
+	return res
+}
@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func createNExpiringOrders(n int) []types.Order {
+	items := make([]types.Order, n)
+	for i := range items {
+		items[i] = types.Order{
+			Id:                uint64(i),
+			Account:           keepertest.TestAccount,
+			ContractAddr:      keepertest.TestContract,
+			Price:             sdk.NewDec(int64(i + 1)),
+			Quantity:          sdk.NewDec(int64(i + 1)),
+			PriceDenom:        keepertest.TestPriceDenom,
+			AssetDenom:        keepertest.TestAssetDenom,
+			OrderType:         types.OrderType_LIMIT,
+			PositionDirection: types.PositionDirection_LONG,
+			Nominal:           sdk.ZeroDec(),
+			TriggerPrice:      sdk.ZeroDec(),
+		}
+		if i%2 == 0 {
+			items[i].ExpiryHeight = uint64(i + 1)
+		} else {
+			items[i].ExpiryTimestamp = uint64(i + 1)
+		}
+	}
+	return items
+}
+
+func TestGetExpiredOrders(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNExpiringOrders(10)
+	for _, item := range items {
+		keeper.SetOrderExpiry(ctx, keepertest.TestContract, item)
+	}
+	// orders expiring by height
+	ctx = ctx.WithBlockHeight(5).WithBlockTime(time.Unix(0, 0))
+	require.Equal(t, []types.Order{items[0], items[2], items[4]}, keeper.GetExpiredOrders(ctx, keepertest.TestContract))
+	// orders expiring by timestamp
+	ctx = ctx.WithBlockHeight(0).WithBlockTime(time.Unix(4, 0))
+	require.Equal(t, []types.Order{items[1], items[3]}, keeper.GetExpiredOrders(ctx, keepertest.TestContract))
+
+	// an order with both expiries should only be returned once
+	items[1].ExpiryHeight = 1
+	keeper.SetOrderExpiry(ctx, keepertest.TestContract, items[1])
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(time.Unix(2, 0))
+	require.Equal(t, []types.Order{items[0], items[1]}, keeper.GetExpiredOrders(ctx, keepertest.TestContract))
+
+	keeper.RemoveOrderExpiry(ctx, keepertest.TestContract, items[1])
+	require.Equal(t, []types.Order{items[0]}, keeper.GetExpiredOrders(ctx, keepertest.TestContract))
+
+	// orders of all pairs of the contract are returned, but not those of other contracts
+	otherPairOrder := items[9]
+	otherPairOrder.Id = 10
+	otherPairOrder.AssetDenom = "other"
+	otherPairOrder.ExpiryTimestamp = 1
+	keeper.SetOrderExpiry(ctx, keepertest.TestContract, otherPairOrder)
+	require.Equal(t, []types.Order{items[0], otherPairOrder}, keeper.GetExpiredOrders(ctx, keepertest.TestContract))
+	require.Empty(t, keeper.GetExpiredOrders(ctx, keepertest.TestContract2))
+}
+
+func TestGetAllExpiringOrders(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNExpiringOrders(10)
+	items[0].ExpiryTimestamp = 100
+	for _, item := range items {
+		keeper.SetOrderExpiry(ctx, keepertest.TestContract, item)
+	}
+	require.Equal(t, items, keeper.GetAllExpiringOrders(ctx, keepertest.TestContract))
+
+	keeper.RemoveAllOrderExpiriesForContract(ctx, keepertest.TestContract)
+	require.Empty(t, keeper.GetAllExpiringOrders(ctx, keepertest.TestContract))
+}
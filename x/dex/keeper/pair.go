@@ -54,3 +54,24 @@ func (k Keeper) GetAllRegisteredPairs(ctx sdk.Context, contractAddr string) []ty
 func (k Keeper) DeleteAllRegisteredPairsForContract(ctx sdk.Context, contractAddr string) {
 	k.removeAllForPrefix(ctx, types.RegisteredPairPrefix(contractAddr))
 }
+
+// contract_addr, pair -> fee rates
+func (k Keeper) SetFeeRatesForPair(ctx sdk.Context, contractAddr string, pair types.Pair, makerFeeRate *sdk.Dec, takerFeeRate *sdk.Dec) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RegisteredPairPrefix(contractAddr))
+
+	pair, found := k.GetRegisteredPair(ctx, contractAddr, pair.PriceDenom, pair.AssetDenom)
+	if !found {
+		return types.ErrPairNotRegistered
+	}
+	pair.MakerFeeRate = makerFeeRate
+	pair.TakerFeeRate = takerFeeRate
+	store.Set(types.PairPrefix(pair.PriceDenom, pair.AssetDenom), k.Cdc.MustMarshal(&pair))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetFeeRates,
+		sdk.NewAttribute(types.AttributeKeyContractAddress, contractAddr),
+		sdk.NewAttribute(types.AttributeKeyPriceDenom, pair.PriceDenom),
+		sdk.NewAttribute(types.AttributeKeyAssetDenom, pair.AssetDenom),
+	))
+	return nil
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
 	"github.com/sei-protocol/sei-chain/testutil/nullify"
 	"github.com/sei-protocol/sei-chain/x/dex/types"
@@ -39,3 +40,20 @@ func TestAddGetPair(t *testing.T) {
 	require.True(t, hasPair)
 
 }
+
+func TestSetFeeRatesForPair(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	makerFeeRate := sdk.MustNewDecFromStr("0.001")
+	takerFeeRate := sdk.MustNewDecFromStr("0.002")
+	err := keeper.SetFeeRatesForPair(ctx, keepertest.TestContract, keepertest.TestPair, &makerFeeRate, &takerFeeRate)
+	require.Equal(t, types.ErrPairNotRegistered, err)
+
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, keepertest.TestPair)
+	err = keeper.SetFeeRatesForPair(ctx, keepertest.TestContract, keepertest.TestPair, &makerFeeRate, &takerFeeRate)
+	require.NoError(t, err)
+	pair, found := keeper.GetRegisteredPair(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	require.True(t, found)
+	require.Equal(t, makerFeeRate, *pair.MakerFeeRate)
+	require.Equal(t, takerFeeRate, *pair.TakerFeeRate)
+	require.Equal(t, keepertest.TestTicksize, *pair.PriceTicksize)
+}
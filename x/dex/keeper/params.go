@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/sei-protocol/sei-chain/x/dex/types"
 )
 
@@ -36,6 +37,20 @@ func (k Keeper) GetMaxPairsPerContract(ctx sdk.Context) uint64 {
 	return k.GetParams(ctx).MaxPairsPerContract
 }
 
+// GetFeeCollectorAddress returns the account that trading fees are sent to
+func (k Keeper) GetFeeCollectorAddress(ctx sdk.Context) sdk.AccAddress {
+	if addr := k.GetParams(ctx).FeeCollectorAddress; addr != "" {
+		return sdk.MustAccAddressFromBech32(addr)
+	}
+	return k.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+}
+
+// GetFillRetention returns the number of seconds to retain account fills for. Fills
+// aren't recorded if it's 0.
+func (k Keeper) GetFillRetention(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).FillRetention
+}
+
 // SetParams set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.Paramstore.SetParamSet(ctx, &params)
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
 	"github.com/sei-protocol/sei-chain/x/dex/types"
 	"github.com/stretchr/testify/require"
@@ -22,3 +23,14 @@ func TestGetSettlementGasAllowance(t *testing.T) {
 	gasAllowance := k.GetSettlementGasAllowance(ctx, 10)
 	require.Equal(t, uint64(10)*types.DefaultGasAllowancePerSettlement, gasAllowance)
 }
+
+func TestGetFeeCollectorAddress(t *testing.T) {
+	k, ctx := testkeeper.DexKeeper(t)
+	params := types.DefaultParams()
+	k.SetParams(ctx, params)
+	require.Equal(t, k.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName), k.GetFeeCollectorAddress(ctx))
+
+	params.FeeCollectorAddress = testkeeper.TestAccount
+	k.SetParams(ctx, params)
+	require.Equal(t, testkeeper.TestAccount, k.GetFeeCollectorAddress(ctx).String())
+}
@@ -52,6 +52,22 @@ func (k Keeper) GetPriceState(ctx sdk.Context, contractAddr string, timestamp ui
 	return res, true
 }
 
+// GetLatestPrice returns the most recent price snapshot of a pair, which is the last traded price
+func (k Keeper) GetLatestPrice(ctx sdk.Context, contractAddr string, pair types.Pair) (types.Price, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PricePrefix(contractAddr, pair.PriceDenom, pair.AssetDenom))
+	iterator := sdk.KVStoreReversePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	res := types.Price{}
+	if !iterator.Valid() {
+		res.Pair = &pair
+		return res, false
+	}
+	k.Cdc.MustUnmarshal(iterator.Value(), &res)
+	return res, true
+}
+
 func (k Keeper) GetAllPrices(ctx sdk.Context, contractAddr string, pair types.Pair) (list []*types.Price) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PricePrefix(contractAddr, pair.PriceDenom, pair.AssetDenom))
 	iterator := sdk.KVStorePrefixIterator(store, []byte{})
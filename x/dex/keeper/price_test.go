@@ -18,3 +18,16 @@ func TestDeletePriceStateBefore(t *testing.T) {
 	require.Equal(t, uint64(2), prices[0].SnapshotTimestampInSeconds)
 	require.Equal(t, uint64(3), prices[1].SnapshotTimestampInSeconds)
 }
+
+func TestGetLatestPrice(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	_, found := keeper.GetLatestPrice(ctx, keepertest.TestContract, keepertest.TestPair)
+	require.False(t, found)
+	keepertest.SeedPriceSnapshot(ctx, keeper, "100", 1)
+	keepertest.SeedPriceSnapshot(ctx, keeper, "101", 3)
+	keepertest.SeedPriceSnapshot(ctx, keeper, "99", 2)
+	price, found := keeper.GetLatestPrice(ctx, keepertest.TestContract, keepertest.TestPair)
+	require.True(t, found)
+	require.Equal(t, uint64(3), price.SnapshotTimestampInSeconds)
+	require.Equal(t, "101.000000000000000000", price.Price.String())
+}
@@ -0,0 +1,27 @@
+package query
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k KeeperWrapper) GetAccountTrades(c context.Context, req *types.QueryGetAccountTradesRequest) (*types.QueryGetAccountTradesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Account); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	trades, pageRes, err := k.GetAccountFillsPaginated(ctx, req.Account, req.ContractAddr, req.PriceDenom, req.AssetDenom, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetAccountTradesResponse{Trades: trades, Pagination: pageRes}, nil
+}
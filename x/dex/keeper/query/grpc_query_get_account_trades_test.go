@@ -0,0 +1,61 @@
+package query_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAccountTradesAndOrderHistory(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	params := keeper.GetParams(ctx)
+	params.FillRetention = 100
+	keeper.SetParams(ctx, params)
+	settlements := []*types.SettlementEntry{}
+	for _, pair := range []types.Pair{keepertest.TestPair, {PriceDenom: "usdc", AssetDenom: "sei"}} {
+		settlements = append(settlements, types.NewSettlementEntry(
+			ctx, 1, keepertest.TestAccount, types.PositionDirection_SHORT, pair.PriceDenom, pair.AssetDenom,
+			sdk.NewDec(2), sdk.NewDec(10), sdk.NewDec(10), types.OrderType_MARKET,
+		))
+	}
+	keeper.RecordFills(ctx, keepertest.TestContract, settlements)
+
+	wctx := sdk.WrapSDKContext(ctx)
+	wrapper := query.KeeperWrapper{Keeper: keeper}
+	trades, err := wrapper.GetAccountTrades(wctx, &types.QueryGetAccountTradesRequest{
+		ContractAddr: keepertest.TestContract,
+		Account:      keepertest.TestAccount,
+	})
+	require.Nil(t, err)
+	require.Equal(t, 2, len(trades.Trades))
+	trades, err = wrapper.GetAccountTrades(wctx, &types.QueryGetAccountTradesRequest{
+		ContractAddr: keepertest.TestContract,
+		Account:      keepertest.TestAccount,
+		PriceDenom:   "usdc",
+		AssetDenom:   "sei",
+	})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(trades.Trades))
+	require.Equal(t, "sei", trades.Trades[0].AssetDenom)
+
+	history, err := wrapper.GetOrderHistory(wctx, &types.QueryGetOrderHistoryRequest{
+		ContractAddr: keepertest.TestContract,
+		Account:      keepertest.TestAccount,
+		PriceDenom:   keepertest.TestPriceDenom,
+		AssetDenom:   keepertest.TestAssetDenom,
+	})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(history.Orders))
+	require.Equal(t, sdk.NewDec(2), history.Orders[0].FilledQuantity)
+	require.Equal(t, sdk.NewDec(20), history.Orders[0].FilledNotional)
+
+	_, err = wrapper.GetAccountTrades(wctx, &types.QueryGetAccountTradesRequest{
+		ContractAddr: keepertest.TestContract,
+		Account:      "invalid",
+	})
+	require.NotNil(t, err)
+}
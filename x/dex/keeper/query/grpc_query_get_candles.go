@@ -0,0 +1,39 @@
+package query
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k KeeperWrapper) GetCandles(goCtx context.Context, req *types.QueryGetCandlesRequest) (*types.QueryGetCandlesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := types.ValidateCandleInterval(req.IntervalInSeconds); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.EndTimestamp > 0 && req.EndTimestamp <= req.StartTimestamp {
+		return nil, status.Error(codes.InvalidArgument, "end timestamp must be after start timestamp")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	candles, pageRes, err := k.GetCandlesPaginated(
+		ctx,
+		req.ContractAddr,
+		types.Pair{PriceDenom: req.PriceDenom, AssetDenom: req.AssetDenom},
+		req.IntervalInSeconds,
+		req.StartTimestamp,
+		req.EndTimestamp,
+		req.Pagination,
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetCandlesResponse{Candles: candles, Pagination: pageRes}, nil
+}
@@ -0,0 +1,56 @@
+package query_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCandles(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	for _, timestamp := range []int64{3600, 3660, 7200} {
+		ctx = ctx.WithBlockTime(time.Unix(timestamp, 0))
+		keeper.UpdateCandles(ctx, keepertest.TestContract, keepertest.TestPair, sdk.NewDec(timestamp/36), sdk.NewDec(2))
+	}
+
+	wctx := sdk.WrapSDKContext(ctx)
+	wrapper := query.KeeperWrapper{Keeper: keeper}
+	resp, err := wrapper.GetCandles(wctx, &types.QueryGetCandlesRequest{
+		ContractAddr:      keepertest.TestContract,
+		PriceDenom:        keepertest.TestPair.PriceDenom,
+		AssetDenom:        keepertest.TestPair.AssetDenom,
+		IntervalInSeconds: types.CandleIntervalOneHour,
+	})
+	require.Nil(t, err)
+	require.Equal(t, 2, len(resp.Candles))
+	require.Equal(t, uint64(3600), resp.Candles[0].BeginTimestamp)
+	require.Equal(t, sdk.NewDec(100), *resp.Candles[0].Open)
+	require.Equal(t, sdk.NewDec(101), *resp.Candles[0].Close)
+	require.Equal(t, sdk.NewDec(4), *resp.Candles[0].Volume)
+	require.Equal(t, uint64(7200), resp.Candles[1].BeginTimestamp)
+
+	resp, err = wrapper.GetCandles(wctx, &types.QueryGetCandlesRequest{
+		ContractAddr:      keepertest.TestContract,
+		PriceDenom:        keepertest.TestPair.PriceDenom,
+		AssetDenom:        keepertest.TestPair.AssetDenom,
+		IntervalInSeconds: types.CandleIntervalOneMinute,
+		StartTimestamp:    3660,
+		EndTimestamp:      7200,
+	})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(resp.Candles))
+	require.Equal(t, uint64(3660), resp.Candles[0].BeginTimestamp)
+
+	_, err = wrapper.GetCandles(wctx, &types.QueryGetCandlesRequest{
+		ContractAddr:      keepertest.TestContract,
+		PriceDenom:        keepertest.TestPair.PriceDenom,
+		AssetDenom:        keepertest.TestPair.AssetDenom,
+		IntervalInSeconds: 120,
+	})
+	require.NotNil(t, err)
+}
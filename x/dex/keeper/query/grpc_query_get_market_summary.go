@@ -22,10 +22,14 @@ func (k KeeperWrapper) GetMarketSummary(goCtx context.Context, req *types.QueryG
 	minPrice := sdk.ZeroDec()
 	latestTimestamp := 0
 	lastPrice := sdk.ZeroDec()
+	totalFees := sdk.ZeroDec()
 	for _, price := range prices {
 		if price.SnapshotTimestampInSeconds < uint64(cutoff) {
 			continue
 		}
+		if price.TotalFees != nil {
+			totalFees = totalFees.Add(*price.TotalFees)
+		}
 		if maxPrice.IsZero() || price.Price.GT(maxPrice) {
 			maxPrice = price.Price
 		}
@@ -45,5 +49,6 @@ func (k KeeperWrapper) GetMarketSummary(goCtx context.Context, req *types.QueryG
 		HighPrice:           &maxPrice,
 		LowPrice:            &minPrice,
 		LastPrice:           &lastPrice,
+		TotalFees:           &totalFees,
 	}, nil
 }
@@ -31,3 +31,29 @@ func TestGetMarketSummary(t *testing.T) {
 	require.Equal(t, sdk.MustNewDecFromStr("99"), *resp.LastPrice)
 	require.Equal(t, sdk.MustNewDecFromStr("101"), *resp.HighPrice)
 }
+
+func TestGetMarketSummaryTotalFees(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	for timestamp, fee := range map[uint64]string{1: "1.5", 2: "2", 3: "0.5"} {
+		fee := sdk.MustNewDecFromStr(fee)
+		keeper.SetPriceState(ctx, types.Price{
+			SnapshotTimestampInSeconds: timestamp,
+			Price:                      sdk.NewDec(100),
+			Pair:                       &keepertest.TestPair,
+			TotalFees:                  &fee,
+		}, keepertest.TestContract)
+	}
+	keepertest.SeedPriceSnapshot(ctx, keeper, "100", 4)
+
+	ctx = ctx.WithBlockTime(time.Unix(4, 0))
+	wctx := sdk.WrapSDKContext(ctx)
+	wrapper := query.KeeperWrapper{Keeper: keeper}
+	resp, err := wrapper.GetMarketSummary(wctx, &types.QueryGetMarketSummaryRequest{
+		ContractAddr:      keepertest.TestContract,
+		PriceDenom:        keepertest.TestPair.PriceDenom,
+		AssetDenom:        keepertest.TestPair.AssetDenom,
+		LookbackInSeconds: 2,
+	})
+	require.Nil(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("2.5"), *resp.TotalFees)
+}
@@ -0,0 +1,86 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const MaxOrderBookDepth = 100
+
+func (k KeeperWrapper) GetOrderBookDepth(c context.Context, req *types.QueryGetOrderBookDepthRequest) (*types.QueryGetOrderBookDepthResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Depth == 0 || req.Depth > MaxOrderBookDepth {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("depth must be between 1 and %d", MaxOrderBookDepth))
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	pair := types.Pair{PriceDenom: req.PriceDenom, AssetDenom: req.AssetDenom}
+
+	bidPrice, askPrice := func(price sdk.Dec) sdk.Dec { return price }, func(price sdk.Dec) sdk.Dec { return price }
+	if req.TickSizeMultiplier > 0 {
+		tickSize, found := k.GetPriceTickSizeForPair(ctx, req.ContractAddr, pair)
+		if !found {
+			return nil, types.ErrPairNotRegistered
+		}
+		bucketSize := tickSize.MulInt64(int64(req.TickSizeMultiplier))
+		// bids are rounded down and asks are rounded up, so that buckets never cross
+		bidPrice = func(price sdk.Dec) sdk.Dec { return price.QuoTruncate(bucketSize).TruncateDec().Mul(bucketSize) }
+		askPrice = func(price sdk.Dec) sdk.Dec { return price.QuoRoundUp(bucketSize).Ceil().Mul(bucketSize) }
+	}
+
+	depth := int(req.Depth)
+	bids := getOrderBookLevels(func(startExclusive *sdk.Dec) []types.OrderBookEntry {
+		if startExclusive == nil {
+			return k.GetTopNLongBooksForPair(ctx, req.ContractAddr, req.PriceDenom, req.AssetDenom, depth)
+		}
+		return k.GetTopNLongBooksForPairStarting(ctx, req.ContractAddr, req.PriceDenom, req.AssetDenom, depth, *startExclusive)
+	}, depth, bidPrice)
+	asks := getOrderBookLevels(func(startExclusive *sdk.Dec) []types.OrderBookEntry {
+		if startExclusive == nil {
+			return k.GetTopNShortBooksForPair(ctx, req.ContractAddr, req.PriceDenom, req.AssetDenom, depth)
+		}
+		return k.GetTopNShortBooksForPairStarting(ctx, req.ContractAddr, req.PriceDenom, req.AssetDenom, depth, *startExclusive)
+	}, depth, askPrice)
+
+	return &types.QueryGetOrderBookDepthResponse{Bids: bids, Asks: asks}, nil
+}
+
+// Aggregates order book entries, loaded `depth` at a time from the top of the book, into
+// (up to) `depth` price levels. Entries whose prices map to the same level price are summed.
+func getOrderBookLevels(
+	load func(startExclusive *sdk.Dec) []types.OrderBookEntry,
+	depth int,
+	levelPrice func(sdk.Dec) sdk.Dec,
+) []types.OrderBookLevel {
+	levels := []types.OrderBookLevel{}
+	var lastPrice *sdk.Dec
+	for {
+		entries := load(lastPrice)
+		for _, entry := range entries {
+			price := entry.GetPrice()
+			lastPrice = &price
+			quantity := entry.GetOrderEntry().Quantity
+			if quantity.IsZero() {
+				continue
+			}
+			bucket := levelPrice(price)
+			if len(levels) > 0 && levels[len(levels)-1].Price.Equal(bucket) {
+				levels[len(levels)-1].Quantity = levels[len(levels)-1].Quantity.Add(quantity)
+				continue
+			}
+			if len(levels) == depth {
+				return levels
+			}
+			levels = append(levels, types.OrderBookLevel{Price: bucket, Quantity: quantity})
+		}
+		if len(entries) < depth {
+			return levels
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package query_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOrderBookDepth(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	tickSize := sdk.MustNewDecFromStr("0.5")
+	pair := types.Pair{PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom, PriceTicksize: &tickSize}
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, pair)
+	entry := func(price string, quantity int64) *types.OrderEntry {
+		return &types.OrderEntry{
+			Price:      sdk.MustNewDecFromStr(price),
+			Quantity:   sdk.NewDec(quantity),
+			PriceDenom: pair.PriceDenom,
+			AssetDenom: pair.AssetDenom,
+		}
+	}
+	for _, e := range []*types.OrderEntry{entry("99.5", 1), entry("99", 2), entry("98", 3), entry("97.5", 4), entry("96", 0)} {
+		keeper.SetLongBook(ctx, keepertest.TestContract, types.LongBook{Price: e.Price, Entry: e})
+	}
+	for _, e := range []*types.OrderEntry{entry("100", 5), entry("100.5", 6), entry("101.5", 7)} {
+		keeper.SetShortBook(ctx, keepertest.TestContract, types.ShortBook{Price: e.Price, Entry: e})
+	}
+	wctx := sdk.WrapSDKContext(ctx)
+	wrapper := query.KeeperWrapper{Keeper: keeper}
+
+	resp, err := wrapper.GetOrderBookDepth(wctx, &types.QueryGetOrderBookDepthRequest{
+		ContractAddr: keepertest.TestContract,
+		PriceDenom:   pair.PriceDenom,
+		AssetDenom:   pair.AssetDenom,
+		Depth:        2,
+	})
+	require.Nil(t, err)
+	require.Equal(t, []types.OrderBookLevel{
+		{Price: sdk.MustNewDecFromStr("99.5"), Quantity: sdk.NewDec(1)},
+		{Price: sdk.NewDec(99), Quantity: sdk.NewDec(2)},
+	}, resp.Bids)
+	require.Equal(t, []types.OrderBookLevel{
+		{Price: sdk.NewDec(100), Quantity: sdk.NewDec(5)},
+		{Price: sdk.MustNewDecFromStr("100.5"), Quantity: sdk.NewDec(6)},
+	}, resp.Asks)
+
+	// bucketed by 1 (2 ticks), with more levels requested than there are in the order book
+	resp, err = wrapper.GetOrderBookDepth(wctx, &types.QueryGetOrderBookDepthRequest{
+		ContractAddr:       keepertest.TestContract,
+		PriceDenom:         pair.PriceDenom,
+		AssetDenom:         pair.AssetDenom,
+		Depth:              5,
+		TickSizeMultiplier: 2,
+	})
+	require.Nil(t, err)
+	require.Equal(t, []types.OrderBookLevel{
+		{Price: sdk.NewDec(99), Quantity: sdk.NewDec(3)},
+		{Price: sdk.NewDec(98), Quantity: sdk.NewDec(3)},
+		{Price: sdk.NewDec(97), Quantity: sdk.NewDec(4)},
+	}, resp.Bids)
+	require.Equal(t, []types.OrderBookLevel{
+		{Price: sdk.NewDec(100), Quantity: sdk.NewDec(5)},
+		{Price: sdk.NewDec(101), Quantity: sdk.NewDec(6)},
+		{Price: sdk.NewDec(102), Quantity: sdk.NewDec(7)},
+	}, resp.Asks)
+
+	// entries beyond the first `depth` ones are loaded when they fall into the same buckets
+	resp, err = wrapper.GetOrderBookDepth(wctx, &types.QueryGetOrderBookDepthRequest{
+		ContractAddr:       keepertest.TestContract,
+		PriceDenom:         pair.PriceDenom,
+		AssetDenom:         pair.AssetDenom,
+		Depth:              2,
+		TickSizeMultiplier: 2,
+	})
+	require.Nil(t, err)
+	require.Equal(t, []types.OrderBookLevel{
+		{Price: sdk.NewDec(99), Quantity: sdk.NewDec(3)},
+		{Price: sdk.NewDec(98), Quantity: sdk.NewDec(3)},
+	}, resp.Bids)
+
+	_, err = wrapper.GetOrderBookDepth(wctx, &types.QueryGetOrderBookDepthRequest{
+		ContractAddr: keepertest.TestContract,
+		PriceDenom:   pair.PriceDenom,
+		AssetDenom:   pair.AssetDenom,
+	})
+	require.NotNil(t, err)
+}
@@ -0,0 +1,27 @@
+package query
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k KeeperWrapper) GetOrderHistory(c context.Context, req *types.QueryGetOrderHistoryRequest) (*types.QueryGetOrderHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Account); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	orders, pageRes, err := k.GetOrderFillSummariesPaginated(ctx, req.Account, req.ContractAddr, req.PriceDenom, req.AssetDenom, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetOrderHistoryResponse{Orders: orders, Pagination: pageRes}, nil
+}
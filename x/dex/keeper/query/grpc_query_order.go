@@ -59,6 +59,11 @@ func (k KeeperWrapper) GetOrder(c context.Context, req *types.QueryGetOrderByIDR
 		}
 	}
 
+	if triggeredOrder, found := k.GetTriggeredOrderByID(ctx, req.ContractAddr, req.Id, req.PriceDenom, req.AssetDenom); found {
+		triggeredOrder.Status = types.OrderStatus_PLACED
+		return &types.QueryGetOrderByIDResponse{Order: &triggeredOrder}, nil
+	}
+
 	return &types.QueryGetOrderByIDResponse{}, types.ErrInvalidOrderID
 }
 
@@ -108,5 +113,13 @@ func (k KeeperWrapper) GetOrders(c context.Context, req *types.QueryGetOrdersReq
 		}
 	}
 
+	for _, triggeredOrder := range k.GetAllTriggeredOrders(ctx, req.ContractAddr) {
+		if triggeredOrder.Account == req.Account {
+			order := triggeredOrder
+			order.Status = types.OrderStatus_PLACED
+			orders = append(orders, &order)
+		}
+	}
+
 	return &types.QueryGetOrdersResponse{Orders: orders}, nil
 }
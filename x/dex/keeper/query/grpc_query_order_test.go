@@ -73,3 +73,50 @@ func TestGetOrders(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, 1, len(resp.Orders))
 }
+
+func TestGetTriggeredOrders(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wrapper := query.KeeperWrapper{Keeper: keeper}
+	wctx := sdk.WrapSDKContext(ctx)
+	keeper.SetTriggeredOrder(ctx, keepertest.TestContract, types.Order{
+		Id:                2,
+		Account:           keepertest.TestAccount,
+		ContractAddr:      keepertest.TestContract,
+		Price:             sdk.ZeroDec(),
+		Quantity:          sdk.MustNewDecFromStr("2"),
+		PriceDenom:        keepertest.TestPriceDenom,
+		AssetDenom:        keepertest.TestAssetDenom,
+		OrderType:         types.OrderType_STOPLOSS,
+		PositionDirection: types.PositionDirection_SHORT,
+		TriggerPrice:      sdk.MustNewDecFromStr("10"),
+	})
+
+	resp, err := wrapper.GetOrders(wctx, &types.QueryGetOrdersRequest{
+		ContractAddr: keepertest.TestContract,
+		Account:      keepertest.TestAccount,
+	})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(resp.Orders))
+	require.Equal(t, types.OrderType_STOPLOSS, resp.Orders[0].OrderType)
+	require.Equal(t, sdk.MustNewDecFromStr("10"), resp.Orders[0].TriggerPrice)
+
+	orderResp, err := wrapper.GetOrder(wctx, &types.QueryGetOrderByIDRequest{
+		ContractAddr: keepertest.TestContract,
+		PriceDenom:   keepertest.TestPriceDenom,
+		AssetDenom:   keepertest.TestAssetDenom,
+		Id:           2,
+	})
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), orderResp.Order.Id)
+	require.Equal(t, types.OrderStatus_PLACED, orderResp.Order.Status)
+	require.False(t, orderResp.Order.TriggerStatus)
+
+	// stop orders are looked up in the trigger book of the requested pair
+	_, err = wrapper.GetOrder(wctx, &types.QueryGetOrderByIDRequest{
+		ContractAddr: keepertest.TestContract,
+		PriceDenom:   keepertest.TestAssetDenom,
+		AssetDenom:   keepertest.TestPriceDenom,
+		Id:           2,
+	})
+	require.Equal(t, types.ErrInvalidOrderID, err)
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// GetStreamEvents returns, for every pair matched in the current block, the fills and
+// cancellations of the block along with the post-block quantities of the price levels
+// they touched. It should be called after the dex EndBlocker.
+func (k Keeper) GetStreamEvents(ctx sdk.Context) []*types.StreamEvent {
+	events := []*types.StreamEvent{}
+	for _, contractInfo := range k.GetAllContractInfo(ctx) {
+		result, _ := k.GetMatchResultState(ctx, contractInfo.ContractAddr)
+		if result.Height != ctx.BlockHeight() {
+			continue
+		}
+		events = append(events, k.getStreamEventsForMatchResult(ctx, result)...)
+	}
+	return events
+}
+
+type bookLevel struct {
+	direction types.PositionDirection
+	price     sdk.Dec
+}
+
+func (k Keeper) getStreamEventsForMatchResult(ctx sdk.Context, result *types.MatchResult) []*types.StreamEvent {
+	events := []*types.StreamEvent{}
+	for _, pair := range k.GetAllRegisteredPairs(ctx, result.ContractAddr) {
+		event := &types.StreamEvent{
+			Height:       result.Height,
+			ContractAddr: result.ContractAddr,
+			PriceDenom:   pair.PriceDenom,
+			AssetDenom:   pair.AssetDenom,
+		}
+		levels := []bookLevel{}
+		seen := map[string]struct{}{}
+		touch := func(direction types.PositionDirection, price sdk.Dec) {
+			key := direction.String() + price.String()
+			if _, ok := seen[key]; ok {
+				return
+			}
+			seen[key] = struct{}{}
+			levels = append(levels, bookLevel{direction: direction, price: price})
+		}
+		for _, order := range result.Orders {
+			if order.PriceDenom == pair.PriceDenom && order.AssetDenom == pair.AssetDenom && order.OrderType == types.OrderType_LIMIT {
+				touch(order.PositionDirection, order.Price)
+			}
+		}
+		for _, settlement := range result.Settlements {
+			if settlement.PriceDenom == pair.PriceDenom && settlement.AssetDenom == pair.AssetDenom {
+				event.Fills = append(event.Fills, settlement)
+				direction, err := types.GetPositionDirectionFromStr(settlement.PositionDirection)
+				if err == nil && settlement.OrderType == types.GetContractOrderType(types.OrderType_LIMIT) {
+					touch(direction, settlement.ExecutionCostOrProceed)
+				}
+			}
+		}
+		for _, cancellation := range result.Cancellations {
+			if cancellation.PriceDenom == pair.PriceDenom && cancellation.AssetDenom == pair.AssetDenom {
+				event.Cancellations = append(event.Cancellations, cancellation)
+				touch(cancellation.PositionDirection, cancellation.Price)
+			}
+		}
+		if len(levels) == 0 && len(event.Fills) == 0 {
+			continue
+		}
+		for _, level := range levels {
+			event.BookUpdates = append(event.BookUpdates, &types.BookLevelUpdate{
+				Direction: level.direction,
+				Price:     level.price,
+				Quantity:  k.getBookLevelQuantity(ctx, result.ContractAddr, pair, level),
+			})
+		}
+		events = append(events, event)
+	}
+	return events
+}
+
+func (k Keeper) getBookLevelQuantity(ctx sdk.Context, contractAddr string, pair types.Pair, level bookLevel) sdk.Dec {
+	var entry types.OrderBookEntry
+	var found bool
+	if level.direction == types.PositionDirection_LONG {
+		entry, found = k.GetLongOrderBookEntryByPrice(ctx, contractAddr, level.price, pair.PriceDenom, pair.AssetDenom)
+	} else {
+		entry, found = k.GetShortOrderBookEntryByPrice(ctx, contractAddr, level.price, pair.PriceDenom, pair.AssetDenom)
+	}
+	if !found {
+		return sdk.ZeroDec()
+	}
+	return entry.GetOrderEntry().Quantity
+}
@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetStreamEvents(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(5)
+	pair := types.Pair{PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom}
+	require.Nil(t, keeper.SetContract(ctx, &types.ContractInfoV2{ContractAddr: keepertest.TestContract}))
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, pair)
+	keeper.SetLongBook(ctx, keepertest.TestContract, types.LongBook{
+		Price: sdk.NewDec(99),
+		Entry: &types.OrderEntry{Price: sdk.NewDec(99), Quantity: sdk.NewDec(3), PriceDenom: pair.PriceDenom, AssetDenom: pair.AssetDenom},
+	})
+
+	// no match result at the current height
+	require.Empty(t, keeper.GetStreamEvents(ctx))
+
+	orders := []*types.Order{{
+		Id:                1,
+		PositionDirection: types.PositionDirection_LONG,
+		OrderType:         types.OrderType_LIMIT,
+		Price:             sdk.NewDec(99),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+	}}
+	cancellations := []*types.Cancellation{{
+		Id:                2,
+		Creator:           keepertest.TestAccount,
+		PositionDirection: types.PositionDirection_SHORT,
+		Price:             sdk.NewDec(101),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+	}}
+	settlements := []*types.SettlementEntry{settleAt(ctx, 3, 1, 99)}
+	keeper.SetMatchResult(ctx, keepertest.TestContract, types.NewMatchResult(orders, cancellations, settlements))
+
+	events := keeper.GetStreamEvents(ctx)
+	require.Equal(t, 1, len(events))
+	require.Equal(t, int64(5), events[0].Height)
+	require.Equal(t, keepertest.TestContract, events[0].ContractAddr)
+	require.Equal(t, settlements, events[0].Fills)
+	require.Equal(t, cancellations, events[0].Cancellations)
+	// the order and the fill are at the same level
+	require.Equal(t, []*types.BookLevelUpdate{
+		{Direction: types.PositionDirection_LONG, Price: sdk.NewDec(99), Quantity: sdk.NewDec(3)},
+		{Direction: types.PositionDirection_SHORT, Price: sdk.NewDec(101), Quantity: sdk.ZeroDec()},
+	}, events[0].BookUpdates)
+}
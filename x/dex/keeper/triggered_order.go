@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// SetTriggeredOrder stores a stop order in the trigger book of its pair. The
+// same store holds both untriggered orders and orders whose trigger price has
+// been crossed but which have not been matched yet (TriggerStatus = true).
+func (k Keeper) SetTriggeredOrder(ctx sdk.Context, contractAddr string, order types.Order) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.TriggerOrderBookPrefix(contractAddr, order.PriceDenom, order.AssetDenom),
+	)
+	b := k.Cdc.MustMarshal(&order)
+	store.Set(GetKeyForOrderID(order.Id), b)
+}
+
+func (k Keeper) GetTriggeredOrderByID(ctx sdk.Context, contractAddr string, orderID uint64, priceDenom string, assetDenom string) (val types.Order, found bool) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.TriggerOrderBookPrefix(contractAddr, priceDenom, assetDenom),
+	)
+	b := store.Get(GetKeyForOrderID(orderID))
+	if b == nil {
+		return val, false
+	}
+	k.Cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+func (k Keeper) RemoveTriggeredOrder(ctx sdk.Context, contractAddr string, orderID uint64, priceDenom string, assetDenom string) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.TriggerOrderBookPrefix(contractAddr, priceDenom, assetDenom),
+	)
+	store.Delete(GetKeyForOrderID(orderID))
+}
+
+// GetAllTriggeredOrdersForPair returns all stop orders of a pair, ordered by order ID
+func (k Keeper) GetAllTriggeredOrdersForPair(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string) (list []types.Order) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TriggerOrderBookPrefix(contractAddr, priceDenom, assetDenom))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Order
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllTriggeredOrders returns all stop orders of a contract
+func (k Keeper) GetAllTriggeredOrders(ctx sdk.Context, contractAddr string) (list []types.Order) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ContractKeyPrefix(types.TriggerBookKey, contractAddr))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Order
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func (k Keeper) RemoveAllTriggeredOrdersForContract(ctx sdk.Context, contractAddr string) {
+	k.removeAllForPrefix(ctx, types.ContractKeyPrefix(types.TriggerBookKey, contractAddr))
+}
+
+func GetKeyForOrderID(orderID uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, orderID)
+	return key
+}
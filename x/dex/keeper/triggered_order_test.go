@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func createNTriggeredOrders(n int) []types.Order {
+	items := make([]types.Order, n)
+	for i := range items {
+		items[i] = types.Order{
+			Id:                uint64(i),
+			Account:           keepertest.TestAccount,
+			ContractAddr:      keepertest.TestContract,
+			Price:             sdk.NewDec(int64(i)),
+			Quantity:          sdk.NewDec(int64(i + 1)),
+			PriceDenom:        keepertest.TestPriceDenom,
+			AssetDenom:        keepertest.TestAssetDenom,
+			OrderType:         types.OrderType_STOPLIMIT,
+			PositionDirection: types.PositionDirection_LONG,
+			TriggerPrice:      sdk.NewDec(int64(i + 1)),
+			Nominal:           sdk.ZeroDec(),
+		}
+	}
+	return items
+}
+
+func TestTriggeredOrderGetRemove(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNTriggeredOrders(10)
+	for _, item := range items {
+		keeper.SetTriggeredOrder(ctx, keepertest.TestContract, item)
+	}
+	for _, item := range items {
+		got, found := keeper.GetTriggeredOrderByID(ctx, keepertest.TestContract, item.Id, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+		require.True(t, found)
+		require.Equal(t, item, got)
+	}
+	_, found := keeper.GetTriggeredOrderByID(ctx, keepertest.TestContract, 1, keepertest.TestPriceDenom, "other")
+	require.False(t, found)
+
+	keeper.RemoveTriggeredOrder(ctx, keepertest.TestContract, 1, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	_, found = keeper.GetTriggeredOrderByID(ctx, keepertest.TestContract, 1, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	require.False(t, found)
+	require.Equal(t, 9, len(keeper.GetAllTriggeredOrdersForPair(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)))
+}
+
+func TestTriggeredOrderGetAll(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNTriggeredOrders(10)
+	for _, item := range items {
+		keeper.SetTriggeredOrder(ctx, keepertest.TestContract, item)
+	}
+	otherPairOrder := items[0]
+	otherPairOrder.Id = 10
+	otherPairOrder.AssetDenom = "other"
+	keeper.SetTriggeredOrder(ctx, keepertest.TestContract, otherPairOrder)
+
+	require.Equal(t, items, keeper.GetAllTriggeredOrdersForPair(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom))
+	require.ElementsMatch(t, append(items, otherPairOrder), keeper.GetAllTriggeredOrders(ctx, keepertest.TestContract))
+
+	keeper.RemoveAllTriggeredOrdersForContract(ctx, keepertest.TestContract)
+	require.Empty(t, keeper.GetAllTriggeredOrders(ctx, keepertest.TestContract))
+}
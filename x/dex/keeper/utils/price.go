@@ -24,5 +24,50 @@ func SetPriceStateFromExecutionOutcome(
 		Price:                      avgPrice,
 		SnapshotTimestampInSeconds: uint64(ctx.BlockTime().Unix()),
 	}
+	// keep fees of earlier blocks with the same timestamp
+	if existing, found := keeper.GetPriceState(ctx, string(contractAddr), priceState.SnapshotTimestampInSeconds, pair); found {
+		priceState.TotalFees = existing.TotalFees
+	}
 	keeper.SetPriceState(ctx, priceState, string(contractAddr))
 }
+
+// AddFeesToPriceState adds fees charged in the current block to the price snapshot of the
+// current timestamp. It should be called once per block after all matching is done.
+func AddFeesToPriceState(
+	ctx sdk.Context,
+	keeper *keeper.Keeper,
+	contractAddr types.ContractAddress,
+	pair types.Pair,
+	outcome exchange.ExecutionOutcome,
+) {
+	fees := outcome.TotalFees()
+	if fees.IsZero() {
+		return
+	}
+	priceState, found := keeper.GetPriceState(ctx, string(contractAddr), uint64(ctx.BlockTime().Unix()), pair)
+	if !found {
+		return
+	}
+	if priceState.TotalFees != nil {
+		fees = fees.Add(*priceState.TotalFees)
+	}
+	priceState.TotalFees = &fees
+	keeper.SetPriceState(ctx, priceState, string(contractAddr))
+}
+
+// UpdateCandlesFromExecutionOutcome adds the current block's trades to the candles of the
+// pair, at the first, last, lowest and highest execution prices of the block. It should be
+// called once per block after all matching is done.
+func UpdateCandlesFromExecutionOutcome(
+	ctx sdk.Context,
+	keeper *keeper.Keeper,
+	contractAddr types.ContractAddress,
+	pair types.Pair,
+	outcome exchange.ExecutionOutcome,
+) {
+	volume := outcome.Volume()
+	if volume.IsZero() {
+		return
+	}
+	keeper.UpdateCandlesWithPrices(ctx, string(contractAddr), pair, outcome.FirstPrice, outcome.MaxPrice, outcome.MinPrice, outcome.LastPrice, volume)
+}
@@ -0,0 +1,16 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// FeeAndFillParamsUpdate writes the default fee collector address and fill retention
+// params, without reverting any other params that have been changed.
+func FeeAndFillParamsUpdate(ctx sdk.Context, paramStore paramtypes.Subspace) error {
+	defaultParams := types.DefaultParams()
+	paramStore.Set(ctx, types.KeyFeeCollectorAddress, defaultParams.FeeCollectorAddress)
+	paramStore.Set(ctx, types.KeyFillRetention, defaultParams.FillRetention)
+	return nil
+}
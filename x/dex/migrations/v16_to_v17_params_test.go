@@ -0,0 +1,61 @@
+package migrations_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	typesparams "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/sei-protocol/sei-chain/x/dex/migrations"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmdb "github.com/tendermint/tm-db"
+)
+
+func TestFeeAndFillParamsUpdate(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
+
+	db := tmdb.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db)
+	stateStore.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(memStoreKey, sdk.StoreTypeMemory, nil)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	registry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(registry)
+
+	paramsSubspace := typesparams.NewSubspace(cdc,
+		types.Amino,
+		storeKey,
+		memStoreKey,
+		"DexParams",
+	).WithKeyTable(types.ParamKeyTable())
+	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+
+	// write old params, without the fee collector address and fill retention
+	prevParams := types.DefaultParams()
+	prevParams.MaxPairsPerContract = 5
+	for _, pair := range prevParams.ParamSetPairs() {
+		if bytes.Equal(pair.Key, types.KeyFeeCollectorAddress) || bytes.Equal(pair.Key, types.KeyFillRetention) {
+			continue
+		}
+		paramsSubspace.Set(ctx, pair.Key, pair.Value)
+	}
+	require.Panics(t, func() { paramsSubspace.GetParamSet(ctx, &types.Params{}) })
+
+	require.NoError(t, migrations.FeeAndFillParamsUpdate(ctx, paramsSubspace))
+
+	params := types.Params{}
+	paramsSubspace.GetParamSet(ctx, &params)
+	require.Equal(t, "", params.FeeCollectorAddress)
+	require.Equal(t, uint64(0), params.FillRetention)
+	// other params are kept
+	require.Equal(t, uint64(5), params.MaxPairsPerContract)
+}
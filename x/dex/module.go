@@ -209,6 +209,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	_ = cfg.RegisterMigration(types.ModuleName, 15, func(ctx sdk.Context) error {
 		return migrations.V15ToV16(ctx, am.keeper)
 	})
+	_ = cfg.RegisterMigration(types.ModuleName, 16, func(ctx sdk.Context) error {
+		return migrations.FeeAndFillParamsUpdate(ctx, am.keeper.Paramstore)
+	})
 }
 
 // RegisterInvariants registers the capability module's invariants.
@@ -233,7 +236,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 16 }
+func (AppModule) ConsensusVersion() uint64 { return 17 }
 
 // BeginBlock executes all ABCI BeginBlock logic respective to the capability module.
 func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
@@ -278,6 +281,7 @@ func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 			}
 		}
 	}
+	am.keeper.PruneFills(cachedCtx)
 	// only write if all contracts have been processed
 	cachedStore.Write()
 }
@@ -315,6 +319,7 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) (ret []abc
 	defer dexutils.GetMemState(ctx.Context()).Clear(ctx)
 
 	validContractsInfo := am.keeper.GetAllProcessableContractInfo(ctx)
+	contract.CancelExpiredOrders(ctx, &am.keeper, validContractsInfo)
 	// Each iteration is atomic. If an iteration finishes without any error, it will return,
 	// otherwise it will rollback any state change, filter out contracts that cause the error,
 	// and proceed to the next iteration. The loop is guaranteed to finish since
@@ -833,3 +833,90 @@ func TestOrderCountUpdate(t *testing.T) {
 	require.Equal(t, uint64(0), dexkeeper.GetOrderCountState(ctx, contractAddr.String(), pair.PriceDenom, pair.AssetDenom, types.PositionDirection_LONG, sdk.NewDec(3)))
 	require.Equal(t, uint64(1), dexkeeper.GetOrderCountState(ctx, contractAddr.String(), pair.PriceDenom, pair.AssetDenom, types.PositionDirection_SHORT, sdk.NewDec(3)))
 }
+
+func TestEndBlockFeesChargedForOptedInContracts(t *testing.T) {
+	testApp := keepertest.TestApp()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), dexutils.DexMemStateContextKey, dexcache.NewMemState(testApp.GetMemKey(types.MemStoreKey))))
+	dexkeeper := testApp.DexKeeper
+	makerFeeRate := sdk.MustNewDecFromStr("0.01")
+	pair := types.Pair{PriceDenom: "SEI", AssetDenom: "ATOM", MakerFeeRate: &makerFeeRate}
+
+	testAccount, _ := sdk.AccAddressFromBech32("sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx")
+	amounts := sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(10000000)), sdk.NewCoin("uusdc", sdk.NewInt(10000000)))
+	bankkeeper := testApp.BankKeeper
+	bankkeeper.MintCoins(ctx, minttypes.ModuleName, amounts)
+	bankkeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, testAccount, amounts)
+	wasm, err := ioutil.ReadFile("./testdata/mars.wasm")
+	if err != nil {
+		panic(err)
+	}
+	wasmKeeper := testApp.WasmKeeper
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(&wasmKeeper)
+	var perm *wasmtypes.AccessConfig
+	codeId, err := contractKeeper.Create(ctx, testAccount, wasm, perm)
+	if err != nil {
+		panic(err)
+	}
+	contractAddr, _, err := contractKeeper.Instantiate(ctx, codeId, testAccount, testAccount, []byte(GOOD_CONTRACT_INSTANTIATE), "test",
+		sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(100000))))
+	if err != nil {
+		panic(err)
+	}
+	// the fee totals are transferred from the contract's balance
+	fees := sdk.NewCoins(sdk.NewCoin("SEI", sdk.NewInt(4)))
+	bankkeeper.MintCoins(ctx, minttypes.ModuleName, fees)
+	bankkeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, contractAddr, fees)
+
+	contractInfo := types.ContractInfoV2{CodeId: 123, ContractAddr: contractAddr.String(), NeedHook: false, NeedOrderMatching: true, RentBalance: 100000000}
+	dexkeeper.SetContract(ctx, &contractInfo)
+	dexkeeper.AddRegisteredPair(ctx, contractAddr.String(), pair)
+	placeCrossingOrders := func(startID uint64) {
+		for i, direction := range []types.PositionDirection{types.PositionDirection_LONG, types.PositionDirection_SHORT} {
+			dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(contractAddr.String()), pair).Add(
+				&types.Order{
+					Id:                startID + uint64(i),
+					Account:           testAccount.String(),
+					ContractAddr:      contractAddr.String(),
+					Price:             sdk.MustNewDecFromStr("2"),
+					Quantity:          sdk.MustNewDecFromStr("100"),
+					PriceDenom:        pair.PriceDenom,
+					AssetDenom:        pair.AssetDenom,
+					OrderType:         types.OrderType_LIMIT,
+					PositionDirection: direction,
+					Data:              "{\"position_effect\":\"Open\",\"leverage\":\"1\"}",
+				},
+			)
+		}
+		dexutils.GetMemState(ctx.Context()).SetDownstreamsToProcess(ctx, contractAddr.String(), dexkeeper.GetContractWithoutGasCharge)
+	}
+	feeCollector := dexkeeper.GetFeeCollectorAddress(ctx)
+
+	// contracts registered without opting in aren't charged the fee rates of their pairs
+	placeCrossingOrders(1)
+	ctx = ctx.WithBlockHeight(1)
+	testApp.EndBlocker(ctx, abci.RequestEndBlock{})
+	matchResults, _ := dexkeeper.GetMatchResultState(ctx, contractAddr.String())
+	require.Equal(t, 2, len(matchResults.Settlements))
+	for _, settlement := range matchResults.Settlements {
+		require.True(t, settlement.FeeOrZero().IsZero())
+	}
+	require.True(t, bankkeeper.GetBalance(ctx, feeCollector, "SEI").IsZero())
+	require.Equal(t, fees[0], bankkeeper.GetBalance(ctx, contractAddr, "SEI"))
+
+	contractInfo.ChargeFees = true
+	dexkeeper.SetContract(ctx, &contractInfo)
+	dexutils.GetMemState(ctx.Context()).Clear(ctx)
+	placeCrossingOrders(3)
+	ctx = ctx.WithBlockHeight(2)
+	testApp.EndBlocker(ctx, abci.RequestEndBlock{})
+	matchResults, _ = dexkeeper.GetMatchResultState(ctx, contractAddr.String())
+	require.Equal(t, 2, len(matchResults.Settlements))
+	for _, settlement := range matchResults.Settlements {
+		require.Equal(t, sdk.NewDec(2), settlement.Fee)
+	}
+	require.Equal(t, fees[0], bankkeeper.GetBalance(ctx, feeCollector, "SEI"))
+	contractInfo, err = dexkeeper.GetContract(ctx, contractAddr.String())
+	require.Nil(t, err)
+	require.False(t, contractInfo.Suspended)
+}
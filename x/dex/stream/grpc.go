@@ -0,0 +1,41 @@
+package stream
+
+import (
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.StreamServer = GRPCServer{}
+
+type GRPCServer struct {
+	streamer *Streamer
+}
+
+func NewGRPCServer(streamer *Streamer) GRPCServer {
+	return GRPCServer{streamer: streamer}
+}
+
+func (s GRPCServer) Subscribe(req *types.SubscribeRequest, server types.Stream_SubscribeServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "invalid request")
+	}
+	sub, err := s.streamer.Subscribe(*req)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	defer s.streamer.Unsubscribe(sub)
+	for {
+		select {
+		case <-server.Context().Done():
+			return nil
+		case event, ok := <-sub.Events():
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "subscription fell too far behind")
+			}
+			if err := server.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
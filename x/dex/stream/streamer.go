@@ -0,0 +1,188 @@
+package stream
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// number of events a subscription can lag behind before it is dropped
+const SubscriptionBufferSize = 1000
+
+// EventLoader builds the dex events of a committed height from the state committed at that
+// height.
+type EventLoader func(height int64) ([]*types.StreamEvent, error)
+
+// Streamer fans out dex events of committed blocks to subscriptions. Events are only built
+// once a block is committed, from the committed state and by a background worker, so that
+// blocks that are processed but not committed (e.g. optimistically processed proposals) are
+// never streamed, and streaming puts no node-specific work on block processing.
+type Streamer struct {
+	mu            sync.Mutex
+	subscriptions map[uint64]*Subscription
+	nextID        uint64
+
+	loader          EventLoader
+	logger          log.Logger
+	committedHeight int64
+	loadedHeight    int64
+	notify          chan struct{}
+	done            chan struct{}
+	startOnce       sync.Once
+	closeOnce       sync.Once
+}
+
+type Subscription struct {
+	id     uint64
+	filter types.SubscribeRequest
+	events chan *types.StreamEvent
+}
+
+// NewStreamer creates a streamer whose worker only loads events once Start is called.
+func NewStreamer(loader EventLoader, logger log.Logger) *Streamer {
+	return &Streamer{
+		subscriptions: map[uint64]*Subscription{},
+		loader:        loader,
+		logger:        logger,
+		notify:        make(chan struct{}, 1),
+		done:          make(chan struct{}),
+	}
+}
+
+// Start starts the worker loading the events of committed heights. Calling it more than once
+// is a no-op.
+func (s *Streamer) Start() {
+	s.startOnce.Do(func() {
+		go s.run()
+	})
+}
+
+// Close stops the worker and closes all subscriptions. Calling it more than once is a no-op.
+func (s *Streamer) Close() {
+	s.closeOnce.Do(func() {
+		close(s.done)
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for _, sub := range s.subscriptions {
+			s.remove(sub)
+		}
+	})
+}
+
+// Events is closed when the subscription is cancelled or falls too far behind.
+func (s *Subscription) Events() <-chan *types.StreamEvent {
+	return s.events
+}
+
+func (s *Streamer) Subscribe(filter types.SubscribeRequest) (*Subscription, error) {
+	if err := filter.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextID++
+	sub := &Subscription{
+		id:     s.nextID,
+		filter: filter,
+		events: make(chan *types.StreamEvent, SubscriptionBufferSize),
+	}
+	s.subscriptions[sub.id] = sub
+	return sub, nil
+}
+
+func (s *Streamer) Unsubscribe(sub *Subscription) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.remove(sub)
+}
+
+func (s *Streamer) HasSubscriptions() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.subscriptions) > 0
+}
+
+// Publish notifies the worker that a height got committed, without waiting for its events
+// to be loaded. Heights committed while there is no subscription are never loaded.
+func (s *Streamer) Publish(committedHeight int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.committedHeight = committedHeight
+	if len(s.subscriptions) == 0 {
+		s.loadedHeight = committedHeight
+		return
+	}
+	if s.loadedHeight == 0 {
+		s.loadedHeight = committedHeight - 1
+	}
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+// run loads the events of every committed height that hasn't been loaded yet, in order, until
+// the streamer is closed.
+func (s *Streamer) run() {
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-s.notify:
+		}
+		for {
+			select {
+			case <-s.done:
+				return
+			default:
+			}
+			s.mu.Lock()
+			height := s.loadedHeight + 1
+			if height > s.committedHeight {
+				s.mu.Unlock()
+				break
+			}
+			s.mu.Unlock()
+
+			events, err := s.loader(height)
+			if err != nil {
+				s.logger.Error(fmt.Sprintf("failed to load dex stream events of height %d: %s", height, err))
+			}
+			s.publish(height, events)
+		}
+	}
+}
+
+// publish sends events of a height to all matching subscriptions. Subscriptions that cannot
+// keep up are dropped rather than blocking the worker.
+func (s *Streamer) publish(height int64, events []*types.StreamEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if height <= s.loadedHeight {
+		return
+	}
+	s.loadedHeight = height
+	for _, event := range events {
+		for _, sub := range s.subscriptions {
+			filtered := sub.filter.Filter(event)
+			if filtered == nil {
+				continue
+			}
+			select {
+			case sub.events <- filtered:
+			default:
+				s.remove(sub)
+			}
+		}
+	}
+}
+
+func (s *Streamer) remove(sub *Subscription) {
+	if _, ok := s.subscriptions[sub.id]; !ok {
+		return
+	}
+	delete(s.subscriptions, sub.id)
+	close(sub.events)
+}
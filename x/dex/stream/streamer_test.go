@@ -0,0 +1,127 @@
+package stream_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/stream"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func testEvent(height int64, priceDenom string) *types.StreamEvent {
+	return &types.StreamEvent{
+		Height:       height,
+		ContractAddr: keepertest.TestContract,
+		PriceDenom:   priceDenom,
+		AssetDenom:   keepertest.TestAssetDenom,
+		BookUpdates:  []*types.BookLevelUpdate{{}},
+		Fills:        []*types.SettlementEntry{{Account: keepertest.TestAccount}, {Account: "other"}},
+	}
+}
+
+// testStreamer returns a streamer loading the given events of each height, and records the
+// loaded heights.
+func testStreamer(eventsByHeight map[int64][]*types.StreamEvent) (*stream.Streamer, func() []int64) {
+	mu := sync.Mutex{}
+	loaded := []int64{}
+	streamer := stream.NewStreamer(func(height int64) ([]*types.StreamEvent, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		loaded = append(loaded, height)
+		return eventsByHeight[height], nil
+	}, log.NewNopLogger())
+	streamer.Start()
+	return streamer, func() []int64 {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]int64{}, loaded...)
+	}
+}
+
+func TestSubscribeInvalidFilter(t *testing.T) {
+	streamer, _ := testStreamer(nil)
+	_, err := streamer.Subscribe(types.SubscribeRequest{PriceDenom: keepertest.TestPriceDenom})
+	require.NotNil(t, err)
+	require.False(t, streamer.HasSubscriptions())
+}
+
+func TestPublish(t *testing.T) {
+	streamer, getLoaded := testStreamer(map[int64][]*types.StreamEvent{
+		2: {testEvent(2, keepertest.TestPriceDenom), testEvent(2, "other")},
+		4: {testEvent(4, keepertest.TestPriceDenom)},
+	})
+	// heights committed without any subscription are not loaded
+	streamer.Publish(1)
+
+	all, err := streamer.Subscribe(types.SubscribeRequest{})
+	require.Nil(t, err)
+	pair, err := streamer.Subscribe(types.SubscribeRequest{
+		ContractAddr: keepertest.TestContract,
+		PriceDenom:   keepertest.TestPriceDenom,
+		AssetDenom:   keepertest.TestAssetDenom,
+	})
+	require.Nil(t, err)
+	account, err := streamer.Subscribe(types.SubscribeRequest{Account: keepertest.TestAccount})
+	require.Nil(t, err)
+
+	streamer.Publish(2)
+	require.Eventually(t, func() bool { return len(all.Events()) == 2 }, time.Second, time.Millisecond)
+	require.Equal(t, []int64{2}, getLoaded())
+	require.Equal(t, 1, len(pair.Events()))
+	require.Equal(t, keepertest.TestPriceDenom, (<-pair.Events()).PriceDenom)
+	require.Equal(t, 2, len(account.Events()))
+	event := <-account.Events()
+	require.Empty(t, event.BookUpdates)
+	require.Equal(t, 1, len(event.Fills))
+	require.Equal(t, keepertest.TestAccount, event.Fills[0].Account)
+
+	// heights that the worker fell behind on are loaded in order
+	streamer.Publish(4)
+	require.Eventually(t, func() bool { return len(all.Events()) == 3 }, time.Second, time.Millisecond)
+	require.Equal(t, []int64{2, 3, 4}, getLoaded())
+	require.Equal(t, int64(4), (<-pair.Events()).Height)
+
+	streamer.Unsubscribe(account)
+	for range account.Events() {
+	}
+	_, ok := <-account.Events()
+	require.False(t, ok)
+}
+
+func TestPublishDropsSlowSubscription(t *testing.T) {
+	events := make([]*types.StreamEvent, stream.SubscriptionBufferSize+1)
+	for i := range events {
+		events[i] = testEvent(1, keepertest.TestPriceDenom)
+	}
+	streamer, _ := testStreamer(map[int64][]*types.StreamEvent{1: events})
+	sub, err := streamer.Subscribe(types.SubscribeRequest{})
+	require.Nil(t, err)
+	streamer.Publish(1)
+	require.Eventually(t, func() bool { return !streamer.HasSubscriptions() }, time.Second, time.Millisecond)
+	for range sub.Events() {
+	}
+	// unsubscribing a dropped subscription is a no-op
+	streamer.Unsubscribe(sub)
+}
+
+func TestClose(t *testing.T) {
+	streamer, getLoaded := testStreamer(map[int64][]*types.StreamEvent{
+		1: {testEvent(1, keepertest.TestPriceDenom)},
+	})
+	sub, err := streamer.Subscribe(types.SubscribeRequest{})
+	require.Nil(t, err)
+	streamer.Close()
+	// subscriptions are closed and committed heights are no longer loaded
+	_, ok := <-sub.Events()
+	require.False(t, ok)
+	require.False(t, streamer.HasSubscriptions())
+	streamer.Publish(1)
+	time.Sleep(10 * time.Millisecond)
+	require.Empty(t, getLoaded())
+	// closing twice is a no-op
+	streamer.Close()
+}
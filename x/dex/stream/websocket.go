@@ -0,0 +1,98 @@
+package stream
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/gorilla/websocket"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+const (
+	WebSocketRoute = "/sei-protocol/seichain/dex/stream"
+
+	writeWait  = 10 * time.Second
+	pongWait   = 60 * time.Second
+	pingPeriod = pongWait * 9 / 10
+)
+
+// NewWebSocketHandler serves subscriptions over WebSocket. Filters are read from the
+// `contract_address`, `price_denom`, `asset_denom` and `account` query parameters, and each
+// event is sent as a JSON text message. Cross-origin connections are only accepted if
+// `allowAllOrigins` is set.
+func NewWebSocketHandler(streamer *Streamer, allowAllOrigins bool) http.Handler {
+	upgrader := websocket.Upgrader{}
+	if allowAllOrigins {
+		upgrader.CheckOrigin = func(*http.Request) bool { return true }
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		params := r.URL.Query()
+		filter := types.SubscribeRequest{
+			ContractAddr: params.Get("contract_address"),
+			PriceDenom:   params.Get("price_denom"),
+			AssetDenom:   params.Get("asset_denom"),
+			Account:      params.Get("account"),
+		}
+		if err := filter.ValidateBasic(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			// the upgrader has already replied with an error
+			return
+		}
+		defer conn.Close()
+		sub, err := streamer.Subscribe(filter)
+		if err != nil {
+			return
+		}
+		defer streamer.Unsubscribe(sub)
+		serveWebSocket(conn, sub)
+	})
+}
+
+func serveWebSocket(conn *websocket.Conn, sub *Subscription) {
+	// incoming messages are discarded; reading is still needed to process pongs and closes
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		_ = conn.SetReadDeadline(time.Now().Add(pongWait))
+		conn.SetPongHandler(func(string) error {
+			return conn.SetReadDeadline(time.Now().Add(pongWait))
+		})
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(pingPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-closed:
+			return
+		case <-ticker.C:
+			_ = conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		case event, ok := <-sub.Events():
+			_ = conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if !ok {
+				_ = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "subscription fell too far behind"))
+				return
+			}
+			bz, err := codec.ProtoMarshalJSON(event, nil)
+			if err != nil {
+				return
+			}
+			if err := conn.WriteMessage(websocket.TextMessage, bz); err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package types
+
+import "fmt"
+
+const (
+	CandleIntervalOneMinute   uint64 = 60
+	CandleIntervalFiveMinutes uint64 = 5 * 60
+	CandleIntervalOneHour     uint64 = 60 * 60
+	CandleIntervalOneDay      uint64 = 24 * 60 * 60
+
+	// Number of most recent candles kept for each interval. Older candles are pruned
+	// as new ones get opened, so that storage per pair stays bounded.
+	CandleRetentionCount uint64 = 1440
+)
+
+var SupportedCandleIntervals = []uint64{
+	CandleIntervalOneMinute,
+	CandleIntervalFiveMinutes,
+	CandleIntervalOneHour,
+	CandleIntervalOneDay,
+}
+
+func ValidateCandleInterval(intervalInSeconds uint64) error {
+	for _, interval := range SupportedCandleIntervals {
+		if interval == intervalInSeconds {
+			return nil
+		}
+	}
+	return fmt.Errorf("unsupported candle interval %ds, must be one of %v", intervalInSeconds, SupportedCandleIntervals)
+}
@@ -117,6 +117,10 @@ type ContractInfoV2 struct {
 	RentBalance             uint64                    `protobuf:"varint,8,opt,name=rentBalance,proto3" json:"rentBalance,omitempty"`
 	Suspended               bool                      `protobuf:"varint,9,opt,name=suspended,proto3" json:"suspended,omitempty"`
 	SuspensionReason        string                    `protobuf:"bytes,10,opt,name=suspensionReason,proto3" json:"suspensionReason,omitempty"`
+	// whether the fee rates of the contract's pairs are charged on its settlements. The
+	// contract is then expected to deduct the fees from the settled accounts, since the
+	// fee totals are transferred from the contract's balance.
+	ChargeFees bool `protobuf:"varint,11,opt,name=chargeFees,proto3" json:"chargeFees,omitempty"`
 }
 
 func (m *ContractInfoV2) Reset()         { *m = ContractInfoV2{} }
@@ -222,6 +226,13 @@ func (m *ContractInfoV2) GetSuspensionReason() string {
 	return ""
 }
 
+func (m *ContractInfoV2) GetChargeFees() bool {
+	if m != nil {
+		return m.ChargeFees
+	}
+	return false
+}
+
 // suppose A is first registered and depends on X, then B is added and depends on X,
 // and then C is added and depends on X, then A is the elder sibling to B and B is
 // the younger sibling to A, and B is the elder sibling to C and C is the younger to B
@@ -416,38 +427,38 @@ func init() {
 func init() { proto.RegisterFile("dex/contract.proto", fileDescriptor_ee35557664974a8a) }
 
 var fileDescriptor_ee35557664974a8a = []byte{
-	// 481 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x54, 0x41, 0x8b, 0x13, 0x31,
-	0x14, 0x6e, 0xb6, 0x6b, 0xb7, 0x7d, 0x5b, 0x45, 0x03, 0xd6, 0x20, 0x32, 0x0c, 0x83, 0x87, 0x22,
-	0xee, 0x54, 0x56, 0x11, 0xf1, 0xe6, 0x6e, 0x45, 0x0b, 0x8a, 0x30, 0x8a, 0xa0, 0xb7, 0x34, 0x79,
-	0x4e, 0x83, 0x9d, 0xa4, 0x4c, 0x52, 0x6c, 0xff, 0x85, 0x3f, 0xc2, 0x83, 0x07, 0x7f, 0x88, 0xc7,
-	0xc5, 0x93, 0x47, 0x69, 0xff, 0x88, 0x4c, 0xba, 0xd3, 0x9d, 0xb2, 0xed, 0xdd, 0x83, 0xb7, 0xbc,
-	0xef, 0x4b, 0xbe, 0xc7, 0xf7, 0xbd, 0x37, 0x03, 0x54, 0xe2, 0xac, 0x27, 0x8c, 0x76, 0x39, 0x17,
-	0x2e, 0x9e, 0xe4, 0xc6, 0x19, 0xca, 0x2c, 0x2a, 0x7f, 0x12, 0x66, 0x1c, 0x5b, 0x54, 0x62, 0xc4,
-	0x95, 0x8e, 0x25, 0xce, 0xa2, 0x6f, 0x7b, 0xd0, 0x3e, 0x3d, 0xbf, 0x3c, 0xd0, 0x9f, 0x0c, 0xed,
-	0x40, 0x43, 0x18, 0x89, 0x03, 0xc9, 0x48, 0x48, 0xba, 0xfb, 0xc9, 0x79, 0x45, 0x23, 0x68, 0x97,
-	0xa2, 0xcf, 0xa4, 0xcc, 0xd9, 0x5e, 0x48, 0xba, 0xad, 0x64, 0x03, 0xa3, 0xb7, 0xa1, 0xa9, 0x11,
-	0xe5, 0x4b, 0x63, 0x3e, 0xb3, 0x7a, 0x48, 0xba, 0xcd, 0x64, 0x5d, 0xd3, 0xfb, 0x70, 0xa3, 0x38,
-	0xbf, 0xc9, 0x25, 0xe6, 0xaf, 0xb9, 0x13, 0x23, 0xa5, 0x53, 0xb6, 0xef, 0x2f, 0x5d, 0x26, 0xe8,
-	0x3b, 0x68, 0x4b, 0x9c, 0xa0, 0x96, 0xa8, 0x85, 0x42, 0xcb, 0xae, 0x84, 0xf5, 0xee, 0xe1, 0xf1,
-	0x83, 0x78, 0x97, 0x8f, 0xb8, 0xf4, 0xd0, 0x2f, 0x5f, 0xcd, 0x0b, 0x37, 0xc9, 0x86, 0x0a, 0x7d,
-	0x02, 0xb7, 0xf4, 0x34, 0x1b, 0x68, 0x61, 0x32, 0xa5, 0xd3, 0x7e, 0xb5, 0x41, 0x23, 0x24, 0xdd,
-	0x7a, 0xb2, 0x8b, 0x8e, 0x7e, 0xd4, 0xe1, 0x5a, 0x35, 0xa6, 0xf7, 0xc7, 0xff, 0x83, 0xda, 0x46,
-	0x53, 0x06, 0x07, 0x22, 0x47, 0xee, 0x4c, 0xce, 0x0e, 0xbc, 0xf1, 0xb2, 0xa4, 0x21, 0x1c, 0xe6,
-	0xa8, 0xdd, 0x09, 0x1f, 0x73, 0x2d, 0x90, 0x35, 0x7d, 0x68, 0x55, 0x88, 0xde, 0x81, 0x96, 0x9d,
-	0x5a, 0x2f, 0x26, 0x59, 0xcb, 0x3b, 0xbe, 0x00, 0xe8, 0x3d, 0xb8, 0xbe, 0x2a, 0xac, 0x32, 0x3a,
-	0x41, 0x6e, 0x8d, 0x66, 0xe0, 0x5b, 0x5c, 0xc2, 0xa3, 0xef, 0x04, 0x3a, 0xdb, 0x8d, 0xd2, 0x00,
-	0x60, 0x6d, 0x75, 0xee, 0x47, 0xd7, 0x4a, 0x2a, 0x08, 0x7d, 0x04, 0x37, 0x55, 0x96, 0xa1, 0x54,
-	0xdc, 0xe1, 0xf3, 0xb1, 0xc4, 0xfc, 0xad, 0x1a, 0x8e, 0x8b, 0x11, 0xac, 0xe6, 0xb8, 0x9d, 0x2c,
-	0x02, 0x5b, 0x13, 0x1f, 0xcc, 0x54, 0xa7, 0x17, 0xef, 0xea, 0xfe, 0xdd, 0x2e, 0x3a, 0xfa, 0x45,
-	0x80, 0xbe, 0xc2, 0x94, 0x8b, 0xf9, 0x3f, 0xf8, 0x19, 0x3e, 0x86, 0x4e, 0x19, 0x8d, 0x3b, 0xad,
-	0xb4, 0x58, 0xed, 0x59, 0x2b, 0xd9, 0xc1, 0x46, 0x4f, 0x81, 0xf6, 0xcd, 0x17, 0x6d, 0x1d, 0xf2,
-	0xac, 0x64, 0x2c, 0xbd, 0x0b, 0x57, 0xc5, 0x86, 0x08, 0xf1, 0x22, 0x9b, 0xe0, 0xc9, 0x8b, 0x9f,
-	0x8b, 0x80, 0x9c, 0x2d, 0x02, 0xf2, 0x67, 0x11, 0x90, 0xaf, 0xcb, 0xa0, 0x76, 0xb6, 0x0c, 0x6a,
-	0xbf, 0x97, 0x41, 0xed, 0xe3, 0x51, 0xaa, 0xdc, 0x68, 0x3a, 0x8c, 0x85, 0xc9, 0x7a, 0x16, 0xd5,
-	0x51, 0xb9, 0xe0, 0xbe, 0xf0, 0x1b, 0xde, 0x9b, 0xf5, 0x8a, 0xbf, 0x9f, 0x9b, 0x4f, 0xd0, 0x0e,
-	0x1b, 0x9e, 0x7f, 0xf8, 0x37, 0x00, 0x00, 0xff, 0xff, 0x37, 0xae, 0xf3, 0x6f, 0x11, 0x05, 0x00,
-	0x00,
+	// 494 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x54, 0xcd, 0x8a, 0x13, 0x4d,
+	0x14, 0x9d, 0x9a, 0xcc, 0x97, 0x49, 0x6e, 0xf2, 0x89, 0x16, 0x18, 0x0b, 0x91, 0xa6, 0x69, 0x5c,
+	0x04, 0x71, 0x3a, 0x32, 0x8a, 0x88, 0x3b, 0x67, 0xe2, 0x4f, 0x40, 0x11, 0x5a, 0x11, 0x74, 0x57,
+	0xa9, 0xba, 0x76, 0x0a, 0xd3, 0x55, 0xa1, 0xab, 0x82, 0xc9, 0x5b, 0xf8, 0x10, 0x2e, 0x7c, 0x14,
+	0x17, 0x2e, 0x06, 0x57, 0x2e, 0x25, 0x79, 0x11, 0xe9, 0xca, 0x74, 0xa6, 0xc3, 0x24, 0x7b, 0x17,
+	0xee, 0xea, 0x9e, 0x53, 0xf7, 0x14, 0xe7, 0xdc, 0xbe, 0x0d, 0x54, 0xe2, 0xac, 0x27, 0x8c, 0x76,
+	0x39, 0x17, 0x2e, 0x9e, 0xe4, 0xc6, 0x19, 0xca, 0x2c, 0x2a, 0x7f, 0x12, 0x66, 0x1c, 0x5b, 0x54,
+	0x62, 0xc4, 0x95, 0x8e, 0x25, 0xce, 0xa2, 0xaf, 0xfb, 0xd0, 0x3e, 0x3d, 0xbf, 0x3c, 0xd0, 0x1f,
+	0x0d, 0xed, 0x40, 0x5d, 0x18, 0x89, 0x03, 0xc9, 0x48, 0x48, 0xba, 0x07, 0xc9, 0x79, 0x45, 0x23,
+	0x68, 0x97, 0xa2, 0x4f, 0xa4, 0xcc, 0xd9, 0x7e, 0x48, 0xba, 0xcd, 0x64, 0x03, 0xa3, 0x37, 0xa1,
+	0xa1, 0x11, 0xe5, 0x0b, 0x63, 0x3e, 0xb1, 0x5a, 0x48, 0xba, 0x8d, 0x64, 0x5d, 0xd3, 0xbb, 0x70,
+	0xad, 0x38, 0xbf, 0xce, 0x25, 0xe6, 0xaf, 0xb8, 0x13, 0x23, 0xa5, 0x53, 0x76, 0xe0, 0x2f, 0x5d,
+	0x26, 0xe8, 0x5b, 0x68, 0x4b, 0x9c, 0xa0, 0x96, 0xa8, 0x85, 0x42, 0xcb, 0xfe, 0x0b, 0x6b, 0xdd,
+	0xd6, 0xf1, 0xbd, 0x78, 0x97, 0x8f, 0xb8, 0xf4, 0xd0, 0x2f, 0xbb, 0xe6, 0x85, 0x9b, 0x64, 0x43,
+	0x85, 0x3e, 0x82, 0x1b, 0x7a, 0x9a, 0x0d, 0xb4, 0x30, 0x99, 0xd2, 0x69, 0xbf, 0xfa, 0x40, 0x3d,
+	0x24, 0xdd, 0x5a, 0xb2, 0x8b, 0x8e, 0x7e, 0xd4, 0xe0, 0x4a, 0x35, 0xa6, 0x77, 0xc7, 0xff, 0x82,
+	0xda, 0x46, 0x53, 0x06, 0x87, 0x22, 0x47, 0xee, 0x4c, 0xce, 0x0e, 0xbd, 0xf1, 0xb2, 0xa4, 0x21,
+	0xb4, 0x72, 0xd4, 0xee, 0x84, 0x8f, 0xb9, 0x16, 0xc8, 0x1a, 0x3e, 0xb4, 0x2a, 0x44, 0x6f, 0x41,
+	0xd3, 0x4e, 0xad, 0x17, 0x93, 0xac, 0xe9, 0x1d, 0x5f, 0x00, 0xf4, 0x0e, 0x5c, 0x5d, 0x15, 0x56,
+	0x19, 0x9d, 0x20, 0xb7, 0x46, 0x33, 0xf0, 0x4f, 0x5c, 0xc2, 0x69, 0x00, 0x20, 0x46, 0x3c, 0x4f,
+	0xf1, 0x19, 0xa2, 0x65, 0x2d, 0x2f, 0x55, 0x41, 0xa2, 0x6f, 0x04, 0x3a, 0xdb, 0x83, 0x28, 0x5a,
+	0xd7, 0x51, 0xcc, 0xfd, 0x68, 0x9b, 0x49, 0x05, 0xa1, 0x0f, 0xe0, 0xba, 0xca, 0x32, 0x94, 0x8a,
+	0x3b, 0x7c, 0x3a, 0x96, 0x98, 0xbf, 0x51, 0xc3, 0x71, 0x31, 0xa2, 0xd5, 0x9c, 0xb7, 0x93, 0x45,
+	0xa0, 0x6b, 0xe2, 0xbd, 0x99, 0xea, 0xf4, 0xa2, 0xaf, 0xe6, 0xfb, 0x76, 0xd1, 0xd1, 0x4f, 0x02,
+	0xf4, 0x25, 0xa6, 0x5c, 0xcc, 0xff, 0xc2, 0x35, 0x7d, 0x08, 0x9d, 0x32, 0x1a, 0x77, 0x5a, 0x79,
+	0x62, 0xf5, 0x1d, 0x36, 0x93, 0x1d, 0x6c, 0xf4, 0x18, 0x68, 0xdf, 0x7c, 0xd6, 0xd6, 0x21, 0xcf,
+	0x4a, 0xc6, 0xd2, 0xdb, 0xf0, 0xbf, 0xd8, 0x10, 0x21, 0x5e, 0x64, 0x13, 0x3c, 0x79, 0xfe, 0x7d,
+	0x11, 0x90, 0xb3, 0x45, 0x40, 0x7e, 0x2f, 0x02, 0xf2, 0x65, 0x19, 0xec, 0x9d, 0x2d, 0x83, 0xbd,
+	0x5f, 0xcb, 0x60, 0xef, 0xc3, 0x51, 0xaa, 0xdc, 0x68, 0x3a, 0x8c, 0x85, 0xc9, 0x7a, 0x16, 0xd5,
+	0x51, 0xb9, 0x00, 0xbe, 0xf0, 0x1b, 0xd0, 0x9b, 0xf5, 0x8a, 0xbf, 0xa3, 0x9b, 0x4f, 0xd0, 0x0e,
+	0xeb, 0x9e, 0xbf, 0xff, 0x67, 0x00, 0xde, 0xc0, 0xc9, 0x0b, 0x31, 0x05, 0x00, 0x00,
 }
 
 func (m *ContractInfo) Marshal() (dAtA []byte, err error) {
@@ -544,6 +555,16 @@ func (m *ContractInfoV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ChargeFees {
+		i--
+		if m.ChargeFees {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
 	if len(m.SuspensionReason) > 0 {
 		i -= len(m.SuspensionReason)
 		copy(dAtA[i:], m.SuspensionReason)
@@ -851,6 +872,9 @@ func (m *ContractInfoV2) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovContract(uint64(l))
 	}
+	if m.ChargeFees {
+		n += 2
+	}
 	return n
 }
 
@@ -1394,6 +1418,26 @@ func (m *ContractInfoV2) Unmarshal(dAtA []byte) error {
 			}
 			m.SuspensionReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargeFees", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ChargeFees = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipContract(dAtA[iNdEx:])
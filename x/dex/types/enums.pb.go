@@ -174,16 +174,19 @@ type CancellationInitiator int32
 const (
 	CancellationInitiator_USER       CancellationInitiator = 0
 	CancellationInitiator_LIQUIDATED CancellationInitiator = 1
+	CancellationInitiator_EXPIRED    CancellationInitiator = 2
 )
 
 var CancellationInitiator_name = map[int32]string{
 	0: "USER",
 	1: "LIQUIDATED",
+	2: "EXPIRED",
 }
 
 var CancellationInitiator_value = map[string]int32{
 	"USER":       0,
 	"LIQUIDATED": 1,
+	"EXPIRED":    2,
 }
 
 func (x CancellationInitiator) String() string {
@@ -206,30 +209,31 @@ func init() {
 func init() { proto.RegisterFile("dex/enums.proto", fileDescriptor_b8c5bb23c6eb0b88) }
 
 var fileDescriptor_b8c5bb23c6eb0b88 = []byte{
-	// 394 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x91, 0xdf, 0x6e, 0xd3, 0x30,
-	0x18, 0xc5, 0xe3, 0xb5, 0xab, 0xd6, 0x0f, 0xd8, 0x8c, 0x01, 0x89, 0xab, 0xdc, 0x21, 0xa1, 0x48,
-	0x6b, 0x84, 0xe0, 0x05, 0xbc, 0xc4, 0x1d, 0xd6, 0xdc, 0x38, 0xe4, 0x0f, 0x12, 0xdc, 0x4c, 0x59,
-	0xe2, 0x31, 0x4b, 0x5d, 0x52, 0x25, 0xae, 0xd4, 0xbd, 0x05, 0x8f, 0xc5, 0xe5, 0x2e, 0xb9, 0x44,
-	0xed, 0x8b, 0x20, 0x3b, 0x8c, 0xbb, 0x73, 0x3e, 0x9f, 0xcf, 0xfe, 0xc9, 0x07, 0xce, 0x1a, 0xb5,
-	0x0b, 0x55, 0xbb, 0xbd, 0x1f, 0x16, 0x9b, 0xbe, 0x33, 0x1d, 0x79, 0x3b, 0x28, 0xed, 0x54, 0xdd,
-	0xad, 0x17, 0x83, 0xd2, 0xf5, 0x5d, 0xa5, 0xdb, 0x45, 0xa3, 0x76, 0xc1, 0x7b, 0x78, 0x99, 0x76,
-	0x83, 0x36, 0xba, 0x6b, 0x63, 0xdd, 0xab, 0xda, 0x0a, 0x72, 0x02, 0x53, 0x21, 0x93, 0x4b, 0xec,
-	0x91, 0x39, 0x1c, 0xe7, 0x9f, 0x65, 0x56, 0x60, 0x14, 0xbc, 0x83, 0xd3, 0xa7, 0x24, 0xbb, 0xbd,
-	0x55, 0xb5, 0xb1, 0x31, 0x99, 0xb2, 0x64, 0x8c, 0x45, 0x42, 0xe6, 0x0c, 0xa3, 0xa0, 0x81, 0xb9,
-	0xec, 0x1b, 0xd5, 0x17, 0x0f, 0x1b, 0x65, 0xe7, 0x82, 0xaf, 0x78, 0x81, 0x3d, 0x02, 0x30, 0x5b,
-	0xd1, 0xec, 0x8a, 0x15, 0x18, 0x91, 0x17, 0x30, 0x5f, 0xca, 0xab, 0x7f, 0x76, 0x42, 0x5e, 0x03,
-	0xfe, 0x6f, 0x2f, 0xbe, 0x7d, 0xa5, 0xa2, 0x64, 0x78, 0x4a, 0x9e, 0xc3, 0x49, 0x5e, 0xc8, 0x54,
-	0xc8, 0x3c, 0xc7, 0xc7, 0x76, 0xc5, 0x39, 0x77, 0xdb, 0x2c, 0xf8, 0x04, 0xd3, 0xb2, 0xd5, 0x66,
-	0x0c, 0xd1, 0x24, 0xa6, 0x59, 0x3c, 0x62, 0xac, 0xb8, 0x10, 0x1c, 0xa3, 0x51, 0x46, 0x99, 0xc4,
-	0x47, 0x16, 0x33, 0xa1, 0x89, 0xc4, 0x93, 0x40, 0xc0, 0x33, 0xc7, 0x96, 0x9b, 0xca, 0x6c, 0x07,
-	0x8b, 0x94, 0x0a, 0x1a, 0x31, 0xbb, 0xfa, 0x0a, 0xce, 0x96, 0x94, 0x0b, 0x16, 0x5f, 0x17, 0xf2,
-	0xda, 0x4d, 0x47, 0xce, 0x88, 0x26, 0x11, 0x13, 0x82, 0xc5, 0xf8, 0xc8, 0x61, 0x97, 0x62, 0xc9,
-	0x9d, 0x9d, 0x04, 0x1f, 0xe0, 0x4d, 0x54, 0xb5, 0xb5, 0x5a, 0xaf, 0x2b, 0xfb, 0x29, 0xbc, 0xd5,
-	0x46, 0x57, 0xa6, 0xeb, 0xed, 0x83, 0x65, 0xce, 0x32, 0xec, 0x91, 0x53, 0x00, 0xc1, 0xbf, 0x94,
-	0x3c, 0xa6, 0x05, 0x8b, 0x31, 0xba, 0xb8, 0xfc, 0xb5, 0xf7, 0xd1, 0xe3, 0xde, 0x47, 0x7f, 0xf6,
-	0x3e, 0xfa, 0x79, 0xf0, 0xbd, 0xc7, 0x83, 0xef, 0xfd, 0x3e, 0xf8, 0xde, 0xf7, 0xf3, 0x1f, 0xda,
-	0xdc, 0x6d, 0x6f, 0x16, 0x75, 0x77, 0x1f, 0x0e, 0x4a, 0x9f, 0x3f, 0xb5, 0xe5, 0x8c, 0xab, 0x2b,
-	0xdc, 0x85, 0xb6, 0x56, 0xf3, 0xb0, 0x51, 0xc3, 0xcd, 0xcc, 0x9d, 0x7f, 0xfc, 0x1b, 0x00, 0x00,
-	0xff, 0xff, 0x40, 0x7d, 0x48, 0x4c, 0xea, 0x01, 0x00, 0x00,
+	// 401 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x91, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0xe3, 0xb5, 0x2b, 0xeb, 0x3b, 0xd8, 0x8c, 0x01, 0x89, 0x53, 0x6e, 0x48, 0x28, 0xd2,
+	0xda, 0x03, 0x9c, 0x91, 0xbc, 0xc4, 0x1d, 0xd6, 0xdc, 0x38, 0xe4, 0x0f, 0x02, 0x2e, 0x53, 0x96,
+	0x78, 0xcc, 0x52, 0x97, 0x54, 0x89, 0x2b, 0x75, 0xdf, 0x82, 0x8f, 0xc5, 0x71, 0x47, 0x8e, 0xa8,
+	0xfd, 0x22, 0xc8, 0x0e, 0xdb, 0xed, 0x79, 0xde, 0x3c, 0xef, 0x9b, 0x9f, 0xfc, 0xc0, 0x69, 0xad,
+	0xb6, 0x73, 0xd5, 0x6c, 0xee, 0xfa, 0xd9, 0xba, 0x6b, 0x4d, 0x4b, 0xde, 0xf6, 0x4a, 0x3b, 0x55,
+	0xb5, 0xab, 0x59, 0xaf, 0x74, 0x75, 0x5b, 0xea, 0x66, 0x56, 0xab, 0x6d, 0xf0, 0x1e, 0x5e, 0x26,
+	0x6d, 0xaf, 0x8d, 0x6e, 0x9b, 0x48, 0x77, 0xaa, 0xb2, 0x82, 0x1c, 0xc1, 0x58, 0xc8, 0xf8, 0x02,
+	0x7b, 0x64, 0x0a, 0x87, 0xd9, 0x67, 0x99, 0xe6, 0x18, 0x05, 0xef, 0xe0, 0xe4, 0x31, 0xc9, 0x6e,
+	0x6e, 0x54, 0x65, 0x6c, 0x4c, 0x26, 0x2c, 0x1e, 0x62, 0xa1, 0x90, 0x19, 0xc3, 0x28, 0xa8, 0x61,
+	0x2a, 0xbb, 0x5a, 0x75, 0xf9, 0xfd, 0x5a, 0xd9, 0xb9, 0xe0, 0x4b, 0x9e, 0x63, 0x8f, 0x00, 0x4c,
+	0x96, 0x34, 0xbd, 0x64, 0x39, 0x46, 0xe4, 0x05, 0x4c, 0x17, 0xf2, 0xf2, 0xbf, 0x1d, 0x91, 0xd7,
+	0x80, 0x9f, 0xec, 0xf9, 0xf7, 0xaf, 0x54, 0x14, 0x0c, 0x8f, 0xc9, 0x73, 0x38, 0xca, 0x72, 0x99,
+	0x08, 0x99, 0x65, 0xf8, 0xd0, 0xae, 0x38, 0xe7, 0xae, 0x4d, 0x82, 0x8f, 0x30, 0x2e, 0x1a, 0x6d,
+	0x86, 0x10, 0x8d, 0x23, 0x9a, 0x46, 0x03, 0xc6, 0x92, 0x0b, 0xc1, 0x31, 0x1a, 0x64, 0x98, 0x4a,
+	0x7c, 0x60, 0x31, 0x63, 0x1a, 0x4b, 0x3c, 0x0a, 0x04, 0x1c, 0x3b, 0xb6, 0xcc, 0x94, 0x66, 0xd3,
+	0x5b, 0xa4, 0x44, 0xd0, 0x90, 0xd9, 0xd5, 0x57, 0x70, 0xba, 0xa0, 0x5c, 0xb0, 0xe8, 0x2a, 0x97,
+	0x57, 0x6e, 0x3a, 0x70, 0x86, 0x34, 0x0e, 0x99, 0x10, 0x2c, 0xc2, 0x07, 0x0e, 0xbb, 0x10, 0x0b,
+	0xee, 0xec, 0x28, 0xf8, 0x04, 0x6f, 0xc2, 0xb2, 0xa9, 0xd4, 0x6a, 0x55, 0xda, 0x47, 0xe1, 0x8d,
+	0x36, 0xba, 0x34, 0x6d, 0x67, 0x7f, 0x58, 0x64, 0x2c, 0xc5, 0x1e, 0x39, 0x01, 0x10, 0xfc, 0x4b,
+	0xc1, 0x23, 0x9a, 0xb3, 0x08, 0x23, 0x72, 0x0c, 0xcf, 0xd8, 0xb7, 0x84, 0xa7, 0xf6, 0xdc, 0xf9,
+	0xc5, 0xef, 0x9d, 0x8f, 0x1e, 0x76, 0x3e, 0xfa, 0xbb, 0xf3, 0xd1, 0xaf, 0xbd, 0xef, 0x3d, 0xec,
+	0x7d, 0xef, 0xcf, 0xde, 0xf7, 0x7e, 0x9c, 0xfd, 0xd4, 0xe6, 0x76, 0x73, 0x3d, 0xab, 0xda, 0xbb,
+	0x79, 0xaf, 0xf4, 0xd9, 0x63, 0x75, 0xce, 0xb8, 0xee, 0xe6, 0xdb, 0xb9, 0xed, 0xd8, 0xdc, 0xaf,
+	0x55, 0x7f, 0x3d, 0x71, 0xdf, 0x3f, 0xfc, 0x1b, 0x00, 0x55, 0x36, 0xae, 0x0e, 0xf7, 0x01, 0x00,
+	0x00,
 }
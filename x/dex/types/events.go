@@ -9,6 +9,9 @@ const (
 	EventTypeRegisterPair        = "register_pair"
 	EventTypeSetQuantityTickSize = "set_quantity_tick_size"
 	EventTypeSetPriceTickSize    = "set_price_tick_size"
+	EventTypeSetFeeRates         = "set_fee_rates"
+	EventTypeSettlement          = "settlement"
+	EventTypeCollectFees         = "collect_fees"
 
 	AttributeKeyOrderID         = "order_id"
 	AttributeKeyCancellationID  = "cancellation_id"
@@ -16,6 +19,10 @@ const (
 	AttributeKeyRentBalance     = "rent_balance"
 	AttributeKeyPriceDenom      = "price_denom"
 	AttributeKeyAssetDenom      = "asset_denom"
+	AttributeKeySettlementCount = "settlement_count"
+	AttributeKeyFee             = "fee"
+	AttributeKeyFees            = "fees"
+	AttributeKeyFeeCollector    = "fee_collector"
 
 	AttributeValueCategory = ModuleName
 )
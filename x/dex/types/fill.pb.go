@@ -0,0 +1,914 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/fill.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Aggregated fills of an order, kept for as long as its latest fill is retained
+type OrderFillSummary struct {
+	OrderId           uint64                                 `protobuf:"varint,1,opt,name=orderId,proto3" json:"order_id"`
+	Account           string                                 `protobuf:"bytes,2,opt,name=account,proto3" json:"account"`
+	ContractAddr      string                                 `protobuf:"bytes,3,opt,name=contractAddr,proto3" json:"contract_address"`
+	PriceDenom        string                                 `protobuf:"bytes,4,opt,name=priceDenom,proto3" json:"price_denom"`
+	AssetDenom        string                                 `protobuf:"bytes,5,opt,name=assetDenom,proto3" json:"asset_denom"`
+	PositionDirection string                                 `protobuf:"bytes,6,opt,name=positionDirection,proto3" json:"position_direction"`
+	OrderType         string                                 `protobuf:"bytes,7,opt,name=orderType,proto3" json:"order_type"`
+	FilledQuantity    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=filledQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"filled_quantity"`
+	// sum of quantity * execution price over all fills
+	FilledNotional    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=filledNotional,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"filled_notional"`
+	TotalFees         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=totalFees,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_fees"`
+	FillCount         uint64                                 `protobuf:"varint,11,opt,name=fillCount,proto3" json:"fill_count"`
+	FirstFillHeight   uint64                                 `protobuf:"varint,12,opt,name=firstFillHeight,proto3" json:"first_fill_height"`
+	LastFillHeight    uint64                                 `protobuf:"varint,13,opt,name=lastFillHeight,proto3" json:"last_fill_height"`
+	LastFillTimestamp uint64                                 `protobuf:"varint,14,opt,name=lastFillTimestamp,proto3" json:"last_fill_timestamp"`
+}
+
+func (m *OrderFillSummary) Reset()         { *m = OrderFillSummary{} }
+func (m *OrderFillSummary) String() string { return proto.CompactTextString(m) }
+func (*OrderFillSummary) ProtoMessage()    {}
+func (*OrderFillSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4c1f80fd81e0e2f, []int{0}
+}
+func (m *OrderFillSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderFillSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderFillSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderFillSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderFillSummary.Merge(m, src)
+}
+func (m *OrderFillSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderFillSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderFillSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderFillSummary proto.InternalMessageInfo
+
+func (m *OrderFillSummary) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+func (m *OrderFillSummary) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *OrderFillSummary) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
+func (m *OrderFillSummary) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
+}
+
+func (m *OrderFillSummary) GetAssetDenom() string {
+	if m != nil {
+		return m.AssetDenom
+	}
+	return ""
+}
+
+func (m *OrderFillSummary) GetPositionDirection() string {
+	if m != nil {
+		return m.PositionDirection
+	}
+	return ""
+}
+
+func (m *OrderFillSummary) GetOrderType() string {
+	if m != nil {
+		return m.OrderType
+	}
+	return ""
+}
+
+func (m *OrderFillSummary) GetFillCount() uint64 {
+	if m != nil {
+		return m.FillCount
+	}
+	return 0
+}
+
+func (m *OrderFillSummary) GetFirstFillHeight() uint64 {
+	if m != nil {
+		return m.FirstFillHeight
+	}
+	return 0
+}
+
+func (m *OrderFillSummary) GetLastFillHeight() uint64 {
+	if m != nil {
+		return m.LastFillHeight
+	}
+	return 0
+}
+
+func (m *OrderFillSummary) GetLastFillTimestamp() uint64 {
+	if m != nil {
+		return m.LastFillTimestamp
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*OrderFillSummary)(nil), "seiprotocol.seichain.dex.OrderFillSummary")
+}
+
+func init() { proto.RegisterFile("dex/fill.proto", fileDescriptor_e4c1f80fd81e0e2f) }
+
+var fileDescriptor_e4c1f80fd81e0e2f = []byte{
+	// 545 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x63, 0x28, 0x4d, 0x73, 0x2d, 0x49, 0x73, 0x14, 0x38, 0x31, 0xd8, 0x15, 0x12, 0x55,
+	0x07, 0x12, 0x0f, 0x2c, 0x0c, 0x95, 0x50, 0x43, 0x28, 0xb0, 0x80, 0x30, 0x9d, 0xba, 0x58, 0xd7,
+	0xbb, 0x4b, 0x72, 0xc2, 0xf6, 0x19, 0xdf, 0x45, 0x4a, 0xbe, 0x05, 0x1b, 0x5f, 0xa9, 0x63, 0x47,
+	0xc4, 0x70, 0x42, 0xc9, 0xe6, 0x4f, 0x81, 0xee, 0x25, 0x96, 0xdb, 0x74, 0x42, 0x4c, 0x79, 0xf9,
+	0xbf, 0xff, 0xef, 0xff, 0xa2, 0x97, 0x67, 0xa3, 0x36, 0x17, 0xb3, 0x70, 0x24, 0x93, 0xa4, 0x9f,
+	0x17, 0xca, 0x28, 0x4c, 0xb4, 0x90, 0x50, 0x31, 0x95, 0xf4, 0xb5, 0x90, 0x6c, 0x42, 0x65, 0xd6,
+	0xe7, 0x62, 0xf6, 0xec, 0x60, 0xac, 0xc6, 0x0a, 0x5a, 0xa1, 0xab, 0x56, 0xfe, 0xe7, 0x3f, 0x9b,
+	0x68, 0xff, 0x73, 0xc1, 0x45, 0x71, 0x26, 0x93, 0xe4, 0xeb, 0x34, 0x4d, 0x69, 0x31, 0xc7, 0x47,
+	0xa8, 0xa9, 0x9c, 0xf6, 0x91, 0x13, 0xef, 0xd0, 0x3b, 0xde, 0x1a, 0xec, 0x95, 0x36, 0xd8, 0x01,
+	0x29, 0x96, 0x3c, 0xaa, 0x9a, 0xf8, 0x05, 0x6a, 0x52, 0xc6, 0xd4, 0x34, 0x33, 0xe4, 0xde, 0xa1,
+	0x77, 0xdc, 0x1a, 0xec, 0x96, 0x36, 0xa8, 0xa4, 0xa8, 0x2a, 0xf0, 0x6b, 0xb4, 0xc7, 0x54, 0x66,
+	0x0a, 0xca, 0xcc, 0x29, 0xe7, 0x05, 0xb9, 0x0f, 0xde, 0x83, 0xd2, 0x06, 0xfb, 0x95, 0x1e, 0x53,
+	0xce, 0x0b, 0xa1, 0x75, 0x74, 0xcb, 0x89, 0x43, 0x84, 0xf2, 0x42, 0x32, 0x31, 0x14, 0x99, 0x4a,
+	0xc9, 0x16, 0x70, 0x9d, 0xd2, 0x06, 0xbb, 0xa0, 0xc6, 0xdc, 0xc9, 0xd1, 0x0d, 0x8b, 0x03, 0xa8,
+	0xd6, 0xc2, 0xac, 0x80, 0x07, 0x35, 0x00, 0x6a, 0x05, 0xd4, 0x16, 0x3c, 0x44, 0xdd, 0x5c, 0x69,
+	0x69, 0xa4, 0xca, 0x86, 0xb2, 0x10, 0xcc, 0x15, 0x64, 0x1b, 0xb8, 0x27, 0xa5, 0x0d, 0x70, 0xd5,
+	0x8c, 0x79, 0xd5, 0x8d, 0xee, 0x02, 0xf8, 0x25, 0x6a, 0xc1, 0x4e, 0xce, 0xe7, 0xb9, 0x20, 0x4d,
+	0xa0, 0xdb, 0xa5, 0x0d, 0xd0, 0x6a, 0x65, 0x66, 0x9e, 0x8b, 0xa8, 0x36, 0x60, 0x89, 0xda, 0xee,
+	0x1f, 0x13, 0xfc, 0xcb, 0x94, 0x66, 0x46, 0x9a, 0x39, 0xd9, 0x01, 0xe4, 0xf4, 0xca, 0x06, 0x8d,
+	0xdf, 0x36, 0x38, 0x1a, 0x4b, 0x33, 0x99, 0x5e, 0xf6, 0x99, 0x4a, 0x43, 0xa6, 0x74, 0xaa, 0xf4,
+	0xfa, 0xa3, 0xa7, 0xf9, 0xb7, 0xd0, 0xa5, 0xe9, 0xfe, 0x50, 0xb0, 0xd2, 0x06, 0x9d, 0x55, 0x4e,
+	0xfc, 0x7d, 0x1d, 0x14, 0x6d, 0x04, 0xd7, 0xa3, 0x3e, 0x29, 0xf7, 0x43, 0x69, 0x42, 0x5a, 0xff,
+	0x39, 0x2a, 0x5b, 0x07, 0x45, 0x1b, 0xc1, 0xf8, 0x02, 0xb5, 0x8c, 0x32, 0x34, 0x39, 0x13, 0x42,
+	0x13, 0x04, 0x53, 0x4e, 0xfe, 0x79, 0x0a, 0x82, 0x88, 0x78, 0x24, 0x84, 0x8e, 0xea, 0x38, 0xb7,
+	0x5f, 0x37, 0xed, 0x2d, 0x9c, 0xda, 0x2e, 0x9c, 0x24, 0xec, 0xd7, 0x89, 0xf1, 0xea, 0xda, 0x6a,
+	0x03, 0x7e, 0x83, 0x3a, 0x23, 0x59, 0x68, 0xe3, 0x4e, 0xfa, 0x83, 0x90, 0xe3, 0x89, 0x21, 0x7b,
+	0xc0, 0x3c, 0x2e, 0x6d, 0xd0, 0x85, 0x56, 0x0c, 0xe4, 0x04, 0x9a, 0xd1, 0xa6, 0x1b, 0x9f, 0xa0,
+	0x76, 0x42, 0x6f, 0xf1, 0x0f, 0x81, 0x87, 0x93, 0x4d, 0xe8, 0x06, 0xbe, 0xe1, 0xc5, 0xef, 0x50,
+	0xb7, 0x52, 0xce, 0x65, 0x2a, 0xb4, 0xa1, 0x69, 0x4e, 0xda, 0x10, 0xf0, 0xb4, 0xb4, 0xc1, 0xa3,
+	0x3a, 0xc0, 0x54, 0xed, 0xe8, 0x2e, 0x31, 0x78, 0x7f, 0xb5, 0xf0, 0xbd, 0xeb, 0x85, 0xef, 0xfd,
+	0x59, 0xf8, 0xde, 0x8f, 0xa5, 0xdf, 0xb8, 0x5e, 0xfa, 0x8d, 0x5f, 0x4b, 0xbf, 0x71, 0xd1, 0xbb,
+	0xb1, 0x4e, 0x2d, 0x64, 0xaf, 0x7a, 0xde, 0xe1, 0x0b, 0x3c, 0xf0, 0xe1, 0x2c, 0x74, 0xef, 0x05,
+	0xd8, 0xec, 0xe5, 0x36, 0xf4, 0x5f, 0xfd, 0x1d, 0x00, 0x06, 0xae, 0xe5, 0xa3, 0x2b, 0x04, 0x00,
+	0x00,
+}
+
+func (m *OrderFillSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderFillSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderFillSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastFillTimestamp != 0 {
+		i = encodeVarintFill(dAtA, i, uint64(m.LastFillTimestamp))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.LastFillHeight != 0 {
+		i = encodeVarintFill(dAtA, i, uint64(m.LastFillHeight))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.FirstFillHeight != 0 {
+		i = encodeVarintFill(dAtA, i, uint64(m.FirstFillHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.FillCount != 0 {
+		i = encodeVarintFill(dAtA, i, uint64(m.FillCount))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.TotalFees.Size()
+		i -= size
+		if _, err := m.TotalFees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFill(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.FilledNotional.Size()
+		i -= size
+		if _, err := m.FilledNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFill(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.FilledQuantity.Size()
+		i -= size
+		if _, err := m.FilledQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFill(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.OrderType) > 0 {
+		i -= len(m.OrderType)
+		copy(dAtA[i:], m.OrderType)
+		i = encodeVarintFill(dAtA, i, uint64(len(m.OrderType)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.PositionDirection) > 0 {
+		i -= len(m.PositionDirection)
+		copy(dAtA[i:], m.PositionDirection)
+		i = encodeVarintFill(dAtA, i, uint64(len(m.PositionDirection)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.AssetDenom) > 0 {
+		i -= len(m.AssetDenom)
+		copy(dAtA[i:], m.AssetDenom)
+		i = encodeVarintFill(dAtA, i, uint64(len(m.AssetDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintFill(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintFill(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintFill(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintFill(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFill(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFill(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OrderFillSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovFill(uint64(m.OrderId))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovFill(uint64(l))
+	}
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovFill(uint64(l))
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovFill(uint64(l))
+	}
+	l = len(m.AssetDenom)
+	if l > 0 {
+		n += 1 + l + sovFill(uint64(l))
+	}
+	l = len(m.PositionDirection)
+	if l > 0 {
+		n += 1 + l + sovFill(uint64(l))
+	}
+	l = len(m.OrderType)
+	if l > 0 {
+		n += 1 + l + sovFill(uint64(l))
+	}
+	l = m.FilledQuantity.Size()
+	n += 1 + l + sovFill(uint64(l))
+	l = m.FilledNotional.Size()
+	n += 1 + l + sovFill(uint64(l))
+	l = m.TotalFees.Size()
+	n += 1 + l + sovFill(uint64(l))
+	if m.FillCount != 0 {
+		n += 1 + sovFill(uint64(m.FillCount))
+	}
+	if m.FirstFillHeight != 0 {
+		n += 1 + sovFill(uint64(m.FirstFillHeight))
+	}
+	if m.LastFillHeight != 0 {
+		n += 1 + sovFill(uint64(m.LastFillHeight))
+	}
+	if m.LastFillTimestamp != 0 {
+		n += 1 + sovFill(uint64(m.LastFillTimestamp))
+	}
+	return n
+}
+
+func sovFill(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFill(x uint64) (n int) {
+	return sovFill(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *OrderFillSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFill
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderFillSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderFillSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFill
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFill
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFill
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFill
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFill
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFill
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFill
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFill
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFill
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFill
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFill
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFill
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFill
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionDirection", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFill
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFill
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFill
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PositionDirection = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFill
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFill
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFill
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilledQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFill
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFill
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFill
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FilledQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilledNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFill
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFill
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFill
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FilledNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFill
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFill
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFill
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FillCount", wireType)
+			}
+			m.FillCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFill
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FillCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstFillHeight", wireType)
+			}
+			m.FirstFillHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFill
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstFillHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFillHeight", wireType)
+			}
+			m.LastFillHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFill
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastFillHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFillTimestamp", wireType)
+			}
+			m.LastFillTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFill
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastFillTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFill(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFill
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFill(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFill
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFill
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFill
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFill
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFill
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFill
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFill        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFill          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFill = fmt.Errorf("proto: unexpected end of group")
+)
@@ -71,5 +71,21 @@ func (cs ContractState) Validate() error {
 		}
 		shortBookPriceMap[priceElem] = struct{}{}
 	}
+	// Check for duplication in trigger book
+	triggeredOrderIDMap := make(map[uint64]struct{})
+	for _, elem := range cs.TriggeredOrdersList {
+		if _, ok := triggeredOrderIDMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated order id for triggered order")
+		}
+		triggeredOrderIDMap[elem.Id] = struct{}{}
+	}
+	// Check for duplication in expiring orders
+	expiringOrderIDMap := make(map[uint64]struct{})
+	for _, elem := range cs.ExpiringOrdersList {
+		if _, ok := expiringOrderIDMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated order id for expiring order")
+		}
+		expiringOrderIDMap[elem.Id] = struct{}{}
+	}
 	return nil
 }
@@ -189,6 +189,62 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated triggered order",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ContractState: []types.ContractState{
+					{
+						TriggeredOrdersList: []types.Order{
+							{
+								Id:         1,
+								PriceDenom: "SEI",
+								AssetDenom: "ATOM",
+							},
+							{
+								Id:         1,
+								PriceDenom: "SEI",
+								AssetDenom: "ATOM",
+							},
+						},
+						ContractInfo: types.ContractInfoV2{
+							CodeId:       uint64(1),
+							ContractAddr: "sei14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sh9m79m",
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated expiring order",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ContractState: []types.ContractState{
+					{
+						ExpiringOrdersList: []types.Order{
+							{
+								Id:           1,
+								PriceDenom:   "SEI",
+								AssetDenom:   "ATOM",
+								ExpiryHeight: 1,
+							},
+							{
+								Id:           1,
+								PriceDenom:   "SEI",
+								AssetDenom:   "ATOM",
+								ExpiryHeight: 2,
+							},
+						},
+						ContractInfo: types.ContractInfoV2{
+							CodeId:       uint64(1),
+							ContractAddr: "sei14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sh9m79m",
+						},
+					},
+				},
+			},
+			valid: false,
+		},
 		{
 			desc: "invalid contract addr",
 			genState: &types.GenesisState{
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -9,14 +10,17 @@ import (
 )
 
 const (
-	ProposalTypeAddAssetMetadata = "AddAssetMetadata"
+	ProposalTypeAddAssetMetadata   = "AddAssetMetadata"
+	ProposalTypeUpdatePairFeeRates = "UpdatePairFeeRates"
 )
 
 func init() {
 	// for routing
 	govtypes.RegisterProposalType(ProposalTypeAddAssetMetadata)
+	govtypes.RegisterProposalType(ProposalTypeUpdatePairFeeRates)
 	// for marshal and unmarshal
 	govtypes.RegisterProposalTypeCodec(&AddAssetMetadataProposal{}, "dex/AddAssetMetadataProposal")
+	govtypes.RegisterProposalTypeCodec(&UpdatePairFeeRatesProposal{}, "dex/UpdatePairFeeRatesProposal")
 }
 
 func (p *AddAssetMetadataProposal) GetTitle() string { return p.Title }
@@ -55,3 +59,44 @@ func (p AddAssetMetadataProposal) String() string {
 `, p.Title, p.Description, assetRecords))
 	return b.String()
 }
+
+func (p *UpdatePairFeeRatesProposal) GetTitle() string { return p.Title }
+
+func (p *UpdatePairFeeRatesProposal) GetDescription() string { return p.Description }
+
+func (p *UpdatePairFeeRatesProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UpdatePairFeeRatesProposal) ProposalType() string {
+	return ProposalTypeUpdatePairFeeRates
+}
+
+func (p *UpdatePairFeeRatesProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(p.ContractAddr); err != nil {
+		return err
+	}
+	if len(p.Pairs) == 0 {
+		return errors.New("no pairs provided in update pair fee rates proposal")
+	}
+	for _, pair := range p.Pairs {
+		if err := pair.ValidateFeeRates(); err != nil {
+			return err
+		}
+	}
+
+	return govtypes.ValidateAbstract(p)
+}
+
+func (p UpdatePairFeeRatesProposal) String() string {
+	pairRecords := ""
+	for _, pair := range p.Pairs {
+		pairRecords += fmt.Sprintf("\n    %s/%s maker: %s taker: %s", pair.PriceDenom, pair.AssetDenom, pair.MakerFeeRate, pair.TakerFeeRate)
+	}
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Update Pair Fee Rates Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+  Pairs:       %s
+`, p.Title, p.Description, p.ContractAddr, pairRecords))
+	return b.String()
+}
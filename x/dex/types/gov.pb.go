@@ -63,34 +63,82 @@ func (m *AddAssetMetadataProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AddAssetMetadataProposal proto.InternalMessageInfo
 
+// UpdatePairFeeRatesProposal is a gov Content type for updating the maker and
+// taker fee rates of pairs registered by a contract. The rates are only charged
+// if the contract was registered with chargeFees.
+type UpdatePairFeeRatesProposal struct {
+	Title        string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description  string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	ContractAddr string `protobuf:"bytes,3,opt,name=contractAddr,proto3" json:"contractAddr,omitempty" yaml:"contract_addr"`
+	Pairs        []Pair `protobuf:"bytes,4,rep,name=pairs,proto3" json:"pairs" yaml:"pairs"`
+}
+
+func (m *UpdatePairFeeRatesProposal) Reset()      { *m = UpdatePairFeeRatesProposal{} }
+func (*UpdatePairFeeRatesProposal) ProtoMessage() {}
+func (*UpdatePairFeeRatesProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dab07ca1a96062d0, []int{1}
+}
+func (m *UpdatePairFeeRatesProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdatePairFeeRatesProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdatePairFeeRatesProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdatePairFeeRatesProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatePairFeeRatesProposal.Merge(m, src)
+}
+func (m *UpdatePairFeeRatesProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdatePairFeeRatesProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatePairFeeRatesProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdatePairFeeRatesProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*AddAssetMetadataProposal)(nil), "seiprotocol.seichain.dex.AddAssetMetadataProposal")
+	proto.RegisterType((*UpdatePairFeeRatesProposal)(nil), "seiprotocol.seichain.dex.UpdatePairFeeRatesProposal")
 }
 
 func init() { proto.RegisterFile("dex/gov.proto", fileDescriptor_dab07ca1a96062d0) }
 
 var fileDescriptor_dab07ca1a96062d0 = []byte{
-	// 307 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4d, 0x49, 0xad, 0xd0,
-	0x4f, 0xcf, 0x2f, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x28, 0x4e, 0xcd, 0x04, 0xb3,
-	0x92, 0xf3, 0x73, 0xf4, 0x8a, 0x53, 0x33, 0x93, 0x33, 0x12, 0x33, 0xf3, 0xf4, 0x52, 0x52, 0x2b,
-	0xa4, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x52, 0xfa, 0x20, 0x16, 0x44, 0xbd, 0x94, 0x08, 0x48,
-	0x7b, 0x62, 0x71, 0x71, 0x6a, 0x49, 0x7c, 0x4e, 0x66, 0x71, 0x09, 0x44, 0x54, 0xe9, 0x0d, 0x23,
-	0x97, 0x84, 0x63, 0x4a, 0x8a, 0x23, 0x48, 0xdc, 0x37, 0xb5, 0x24, 0x31, 0x25, 0xb1, 0x24, 0x31,
-	0xa0, 0x28, 0xbf, 0x20, 0xbf, 0x38, 0x31, 0x47, 0x48, 0x8d, 0x8b, 0xb5, 0x24, 0xb3, 0x24, 0x27,
-	0x55, 0x82, 0x51, 0x81, 0x51, 0x83, 0xd3, 0x49, 0xe0, 0xd3, 0x3d, 0x79, 0x9e, 0xca, 0xc4, 0xdc,
-	0x1c, 0x2b, 0x25, 0xb0, 0xb0, 0x52, 0x10, 0x44, 0x5a, 0xc8, 0x82, 0x8b, 0x3b, 0x25, 0xb5, 0x38,
-	0xb9, 0x28, 0xb3, 0xa0, 0x24, 0x33, 0x3f, 0x4f, 0x82, 0x09, 0xac, 0x5a, 0xec, 0xd3, 0x3d, 0x79,
-	0x21, 0x88, 0x6a, 0x24, 0x49, 0xa5, 0x20, 0x64, 0xa5, 0x42, 0x09, 0x5c, 0x9c, 0x60, 0x27, 0xf9,
-	0x64, 0x16, 0x97, 0x48, 0x30, 0x2b, 0x30, 0x6b, 0x70, 0x1b, 0xa9, 0xeb, 0xe1, 0xf2, 0x98, 0x1e,
-	0x8a, 0x2b, 0x9d, 0x24, 0x4f, 0xdc, 0x93, 0x67, 0xf8, 0x74, 0x4f, 0x5e, 0x10, 0x62, 0x09, 0xc2,
-	0x6b, 0x4a, 0x41, 0x08, 0x43, 0xad, 0x78, 0x3a, 0x16, 0xc8, 0x33, 0xcc, 0x58, 0x20, 0xcf, 0xf0,
-	0x62, 0x81, 0x3c, 0x83, 0x93, 0xfb, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78,
-	0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44,
-	0xe9, 0xa6, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x17, 0xa7, 0x66, 0xea,
-	0xc2, 0x5c, 0x00, 0xe6, 0x80, 0x9d, 0xa0, 0x5f, 0xa1, 0x0f, 0x0a, 0xc2, 0x92, 0xca, 0x82, 0xd4,
-	0xe2, 0x24, 0x36, 0xb0, 0xbc, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xce, 0xe9, 0x08, 0x1a, 0x95,
-	0x01, 0x00, 0x00,
+	// 400 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x52, 0x31, 0x8f, 0xda, 0x30,
+	0x14, 0x4e, 0xa0, 0x54, 0xc2, 0xd0, 0xaa, 0x8d, 0xa2, 0xca, 0x65, 0x88, 0x91, 0x87, 0x96, 0x85,
+	0x44, 0x6a, 0x97, 0x0a, 0x75, 0x21, 0x43, 0x2b, 0x55, 0xad, 0x84, 0x22, 0x75, 0xe9, 0x42, 0x4d,
+	0x6c, 0x05, 0x4b, 0x01, 0x47, 0xb6, 0x5b, 0xc1, 0x3f, 0xb8, 0xf1, 0xa6, 0xd3, 0x8d, 0xfc, 0x1c,
+	0x46, 0xc6, 0x9b, 0xa2, 0x13, 0x2c, 0x37, 0xdc, 0x94, 0x5f, 0x70, 0x8a, 0x03, 0x02, 0x06, 0xd6,
+	0xdb, 0xfc, 0xde, 0xf7, 0x7d, 0xef, 0xbd, 0xef, 0x4b, 0xc0, 0x2b, 0xca, 0x16, 0x41, 0x22, 0xfe,
+	0xfb, 0x99, 0x14, 0x5a, 0x38, 0x50, 0x31, 0x6e, 0x5e, 0xb1, 0x48, 0x7d, 0xc5, 0x78, 0x3c, 0x25,
+	0x7c, 0xee, 0x53, 0xb6, 0xe8, 0xb8, 0x89, 0x48, 0x84, 0x81, 0x82, 0xf2, 0x55, 0xf1, 0x3b, 0x6e,
+	0x29, 0x27, 0x4a, 0x31, 0x3d, 0x4e, 0xb9, 0xd2, 0xfb, 0xee, 0xeb, 0xb2, 0x9b, 0x11, 0x2e, 0xab,
+	0x1a, 0x3f, 0xda, 0x00, 0x0e, 0x29, 0x1d, 0x96, 0xbc, 0x5f, 0x4c, 0x13, 0x4a, 0x34, 0x19, 0x49,
+	0x91, 0x09, 0x45, 0x52, 0xe7, 0x03, 0x68, 0x68, 0xae, 0x53, 0x06, 0xed, 0xae, 0xdd, 0x6b, 0x86,
+	0x6f, 0x8a, 0x1c, 0xb5, 0x97, 0x64, 0x96, 0x0e, 0xb0, 0x69, 0xe3, 0xa8, 0x82, 0x9d, 0x2f, 0xa0,
+	0x45, 0x99, 0x8a, 0x25, 0xcf, 0x34, 0x17, 0x73, 0x58, 0x33, 0xec, 0x77, 0x45, 0x8e, 0x9c, 0x8a,
+	0x7d, 0x02, 0xe2, 0xe8, 0x94, 0xea, 0xfc, 0x05, 0x4d, 0x73, 0xe2, 0x4f, 0xae, 0x34, 0xac, 0x77,
+	0xeb, 0xbd, 0xd6, 0xa7, 0x8f, 0xfe, 0x25, 0xa3, 0xfe, 0xd9, 0x95, 0xe1, 0xfb, 0x75, 0x8e, 0xac,
+	0x22, 0x47, 0x6f, 0xab, 0x25, 0x47, 0xab, 0x38, 0x3a, 0x0e, 0x1d, 0xb4, 0xaf, 0x56, 0xc8, 0xba,
+	0x5d, 0x21, 0xeb, 0x61, 0x85, 0x2c, 0x7c, 0x53, 0x03, 0x9d, 0xdf, 0x19, 0x25, 0x9a, 0x8d, 0x08,
+	0x97, 0xdf, 0x18, 0x8b, 0x88, 0x66, 0xea, 0x19, 0x0d, 0x7f, 0x05, 0xed, 0x58, 0xcc, 0xb5, 0x24,
+	0xb1, 0x1e, 0x52, 0x2a, 0x61, 0xdd, 0x48, 0x61, 0x91, 0x23, 0xb7, 0x92, 0x1e, 0xd0, 0x31, 0xa1,
+	0x54, 0xe2, 0xe8, 0x8c, 0xed, 0xfc, 0x00, 0x8d, 0xf2, 0xdb, 0x29, 0xf8, 0xc2, 0x44, 0xe5, 0x5d,
+	0x8e, 0xaa, 0xb4, 0x17, 0xba, 0xfb, 0x84, 0xf6, 0x1e, 0x8c, 0x14, 0x47, 0xd5, 0x88, 0xf3, 0x60,
+	0xc2, 0xef, 0xeb, 0xad, 0x67, 0x6f, 0xb6, 0x9e, 0x7d, 0xbf, 0xf5, 0xec, 0xeb, 0x9d, 0x67, 0x6d,
+	0x76, 0x9e, 0x75, 0xb7, 0xf3, 0xac, 0x3f, 0xfd, 0x84, 0xeb, 0xe9, 0xbf, 0x89, 0x1f, 0x8b, 0x59,
+	0xa0, 0x18, 0xef, 0x1f, 0xf6, 0x99, 0xc2, 0x2c, 0x0c, 0x16, 0x41, 0xf9, 0x57, 0xe9, 0x65, 0xc6,
+	0xd4, 0xe4, 0xa5, 0xc1, 0x3f, 0x3f, 0x0d, 0x00, 0x52, 0xbf, 0x6a, 0x00, 0xbe, 0x02, 0x00, 0x00,
 }
 
 func (m *AddAssetMetadataProposal) Marshal() (dAtA []byte, err error) {
@@ -144,6 +192,64 @@ func (m *AddAssetMetadataProposal) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *UpdatePairFeeRatesProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdatePairFeeRatesProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdatePairFeeRatesProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pairs) > 0 {
+		for iNdEx := len(m.Pairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -178,6 +284,33 @@ func (m *AddAssetMetadataProposal) Size() (n int) {
 	return n
 }
 
+func (m *UpdatePairFeeRatesProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Pairs) > 0 {
+		for _, e := range m.Pairs {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGov(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -332,6 +465,186 @@ func (m *AddAssetMetadataProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UpdatePairFeeRatesProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdatePairFeeRatesProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdatePairFeeRatesProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pairs = append(m.Pairs, Pair{})
+			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGov(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -65,6 +65,14 @@ func OrderBookContractPrefix(long bool, contractAddr string) []byte {
 	return append(prefix, AddressKeyPrefix(contractAddr)...)
 }
 
+// `TriggerBook` constant + contract + price denom + asset denom
+func TriggerOrderBookPrefix(contractAddr string, priceDenom string, assetDenom string) []byte {
+	return append(
+		ContractKeyPrefix(TriggerBookKey, contractAddr),
+		PairPrefix(priceDenom, assetDenom)...,
+	)
+}
+
 // `Price` constant + contract + price denom + asset denom
 func PricePrefix(contractAddr string, priceDenom string, assetDenom string) []byte {
 	return append(
@@ -73,10 +81,38 @@ func PricePrefix(contractAddr string, priceDenom string, assetDenom string) []by
 	)
 }
 
+// `Candle` constant + contract + price denom + asset denom + interval
+func CandlePrefix(contractAddr string, priceDenom string, assetDenom string, intervalInSeconds uint64) []byte {
+	return append(append(
+		ContractKeyPrefix(CandleKey, contractAddr),
+		PairPrefix(priceDenom, assetDenom)...,
+	), sdk.Uint64ToBigEndian(intervalInSeconds)...)
+}
+
 func PriceContractPrefix(contractAddr string) []byte {
 	return append(KeyPrefix(PriceKey), AddressKeyPrefix(contractAddr)...)
 }
 
+// `Fill` constant + account + contract
+func AccountFillPrefix(account string, contractAddr string) []byte {
+	return append(ContractKeyPrefix(FillKey, account), AddressKeyPrefix(contractAddr)...)
+}
+
+// `Fill` constant + account + contract + price denom + asset denom
+func AccountFillPairPrefix(account string, contractAddr string, priceDenom string, assetDenom string) []byte {
+	return append(AccountFillPrefix(account, contractAddr), PairPrefix(priceDenom, assetDenom)...)
+}
+
+// `OrderFillSummary` constant + account + contract
+func OrderFillSummaryPrefix(account string, contractAddr string) []byte {
+	return append(ContractKeyPrefix(OrderFillSummaryKey, account), AddressKeyPrefix(contractAddr)...)
+}
+
+// `OrderFillSummary` constant + account + contract + price denom + asset denom
+func OrderFillSummaryPairPrefix(account string, contractAddr string, priceDenom string, assetDenom string) []byte {
+	return append(OrderFillSummaryPrefix(account, contractAddr), PairPrefix(priceDenom, assetDenom)...)
+}
+
 func RegisteredPairPrefix(contractAddr string) []byte {
 	return append(KeyPrefix(RegisteredPairKey), AddressKeyPrefix(contractAddr)...)
 }
@@ -167,12 +203,20 @@ const (
 
 	ShortBookKey = "ShortBook-value-"
 
+	TriggerBookKey = "TriggerBook-value-"
+
+	OrderExpiryKey = "OrderExpiry-value-"
+
 	OrderKey               = "order"
 	AccountActiveOrdersKey = "account-active-orders"
 	CancelKey              = "cancel"
 
 	TwapKey             = "TWAP-"
 	PriceKey            = "Price-"
+	CandleKey           = "Candle-"
+	FillKey             = "Fill-"
+	FillTimestampKey    = "FillTimestamp-"
+	OrderFillSummaryKey = "OrderFillSummary-"
 	SettlementEntryKey  = "SettlementEntry-"
 	NextSettlementIDKey = "NextSettlementID-"
 	NextOrderIDKey      = "noid"
@@ -73,11 +73,23 @@ func (msg *MsgPlaceOrders) ValidateBasic() error {
 		if len(order.PriceDenom) == 0 || sdk.ValidateDenom(order.PriceDenom) != nil {
 			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid order, price denom is empty or invalid (%s)", err)
 		}
-		if order.OrderType == OrderType_FOKMARKETBYVALUE || order.OrderType == OrderType_FOKMARKET {
-			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "FOK orders are temporarily disabled")
+		if order.OrderType == OrderType_FOKMARKETBYVALUE && (order.Nominal.IsNil() || !order.Nominal.IsPositive()) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid nominal for fill-or-kill market order by value")
 		}
 		if order.OrderType == OrderType_STOPLIMIT || order.OrderType == OrderType_STOPLOSS {
-			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "stop loss/limit order (%s) are not supported yet", err)
+			if order.TriggerPrice.IsNil() || !order.TriggerPrice.IsPositive() {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid trigger price for stop loss/limit order")
+			}
+			if order.OrderType == OrderType_STOPLIMIT && order.Price.IsZero() {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "stop limit order must have a non-zero limit price")
+			}
+		}
+		isLimitOrder := order.OrderType == OrderType_LIMIT || order.OrderType == OrderType_STOPLIMIT
+		if (order.ImmediateOrCancel || order.PostOnly || order.ExpiryHeight > 0 || order.ExpiryTimestamp > 0) && !isLimitOrder {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "time in force options are only supported for limit orders")
+		}
+		if order.ImmediateOrCancel && order.PostOnly {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "an order cannot be both immediate-or-cancel and post-only")
 		}
 	}
 
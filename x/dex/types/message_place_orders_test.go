@@ -99,4 +99,101 @@ func TestValidateMsgPlaceOrder(t *testing.T) {
 		},
 	}
 	require.Error(t, msg.ValidateBasic())
+
+	// Stop orders require a positive trigger price
+	for _, orderType := range []types.OrderType{types.OrderType_STOPLOSS, types.OrderType_STOPLIMIT} {
+		order := &types.Order{
+			Id:           1,
+			Account:      "test",
+			ContractAddr: TEST_CONTRACT,
+			Quantity:     sdk.OneDec(),
+			Price:        sdk.OneDec(),
+			AssetDenom:   "denom1",
+			PriceDenom:   "denom2",
+			OrderType:    orderType,
+		}
+		msg = &types.MsgPlaceOrders{
+			Creator:      "sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx",
+			ContractAddr: TEST_CONTRACT,
+			Orders:       []*types.Order{order},
+		}
+		require.Error(t, msg.ValidateBasic())
+		order.TriggerPrice = sdk.ZeroDec()
+		require.Error(t, msg.ValidateBasic())
+		order.TriggerPrice = sdk.NewDec(2)
+		require.NoError(t, msg.ValidateBasic())
+	}
+	// Stop limit orders require a limit price
+	msg = &types.MsgPlaceOrders{
+		Creator:      "sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx",
+		ContractAddr: TEST_CONTRACT,
+		Orders: []*types.Order{
+			{
+				Id:           1,
+				Account:      "test",
+				ContractAddr: TEST_CONTRACT,
+				Quantity:     sdk.OneDec(),
+				Price:        sdk.ZeroDec(),
+				AssetDenom:   "denom1",
+				PriceDenom:   "denom2",
+				OrderType:    types.OrderType_STOPLIMIT,
+				TriggerPrice: sdk.NewDec(2),
+			},
+		},
+	}
+	require.Error(t, msg.ValidateBasic())
+
+	// FOK orders by value require a positive nominal
+	fokOrder := &types.Order{
+		Id:           1,
+		Account:      "test",
+		ContractAddr: TEST_CONTRACT,
+		Quantity:     sdk.OneDec(),
+		Price:        sdk.OneDec(),
+		AssetDenom:   "denom1",
+		PriceDenom:   "denom2",
+		OrderType:    types.OrderType_FOKMARKETBYVALUE,
+	}
+	msg = &types.MsgPlaceOrders{
+		Creator:      "sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx",
+		ContractAddr: TEST_CONTRACT,
+		Orders:       []*types.Order{fokOrder},
+	}
+	require.Error(t, msg.ValidateBasic())
+	fokOrder.Nominal = sdk.ZeroDec()
+	require.Error(t, msg.ValidateBasic())
+	fokOrder.Nominal = sdk.NewDec(10)
+	require.NoError(t, msg.ValidateBasic())
+	fokOrder.OrderType = types.OrderType_FOKMARKET
+	require.NoError(t, msg.ValidateBasic())
+
+	// Time in force options are only supported for limit orders
+	tifOrder := &types.Order{
+		Id:           1,
+		Account:      "test",
+		ContractAddr: TEST_CONTRACT,
+		Quantity:     sdk.OneDec(),
+		Price:        sdk.OneDec(),
+		AssetDenom:   "denom1",
+		PriceDenom:   "denom2",
+		OrderType:    types.OrderType_LIMIT,
+		ExpiryHeight: 10,
+		PostOnly:     true,
+	}
+	msg = &types.MsgPlaceOrders{
+		Creator:      "sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx",
+		ContractAddr: TEST_CONTRACT,
+		Orders:       []*types.Order{tifOrder},
+	}
+	require.NoError(t, msg.ValidateBasic())
+	tifOrder.ImmediateOrCancel = true
+	require.Error(t, msg.ValidateBasic())
+	tifOrder.PostOnly = false
+	require.NoError(t, msg.ValidateBasic())
+	tifOrder.OrderType = types.OrderType_MARKET
+	require.Error(t, msg.ValidateBasic())
+	tifOrder.ImmediateOrCancel = false
+	require.Error(t, msg.ValidateBasic())
+	tifOrder.ExpiryHeight = 0
+	require.NoError(t, msg.ValidateBasic())
 }
@@ -76,6 +76,9 @@ func (msg *MsgRegisterPairs) ValidateBasic() error {
 			if pair == nil {
 				return errors.New("empty pair info")
 			}
+			if err := pair.ValidateFeeRates(); err != nil {
+				return err
+			}
 		}
 	}
 
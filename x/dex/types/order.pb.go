@@ -40,6 +40,16 @@ type Order struct {
 	Nominal           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=nominal,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"nominal" yaml:"nominal"`
 	TriggerPrice      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=triggerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_price" yaml:"trigger_price"`
 	TriggerStatus     bool                                   `protobuf:"varint,15,opt,name=triggerStatus,proto3" json:"trigger_status"`
+	// an order that is still resting in the order book at or after its expiry
+	// height/timestamp will be cancelled in EndBlock. Zero means no expiry.
+	ExpiryHeight    uint64 `protobuf:"varint,16,opt,name=expiryHeight,proto3" json:"expiry_height"`
+	ExpiryTimestamp uint64 `protobuf:"varint,17,opt,name=expiryTimestamp,proto3" json:"expiry_timestamp"`
+	// any quantity of an immediate-or-cancel order that isn't filled in the block
+	// it's placed will be cancelled instead of resting in the order book
+	ImmediateOrCancel bool `protobuf:"varint,18,opt,name=immediateOrCancel,proto3" json:"immediate_or_cancel"`
+	// a post-only order will be cancelled if it would match against the order book,
+	// including limit orders placed in the same block
+	PostOnly bool `protobuf:"varint,19,opt,name=postOnly,proto3" json:"post_only"`
 }
 
 func (m *Order) Reset()         { *m = Order{} }
@@ -152,6 +162,34 @@ func (m *Order) GetTriggerStatus() bool {
 	return false
 }
 
+func (m *Order) GetExpiryHeight() uint64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *Order) GetExpiryTimestamp() uint64 {
+	if m != nil {
+		return m.ExpiryTimestamp
+	}
+	return 0
+}
+
+func (m *Order) GetImmediateOrCancel() bool {
+	if m != nil {
+		return m.ImmediateOrCancel
+	}
+	return false
+}
+
+func (m *Order) GetPostOnly() bool {
+	if m != nil {
+		return m.PostOnly
+	}
+	return false
+}
+
 type Cancellation struct {
 	Id                uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
 	Initiator         CancellationInitiator                  `protobuf:"varint,2,opt,name=initiator,proto3,enum=seiprotocol.seichain.dex.CancellationInitiator" json:"initiator"`
@@ -298,54 +336,60 @@ func init() {
 func init() { proto.RegisterFile("dex/order.proto", fileDescriptor_c2d5fab85368797d) }
 
 var fileDescriptor_c2d5fab85368797d = []byte{
-	// 737 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x6a, 0xdb, 0x4c,
-	0x14, 0xb5, 0x1c, 0xff, 0x4e, 0x1c, 0xfb, 0xcb, 0x10, 0x82, 0xbe, 0x50, 0x2c, 0xa3, 0xd2, 0xe2,
-	0x50, 0x62, 0x41, 0xbb, 0x09, 0xa5, 0x9b, 0xa8, 0x86, 0x50, 0x4a, 0x68, 0x3a, 0x2d, 0x14, 0x4a,
-	0x8b, 0x51, 0x34, 0x83, 0x33, 0xd4, 0xd6, 0x38, 0x9a, 0x71, 0x89, 0xe9, 0x4b, 0xf4, 0x05, 0xfa,
-	0x3e, 0xd9, 0x35, 0xcb, 0xd2, 0x85, 0x28, 0xc9, 0x4e, 0xcb, 0x3c, 0x41, 0xd1, 0x95, 0x26, 0xb6,
-	0xf3, 0x43, 0xea, 0x45, 0x36, 0xd6, 0x9d, 0x73, 0xcf, 0x39, 0x57, 0x9a, 0x99, 0x7b, 0x8d, 0x1a,
-	0x94, 0x1d, 0x3b, 0x22, 0xa4, 0x2c, 0xec, 0x8c, 0x42, 0xa1, 0x04, 0x36, 0x25, 0xe3, 0x10, 0xf9,
-	0x62, 0xd0, 0x91, 0x8c, 0xfb, 0x87, 0x1e, 0x0f, 0x3a, 0x94, 0x1d, 0x6f, 0xac, 0xf5, 0x45, 0x5f,
-	0x40, 0xca, 0x49, 0xa2, 0x94, 0xbf, 0x01, 0x06, 0x2c, 0x18, 0x0f, 0x65, 0x0a, 0xd8, 0x3f, 0x2b,
-	0xa8, 0xf8, 0x26, 0x31, 0xc4, 0x1b, 0x28, 0xcf, 0xa9, 0x69, 0xb4, 0x8c, 0x76, 0xc1, 0x45, 0x27,
-	0x91, 0x65, 0xc4, 0x91, 0x95, 0xe7, 0x94, 0xe4, 0x39, 0xc5, 0x7b, 0xa8, 0x24, 0x95, 0xa7, 0xc6,
-	0xd2, 0xcc, 0xb7, 0x8c, 0x76, 0xfd, 0xe9, 0xa3, 0xce, 0x6d, 0x75, 0x3b, 0x60, 0xf6, 0x0e, 0xc8,
-	0x6e, 0x3d, 0xb3, 0xc9, 0xc4, 0x24, 0x7b, 0xe2, 0x4d, 0x54, 0xf6, 0x7c, 0x5f, 0x8c, 0x03, 0x65,
-	0x2e, 0xb5, 0x8c, 0x76, 0xd5, 0x6d, 0x64, 0x44, 0x0d, 0x13, 0x1d, 0xe0, 0x17, 0xa8, 0xe6, 0x8b,
-	0x40, 0x85, 0x9e, 0xaf, 0x76, 0x28, 0x0d, 0xcd, 0x02, 0xf0, 0xcd, 0x8c, 0xff, 0x9f, 0xce, 0xf5,
-	0x3c, 0x4a, 0x43, 0x26, 0x25, 0x99, 0x63, 0xe3, 0xcf, 0xa8, 0x38, 0x0a, 0xb9, 0xcf, 0xcc, 0x22,
-	0xc8, 0x76, 0x4f, 0x22, 0x2b, 0xf7, 0x3b, 0xb2, 0x1e, 0xf7, 0xb9, 0x3a, 0x1c, 0x1f, 0x74, 0x7c,
-	0x31, 0x74, 0x7c, 0x21, 0x87, 0x42, 0x66, 0x8f, 0x2d, 0x49, 0xbf, 0x38, 0x6a, 0x32, 0x62, 0xb2,
-	0xd3, 0x65, 0x7e, 0x1c, 0x59, 0xa9, 0xfc, 0x22, 0xb2, 0x6a, 0x13, 0x6f, 0x38, 0x78, 0x6e, 0xc3,
-	0xd2, 0x26, 0x29, 0x8c, 0x39, 0xaa, 0x1c, 0x8d, 0xbd, 0x40, 0x71, 0x35, 0x31, 0x4b, 0x50, 0x61,
-	0x6f, 0xe1, 0x0a, 0x97, 0x0e, 0x17, 0x91, 0xd5, 0x48, 0x8b, 0x68, 0xc4, 0x26, 0x97, 0x49, 0xec,
-	0x20, 0x04, 0x35, 0xbb, 0x2c, 0x10, 0x43, 0xb3, 0x9c, 0xee, 0x5a, 0x1c, 0x59, 0xcb, 0x80, 0xf6,
-	0x68, 0x02, 0x93, 0x19, 0x4a, 0x22, 0xf0, 0xa4, 0x64, 0x2a, 0x15, 0x54, 0xa6, 0x02, 0x40, 0xb5,
-	0x60, 0x4a, 0xc1, 0x6f, 0x51, 0x15, 0x6e, 0xd6, 0xfb, 0xc9, 0x88, 0x99, 0x55, 0x38, 0xe6, 0x87,
-	0x77, 0x1c, 0x73, 0x42, 0x75, 0xeb, 0x71, 0x64, 0x21, 0x50, 0xf6, 0x92, 0xef, 0x22, 0x53, 0x17,
-	0x7c, 0x84, 0x56, 0x47, 0x42, 0x72, 0xc5, 0x45, 0xd0, 0xe5, 0x21, 0xf3, 0x93, 0xc0, 0x44, 0x60,
-	0xfd, 0xe4, 0x76, 0xeb, 0xfd, 0xab, 0x12, 0x77, 0x3d, 0x8e, 0x2c, 0xac, 0x9d, 0x7a, 0x54, 0xe3,
-	0xe4, 0xba, 0x3b, 0x7e, 0x80, 0x0a, 0xd4, 0x53, 0x9e, 0xb9, 0x0c, 0x1f, 0x5c, 0x89, 0x23, 0x0b,
-	0xd6, 0x04, 0x7e, 0x71, 0x17, 0xad, 0xa6, 0x57, 0xb0, 0xcb, 0xa4, 0x1f, 0xf2, 0x11, 0xbc, 0x50,
-	0x0d, 0xa8, 0x50, 0x23, 0x4d, 0xf6, 0xe8, 0x34, 0x4b, 0xae, 0x0b, 0x30, 0x43, 0xe5, 0x40, 0x0c,
-	0x79, 0xe0, 0x0d, 0xcc, 0x15, 0xd0, 0xbe, 0x5e, 0xf8, 0xd4, 0xb5, 0xc1, 0x45, 0x64, 0xd5, 0xd3,
-	0x43, 0xcf, 0x00, 0x9b, 0xe8, 0x14, 0xfe, 0x86, 0x6a, 0x2a, 0xe4, 0xfd, 0x3e, 0x0b, 0xf7, 0xe1,
-	0x0e, 0xd7, 0xa1, 0xd6, 0x87, 0x85, 0x6b, 0xad, 0x64, 0x2e, 0x3d, 0x7d, 0x97, 0xd7, 0xd2, 0x8a,
-	0x73, 0xb0, 0x4d, 0xe6, 0x8a, 0xe1, 0x6d, 0xa4, 0x65, 0x69, 0x2f, 0x9b, 0x8d, 0x96, 0xd1, 0xae,
-	0xb8, 0x38, 0x8e, 0xac, 0xba, 0x16, 0x66, 0x5d, 0x3d, 0x4f, 0xb4, 0x7f, 0x14, 0x50, 0xed, 0xa5,
-	0x17, 0xf8, 0x6c, 0x30, 0xf0, 0x60, 0xbb, 0xd6, 0x67, 0x06, 0x4b, 0x69, 0x66, 0xa8, 0x7c, 0x42,
-	0x55, 0x1e, 0x70, 0xc5, 0x3d, 0x25, 0xc2, 0x6c, 0xae, 0x38, 0xb7, 0xdf, 0x8a, 0x59, 0xcb, 0x57,
-	0x5a, 0xe6, 0xae, 0xc4, 0x91, 0x35, 0x75, 0x21, 0xd3, 0x30, 0x99, 0x31, 0x7e, 0xc8, 0xc0, 0xfb,
-	0xca, 0x8c, 0xc9, 0x60, 0xa2, 0x03, 0xbc, 0x7d, 0xe3, 0x8c, 0x59, 0xfb, 0x87, 0xf9, 0x32, 0xdf,
-	0x95, 0xc5, 0x45, 0xbb, 0xb2, 0x74, 0x77, 0x57, 0xde, 0xd8, 0x42, 0xe5, 0x7b, 0x6d, 0xa1, 0xcb,
-	0xa1, 0x59, 0xb9, 0x8f, 0xa1, 0x69, 0x6f, 0xa2, 0xda, 0x8e, 0xaf, 0xf8, 0x57, 0x06, 0x23, 0x44,
-	0xe2, 0xff, 0xd1, 0x12, 0xa7, 0xd2, 0x34, 0x5a, 0x4b, 0xed, 0x82, 0x5b, 0x8e, 0x23, 0x2b, 0x59,
-	0x92, 0xe4, 0xc7, 0xdd, 0x3d, 0x39, 0x6b, 0x1a, 0xa7, 0x67, 0x4d, 0xe3, 0xcf, 0x59, 0xd3, 0xf8,
-	0x7e, 0xde, 0xcc, 0x9d, 0x9e, 0x37, 0x73, 0xbf, 0xce, 0x9b, 0xb9, 0x8f, 0x5b, 0x33, 0x2f, 0x23,
-	0x19, 0xdf, 0xd2, 0xdb, 0x00, 0x0b, 0xd8, 0x07, 0xe7, 0xd8, 0x49, 0xfe, 0xeb, 0xe0, 0xbd, 0x0e,
-	0x4a, 0x90, 0x7f, 0xf6, 0x37, 0x00, 0x00, 0xff, 0xff, 0xa3, 0x4e, 0x8b, 0xbe, 0x40, 0x07, 0x00,
-	0x00,
+	// 846 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xdd, 0x6a, 0xe3, 0x46,
+	0x18, 0x8d, 0x12, 0xc7, 0x3f, 0xb3, 0x8e, 0xbd, 0x9e, 0x0d, 0xdb, 0x69, 0x28, 0x96, 0x71, 0x69,
+	0x71, 0x28, 0xb1, 0xa1, 0xa5, 0xb0, 0x94, 0x52, 0x58, 0xd7, 0x65, 0x5b, 0xca, 0x92, 0xed, 0x74,
+	0xa1, 0x50, 0x5a, 0x84, 0x56, 0x33, 0x38, 0x43, 0x2d, 0x8d, 0x56, 0x33, 0x2e, 0x31, 0x7d, 0x89,
+	0xbe, 0x40, 0xdf, 0xa3, 0x8f, 0x90, 0xcb, 0xbd, 0x2c, 0xbd, 0x18, 0x4a, 0x72, 0xa7, 0xcb, 0x3c,
+	0x41, 0xd1, 0x37, 0x1a, 0xff, 0x24, 0x1b, 0x76, 0x7d, 0xb1, 0x37, 0xd2, 0xcc, 0xf9, 0xce, 0x39,
+	0xdf, 0x48, 0x33, 0x3a, 0x42, 0x6d, 0xc6, 0xcf, 0x47, 0x32, 0x63, 0x3c, 0x1b, 0xa6, 0x99, 0xd4,
+	0x12, 0x13, 0xc5, 0x05, 0x8c, 0x22, 0x39, 0x1b, 0x2a, 0x2e, 0xa2, 0xb3, 0x50, 0x24, 0x43, 0xc6,
+	0xcf, 0x8f, 0x0e, 0xa7, 0x72, 0x2a, 0xa1, 0x34, 0x2a, 0x46, 0x96, 0x7f, 0x04, 0x06, 0x3c, 0x99,
+	0xc7, 0xca, 0x02, 0xfd, 0xbf, 0x11, 0xda, 0x3f, 0x2d, 0x0c, 0xf1, 0x11, 0xda, 0x15, 0x8c, 0x78,
+	0x3d, 0x6f, 0x50, 0x19, 0xa3, 0x0b, 0xe3, 0x7b, 0xb9, 0xf1, 0x77, 0x05, 0xa3, 0xbb, 0x82, 0xe1,
+	0xa7, 0xa8, 0xaa, 0x74, 0xa8, 0xe7, 0x8a, 0xec, 0xf6, 0xbc, 0x41, 0xeb, 0xd3, 0x8f, 0x86, 0x77,
+	0xf5, 0x1d, 0x82, 0xd9, 0x8f, 0x40, 0x1e, 0xb7, 0x4a, 0x9b, 0x52, 0x4c, 0xcb, 0x3b, 0x3e, 0x46,
+	0xb5, 0x30, 0x8a, 0xe4, 0x3c, 0xd1, 0x64, 0xaf, 0xe7, 0x0d, 0x1a, 0xe3, 0x76, 0x49, 0x74, 0x30,
+	0x75, 0x03, 0xfc, 0x25, 0x6a, 0x46, 0x32, 0xd1, 0x59, 0x18, 0xe9, 0xc7, 0x8c, 0x65, 0xa4, 0x02,
+	0x7c, 0x52, 0xf2, 0xef, 0xbb, 0x5a, 0x10, 0x32, 0x96, 0x71, 0xa5, 0xe8, 0x06, 0x1b, 0xff, 0x8a,
+	0xf6, 0xd3, 0x4c, 0x44, 0x9c, 0xec, 0x83, 0xec, 0xc9, 0x85, 0xf1, 0x77, 0xfe, 0x35, 0xfe, 0xc7,
+	0x53, 0xa1, 0xcf, 0xe6, 0x2f, 0x86, 0x91, 0x8c, 0x47, 0x91, 0x54, 0xb1, 0x54, 0xe5, 0xed, 0x44,
+	0xb1, 0xdf, 0x46, 0x7a, 0x91, 0x72, 0x35, 0x9c, 0xf0, 0x28, 0x37, 0xbe, 0x95, 0x5f, 0x1b, 0xbf,
+	0xb9, 0x08, 0xe3, 0xd9, 0x17, 0x7d, 0x98, 0xf6, 0xa9, 0x85, 0xb1, 0x40, 0xf5, 0x97, 0xf3, 0x30,
+	0xd1, 0x42, 0x2f, 0x48, 0x15, 0x3a, 0x3c, 0xdd, 0xba, 0xc3, 0xd2, 0xe1, 0xda, 0xf8, 0x6d, 0xdb,
+	0xc4, 0x21, 0x7d, 0xba, 0x2c, 0xe2, 0x11, 0x42, 0xd0, 0x73, 0xc2, 0x13, 0x19, 0x93, 0x9a, 0x7d,
+	0x6b, 0xb9, 0xf1, 0xef, 0x01, 0x1a, 0xb0, 0x02, 0xa6, 0x6b, 0x94, 0x42, 0x10, 0x2a, 0xc5, 0xb5,
+	0x15, 0xd4, 0x57, 0x02, 0x40, 0x9d, 0x60, 0x45, 0xc1, 0x3f, 0xa0, 0x06, 0x9c, 0xac, 0xe7, 0x8b,
+	0x94, 0x93, 0x06, 0x6c, 0xf3, 0x87, 0x6f, 0xd8, 0xe6, 0x82, 0x3a, 0x6e, 0xe5, 0xc6, 0x47, 0xa0,
+	0x0c, 0x8a, 0xe7, 0xa2, 0x2b, 0x17, 0xfc, 0x12, 0x75, 0x52, 0xa9, 0x84, 0x16, 0x32, 0x99, 0x88,
+	0x8c, 0x47, 0xc5, 0x80, 0x20, 0xb0, 0xfe, 0xe4, 0x6e, 0xeb, 0x67, 0x37, 0x25, 0xe3, 0x87, 0xb9,
+	0xf1, 0xb1, 0x73, 0x0a, 0x98, 0xc3, 0xe9, 0x6d, 0x77, 0xfc, 0x01, 0xaa, 0xb0, 0x50, 0x87, 0xe4,
+	0x1e, 0x3c, 0x70, 0x3d, 0x37, 0x3e, 0xcc, 0x29, 0x5c, 0xf1, 0x04, 0x75, 0xec, 0x11, 0x9c, 0x70,
+	0x15, 0x65, 0x22, 0x85, 0x05, 0x35, 0x81, 0x0a, 0x3d, 0x6c, 0x31, 0x60, 0xab, 0x2a, 0xbd, 0x2d,
+	0xc0, 0x1c, 0xd5, 0x12, 0x19, 0x8b, 0x24, 0x9c, 0x91, 0x03, 0xd0, 0x7e, 0xbf, 0xf5, 0xae, 0x3b,
+	0x83, 0x6b, 0xe3, 0xb7, 0xec, 0xa6, 0x97, 0x40, 0x9f, 0xba, 0x12, 0xfe, 0x03, 0x35, 0x75, 0x26,
+	0xa6, 0x53, 0x9e, 0x3d, 0x83, 0x33, 0xdc, 0x82, 0x5e, 0x3f, 0x6d, 0xdd, 0xeb, 0xa0, 0x74, 0x09,
+	0xdc, 0x59, 0x3e, 0xb4, 0x1d, 0x37, 0xe0, 0x3e, 0xdd, 0x68, 0x86, 0x1f, 0x21, 0x27, 0xb3, 0xdf,
+	0x32, 0x69, 0xf7, 0xbc, 0x41, 0x7d, 0x8c, 0x73, 0xe3, 0xb7, 0x9c, 0xb0, 0xfc, 0xaa, 0x37, 0x89,
+	0xf8, 0x73, 0xd4, 0xe4, 0xe7, 0xa9, 0xc8, 0x16, 0xdf, 0x72, 0x31, 0x3d, 0xd3, 0xe4, 0x3e, 0x24,
+	0x4a, 0xa7, 0x58, 0x88, 0xc5, 0x83, 0x33, 0x28, 0xd0, 0x0d, 0x1a, 0xfe, 0x0a, 0xb5, 0xed, 0xfc,
+	0xb9, 0x88, 0xb9, 0xd2, 0x61, 0x9c, 0x92, 0x0e, 0x28, 0x0f, 0x8b, 0xef, 0xbc, 0x54, 0x6a, 0x57,
+	0xa3, 0x37, 0xc9, 0xf8, 0x1b, 0xd4, 0x11, 0x71, 0xcc, 0x99, 0x08, 0x35, 0x3f, 0xcd, 0xbe, 0x0e,
+	0x93, 0x88, 0xcf, 0x08, 0x86, 0x45, 0xbf, 0x97, 0x1b, 0xff, 0xc1, 0xb2, 0x18, 0xc8, 0x2c, 0x88,
+	0xa0, 0x4c, 0x6f, 0x2b, 0xf0, 0x31, 0xaa, 0xa7, 0x52, 0xe9, 0xd3, 0x64, 0xb6, 0x20, 0x0f, 0x40,
+	0x7d, 0x90, 0x1b, 0xbf, 0x51, 0x60, 0x81, 0x4c, 0x66, 0x0b, 0xba, 0x2c, 0xf7, 0xff, 0xaa, 0xa0,
+	0xa6, 0x55, 0xcd, 0x42, 0x38, 0x17, 0x0f, 0xd7, 0x12, 0xb4, 0xba, 0x96, 0x9e, 0xbf, 0xa0, 0x86,
+	0x48, 0x84, 0x16, 0xa1, 0x96, 0x59, 0x19, 0xa0, 0xa3, 0xbb, 0x8f, 0xff, 0xba, 0xe5, 0x77, 0x4e,
+	0x66, 0x57, 0xb1, 0x74, 0xa1, 0xab, 0x61, 0x11, 0xa6, 0x51, 0xc6, 0xc1, 0xfb, 0x46, 0x98, 0x96,
+	0x30, 0x75, 0x03, 0xfc, 0xe8, 0xb5, 0x61, 0x7a, 0xf8, 0x16, 0x41, 0xba, 0x19, 0x3f, 0xfb, 0xdb,
+	0xc6, 0x4f, 0xf5, 0xcd, 0xf1, 0xf3, 0xda, 0xac, 0xa8, 0xbd, 0xd3, 0xac, 0x58, 0xfe, 0x1d, 0xea,
+	0xef, 0xe2, 0xef, 0xd0, 0x3f, 0x46, 0xcd, 0xc7, 0x91, 0x16, 0xbf, 0x73, 0xc8, 0x4a, 0x85, 0xdf,
+	0x47, 0x7b, 0x82, 0x29, 0xe2, 0xf5, 0xf6, 0x06, 0x95, 0x71, 0x2d, 0x37, 0x7e, 0x31, 0xa5, 0xc5,
+	0x65, 0xfc, 0xe4, 0xe2, 0xb2, 0xeb, 0xbd, 0xba, 0xec, 0x7a, 0xff, 0x5d, 0x76, 0xbd, 0x3f, 0xaf,
+	0xba, 0x3b, 0xaf, 0xae, 0xba, 0x3b, 0xff, 0x5c, 0x75, 0x77, 0x7e, 0x3e, 0x59, 0x5b, 0x8c, 0xe2,
+	0xe2, 0xc4, 0xbd, 0x06, 0x98, 0xc0, 0x7b, 0x18, 0x9d, 0x8f, 0x8a, 0x9f, 0x3a, 0xac, 0xeb, 0x45,
+	0x15, 0xea, 0x9f, 0xfd, 0x3f, 0x00, 0xa7, 0x5b, 0x8f, 0xe2, 0x29, 0x08, 0x00, 0x00,
 }
 
 func (m *Order) Marshal() (dAtA []byte, err error) {
@@ -368,6 +412,44 @@ func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PostOnly {
+		i--
+		if m.PostOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.ImmediateOrCancel {
+		i--
+		if m.ImmediateOrCancel {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.ExpiryTimestamp != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.ExpiryTimestamp))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	if m.TriggerStatus {
 		i--
 		if m.TriggerStatus {
@@ -664,6 +746,18 @@ func (m *Order) Size() (n int) {
 	if m.TriggerStatus {
 		n += 2
 	}
+	if m.ExpiryHeight != 0 {
+		n += 2 + sovOrder(uint64(m.ExpiryHeight))
+	}
+	if m.ExpiryTimestamp != 0 {
+		n += 2 + sovOrder(uint64(m.ExpiryTimestamp))
+	}
+	if m.ImmediateOrCancel {
+		n += 3
+	}
+	if m.PostOnly {
+		n += 3
+	}
 	return n
 }
 
@@ -1178,6 +1272,84 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.TriggerStatus = bool(v != 0)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTimestamp", wireType)
+			}
+			m.ExpiryTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImmediateOrCancel", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ImmediateOrCancel = bool(v != 0)
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PostOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrder(dAtA[iNdEx:])
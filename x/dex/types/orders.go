@@ -102,6 +102,31 @@ func (c *CachedSortedOrderBookEntries) Refresh(ctx sdk.Context) {
 	c.currentChanged = false
 }
 
+// Snapshot returns a copy of the cache whose entries can be settled against without
+// affecting the original. The copy loads from and writes to the same store.
+func (c *CachedSortedOrderBookEntries) Snapshot() *CachedSortedOrderBookEntries {
+	entries := make([]OrderBookEntry, 0, len(c.CachedEntries))
+	for _, entry := range c.CachedEntries {
+		entries = append(entries, entry.DeepCopy())
+	}
+	return &CachedSortedOrderBookEntries{
+		CachedEntries:  entries,
+		currentPtr:     c.currentPtr,
+		currentChanged: c.currentChanged,
+		loader:         c.loader,
+		setter:         c.setter,
+		deleter:        c.deleter,
+	}
+}
+
+// Apply adopts the state of a snapshot taken from this cache, so that changes made
+// to the snapshot are written to the store upon the next Flush.
+func (c *CachedSortedOrderBookEntries) Apply(snapshot *CachedSortedOrderBookEntries) {
+	c.CachedEntries = snapshot.CachedEntries
+	c.currentPtr = snapshot.currentPtr
+	c.currentChanged = snapshot.currentChanged
+}
+
 func (c *CachedSortedOrderBookEntries) Flush(ctx sdk.Context) {
 	stop := c.currentPtr
 	if !c.currentChanged {
@@ -164,3 +164,37 @@ func TestRefreshAndFlush(t *testing.T) {
 	require.Equal(t, TestEntryOne.Price, entry.GetPrice())
 	require.Equal(t, TestEntryOne, *entry.GetOrderEntry())
 }
+
+func TestSnapshotAndApply(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	populateEntries(ctx, dexkeeper)
+	cache := getCachedSortedOrderBookEntries(dexkeeper)
+	_ = cache.Next(ctx)
+
+	// settling against a snapshot should not affect the original cache
+	snapshot := cache.Snapshot()
+	_ = snapshot.Next(ctx)
+	_, _ = snapshot.SettleQuantity(ctx, sdk.NewDec(2))
+	entry := snapshot.Next(ctx)
+	require.NotNil(t, entry)
+	require.Equal(t, TestEntryOne.Price, entry.GetPrice())
+	_, _ = snapshot.SettleQuantity(ctx, sdk.NewDec(2))
+	entry = cache.Next(ctx)
+	require.NotNil(t, entry)
+	require.Equal(t, TestEntryTwo, *entry.GetOrderEntry())
+	cache.Flush(ctx)
+	require.Equal(t, 2, len(dexkeeper.GetAllLongBookForPair(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)))
+
+	// applying the snapshot should make its changes flushable
+	cache.Apply(snapshot)
+	cache.Flush(ctx)
+	longBooks := dexkeeper.GetAllLongBookForPair(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	require.Equal(t, 1, len(longBooks))
+	require.Equal(t, TestEntryOne.Price, longBooks[0].GetPrice())
+	require.Equal(t, sdk.OneDec(), longBooks[0].GetOrderEntry().Quantity)
+	require.Equal(t, []*types.Allocation{{
+		Quantity: sdk.OneDec(),
+		Account:  "def",
+		OrderId:  2,
+	}}, longBooks[0].GetOrderEntry().Allocations)
+}
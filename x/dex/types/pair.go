@@ -0,0 +1,28 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateFeeRates checks that fee rates, if specified, are within [0, 1)
+func (m *Pair) ValidateFeeRates() error {
+	if err := validateFeeRate("maker", m.MakerFeeRate); err != nil {
+		return fmt.Errorf("pair %s/%s: %w", m.PriceDenom, m.AssetDenom, err)
+	}
+	if err := validateFeeRate("taker", m.TakerFeeRate); err != nil {
+		return fmt.Errorf("pair %s/%s: %w", m.PriceDenom, m.AssetDenom, err)
+	}
+	return nil
+}
+
+func validateFeeRate(name string, rate *sdk.Dec) error {
+	if rate == nil {
+		return nil
+	}
+	if rate.IsNil() || rate.IsNegative() || rate.GTE(sdk.OneDec()) {
+		return fmt.Errorf("%s fee rate %s must be within [0, 1)", name, rate)
+	}
+	return nil
+}
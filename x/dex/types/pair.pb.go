@@ -29,6 +29,10 @@ type Pair struct {
 	AssetDenom       string                                  `protobuf:"bytes,2,opt,name=assetDenom,proto3" json:"asset_denom"`
 	PriceTicksize    *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=priceTicksize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_tick_size"`
 	QuantityTicksize *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=quantityTicksize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity_tick_size"`
+	// fee rate charged on the notional of fills of resting orders
+	MakerFeeRate *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=makerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maker_fee_rate"`
+	// fee rate charged on the notional of fills of orders taking liquidity
+	TakerFeeRate *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=takerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"taker_fee_rate"`
 }
 
 func (m *Pair) Reset()         { *m = Pair{} }
@@ -138,30 +142,33 @@ func init() {
 func init() { proto.RegisterFile("dex/pair.proto", fileDescriptor_d4350ebee878f69a) }
 
 var fileDescriptor_d4350ebee878f69a = []byte{
-	// 368 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x3f, 0x4f, 0xf2, 0x40,
-	0x18, 0xa7, 0xc0, 0xfb, 0x26, 0x1c, 0x22, 0xd2, 0x38, 0x34, 0x0e, 0x2d, 0x61, 0x30, 0x2c, 0xf4,
-	0x12, 0x8d, 0xb3, 0xa1, 0x92, 0xb8, 0x9a, 0xc6, 0xc9, 0x85, 0x1c, 0x77, 0x97, 0x72, 0x41, 0x7a,
-	0xf5, 0xee, 0x48, 0xc0, 0xaf, 0xe0, 0xe2, 0x77, 0x72, 0x61, 0x64, 0x34, 0x0e, 0x8d, 0x81, 0xad,
-	0x9f, 0xc2, 0xdc, 0x95, 0x0a, 0xc4, 0x38, 0x38, 0xdd, 0x3d, 0xbf, 0xe7, 0xf7, 0xa7, 0x7d, 0x9e,
-	0x03, 0xc7, 0x84, 0xce, 0x61, 0x82, 0x98, 0xf0, 0x13, 0xc1, 0x15, 0xb7, 0x1d, 0x49, 0x99, 0xb9,
-	0x61, 0xfe, 0xe8, 0x4b, 0xca, 0xf0, 0x18, 0xb1, 0xd8, 0x27, 0x74, 0x7e, 0x76, 0x1a, 0xf1, 0x88,
-	0x9b, 0x16, 0xd4, 0xb7, 0x9c, 0xdf, 0x79, 0x2b, 0x83, 0xea, 0x1d, 0x62, 0xc2, 0x86, 0x00, 0x24,
-	0x82, 0x61, 0x3a, 0xa0, 0x31, 0x9f, 0x3a, 0x56, 0xdb, 0xea, 0xd6, 0x82, 0x66, 0x96, 0x7a, 0x75,
-	0x83, 0x0e, 0x89, 0x86, 0xc3, 0x3d, 0x8a, 0x16, 0x20, 0x29, 0xa9, 0xca, 0x05, 0xe5, 0x9d, 0xc0,
-	0xa0, 0x85, 0x60, 0x47, 0xb1, 0x23, 0xd0, 0x30, 0xf2, 0x7b, 0x86, 0x27, 0x92, 0x3d, 0x53, 0xa7,
-	0x62, 0x34, 0xfd, 0x65, 0xea, 0x59, 0x1f, 0xa9, 0x77, 0x1e, 0x31, 0x35, 0x9e, 0x8d, 0x7c, 0xcc,
-	0xa7, 0x10, 0x73, 0x39, 0xe5, 0x72, 0x7b, 0xf4, 0x24, 0x99, 0x40, 0xb5, 0x48, 0xa8, 0xf4, 0x07,
-	0x14, 0x67, 0xa9, 0xd7, 0xcc, 0x3f, 0x49, 0x31, 0x3c, 0x19, 0x6a, 0xa3, 0xf0, 0xd0, 0xd7, 0x4e,
-	0xc0, 0xc9, 0xd3, 0x0c, 0xc5, 0x8a, 0xa9, 0xc5, 0x77, 0x56, 0xd5, 0x64, 0x0d, 0xfe, 0x9c, 0x65,
-	0x17, 0x4e, 0x7b, 0x71, 0x3f, 0xdc, 0x3b, 0x2f, 0x16, 0x68, 0x05, 0x48, 0xe1, 0xf1, 0x0d, 0x8f,
-	0x95, 0x40, 0x58, 0x99, 0x91, 0x5e, 0x81, 0x23, 0xbc, 0xad, 0xfb, 0x84, 0x88, 0xed, 0x50, 0x5b,
-	0x59, 0xea, 0x35, 0x0a, 0x7c, 0x88, 0x08, 0x11, 0xe1, 0x01, 0xcd, 0xbe, 0x06, 0xff, 0xf4, 0x42,
-	0xa5, 0x53, 0x6e, 0x57, 0xba, 0xf5, 0x0b, 0xd7, 0xff, 0x6d, 0xa5, 0xbe, 0x4e, 0x09, 0x6a, 0x59,
-	0xea, 0xe5, 0x82, 0x30, 0x3f, 0x82, 0xdb, 0xe5, 0xda, 0xb5, 0x56, 0x6b, 0xd7, 0xfa, 0x5c, 0xbb,
-	0xd6, 0xeb, 0xc6, 0x2d, 0xad, 0x36, 0x6e, 0xe9, 0x7d, 0xe3, 0x96, 0x1e, 0x7a, 0x7b, 0xff, 0x2d,
-	0x29, 0xeb, 0x15, 0xb6, 0xa6, 0x30, 0xbe, 0x70, 0x0e, 0xf5, 0x8b, 0x32, 0x23, 0x18, 0xfd, 0x37,
-	0xfd, 0xcb, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd0, 0x03, 0x32, 0x50, 0x65, 0x02, 0x00, 0x00,
+	// 410 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x31, 0x8f, 0xd3, 0x30,
+	0x14, 0xc7, 0x9b, 0xbb, 0xde, 0x49, 0xe7, 0xbb, 0x6b, 0x69, 0xc4, 0x10, 0x31, 0x24, 0x55, 0x07,
+	0xd4, 0xa5, 0x89, 0x04, 0x62, 0x86, 0x86, 0x0a, 0x56, 0x14, 0x31, 0xb1, 0x44, 0xae, 0xfd, 0x48,
+	0xad, 0x90, 0x38, 0xd8, 0xae, 0xd4, 0xf2, 0x15, 0x18, 0xe0, 0x63, 0x75, 0xec, 0x88, 0x18, 0x22,
+	0xd4, 0x6e, 0xf9, 0x14, 0xc8, 0x4e, 0x43, 0x53, 0x10, 0x43, 0x6f, 0xb2, 0xfd, 0xfc, 0xfe, 0xbf,
+	0x5f, 0x2c, 0xbd, 0xa0, 0x1e, 0x85, 0x55, 0x50, 0x60, 0x26, 0xfc, 0x42, 0x70, 0xc5, 0x6d, 0x47,
+	0x02, 0x33, 0x3b, 0xc2, 0x3f, 0xf9, 0x12, 0x18, 0x59, 0x60, 0x96, 0xfb, 0x14, 0x56, 0x4f, 0x1e,
+	0x27, 0x3c, 0xe1, 0xe6, 0x2a, 0xd0, 0xbb, 0xba, 0x7f, 0xf4, 0xad, 0x8b, 0xba, 0xef, 0x30, 0x13,
+	0x76, 0x80, 0x50, 0x21, 0x18, 0x81, 0x19, 0xe4, 0x3c, 0x73, 0xac, 0xa1, 0x35, 0xbe, 0x09, 0xfb,
+	0x55, 0xe9, 0xdd, 0x9a, 0x6a, 0x4c, 0x75, 0x39, 0x6a, 0xb5, 0xe8, 0x00, 0x96, 0x12, 0x54, 0x1d,
+	0xb8, 0x38, 0x06, 0x4c, 0xb5, 0x09, 0x1c, 0x5b, 0xec, 0x04, 0xdd, 0x9b, 0xf8, 0x7b, 0x46, 0x52,
+	0xc9, 0xbe, 0x80, 0x73, 0x69, 0x32, 0xd3, 0x4d, 0xe9, 0x59, 0x3f, 0x4b, 0xef, 0x69, 0xc2, 0xd4,
+	0x62, 0x39, 0xf7, 0x09, 0xcf, 0x02, 0xc2, 0x65, 0xc6, 0xe5, 0x61, 0x99, 0x48, 0x9a, 0x06, 0x6a,
+	0x5d, 0x80, 0xf4, 0x67, 0x40, 0xaa, 0xd2, 0xeb, 0xd7, 0x9f, 0xa4, 0x18, 0x49, 0x63, 0x0d, 0x8a,
+	0x4e, 0xb9, 0x76, 0x81, 0x1e, 0x7d, 0x5e, 0xe2, 0x5c, 0x31, 0xb5, 0xfe, 0xe3, 0xea, 0x1a, 0xd7,
+	0xec, 0x6c, 0x97, 0xdd, 0x90, 0x5a, 0xba, 0x7f, 0xe8, 0x36, 0x45, 0x77, 0x19, 0x4e, 0x41, 0xbc,
+	0x01, 0x88, 0xb0, 0x02, 0xe7, 0xca, 0xd8, 0x5e, 0x9d, 0x6d, 0xeb, 0x19, 0x4a, 0xfc, 0x11, 0x20,
+	0x16, 0x58, 0x41, 0x74, 0x42, 0xd5, 0x16, 0xd5, 0xb6, 0x5c, 0x3f, 0xd4, 0xa2, 0xfe, 0xb2, 0xb4,
+	0xa9, 0xa3, 0xaf, 0x16, 0x1a, 0x84, 0x58, 0x91, 0xc5, 0x6b, 0x9e, 0x2b, 0x81, 0x89, 0x32, 0xe3,
+	0xf1, 0x02, 0xdd, 0x91, 0xc3, 0x79, 0x4a, 0xa9, 0x38, 0x0c, 0xc8, 0xa0, 0x2a, 0xbd, 0xfb, 0xa6,
+	0x1e, 0x63, 0x4a, 0x45, 0x74, 0xd2, 0x66, 0xbf, 0x44, 0x57, 0x7a, 0x38, 0xa5, 0x73, 0x31, 0xbc,
+	0x1c, 0xdf, 0x3e, 0x73, 0xfd, 0xff, 0x8d, 0xa7, 0xaf, 0x2d, 0xe1, 0x4d, 0x55, 0x7a, 0x75, 0x20,
+	0xaa, 0x97, 0xf0, 0xed, 0x66, 0xe7, 0x5a, 0xdb, 0x9d, 0x6b, 0xfd, 0xda, 0xb9, 0xd6, 0xf7, 0xbd,
+	0xdb, 0xd9, 0xee, 0xdd, 0xce, 0x8f, 0xbd, 0xdb, 0xf9, 0x30, 0x69, 0xbd, 0x57, 0x02, 0x9b, 0x34,
+	0x58, 0x73, 0x30, 0xdc, 0x60, 0x15, 0xe8, 0xbf, 0xc3, 0x3c, 0x7d, 0x7e, 0x6d, 0xee, 0x9f, 0xff,
+	0x1e, 0x00, 0x0a, 0x38, 0x9c, 0x95, 0x31, 0x03, 0x00, 0x00,
 }
 
 func (m *Pair) Marshal() (dAtA []byte, err error) {
@@ -184,6 +191,30 @@ func (m *Pair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TakerFeeRate != nil {
+		{
+			size := m.TakerFeeRate.Size()
+			i -= size
+			if _, err := m.TakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintPair(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MakerFeeRate != nil {
+		{
+			size := m.MakerFeeRate.Size()
+			i -= size
+			if _, err := m.MakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintPair(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.QuantityTicksize != nil {
 		{
 			size := m.QuantityTicksize.Size()
@@ -302,6 +333,14 @@ func (m *Pair) Size() (n int) {
 		l = m.QuantityTicksize.Size()
 		n += 1 + l + sovPair(uint64(l))
 	}
+	if m.MakerFeeRate != nil {
+		l = m.MakerFeeRate.Size()
+		n += 1 + l + sovPair(uint64(l))
+	}
+	if m.TakerFeeRate != nil {
+		l = m.TakerFeeRate.Size()
+		n += 1 + l + sovPair(uint64(l))
+	}
 	return n
 }
 
@@ -495,6 +534,78 @@ func (m *Pair) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPair
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPair
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MakerFeeRate = &v
+			if err := m.MakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPair
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPair
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.TakerFeeRate = &v
+			if err := m.TakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPair(dAtA[iNdEx:])
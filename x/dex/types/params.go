@@ -23,6 +23,8 @@ var (
 	KeyMaxOrderPerPrice           = []byte("KeyMaxOrderPerPrice")
 	KeyMaxPairsPerContract        = []byte("KeyMaxPairsPerContract")
 	KeyDefaultGasPerOrderDataByte = []byte("KeyDefaultGasPerOrderDataByte")
+	KeyFeeCollectorAddress        = []byte("KeyFeeCollectorAddress") // recipient of trading fees
+	KeyFillRetention              = []byte("KeyFillRetention")       // seconds to retain account fills for, 0 to disable
 )
 
 const (
@@ -87,6 +89,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyMaxOrderPerPrice, &p.MaxOrderPerPrice, validateUint64Param),
 		paramtypes.NewParamSetPair(KeyMaxPairsPerContract, &p.MaxPairsPerContract, validateUint64Param),
 		paramtypes.NewParamSetPair(KeyDefaultGasPerOrderDataByte, &p.DefaultGasPerOrderDataByte, validateUint64Param),
+		paramtypes.NewParamSetPair(KeyFeeCollectorAddress, &p.FeeCollectorAddress, validateFeeCollectorAddress),
+		paramtypes.NewParamSetPair(KeyFillRetention, &p.FillRetention, validateUint64Param),
 	}
 }
 
@@ -98,6 +102,9 @@ func (p Params) Validate() error {
 	if err := validateSudoCallGasPrice(p.SudoCallGasPrice); err != nil {
 		return err
 	}
+	if err := validateFeeCollectorAddress(p.FeeCollectorAddress); err != nil {
+		return err
+	}
 	// it's not possible for other params to fail validation if they've already
 	// made it into Params' fields.
 	return nil
@@ -141,3 +148,18 @@ func validateUint64Param(i interface{}) error {
 
 	return nil
 }
+
+func validateFeeCollectorAddress(i interface{}) error {
+	addr, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// empty means the fee collector module account
+	if addr == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+		return fmt.Errorf("invalid fee collector address: %w", err)
+	}
+	return nil
+}
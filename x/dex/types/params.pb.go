@@ -40,6 +40,10 @@ type Params struct {
 	MaxOrderPerPrice           uint64                                 `protobuf:"varint,12,opt,name=max_order_per_price,json=maxOrderPerPrice,proto3" json:"max_order_per_price" yaml:"max_order_per_price"`
 	MaxPairsPerContract        uint64                                 `protobuf:"varint,13,opt,name=max_pairs_per_contract,json=maxPairsPerContract,proto3" json:"max_pairs_per_contract" yaml:"max_pairs_per_contract"`
 	DefaultGasPerOrderDataByte uint64                                 `protobuf:"varint,14,opt,name=default_gas_per_order_data_byte,json=defaultGasPerOrderDataByte,proto3" json:"default_gas_per_order_data_byte" yaml:"default_gas_per_order_data_byte"`
+	// account that trading fees are sent to; defaults to the fee collector module account if empty
+	FeeCollectorAddress string `protobuf:"bytes,15,opt,name=fee_collector_address,json=feeCollectorAddress,proto3" json:"fee_collector_address" yaml:"fee_collector_address"`
+	// number of seconds to retain account fills for; the fill store is disabled if 0
+	FillRetention uint64 `protobuf:"varint,16,opt,name=fill_retention,json=fillRetention,proto3" json:"fill_retention" yaml:"fill_retention"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -165,6 +169,20 @@ func (m *Params) GetDefaultGasPerOrderDataByte() uint64 {
 	return 0
 }
 
+func (m *Params) GetFeeCollectorAddress() string {
+	if m != nil {
+		return m.FeeCollectorAddress
+	}
+	return ""
+}
+
+func (m *Params) GetFillRetention() uint64 {
+	if m != nil {
+		return m.FillRetention
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "seiprotocol.seichain.dex.Params")
 }
@@ -172,57 +190,61 @@ func init() {
 func init() { proto.RegisterFile("dex/params.proto", fileDescriptor_e49286500ccff43e) }
 
 var fileDescriptor_e49286500ccff43e = []byte{
-	// 787 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x95, 0xc1, 0x6f, 0x1b, 0x45,
-	0x14, 0xc6, 0xbd, 0x50, 0x42, 0x3b, 0x94, 0xca, 0xda, 0x34, 0xc9, 0x92, 0x16, 0x4f, 0x35, 0x48,
-	0x55, 0x2f, 0xb1, 0x0f, 0x08, 0x21, 0x8a, 0x10, 0xc2, 0x49, 0x94, 0x4b, 0x11, 0xd6, 0x54, 0x1c,
-	0xe0, 0xb2, 0x1a, 0xef, 0x3e, 0x9c, 0x51, 0x66, 0x67, 0x56, 0x33, 0x63, 0x61, 0x9f, 0xb9, 0x70,
-	0x44, 0x9c, 0x38, 0xf6, 0xcf, 0xe9, 0xb1, 0x47, 0xc4, 0x61, 0x84, 0x92, 0x0b, 0xda, 0xe3, 0xfe,
-	0x05, 0x68, 0x66, 0x6d, 0x96, 0x24, 0x6b, 0xf7, 0x94, 0xcd, 0xf7, 0xfb, 0xb4, 0xdf, 0x7b, 0xde,
-	0x79, 0x6f, 0x50, 0x3f, 0x87, 0xc5, 0xa8, 0x64, 0x9a, 0x15, 0x66, 0x58, 0x6a, 0x65, 0x55, 0x9c,
-	0x18, 0xe0, 0xe1, 0x29, 0x53, 0x62, 0x68, 0x80, 0x67, 0xe7, 0x8c, 0xcb, 0x61, 0x0e, 0x8b, 0xc3,
-	0x87, 0x33, 0x35, 0x53, 0x01, 0x8d, 0xfc, 0x53, 0xe3, 0x27, 0xf5, 0x7d, 0xb4, 0x33, 0x09, 0x2f,
-	0x88, 0x97, 0x28, 0x29, 0x35, 0xcf, 0x20, 0x35, 0x92, 0x95, 0xe6, 0x5c, 0xd9, 0x54, 0x83, 0x05,
-	0x69, 0xb9, 0x92, 0x49, 0xf4, 0x24, 0x7a, 0x76, 0x67, 0xfc, 0x75, 0xe5, 0xf0, 0x46, 0x4f, 0xed,
-	0x30, 0x5e, 0xb2, 0x42, 0x3c, 0x27, 0x9b, 0x1c, 0x84, 0xee, 0x07, 0xf4, 0x72, 0x45, 0xe8, 0x1a,
-	0xc4, 0x16, 0xed, 0x9a, 0x79, 0xae, 0xd2, 0x8c, 0x09, 0x91, 0xce, 0x98, 0x49, 0x83, 0x2f, 0x79,
-	0xe7, 0x49, 0xf4, 0xec, 0xde, 0xf8, 0xf4, 0xb5, 0xc3, 0xbd, 0xbf, 0x1c, 0x7e, 0x3a, 0xe3, 0xf6,
-	0x7c, 0x3e, 0x1d, 0x66, 0xaa, 0x18, 0x65, 0xca, 0x14, 0xca, 0xac, 0xfe, 0x1c, 0x99, 0xfc, 0x62,
-	0x64, 0x97, 0x25, 0x98, 0xe1, 0x09, 0x64, 0x95, 0xc3, 0x5d, 0x2f, 0xa3, 0x7d, 0x2f, 0x1e, 0x33,
-	0x21, 0xce, 0x98, 0x99, 0x78, 0x25, 0x16, 0x68, 0x6f, 0x0a, 0x33, 0x2e, 0xd3, 0xa9, 0x50, 0xd9,
-	0x45, 0xb0, 0x0a, 0x5e, 0x70, 0x9b, 0xbc, 0x1b, 0xba, 0xfd, 0xa2, 0x72, 0xb8, 0xdb, 0x50, 0x3b,
-	0xfc, 0xb8, 0x69, 0xb5, 0x13, 0x13, 0x1a, 0x07, 0x7d, 0xec, 0xe5, 0x33, 0x66, 0x5e, 0x78, 0x31,
-	0xce, 0xd1, 0x2e, 0xc8, 0xfc, 0x56, 0xd6, 0x9d, 0x90, 0xf5, 0x99, 0xaf, 0xba, 0x03, 0xd7, 0x0e,
-	0x1f, 0x36, 0x49, 0x1d, 0x90, 0xd0, 0x3e, 0xc8, 0xfc, 0x7a, 0x8a, 0x40, 0x7b, 0x39, 0xfc, 0xc4,
-	0xe6, 0xc2, 0x36, 0xad, 0x83, 0x4e, 0x95, 0xce, 0x41, 0x27, 0xef, 0xb5, 0x3d, 0x75, 0x1a, 0xda,
-	0x9e, 0x3a, 0x31, 0xa1, 0xf1, 0x4a, 0xf7, 0x3f, 0x1f, 0xe8, 0xef, 0xbc, 0x18, 0x97, 0x68, 0xff,
-	0xa6, 0x3b, 0x63, 0x32, 0x03, 0x91, 0xec, 0x84, 0xb8, 0x2f, 0x2b, 0x87, 0x37, 0x38, 0x6a, 0x87,
-	0x3f, 0xee, 0xce, 0x6b, 0x38, 0xa1, 0xbb, 0xd7, 0x02, 0x8f, 0x83, 0x1a, 0xff, 0x80, 0xfa, 0x05,
-	0x97, 0xa9, 0x06, 0x69, 0xd3, 0x1c, 0x4a, 0x65, 0xb8, 0x4d, 0xde, 0x0f, 0x59, 0xa3, 0xca, 0xe1,
-	0x5b, 0xac, 0x76, 0xf8, 0xa0, 0x49, 0xb9, 0x49, 0x08, 0x7d, 0x50, 0x70, 0x49, 0x41, 0xda, 0x93,
-	0x46, 0x88, 0x7f, 0x8d, 0xd0, 0x63, 0x5f, 0x03, 0x13, 0x42, 0xfd, 0xec, 0xd3, 0x42, 0x35, 0x06,
-	0xac, 0x15, 0x50, 0x80, 0xb4, 0xc9, 0xdd, 0x90, 0x73, 0x56, 0x39, 0xbc, 0xd5, 0x57, 0x3b, 0xfc,
-	0x49, 0x93, 0xb9, 0xcd, 0x45, 0xe8, 0x47, 0x33, 0x66, 0xbe, 0x59, 0xd3, 0x09, 0xe8, 0x97, 0xff,
-	0xb1, 0x98, 0xa3, 0x87, 0xbe, 0xde, 0x52, 0xab, 0x0c, 0x8c, 0x61, 0x53, 0x01, 0xa1, 0xf6, 0xe4,
-	0x5e, 0xa8, 0xe0, 0xf3, 0xca, 0xe1, 0x4e, 0x5e, 0x3b, 0xfc, 0xa8, 0xed, 0xf6, 0x26, 0x25, 0x34,
-	0x2e, 0xb8, 0x9c, 0xb4, 0xaa, 0x6f, 0x3e, 0xfe, 0x25, 0x42, 0x8f, 0xc2, 0x17, 0x4e, 0xa7, 0x4a,
-	0x5d, 0xa4, 0x20, 0xad, 0xe6, 0xd0, 0x7c, 0x08, 0xa1, 0x58, 0x9e, 0xa0, 0x10, 0x79, 0x5a, 0x39,
-	0xbc, 0xcd, 0x56, 0x3b, 0x4c, 0x9a, 0xe4, 0x2d, 0x26, 0x42, 0x0f, 0x02, 0x1d, 0x2b, 0x75, 0x71,
-	0xda, 0xb0, 0x09, 0xe8, 0x17, 0x8a, 0xe5, 0xf1, 0x1c, 0x1d, 0x64, 0x4a, 0x5a, 0xcd, 0x32, 0x9b,
-	0xce, 0xa5, 0x99, 0x9b, 0xd2, 0x9f, 0xf7, 0x4c, 0x19, 0x9b, 0x7c, 0x10, 0x0a, 0xf8, 0xaa, 0x72,
-	0x78, 0x93, 0xa5, 0x76, 0x78, 0xd0, 0x84, 0x6f, 0x30, 0x10, 0xba, 0xb7, 0x26, 0xdf, 0xaf, 0xc1,
-	0xb1, 0x32, 0x61, 0x26, 0x0b, 0xb6, 0x68, 0x4e, 0x78, 0x28, 0xb3, 0xd9, 0x3b, 0xf7, 0xdb, 0x99,
-	0xec, 0xc0, 0xed, 0x4c, 0x76, 0x40, 0x42, 0xfb, 0x05, 0x5b, 0x84, 0xe9, 0x98, 0x80, 0x6e, 0xf6,
-	0x4c, 0x89, 0xf6, 0xbd, 0xb3, 0x64, 0x5c, 0xaf, 0x4e, 0xf8, 0xaa, 0x98, 0xe4, 0xc3, 0x76, 0x4a,
-	0xba, 0x1d, 0xed, 0x94, 0x74, 0x73, 0x42, 0x7d, 0x85, 0x13, 0xaf, 0xfb, 0x19, 0x59, 0xa9, 0xf1,
-	0xef, 0x11, 0xc2, 0x9d, 0x63, 0x9c, 0xe6, 0xcc, 0xb2, 0x74, 0xba, 0xb4, 0x90, 0x3c, 0x08, 0xd9,
-	0xdf, 0x56, 0x0e, 0xbf, 0xcd, 0x5a, 0x3b, 0xfc, 0x74, 0xcb, 0x6a, 0x68, 0x8d, 0x84, 0x1e, 0xde,
-	0x5e, 0x12, 0x27, 0xcc, 0xb2, 0xf1, 0xd2, 0xc2, 0xf3, 0xbb, 0x7f, 0xbc, 0xc2, 0xbd, 0x7f, 0x5e,
-	0xe1, 0x68, 0x7c, 0xf6, 0xfa, 0x72, 0x10, 0xbd, 0xb9, 0x1c, 0x44, 0x7f, 0x5f, 0x0e, 0xa2, 0xdf,
-	0xae, 0x06, 0xbd, 0x37, 0x57, 0x83, 0xde, 0x9f, 0x57, 0x83, 0xde, 0x8f, 0x47, 0xff, 0xdb, 0xf1,
-	0x06, 0xf8, 0xd1, 0xfa, 0x2a, 0x0b, 0xff, 0x84, 0xbb, 0x6c, 0xb4, 0x18, 0xf9, 0x4b, 0x2f, 0xac,
-	0xfb, 0xe9, 0x4e, 0xe0, 0x9f, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xff, 0x27, 0x82, 0x3c, 0x08,
-	0x07, 0x00, 0x00,
+	// 857 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x95, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xbd, 0x50, 0x42, 0x3b, 0xd0, 0x60, 0x6d, 0xea, 0x64, 0x49, 0x8b, 0xa7, 0x1a, 0xa4,
+	0xaa, 0x12, 0x8a, 0x7d, 0x40, 0x08, 0x51, 0x84, 0x50, 0x9d, 0x44, 0xb9, 0x14, 0x61, 0x4d, 0xc5,
+	0x01, 0x2e, 0xab, 0xf1, 0xee, 0x8b, 0x33, 0xca, 0xec, 0xce, 0x6a, 0x66, 0x2c, 0xec, 0x33, 0x17,
+	0x8e, 0x88, 0x13, 0xc7, 0xfe, 0x13, 0xfc, 0x0f, 0x3d, 0xf6, 0x88, 0x38, 0x8c, 0x50, 0x72, 0x41,
+	0x7b, 0xdc, 0xbf, 0x00, 0xcd, 0xac, 0xdd, 0xcd, 0x8f, 0xb5, 0x39, 0xc5, 0xf9, 0x7e, 0xbe, 0xf2,
+	0xf7, 0x3d, 0xef, 0xbe, 0xf7, 0x50, 0x37, 0x85, 0xf9, 0xb0, 0x60, 0x8a, 0x65, 0x7a, 0x50, 0x28,
+	0x69, 0x64, 0x18, 0x69, 0xe0, 0xfe, 0x53, 0x22, 0xc5, 0x40, 0x03, 0x4f, 0xce, 0x18, 0xcf, 0x07,
+	0x29, 0xcc, 0xf7, 0x1f, 0x4c, 0xe5, 0x54, 0x7a, 0x34, 0x74, 0x9f, 0x6a, 0x3f, 0xf9, 0x73, 0x1b,
+	0x6d, 0x8d, 0xfd, 0x17, 0x84, 0x0b, 0x14, 0x15, 0x8a, 0x27, 0x10, 0xeb, 0x9c, 0x15, 0xfa, 0x4c,
+	0x9a, 0x58, 0x81, 0x81, 0xdc, 0x70, 0x99, 0x47, 0xc1, 0xe3, 0xe0, 0xe9, 0x9d, 0xd1, 0xb7, 0xa5,
+	0xc5, 0x6b, 0x3d, 0x95, 0xc5, 0x78, 0xc1, 0x32, 0xf1, 0x8c, 0xac, 0x73, 0x10, 0xba, 0xeb, 0xd1,
+	0xcb, 0x25, 0xa1, 0x2b, 0x10, 0x1a, 0xb4, 0xa3, 0x67, 0xa9, 0x8c, 0x13, 0x26, 0x44, 0x3c, 0x65,
+	0x3a, 0xf6, 0xbe, 0xe8, 0x9d, 0xc7, 0xc1, 0xd3, 0x7b, 0xa3, 0xe3, 0xd7, 0x16, 0x77, 0xfe, 0xb6,
+	0xf8, 0xc9, 0x94, 0x9b, 0xb3, 0xd9, 0x64, 0x90, 0xc8, 0x6c, 0x98, 0x48, 0x9d, 0x49, 0xbd, 0xfc,
+	0x73, 0xa0, 0xd3, 0xf3, 0xa1, 0x59, 0x14, 0xa0, 0x07, 0x47, 0x90, 0x94, 0x16, 0xb7, 0x7d, 0x19,
+	0xed, 0x3a, 0xf1, 0x90, 0x09, 0x71, 0xc2, 0xf4, 0xd8, 0x29, 0xa1, 0x40, 0xbd, 0x09, 0x4c, 0x79,
+	0x1e, 0x4f, 0x84, 0x4c, 0xce, 0xbd, 0x55, 0xf0, 0x8c, 0x9b, 0xe8, 0x5d, 0xdf, 0xed, 0x57, 0xa5,
+	0xc5, 0xed, 0x86, 0xca, 0xe2, 0x47, 0x75, 0xab, 0xad, 0x98, 0xd0, 0xd0, 0xeb, 0x23, 0x27, 0x9f,
+	0x30, 0xfd, 0xc2, 0x89, 0x61, 0x8a, 0x76, 0x20, 0x4f, 0x6f, 0x65, 0xdd, 0xf1, 0x59, 0x5f, 0xb8,
+	0xaa, 0x5b, 0x70, 0x65, 0xf1, 0x7e, 0x9d, 0xd4, 0x02, 0x09, 0xed, 0x42, 0x9e, 0x5e, 0x4f, 0x11,
+	0xa8, 0x97, 0xc2, 0x29, 0x9b, 0x09, 0x53, 0xb7, 0x0e, 0x2a, 0x96, 0x2a, 0x05, 0x15, 0xbd, 0xd7,
+	0xf4, 0xd4, 0x6a, 0x68, 0x7a, 0x6a, 0xc5, 0x84, 0x86, 0x4b, 0xdd, 0xfd, 0x7c, 0xa0, 0xbe, 0x77,
+	0x62, 0x58, 0xa0, 0xdd, 0x9b, 0xee, 0x84, 0xe5, 0x09, 0x88, 0x68, 0xcb, 0xc7, 0x7d, 0x5d, 0x5a,
+	0xbc, 0xc6, 0x51, 0x59, 0xfc, 0x49, 0x7b, 0x5e, 0xcd, 0x09, 0xdd, 0xb9, 0x16, 0x78, 0xe8, 0xd5,
+	0xf0, 0x47, 0xd4, 0xcd, 0x78, 0x1e, 0x2b, 0xc8, 0x4d, 0x9c, 0x42, 0x21, 0x35, 0x37, 0xd1, 0xfb,
+	0x3e, 0x6b, 0x58, 0x5a, 0x7c, 0x8b, 0x55, 0x16, 0xef, 0xd5, 0x29, 0x37, 0x09, 0xa1, 0xdb, 0x19,
+	0xcf, 0x29, 0xe4, 0xe6, 0xa8, 0x16, 0xc2, 0x5f, 0x03, 0xf4, 0xc8, 0xd5, 0xc0, 0x84, 0x90, 0x3f,
+	0xbb, 0x34, 0x5f, 0x8d, 0x06, 0x63, 0x04, 0x64, 0x90, 0x9b, 0xe8, 0xae, 0xcf, 0x39, 0x29, 0x2d,
+	0xde, 0xe8, 0xab, 0x2c, 0xfe, 0xb4, 0xce, 0xdc, 0xe4, 0x22, 0xf4, 0xe3, 0x29, 0xd3, 0xcf, 0x57,
+	0x74, 0x0c, 0xea, 0xe5, 0x5b, 0x16, 0x72, 0xf4, 0xc0, 0xd5, 0x5b, 0x28, 0x99, 0x80, 0xd6, 0x6c,
+	0x22, 0xc0, 0xd7, 0x1e, 0xdd, 0xf3, 0x15, 0x7c, 0x59, 0x5a, 0xdc, 0xca, 0x2b, 0x8b, 0x1f, 0x36,
+	0xdd, 0xde, 0xa4, 0x84, 0x86, 0x19, 0xcf, 0xc7, 0x8d, 0xea, 0x9a, 0x0f, 0x7f, 0x09, 0xd0, 0x43,
+	0xff, 0x84, 0xe3, 0x89, 0x94, 0xe7, 0x31, 0xe4, 0x46, 0x71, 0xa8, 0x1f, 0x84, 0x90, 0x2c, 0x8d,
+	0x90, 0x8f, 0x3c, 0x2e, 0x2d, 0xde, 0x64, 0xab, 0x2c, 0x26, 0x75, 0xf2, 0x06, 0x13, 0xa1, 0x7b,
+	0x9e, 0x8e, 0xa4, 0x3c, 0x3f, 0xae, 0xd9, 0x18, 0xd4, 0x0b, 0xc9, 0xd2, 0x70, 0x86, 0xf6, 0x12,
+	0x99, 0x1b, 0xc5, 0x12, 0x13, 0xcf, 0x72, 0x3d, 0xd3, 0x85, 0x7b, 0xdf, 0x13, 0xa9, 0x4d, 0xf4,
+	0x81, 0x2f, 0xe0, 0x9b, 0xd2, 0xe2, 0x75, 0x96, 0xca, 0xe2, 0x7e, 0x1d, 0xbe, 0xc6, 0x40, 0x68,
+	0x6f, 0x45, 0x7e, 0x58, 0x81, 0x43, 0xa9, 0xfd, 0x4c, 0x66, 0x6c, 0x5e, 0xbf, 0xe1, 0xbe, 0xcc,
+	0x7a, 0xef, 0x7c, 0xd8, 0xcc, 0x64, 0x0b, 0x6e, 0x66, 0xb2, 0x05, 0x12, 0xda, 0xcd, 0xd8, 0xdc,
+	0x4f, 0xc7, 0x18, 0x54, 0xbd, 0x67, 0x0a, 0xb4, 0xeb, 0x9c, 0x05, 0xe3, 0x6a, 0xf9, 0x86, 0x2f,
+	0x8b, 0x89, 0xee, 0x37, 0x53, 0xd2, 0xee, 0x68, 0xa6, 0xa4, 0x9d, 0x13, 0xea, 0x2a, 0x1c, 0x3b,
+	0xdd, 0xcd, 0xc8, 0x52, 0x0d, 0x7f, 0x0f, 0x10, 0x6e, 0x1d, 0xe3, 0x38, 0x65, 0x86, 0xc5, 0x93,
+	0x85, 0x81, 0x68, 0xdb, 0x67, 0x7f, 0x57, 0x5a, 0xfc, 0x7f, 0xd6, 0xca, 0xe2, 0x27, 0x1b, 0x56,
+	0x43, 0x63, 0x24, 0x74, 0xff, 0xf6, 0x92, 0x38, 0x62, 0x86, 0x8d, 0x16, 0x06, 0xc2, 0x0c, 0xf5,
+	0x4e, 0x01, 0xe2, 0x44, 0x0a, 0x01, 0x89, 0x91, 0x2a, 0x66, 0x69, 0xaa, 0x40, 0xeb, 0xe8, 0x23,
+	0xbf, 0xe6, 0xfd, 0x6a, 0x6a, 0x35, 0x34, 0xab, 0xa9, 0x15, 0x13, 0xba, 0x73, 0x0a, 0x70, 0xb8,
+	0x92, 0x9f, 0xd7, 0x6a, 0x48, 0xd1, 0xf6, 0x29, 0x17, 0xe2, 0xca, 0x11, 0xeb, 0xfa, 0x8e, 0x3f,
+	0x2b, 0x2d, 0xbe, 0x41, 0x2a, 0x8b, 0x7b, 0xcb, 0x80, 0x6b, 0x3a, 0xa1, 0xf7, 0x9d, 0xf0, 0xf6,
+	0x4e, 0x3d, 0xbb, 0xfb, 0xc7, 0x2b, 0xdc, 0xf9, 0xf7, 0x15, 0x0e, 0x46, 0x27, 0xaf, 0x2f, 0xfa,
+	0xc1, 0x9b, 0x8b, 0x7e, 0xf0, 0xcf, 0x45, 0x3f, 0xf8, 0xed, 0xb2, 0xdf, 0x79, 0x73, 0xd9, 0xef,
+	0xfc, 0x75, 0xd9, 0xef, 0xfc, 0x74, 0x70, 0xe5, 0x4c, 0x69, 0xe0, 0x07, 0xab, 0x6b, 0xec, 0xff,
+	0xf1, 0xe7, 0x78, 0x38, 0x1f, 0xba, 0xbb, 0xed, 0x2f, 0xd6, 0x64, 0xcb, 0xf3, 0xcf, 0xff, 0x1b,
+	0x00, 0xd1, 0x50, 0xe9, 0x6d, 0xcb, 0x07, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -286,6 +308,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.DefaultGasPerOrderDataByte != that1.DefaultGasPerOrderDataByte {
 		return false
 	}
+	if this.FeeCollectorAddress != that1.FeeCollectorAddress {
+		return false
+	}
+	if this.FillRetention != that1.FillRetention {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -308,6 +336,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FillRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FillRetention))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.FeeCollectorAddress) > 0 {
+		i -= len(m.FeeCollectorAddress)
+		copy(dAtA[i:], m.FeeCollectorAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.FeeCollectorAddress)))
+		i--
+		dAtA[i] = 0x7a
+	}
 	if m.DefaultGasPerOrderDataByte != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.DefaultGasPerOrderDataByte))
 		i--
@@ -444,6 +486,13 @@ func (m *Params) Size() (n int) {
 	if m.DefaultGasPerOrderDataByte != 0 {
 		n += 1 + sovParams(uint64(m.DefaultGasPerOrderDataByte))
 	}
+	l = len(m.FeeCollectorAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.FillRetention != 0 {
+		n += 2 + sovParams(uint64(m.FillRetention))
+	}
 	return n
 }
 
@@ -763,6 +812,57 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollectorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeCollectorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FillRetention", wireType)
+			}
+			m.FillRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FillRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -14,4 +14,10 @@ func TestParamsValidate(t *testing.T) {
 
 	p = types.Params{SudoCallGasPrice: sdk.ZeroDec()}
 	require.Error(t, p.Validate())
+
+	p = types.DefaultParams()
+	p.FeeCollectorAddress = "invalid"
+	require.Error(t, p.Validate())
+	p.FeeCollectorAddress = "sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx"
+	require.NoError(t, p.Validate())
 }
@@ -25,9 +25,10 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Price struct {
-	SnapshotTimestampInSeconds uint64                                 `protobuf:"varint,1,opt,name=snapshotTimestampInSeconds,proto3" json:"snapshot_timestamp_in_seconds"`
-	Price                      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price" yaml:"price"`
-	Pair                       *Pair                                  `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair"`
+	SnapshotTimestampInSeconds uint64                                  `protobuf:"varint,1,opt,name=snapshotTimestampInSeconds,proto3" json:"snapshot_timestamp_in_seconds"`
+	Price                      github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price" yaml:"price"`
+	Pair                       *Pair                                   `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair"`
+	TotalFees                  *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=totalFees,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_fees"`
 }
 
 func (m *Price) Reset()         { *m = Price{} }
@@ -142,37 +143,38 @@ func init() {
 func init() { proto.RegisterFile("dex/price.proto", fileDescriptor_bd5d1c9d490efb8c) }
 
 var fileDescriptor_bd5d1c9d490efb8c = []byte{
-	// 466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xe3, 0xfc, 0x83, 0x2e, 0xa5, 0x85, 0x85, 0x83, 0x15, 0x09, 0x3b, 0xe4, 0x80, 0x72,
-	0x89, 0x2d, 0x5a, 0xb8, 0x00, 0x27, 0x17, 0x51, 0x55, 0xe2, 0x50, 0x2d, 0x9c, 0x90, 0x50, 0xe4,
-	0xac, 0x47, 0xf6, 0xaa, 0xf6, 0xae, 0x95, 0x75, 0x21, 0x7d, 0x02, 0xae, 0x3c, 0x56, 0x8f, 0x3d,
-	0x22, 0x0e, 0x16, 0x4a, 0x6e, 0x3e, 0x22, 0x1e, 0x00, 0xed, 0x38, 0xa1, 0x01, 0x09, 0x24, 0x73,
-	0x99, 0xd9, 0xf1, 0x7c, 0xdf, 0x4f, 0x9e, 0xf1, 0x9a, 0xec, 0x47, 0xb0, 0xf0, 0xf3, 0xb9, 0xe0,
-	0xe0, 0xe5, 0x73, 0x55, 0x28, 0x6a, 0x6b, 0x10, 0x78, 0xe2, 0x2a, 0xf5, 0x34, 0x08, 0x9e, 0x84,
-	0x42, 0x7a, 0x11, 0x2c, 0x06, 0xf7, 0x63, 0x15, 0x2b, 0x6c, 0xf9, 0xe6, 0x54, 0xeb, 0x07, 0x7b,
-	0x08, 0x08, 0xc5, 0xbc, 0xae, 0x47, 0x9f, 0xda, 0xa4, 0x77, 0x6a, 0x78, 0x34, 0x24, 0x03, 0x2d,
-	0xc3, 0x5c, 0x27, 0xaa, 0x78, 0x2b, 0x32, 0xd0, 0x45, 0x98, 0xe5, 0x27, 0xf2, 0x0d, 0x70, 0x25,
-	0x23, 0x6d, 0x5b, 0x43, 0x6b, 0xdc, 0x0d, 0x1e, 0x56, 0xa5, 0xfb, 0x60, 0xa3, 0x9a, 0x16, 0x1b,
-	0xd9, 0x54, 0xc8, 0xa9, 0xae, 0x85, 0xec, 0x1f, 0x10, 0xfa, 0x9e, 0xf4, 0xf0, 0xdd, 0xed, 0xf6,
-	0xd0, 0x1a, 0xef, 0x04, 0xc7, 0x97, 0xa5, 0xdb, 0xfa, 0x5a, 0xba, 0x8f, 0x62, 0x51, 0x24, 0xe7,
-	0x33, 0x8f, 0xab, 0xcc, 0xe7, 0x4a, 0x67, 0x4a, 0xaf, 0xd3, 0x44, 0x47, 0x67, 0x7e, 0x71, 0x91,
-	0x83, 0xf6, 0x5e, 0x02, 0xaf, 0x4a, 0xb7, 0xb6, 0x7f, 0x2f, 0xdd, 0xdd, 0x8b, 0x30, 0x4b, 0x9f,
-	0x8d, 0xb0, 0x1c, 0xb1, 0xfa, 0x31, 0x7d, 0x41, 0xba, 0x66, 0x32, 0xbb, 0x33, 0xb4, 0xc6, 0xb7,
-	0x0e, 0x1c, 0xef, 0x6f, 0xab, 0xf1, 0x4e, 0x43, 0x31, 0x0f, 0x6e, 0x56, 0xa5, 0x8b, 0x7a, 0x86,
-	0x71, 0xf4, 0xa3, 0x43, 0xee, 0xe0, 0x26, 0x8e, 0x42, 0x19, 0xa5, 0xa0, 0x0b, 0xc1, 0xcf, 0xe8,
-	0x73, 0xb2, 0x37, 0x83, 0x58, 0xc8, 0x5f, 0xc3, 0xac, 0x17, 0x71, 0xaf, 0x2a, 0xdd, 0x7d, 0xec,
-	0x5c, 0x6f, 0x81, 0xfd, 0x21, 0xa5, 0x4f, 0xc9, 0x2e, 0xc8, 0xe8, 0xda, 0xda, 0x46, 0xeb, 0xdd,
-	0xaa, 0x74, 0x6f, 0x83, 0x8c, 0xb6, 0x8c, 0xbf, 0xc9, 0xe8, 0x2b, 0xd2, 0x55, 0x39, 0x48, 0x1c,
-	0x63, 0x27, 0x38, 0x68, 0xb4, 0x20, 0x74, 0x32, 0x8c, 0x86, 0x93, 0x88, 0x38, 0xb1, 0xbb, 0xff,
-	0xc3, 0x31, 0x4e, 0x86, 0x91, 0x1e, 0x91, 0x4e, 0xaa, 0x3e, 0xda, 0x3d, 0xc4, 0x3c, 0x6e, 0x84,
-	0x31, 0x46, 0x66, 0x02, 0x3d, 0x21, 0x3d, 0x9e, 0x2a, 0x0d, 0x76, 0x1f, 0x31, 0x87, 0xcd, 0x3e,
-	0x3b, 0x5a, 0x59, 0x9d, 0xe8, 0x6b, 0xd2, 0xff, 0xa0, 0xd2, 0xf3, 0x0c, 0xec, 0x1b, 0xc8, 0x7a,
-	0xd2, 0x88, 0xb5, 0xf6, 0xb2, 0x75, 0x0e, 0x8e, 0x2f, 0x97, 0x8e, 0x75, 0xb5, 0x74, 0xac, 0x6f,
-	0x4b, 0xc7, 0xfa, 0xbc, 0x72, 0x5a, 0x57, 0x2b, 0xa7, 0xf5, 0x65, 0xe5, 0xb4, 0xde, 0x4d, 0xb6,
-	0x98, 0x1a, 0xc4, 0x64, 0x73, 0x97, 0xb0, 0xc0, 0xcb, 0xe4, 0x2f, 0x7c, 0xf3, 0x3b, 0x21, 0x7e,
-	0xd6, 0xc7, 0xfe, 0xe1, 0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa8, 0xe7, 0x11, 0xeb, 0xa3, 0x03,
-	0x00, 0x00,
+	// 489 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0xe3, 0xfc, 0x83, 0x1c, 0xa5, 0x85, 0x83, 0xc1, 0x8a, 0x84, 0x1d, 0x32, 0xa0, 0x2c,
+	0xb1, 0x45, 0x0b, 0x0b, 0x74, 0x72, 0x51, 0xab, 0x4a, 0x0c, 0xd5, 0xc1, 0x54, 0x09, 0x45, 0x8e,
+	0xfd, 0x62, 0x9f, 0x6a, 0xdf, 0x59, 0x39, 0x17, 0xd2, 0x6f, 0xc1, 0xc7, 0xca, 0xd8, 0x11, 0x31,
+	0x9c, 0x50, 0xb2, 0x79, 0x44, 0x7c, 0x00, 0xe4, 0xd7, 0x09, 0x29, 0x48, 0x20, 0xb9, 0xcb, 0x7b,
+	0x77, 0xbe, 0xe7, 0xf9, 0xe9, 0xbd, 0x47, 0xaf, 0xc9, 0x5e, 0x08, 0x73, 0x37, 0x9b, 0xf1, 0x00,
+	0x9c, 0x6c, 0x26, 0x73, 0x49, 0x4d, 0x05, 0x1c, 0x77, 0x81, 0x4c, 0x1c, 0x05, 0x3c, 0x88, 0x7d,
+	0x2e, 0x9c, 0x10, 0xe6, 0xfd, 0xc7, 0x91, 0x8c, 0x24, 0x5e, 0xb9, 0xe5, 0xae, 0xd2, 0xf7, 0x77,
+	0x11, 0xe0, 0xf3, 0x59, 0x75, 0x1e, 0xae, 0x9a, 0xa4, 0x73, 0x56, 0xf2, 0xa8, 0x4f, 0xfa, 0x4a,
+	0xf8, 0x99, 0x8a, 0x65, 0xfe, 0x9e, 0xa7, 0xa0, 0x72, 0x3f, 0xcd, 0x4e, 0xc5, 0x3b, 0x08, 0xa4,
+	0x08, 0x95, 0x69, 0x0c, 0x8c, 0x51, 0xdb, 0x7b, 0x5a, 0x68, 0xfb, 0xc9, 0x46, 0x35, 0xc9, 0x37,
+	0xb2, 0x09, 0x17, 0x13, 0x55, 0x09, 0xd9, 0x7f, 0x20, 0xf4, 0x03, 0xe9, 0x60, 0xef, 0x66, 0x73,
+	0x60, 0x8c, 0x7a, 0xde, 0xc9, 0x42, 0xdb, 0x8d, 0x6f, 0xda, 0x7e, 0x16, 0xf1, 0x3c, 0xbe, 0x9c,
+	0x3a, 0x81, 0x4c, 0xdd, 0x40, 0xaa, 0x54, 0xaa, 0xf5, 0x32, 0x56, 0xe1, 0x85, 0x9b, 0x5f, 0x65,
+	0xa0, 0x9c, 0x37, 0x10, 0x14, 0xda, 0xae, 0xec, 0x3f, 0xb4, 0xbd, 0x73, 0xe5, 0xa7, 0xc9, 0xab,
+	0x21, 0x1e, 0x87, 0xac, 0xfa, 0x4c, 0x0f, 0x49, 0xbb, 0x7c, 0x99, 0xd9, 0x1a, 0x18, 0xa3, 0x7b,
+	0xfb, 0x96, 0xf3, 0xaf, 0x68, 0x9c, 0x33, 0x9f, 0xcf, 0xbc, 0xbb, 0x85, 0xb6, 0x51, 0xcf, 0xb0,
+	0xd2, 0x73, 0xd2, 0xcb, 0x65, 0xee, 0x27, 0xc7, 0x00, 0xca, 0x6c, 0x63, 0x83, 0x87, 0x0b, 0x6d,
+	0x1b, 0xb5, 0x1a, 0x24, 0x88, 0x98, 0x7c, 0x04, 0x50, 0x6c, 0x8b, 0x1b, 0xfe, 0x6c, 0x91, 0x07,
+	0x98, 0xf2, 0x91, 0x2f, 0xc2, 0x04, 0x54, 0xce, 0x83, 0x0b, 0xfa, 0x9a, 0xec, 0x4e, 0x21, 0xe2,
+	0xe2, 0x77, 0x50, 0xeb, 0x90, 0x1f, 0x15, 0xda, 0xde, 0xc3, 0x9b, 0x6d, 0xc2, 0xec, 0x2f, 0x29,
+	0x7d, 0x49, 0x76, 0x40, 0x84, 0x5b, 0x6b, 0x13, 0xad, 0x0f, 0x0b, 0x6d, 0xdf, 0x07, 0x11, 0xde,
+	0x30, 0xfe, 0x21, 0xa3, 0xc7, 0xa4, 0x2d, 0x33, 0x10, 0x18, 0x51, 0xcf, 0xdb, 0xaf, 0xf5, 0x36,
+	0x74, 0x32, 0xac, 0x25, 0x27, 0xe6, 0x51, 0x6c, 0xb6, 0x6f, 0xc3, 0x29, 0x9d, 0x0c, 0x2b, 0x3d,
+	0x22, 0xad, 0x44, 0x7e, 0x36, 0x3b, 0x88, 0x79, 0x5e, 0x0b, 0x53, 0x1a, 0x59, 0x59, 0xe8, 0x29,
+	0xe9, 0x04, 0x89, 0x54, 0x60, 0x76, 0x11, 0x73, 0x50, 0x6f, 0xa4, 0xd0, 0xca, 0xaa, 0x85, 0xbe,
+	0x25, 0xdd, 0x4f, 0x32, 0xb9, 0x4c, 0xc1, 0xbc, 0x83, 0xac, 0x17, 0xb5, 0x58, 0x6b, 0x2f, 0x5b,
+	0xaf, 0xde, 0xc9, 0x62, 0x69, 0x19, 0xd7, 0x4b, 0xcb, 0xf8, 0xbe, 0xb4, 0x8c, 0x2f, 0x2b, 0xab,
+	0x71, 0xbd, 0xb2, 0x1a, 0x5f, 0x57, 0x56, 0xe3, 0x7c, 0x7c, 0x83, 0xa9, 0x80, 0x8f, 0x37, 0x73,
+	0x8a, 0x07, 0x1c, 0x54, 0x77, 0xee, 0x96, 0xbf, 0x2a, 0xe2, 0xa7, 0x5d, 0xbc, 0x3f, 0xf8, 0x35,
+	0x00, 0x2f, 0xe9, 0xc6, 0xb3, 0xff, 0x03, 0x00, 0x00,
 }
 
 func (m *Price) Marshal() (dAtA []byte, err error) {
@@ -195,6 +197,18 @@ func (m *Price) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TotalFees != nil {
+		{
+			size := m.TotalFees.Size()
+			i -= size
+			if _, err := m.TotalFees.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintPrice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Pair != nil {
 		{
 			size, err := m.Pair.MarshalToSizedBuffer(dAtA[:i])
@@ -344,6 +358,10 @@ func (m *Price) Size() (n int) {
 		l = m.Pair.Size()
 		n += 1 + l + sovPrice(uint64(l))
 	}
+	if m.TotalFees != nil {
+		l = m.TotalFees.Size()
+		n += 1 + l + sovPrice(uint64(l))
+	}
 	return n
 }
 
@@ -506,6 +524,42 @@ func (m *Price) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.TotalFees = &v
+			if err := m.TotalFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPrice(dAtA[iNdEx:])
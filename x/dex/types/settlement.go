@@ -34,3 +34,11 @@ func NewSettlementEntry(
 		Height:                 uint64(ctx.BlockHeight()),
 	}
 }
+
+// FeeOrZero returns the fee charged for the settlement, which is unset if the pair has no fee rates
+func (m *SettlementEntry) FeeOrZero() sdk.Dec {
+	if m.Fee.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return m.Fee
+}
@@ -37,6 +37,8 @@ type SettlementEntry struct {
 	Timestamp              uint64                                 `protobuf:"varint,10,opt,name=timestamp,proto3" json:"timestamp"`
 	Height                 uint64                                 `protobuf:"varint,11,opt,name=height,proto3" json:"height"`
 	SettlementId           uint64                                 `protobuf:"varint,12,opt,name=settlementId,proto3" json:"settlement_id"`
+	// fee charged for this settlement, denominated in the price denom
+	Fee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee" yaml:"fee"`
 }
 
 func (m *SettlementEntry) Reset()         { *m = SettlementEntry{} }
@@ -195,44 +197,45 @@ func init() {
 func init() { proto.RegisterFile("dex/settlement.proto", fileDescriptor_c24d83c09612bb1c) }
 
 var fileDescriptor_c24d83c09612bb1c = []byte{
-	// 584 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x3f, 0x6f, 0xdb, 0x3c,
-	0x10, 0xc6, 0xad, 0xfc, 0xb1, 0x63, 0x3a, 0x79, 0x83, 0x10, 0x79, 0x03, 0xb6, 0x83, 0x68, 0x08,
-	0x68, 0x90, 0xa2, 0x8d, 0x04, 0xb4, 0xe8, 0xd2, 0xd1, 0x75, 0x51, 0x64, 0x28, 0x1a, 0xb0, 0x9d,
-	0xba, 0x08, 0x0a, 0x75, 0xb0, 0x89, 0x46, 0xa2, 0x2a, 0xd2, 0x80, 0xbd, 0xf5, 0x23, 0xf4, 0x3b,
-	0x74, 0xe8, 0x57, 0xc9, 0x98, 0xb1, 0xe8, 0x40, 0x14, 0xc9, 0xa6, 0x31, 0x5b, 0xb7, 0x42, 0x94,
-	0x65, 0x25, 0xad, 0x3d, 0x64, 0xe2, 0xf1, 0xb9, 0xdf, 0xdd, 0x3d, 0x86, 0x79, 0x42, 0xfb, 0x31,
-	0x4c, 0x03, 0x05, 0x5a, 0x9f, 0x43, 0x02, 0xa9, 0xf6, 0xb3, 0x5c, 0x6a, 0x89, 0x89, 0x02, 0x61,
-	0x23, 0x2e, 0xcf, 0x7d, 0x05, 0x82, 0x8f, 0x23, 0x91, 0xfa, 0x31, 0x4c, 0x1f, 0xee, 0x8f, 0xe4,
-	0x48, 0xda, 0x54, 0x50, 0x46, 0x15, 0xef, 0xfd, 0x6e, 0xa3, 0xdd, 0xf7, 0x8b, 0x26, 0xaf, 0x53,
-	0x9d, 0xcf, 0xf0, 0x23, 0xd4, 0x89, 0x38, 0x97, 0x93, 0x54, 0x13, 0xa7, 0xef, 0x1c, 0x75, 0x07,
-	0xbd, 0xc2, 0xd0, 0x5a, 0x62, 0x75, 0x80, 0x03, 0x84, 0xb2, 0x5c, 0x70, 0x18, 0x42, 0x2a, 0x13,
-	0xb2, 0x66, 0xc9, 0xdd, 0xc2, 0xd0, 0x9e, 0x55, 0xc3, 0xb8, 0x94, 0xd9, 0x2d, 0xa4, 0x2c, 0x88,
-	0x94, 0x02, 0x5d, 0x15, 0xac, 0x37, 0x05, 0x56, 0xad, 0x0b, 0x1a, 0x04, 0x0b, 0xb4, 0xf5, 0x79,
-	0x12, 0xa5, 0x5a, 0xe8, 0x19, 0xd9, 0xb0, 0xf8, 0xdb, 0x0b, 0x43, 0x5b, 0x3f, 0x0d, 0x3d, 0x1c,
-	0x09, 0x3d, 0x9e, 0x9c, 0xf9, 0x5c, 0x26, 0x01, 0x97, 0x2a, 0x91, 0x6a, 0x7e, 0x1c, 0xab, 0xf8,
-	0x53, 0xa0, 0x67, 0x19, 0x28, 0x7f, 0x08, 0xbc, 0x30, 0x74, 0xd1, 0xe1, 0xc6, 0xd0, 0xdd, 0x59,
-	0x94, 0x9c, 0xbf, 0xf4, 0x6a, 0xc5, 0x63, 0x8b, 0x24, 0xfe, 0xee, 0xa0, 0x03, 0x98, 0x02, 0x9f,
-	0x68, 0x21, 0xd3, 0x57, 0x52, 0xe9, 0x77, 0xf9, 0x69, 0x2e, 0x39, 0x40, 0x4c, 0x36, 0xed, 0x64,
-	0x79, 0xef, 0xc9, 0x0f, 0x16, 0xfd, 0x42, 0x2e, 0x95, 0x0e, 0x65, 0x1e, 0x66, 0x55, 0xcb, 0x1b,
-	0x43, 0xfb, 0x95, 0x95, 0x95, 0x88, 0xc7, 0x56, 0xd8, 0xc1, 0xdf, 0x1c, 0xf4, 0x3f, 0x4c, 0x33,
-	0xe0, 0x1a, 0xe2, 0xbb, 0x46, 0xdb, 0xd6, 0x68, 0x72, 0x6f, 0xa3, 0xa4, 0x6e, 0xb7, 0xc4, 0x27,
-	0xad, 0x7d, 0x2e, 0x27, 0x3c, 0xb6, 0xdc, 0x0b, 0x1e, 0xa2, 0xbd, 0x4c, 0x2a, 0x51, 0xda, 0x1f,
-	0x8a, 0x1c, 0x78, 0x19, 0x90, 0x8e, 0x35, 0x78, 0x50, 0x18, 0x8a, 0xeb, 0x64, 0x18, 0xd7, 0x59,
-	0xf6, 0x6f, 0x01, 0x7e, 0x8a, 0xba, 0x32, 0x8f, 0x21, 0xff, 0x30, 0xcb, 0x80, 0x6c, 0xd9, 0xea,
-	0xff, 0x0a, 0x43, 0x91, 0x15, 0xc3, 0xf2, 0x37, 0xb0, 0x06, 0xc0, 0x87, 0xa8, 0x63, 0x2f, 0x27,
-	0x31, 0xe9, 0xf6, 0x9d, 0xa3, 0x8d, 0xc1, 0x76, 0xf9, 0xff, 0x57, 0xac, 0x88, 0x59, 0x9d, 0xc4,
-	0x4f, 0x50, 0x57, 0x8b, 0x04, 0x94, 0x8e, 0x92, 0x8c, 0x20, 0x4b, 0xee, 0x14, 0x86, 0x36, 0x22,
-	0x6b, 0x42, 0xec, 0xa1, 0xf6, 0x18, 0xc4, 0x68, 0xac, 0x49, 0xcf, 0x92, 0xa8, 0x30, 0x74, 0xae,
-	0xb0, 0xf9, 0x89, 0x5f, 0xa0, 0xed, 0x66, 0x11, 0x4f, 0x62, 0xb2, 0x6d, 0xc9, 0xbd, 0xc2, 0xd0,
-	0x9d, 0x46, 0x2f, 0x2d, 0xdc, 0xc1, 0xbc, 0x2f, 0x0e, 0xea, 0x35, 0xbb, 0xa7, 0x30, 0x45, 0x9b,
-	0x90, 0x49, 0x3e, 0xb6, 0x5b, 0xb7, 0x3e, 0xe8, 0x16, 0x86, 0x56, 0x02, 0xab, 0x0e, 0x7c, 0x8a,
-	0x3a, 0x90, 0xea, 0x5c, 0x80, 0x22, 0x6b, 0xfd, 0xf5, 0xa3, 0xde, 0xb3, 0xc7, 0xfe, 0xaa, 0x75,
-	0xf7, 0xff, 0x5a, 0xea, 0x6a, 0x87, 0xe7, 0xd5, 0xac, 0x0e, 0x06, 0x6f, 0x2e, 0xae, 0x5c, 0xe7,
-	0xf2, 0xca, 0x75, 0x7e, 0x5d, 0xb9, 0xce, 0xd7, 0x6b, 0xb7, 0x75, 0x79, 0xed, 0xb6, 0x7e, 0x5c,
-	0xbb, 0xad, 0x8f, 0xc7, 0xb7, 0x9e, 0x8f, 0x02, 0x71, 0x5c, 0x4f, 0xb1, 0x17, 0x3b, 0x26, 0x98,
-	0x06, 0xe5, 0x27, 0xc8, 0xbe, 0xa4, 0xb3, 0xb6, 0xcd, 0x3f, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff,
-	0x07, 0xa3, 0x0d, 0xb2, 0x96, 0x04, 0x00, 0x00,
+	// 603 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x31, 0x6f, 0xd4, 0x30,
+	0x14, 0xbe, 0xf4, 0xda, 0x5e, 0xcf, 0xd7, 0x52, 0xd5, 0x2a, 0x95, 0x61, 0x88, 0x4f, 0x91, 0xa8,
+	0x8a, 0xa0, 0x89, 0x04, 0x62, 0x61, 0xbc, 0x1e, 0x42, 0x1d, 0x10, 0x95, 0x81, 0x85, 0x25, 0x4a,
+	0x9d, 0xd7, 0x3b, 0x8b, 0x26, 0x0e, 0xb1, 0x4f, 0xba, 0xdb, 0xf8, 0x09, 0xfc, 0x07, 0x06, 0xfe,
+	0x4a, 0xc7, 0x8e, 0x88, 0xc1, 0x42, 0xed, 0x96, 0xb1, 0xbf, 0x00, 0xc5, 0xb9, 0x5c, 0x5a, 0x68,
+	0x87, 0x4e, 0x7e, 0xfe, 0xde, 0xf7, 0xbd, 0xf7, 0x3d, 0x59, 0x7e, 0x68, 0x3b, 0x86, 0x69, 0xa0,
+	0x40, 0xeb, 0x53, 0x48, 0x20, 0xd5, 0x7e, 0x96, 0x4b, 0x2d, 0x31, 0x51, 0x20, 0x6c, 0xc4, 0xe5,
+	0xa9, 0xaf, 0x40, 0xf0, 0x71, 0x24, 0x52, 0x3f, 0x86, 0xe9, 0xe3, 0xed, 0x91, 0x1c, 0x49, 0x9b,
+	0x0a, 0xca, 0xa8, 0xe2, 0x7b, 0xe7, 0x1d, 0xb4, 0xf9, 0x61, 0x51, 0xe4, 0x4d, 0xaa, 0xf3, 0x19,
+	0x7e, 0x82, 0x3a, 0x11, 0xe7, 0x72, 0x92, 0x6a, 0xe2, 0xf4, 0x9d, 0xbd, 0xee, 0xa0, 0x57, 0x18,
+	0x5a, 0x43, 0xac, 0x0e, 0x70, 0x80, 0x50, 0x96, 0x0b, 0x0e, 0x43, 0x48, 0x65, 0x42, 0x96, 0x2c,
+	0x73, 0xb3, 0x30, 0xb4, 0x67, 0xd1, 0x30, 0x2e, 0x61, 0x76, 0x8d, 0x52, 0x0a, 0x22, 0xa5, 0x40,
+	0x57, 0x82, 0x76, 0x23, 0xb0, 0x68, 0x2d, 0x68, 0x28, 0x58, 0xa0, 0xb5, 0xaf, 0x93, 0x28, 0xd5,
+	0x42, 0xcf, 0xc8, 0xb2, 0xa5, 0xbf, 0x3b, 0x33, 0xb4, 0xf5, 0xdb, 0xd0, 0xdd, 0x91, 0xd0, 0xe3,
+	0xc9, 0xb1, 0xcf, 0x65, 0x12, 0x70, 0xa9, 0x12, 0xa9, 0xe6, 0xc7, 0xbe, 0x8a, 0xbf, 0x04, 0x7a,
+	0x96, 0x81, 0xf2, 0x87, 0xc0, 0x0b, 0x43, 0x17, 0x15, 0xae, 0x0c, 0xdd, 0x9c, 0x45, 0xc9, 0xe9,
+	0x6b, 0xaf, 0x46, 0x3c, 0xb6, 0x48, 0xe2, 0x9f, 0x0e, 0xda, 0x81, 0x29, 0xf0, 0x89, 0x16, 0x32,
+	0x3d, 0x90, 0x4a, 0xbf, 0xcf, 0x8f, 0x72, 0xc9, 0x01, 0x62, 0xb2, 0x62, 0x3b, 0xcb, 0x7b, 0x77,
+	0x7e, 0xb4, 0xa8, 0x17, 0x72, 0xa9, 0x74, 0x28, 0xf3, 0x30, 0xab, 0x4a, 0x5e, 0x19, 0xda, 0xaf,
+	0xac, 0xdc, 0x49, 0xf1, 0xd8, 0x1d, 0x76, 0xf0, 0x0f, 0x07, 0x3d, 0x84, 0x69, 0x06, 0x5c, 0x43,
+	0x7c, 0xd3, 0xe8, 0xaa, 0x35, 0x9a, 0xdc, 0xdb, 0x28, 0xa9, 0xcb, 0xdd, 0xe2, 0x93, 0xd6, 0x3e,
+	0x6f, 0x67, 0x78, 0xec, 0x76, 0x2f, 0x78, 0x88, 0xb6, 0x32, 0xa9, 0x44, 0x69, 0x7f, 0x28, 0x72,
+	0xe0, 0x65, 0x40, 0x3a, 0xd6, 0xe0, 0x4e, 0x61, 0x28, 0xae, 0x93, 0x61, 0x5c, 0x67, 0xd9, 0xff,
+	0x02, 0xfc, 0x1c, 0x75, 0x65, 0x1e, 0x43, 0xfe, 0x71, 0x96, 0x01, 0x59, 0xb3, 0xea, 0x07, 0x85,
+	0xa1, 0xc8, 0x82, 0x61, 0x39, 0x03, 0x6b, 0x08, 0x78, 0x17, 0x75, 0xec, 0xe5, 0x30, 0x26, 0xdd,
+	0xbe, 0xb3, 0xb7, 0x3c, 0x58, 0x2f, 0xdf, 0xbf, 0xe2, 0x8a, 0x98, 0xd5, 0x49, 0xfc, 0x0c, 0x75,
+	0xb5, 0x48, 0x40, 0xe9, 0x28, 0xc9, 0x08, 0xb2, 0xcc, 0x8d, 0xc2, 0xd0, 0x06, 0x64, 0x4d, 0x88,
+	0x3d, 0xb4, 0x3a, 0x06, 0x31, 0x1a, 0x6b, 0xd2, 0xb3, 0x4c, 0x54, 0x18, 0x3a, 0x47, 0xd8, 0xfc,
+	0xc4, 0xaf, 0xd0, 0x7a, 0xf3, 0x11, 0x0f, 0x63, 0xb2, 0x6e, 0x99, 0x5b, 0x85, 0xa1, 0x1b, 0x0d,
+	0x5e, 0x5a, 0xb8, 0x41, 0xc3, 0x9f, 0x50, 0xfb, 0x04, 0x80, 0x6c, 0xd8, 0xb9, 0x0e, 0xee, 0xfd,
+	0x6c, 0xa5, 0xf8, 0xca, 0x50, 0x54, 0xbd, 0xd0, 0x09, 0x80, 0xc7, 0x4a, 0xc8, 0xfb, 0xe6, 0xa0,
+	0x5e, 0xf3, 0xa5, 0x15, 0xa6, 0x68, 0x05, 0x32, 0xc9, 0xc7, 0xf6, 0x33, 0xb7, 0x07, 0xdd, 0xc2,
+	0xd0, 0x0a, 0x60, 0xd5, 0x81, 0x8f, 0x50, 0x07, 0x52, 0x9d, 0x0b, 0x50, 0x64, 0xa9, 0xdf, 0xde,
+	0xeb, 0xbd, 0x78, 0xea, 0xdf, 0xb5, 0x45, 0xfc, 0x7f, 0x76, 0x45, 0xb5, 0x1a, 0xe6, 0x6a, 0x56,
+	0x07, 0x83, 0xb7, 0x67, 0x17, 0xae, 0x73, 0x7e, 0xe1, 0x3a, 0x7f, 0x2e, 0x5c, 0xe7, 0xfb, 0xa5,
+	0xdb, 0x3a, 0xbf, 0x74, 0x5b, 0xbf, 0x2e, 0xdd, 0xd6, 0xe7, 0xfd, 0x6b, 0xe3, 0x29, 0x10, 0xfb,
+	0x75, 0x17, 0x7b, 0xb1, 0x6d, 0x82, 0x69, 0x50, 0x6e, 0x36, 0x3b, 0xe9, 0xf1, 0xaa, 0xcd, 0xbf,
+	0xfc, 0x3b, 0x00, 0xca, 0xb6, 0x42, 0xaa, 0xed, 0x04, 0x00, 0x00,
 }
 
 func (m *SettlementEntry) Marshal() (dAtA []byte, err error) {
@@ -255,6 +258,16 @@ func (m *SettlementEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
 	if m.SettlementId != 0 {
 		i = encodeVarintSettlement(dAtA, i, uint64(m.SettlementId))
 		i--
@@ -440,6 +453,8 @@ func (m *SettlementEntry) Size() (n int) {
 	if m.SettlementId != 0 {
 		n += 1 + sovSettlement(uint64(m.SettlementId))
 	}
+	l = m.Fee.Size()
+	n += 1 + l + sovSettlement(uint64(l))
 	return n
 }
 
@@ -834,6 +849,40 @@ func (m *SettlementEntry) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSettlement(dAtA[iNdEx:])
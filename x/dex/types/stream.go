@@ -0,0 +1,45 @@
+package types
+
+import (
+	"errors"
+
+	"github.com/sei-protocol/sei-chain/utils"
+)
+
+func (r *SubscribeRequest) ValidateBasic() error {
+	if (r.PriceDenom == "") != (r.AssetDenom == "") {
+		return errors.New("price denom and asset denom must be either both set or both empty")
+	}
+	return nil
+}
+
+// Filter returns the part of the event that the subscription is interested in, or nil if
+// there is none. Book updates are not account specific and are left out if an account is
+// specified.
+func (r *SubscribeRequest) Filter(event *StreamEvent) *StreamEvent {
+	if r.ContractAddr != "" && r.ContractAddr != event.ContractAddr {
+		return nil
+	}
+	if r.PriceDenom != "" && (r.PriceDenom != event.PriceDenom || r.AssetDenom != event.AssetDenom) {
+		return nil
+	}
+	if r.Account == "" {
+		return event
+	}
+	filtered := &StreamEvent{
+		Height:       event.Height,
+		ContractAddr: event.ContractAddr,
+		PriceDenom:   event.PriceDenom,
+		AssetDenom:   event.AssetDenom,
+		Fills: utils.Filter(event.Fills, func(s *SettlementEntry) bool {
+			return s.Account == r.Account
+		}),
+		Cancellations: utils.Filter(event.Cancellations, func(c *Cancellation) bool {
+			return c.Creator == r.Account
+		}),
+	}
+	if len(filtered.Fills) == 0 && len(filtered.Cancellations) == 0 {
+		return nil
+	}
+	return filtered
+}
@@ -46,9 +46,12 @@ func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
 		}
 
 		voteTargets := make(map[string]types.Denom)
+		// reward bands are kept aside since pickReferenceDenom removes failing denoms from voteTargets
+		rewardBands := make(map[string]sdk.Dec)
 		totalTargets := 0
 		k.IterateVoteTargets(ctx, func(denom string, denomInfo types.Denom) bool {
 			voteTargets[denom] = denomInfo
+			rewardBands[denom] = denomInfo.RewardBandOr(params.RewardBand)
 			totalTargets++
 			return false
 		})
@@ -59,6 +62,7 @@ func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
 		voteMap := k.OrganizeBallotByDenom(ctx, validatorClaimMap)
 		// belowThresholdVoteMap has assets that failed to meet threshold
 		referenceDenom, belowThresholdVoteMap := pickReferenceDenom(ctx, k, voteTargets, voteMap)
+		ballotResult := types.BallotResult{Height: ctx.BlockHeight(), ReferenceDenom: referenceDenom}
 
 		if referenceDenom != "" {
 			// make voteMap of Reference denom to calculate cross exchange rates
@@ -83,7 +87,9 @@ func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
 				}
 
 				// Get weighted median of cross exchange rates
-				exchangeRate := Tally(ctx, ballot, params.RewardBand, validatorClaimMap)
+				exchangeRate, denomResult := Tally(ctx, ballot, rewardBands[denom], validatorClaimMap)
+				denomResult.Denom, denomResult.Passed = denom, true
+				ballotResult.DenomResults = append(ballotResult.DenomResults, denomResult)
 
 				// Transform into the original form base/quote
 				if denom != referenceDenom {
@@ -107,12 +113,24 @@ func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
 		for _, denom := range belowThresholdKeys {
 			ballot := belowThresholdVoteMap[denom]
 			// perform tally for below threshold assets to calculate total win count
-			Tally(ctx, ballot, params.RewardBand, validatorClaimMap)
+			_, denomResult := Tally(ctx, ballot, rewardBands[denom], validatorClaimMap)
+			denomResult.Denom = denom
+			ballotResult.DenomResults = append(ballotResult.DenomResults, denomResult)
 		}
 
+		//---------------------------
+		// Distribute rewards to ballot winners
+		k.RewardBallotWinners(ctx, params.VotePeriod, params.RewardDistributionWindow, validatorClaimMap)
+
 		//---------------------------
 		// Do miss counting & slashing
 		for _, claim := range validatorClaimMap {
+			ballotResult.ValidatorResults = append(ballotResult.ValidatorResults, types.ValidatorBallotResult{
+				Validator: claim.Recipient.String(),
+				WinCount:  claim.WinCount,
+				DidVote:   claim.DidVote,
+				Success:   int(claim.WinCount) == totalTargets,
+			})
 			// we require validator to have submitted in-range data
 			// for all assets to not be counted as a miss
 			if int(claim.WinCount) == totalTargets {
@@ -128,12 +146,21 @@ func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
 			k.IncrementMissCount(ctx, claim.Recipient)
 		}
 
+		// Keep the ballot result for introspection
+		sort.Slice(ballotResult.ValidatorResults, func(i, j int) bool {
+			return ballotResult.ValidatorResults[i].Validator < ballotResult.ValidatorResults[j].Validator
+		})
+		k.AddBallotResult(ctx, ballotResult)
+
 		// Clear the ballot
 		k.ClearBallots(ctx, params.VotePeriod)
 
 		// Update vote targets
 		k.ApplyWhitelist(ctx, params.Whitelist, voteTargets)
 
+		// Remove exchange rates that have not been updated within their staleness window
+		k.RemoveStaleRates(ctx)
+
 		priceSnapshotItems := []types.PriceSnapshotItem{}
 		k.IterateBaseExchangeRates(ctx, func(denom string, exchangeRate types.OracleExchangeRate) bool {
 			priceSnapshotItem := types.PriceSnapshotItem{
@@ -173,10 +173,20 @@ func TestOracleTally(t *testing.T) {
 		expectedValidatorClaimMap[key] = claim
 	}
 
-	tallyMedian := oracle.Tally(input.Ctx, ballot, input.OracleKeeper.RewardBand(input.Ctx), validatorClaimMap)
+	tallyMedian, ballotResult := oracle.Tally(input.Ctx, ballot, input.OracleKeeper.RewardBand(input.Ctx), validatorClaimMap)
 
 	require.Equal(t, validatorClaimMap, expectedValidatorClaimMap)
 	require.Equal(t, tallyMedian.MulInt64(100).TruncateInt(), weightedMedian.MulInt64(100).TruncateInt())
+
+	require.Equal(t, weightedMedian, ballotResult.WeightedMedian)
+	require.Equal(t, standardDeviation, ballotResult.StandardDeviation)
+	require.Equal(t, maxSpread, ballotResult.RewardSpread)
+	require.Equal(t, ballot.Power(), ballotResult.Power)
+	require.Equal(t, len(ballot), len(ballotResult.Votes))
+	for i, vote := range ballotResult.Votes {
+		require.Equal(t, ballot[i].Voter.String(), vote.Voter)
+		require.Equal(t, expectedValidatorClaimMap[vote.Voter].WinCount == 1, vote.Win)
+	}
 }
 
 func TestOracleTallyTiming(t *testing.T) {
@@ -634,6 +644,233 @@ func TestOraclePriceSnapshot(t *testing.T) {
 	require.Equal(t, expected2, input.OracleKeeper.GetPriceSnapshot(input.Ctx, 200))
 }
 
+func TestOracleStaleRatesRemovedAtVotePeriodEnd(t *testing.T) {
+	input, _ := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.VotePeriod = 10
+	params.StalenessWindow = 60
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	input.OracleKeeper.SetBaseExchangeRate(input.Ctx.WithBlockTime(time.Unix(100, 0)), utils.MicroAtomDenom, randomExchangeRate)
+	input.OracleKeeper.SetBaseExchangeRate(input.Ctx.WithBlockTime(time.Unix(180, 0)), utils.MicroEthDenom, randomExchangeRate)
+	input.Ctx = input.Ctx.WithBlockTime(time.Unix(200, 0))
+
+	// stale rates are kept until the end of the vote period
+	oracle.MidBlocker(input.Ctx.WithBlockHeight(5), input.OracleKeeper)
+	_, _, _, err := input.OracleKeeper.GetBaseExchangeRate(input.Ctx, utils.MicroAtomDenom)
+	require.NoError(t, err)
+
+	oracle.MidBlocker(input.Ctx.WithBlockHeight(9), input.OracleKeeper)
+	_, _, _, err = input.OracleKeeper.GetBaseExchangeRate(input.Ctx, utils.MicroAtomDenom)
+	require.Error(t, err)
+	_, _, _, err = input.OracleKeeper.GetBaseExchangeRate(input.Ctx, utils.MicroEthDenom)
+	require.NoError(t, err)
+
+	// the stale rate is not part of the price snapshot of the vote period
+	snapshot := input.OracleKeeper.GetPriceSnapshot(input.Ctx, 200)
+	require.Equal(t, 1, len(snapshot.PriceSnapshotItems))
+	require.Equal(t, utils.MicroEthDenom, snapshot.PriceSnapshotItems[0].Denom)
+}
+
+func TestOracleRewardDistribution(t *testing.T) {
+	input, h := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	// distribute the whole pool in a single vote period
+	params.RewardDistributionWindow = params.VotePeriod
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	pool := sdk.NewCoins(sdk.NewInt64Coin(utils.MicroSeiDenom, 1000))
+	err := keeper.FundAccount(input, input.OracleKeeper.GetOracleAccount(input.Ctx).GetAddress(), pool)
+	require.NoError(t, err)
+
+	rates := sdk.DecCoins{
+		{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate},
+		{Denom: utils.MicroEthDenom, Amount: anotherRandomExchangeRate},
+	}
+	makeAggregateVote(t, input, h, 0, rates, 0)
+	makeAggregateVote(t, input, h, 0, rates, 1)
+	// validator 2 only wins the atom ballot
+	makeAggregateVote(t, input, h, 0, sdk.DecCoins{
+		{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate},
+		{Denom: utils.MicroEthDenom, Amount: anotherRandomExchangeRate.MulInt64(100)},
+	}, 2)
+
+	oracle.MidBlocker(input.Ctx, input.OracleKeeper)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(utils.MicroSeiDenom, 400)), input.OracleKeeper.GetValidatorRewards(input.Ctx, keeper.ValAddrs[0]))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(utils.MicroSeiDenom, 400)), input.OracleKeeper.GetValidatorRewards(input.Ctx, keeper.ValAddrs[1]))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(utils.MicroSeiDenom, 200)), input.OracleKeeper.GetValidatorRewards(input.Ctx, keeper.ValAddrs[2]))
+	require.True(t, input.OracleKeeper.GetRewardPoolLegacy(input.Ctx).IsZero())
+	outstandingRewards, _ := input.DistrKeeper.GetValidatorOutstandingRewardsCoins(input.Ctx, keeper.ValAddrs[2]).TruncateDecimal()
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(utils.MicroSeiDenom, 200)), outstandingRewards)
+}
+
+func TestCommitRevealUnrevealedPrevoteMisses(t *testing.T) {
+	input, h := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: utils.MicroAtomDenom}}
+	params.CommitRevealEnabled = true
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	input.OracleKeeper.ClearVoteTargets(input.Ctx)
+	input.OracleKeeper.SetVoteTarget(input.Ctx, utils.MicroAtomDenom)
+
+	// all validators commit in the first vote period
+	exchangeRateStr := sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate}}.String()
+	for i := 0; i < 3; i++ {
+		hash := types.GetAggregateVoteHash("salt", exchangeRateStr, keeper.ValAddrs[i])
+		_, err := h(input.Ctx.WithBlockHeight(1), types.NewMsgAggregateExchangeRatePrevote(hash, keeper.Addrs[i], keeper.ValAddrs[i]))
+		require.NoError(t, err)
+	}
+	input.Ctx = input.Ctx.WithBlockHeight(1)
+	oracle.MidBlocker(input.Ctx, input.OracleKeeper)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	// the prevotes are kept for the reveal in the next vote period
+	for i := 0; i < 3; i++ {
+		_, err := input.OracleKeeper.GetAggregateExchangeRatePrevote(input.Ctx, keeper.ValAddrs[i])
+		require.NoError(t, err)
+	}
+
+	// only the first two validators reveal
+	for i := 0; i < 2; i++ {
+		voteMsg := types.NewMsgAggregateExchangeRateVote(exchangeRateStr, keeper.Addrs[i], keeper.ValAddrs[i])
+		voteMsg.Salt = "salt"
+		_, err := h(input.Ctx.WithBlockHeight(2), voteMsg)
+		require.NoError(t, err)
+	}
+	input.Ctx = input.Ctx.WithBlockHeight(2)
+	oracle.MidBlocker(input.Ctx, input.OracleKeeper)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	rate, _, _, err := input.OracleKeeper.GetBaseExchangeRate(input.Ctx, utils.MicroAtomDenom)
+	require.NoError(t, err)
+	require.Equal(t, randomExchangeRate, rate)
+	require.Equal(t, uint64(1), input.OracleKeeper.GetSuccessCount(input.Ctx, keeper.ValAddrs[0]))
+	require.Equal(t, uint64(1), input.OracleKeeper.GetSuccessCount(input.Ctx, keeper.ValAddrs[1]))
+	// the unrevealed prevote counts as a miss, the only abstain being from the first vote period
+	require.Equal(t, uint64(1), input.OracleKeeper.GetMissCount(input.Ctx, keeper.ValAddrs[2]))
+	require.Equal(t, uint64(1), input.OracleKeeper.GetAbstainCount(input.Ctx, keeper.ValAddrs[2]))
+	_, err = input.OracleKeeper.GetAggregateExchangeRatePrevote(input.Ctx, keeper.ValAddrs[2])
+	require.ErrorIs(t, err, types.ErrNoAggregatePrevote)
+}
+
+func TestDenomOverrides(t *testing.T) {
+	input, h := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: utils.MicroAtomDenom}}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	input.OracleKeeper.ClearVoteTargets(input.Ctx)
+	input.OracleKeeper.SetVoteTarget(input.Ctx, utils.MicroAtomDenom)
+
+	rates := sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate}}
+	// 10% off the other votes, which is outside of the default reward band
+	offRates := sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate.Mul(sdk.NewDecWithPrec(11, 1))}}
+	votePeriod := func(height int64) {
+		input.Ctx = input.Ctx.WithBlockHeight(height)
+		makeAggregateVote(t, input, h, height, rates, 0)
+		makeAggregateVote(t, input, h, height, rates, 1)
+		makeAggregateVote(t, input, h, height, offRates, 2)
+		oracle.MidBlocker(input.Ctx, input.OracleKeeper)
+		oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	}
+
+	votePeriod(1)
+	require.Equal(t, uint64(1), input.OracleKeeper.GetMissCount(input.Ctx, keeper.ValAddrs[2]))
+
+	voteThreshold, rewardBand := sdk.NewDecWithPrec(9, 1), sdk.NewDecWithPrec(5, 1)
+	require.NoError(t, input.OracleKeeper.UpdateDenomParams(input.Ctx, types.Denom{
+		Name:          utils.MicroAtomDenom,
+		VoteThreshold: &voteThreshold,
+		RewardBand:    &rewardBand,
+	}))
+	require.Error(t, input.OracleKeeper.UpdateDenomParams(input.Ctx, types.Denom{Name: utils.MicroEthDenom}))
+
+	// the overrides are applied to the vote targets at the end of the vote period
+	votePeriod(2)
+	require.Equal(t, uint64(2), input.OracleKeeper.GetMissCount(input.Ctx, keeper.ValAddrs[2]))
+	voteTarget, err := input.OracleKeeper.GetVoteTarget(input.Ctx, utils.MicroAtomDenom)
+	require.NoError(t, err)
+	require.Equal(t, rewardBand, *voteTarget.RewardBand)
+
+	// the wider reward band makes the off vote a win
+	votePeriod(3)
+	require.Equal(t, uint64(2), input.OracleKeeper.GetMissCount(input.Ctx, keeper.ValAddrs[2]))
+	require.Equal(t, uint64(1), input.OracleKeeper.GetSuccessCount(input.Ctx, keeper.ValAddrs[2]))
+
+	// two thirds of the voting power pass the default vote threshold but not the override
+	input.Ctx = input.Ctx.WithBlockHeight(4)
+	makeAggregateVote(t, input, h, 4, rates, 0)
+	makeAggregateVote(t, input, h, 4, rates, 1)
+	oracle.MidBlocker(input.Ctx, input.OracleKeeper)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	_, lastUpdate, _, err := input.OracleKeeper.GetBaseExchangeRate(input.Ctx, utils.MicroAtomDenom)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), lastUpdate.Int64())
+}
+
+func TestBallotResults(t *testing.T) {
+	input, h := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: utils.MicroAtomDenom}}
+	params.BallotResultHistory = 2
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	input.OracleKeeper.ClearVoteTargets(input.Ctx)
+	input.OracleKeeper.SetVoteTarget(input.Ctx, utils.MicroAtomDenom)
+
+	rates := sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate}}
+	// 10% off the other votes, which is outside of the reward band
+	offRates := sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate.Mul(sdk.NewDecWithPrec(11, 1))}}
+	for height := int64(1); height <= 3; height++ {
+		input.Ctx = input.Ctx.WithBlockHeight(height)
+		makeAggregateVote(t, input, h, height, rates, 0)
+		makeAggregateVote(t, input, h, height, rates, 1)
+		makeAggregateVote(t, input, h, height, offRates, 2)
+		oracle.MidBlocker(input.Ctx, input.OracleKeeper)
+		oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	}
+
+	// only the results of the last 2 vote periods are kept
+	ballotResults := types.BallotResults{}
+	input.OracleKeeper.IterateBallotResults(input.Ctx, func(ballotResult types.BallotResult) bool {
+		ballotResults = append(ballotResults, ballotResult)
+		return false
+	})
+	require.Equal(t, 2, len(ballotResults))
+	require.Equal(t, int64(2), ballotResults[0].Height)
+
+	ballotResult := ballotResults[1]
+	require.Equal(t, int64(3), ballotResult.Height)
+	require.Equal(t, utils.MicroAtomDenom, ballotResult.ReferenceDenom)
+	require.Equal(t, 1, len(ballotResult.DenomResults))
+	denomResult := ballotResult.DenomResults[0]
+	require.Equal(t, utils.MicroAtomDenom, denomResult.Denom)
+	require.True(t, denomResult.Passed)
+	require.Equal(t, randomExchangeRate, denomResult.WeightedMedian)
+	require.Equal(t, 3*stakingAmt.QuoRaw(utils.MicroUnit).Int64(), denomResult.Power)
+	require.Equal(t, 3, len(denomResult.Votes))
+	for _, vote := range denomResult.Votes {
+		require.Equal(t, vote.Voter != keeper.ValAddrs[2].String(), vote.Win)
+	}
+	require.Equal(t, 3, len(ballotResult.ValidatorResults))
+	for _, validatorResult := range ballotResult.ValidatorResults {
+		require.True(t, validatorResult.DidVote)
+		require.Equal(t, validatorResult.Validator != keeper.ValAddrs[2].String(), validatorResult.Success)
+	}
+
+	// disabling the history removes the kept results at the end of the next vote period
+	params.BallotResultHistory = 0
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	input.Ctx = input.Ctx.WithBlockHeight(4)
+	oracle.MidBlocker(input.Ctx, input.OracleKeeper)
+	input.OracleKeeper.IterateBallotResults(input.Ctx, func(ballotResult types.BallotResult) bool {
+		require.Fail(t, "ballot result should have been removed")
+		return false
+	})
+}
+
 func makeAggregateVote(t *testing.T, input keeper.TestInput, h sdk.Handler, height int64, rates sdk.DecCoins, idx int) {
 	voteMsg := types.NewMsgAggregateExchangeRateVote(rates.String(), keeper.Addrs[idx], keeper.ValAddrs[idx])
 	_, err := h(input.Ctx.WithBlockHeight(height), voteMsg)
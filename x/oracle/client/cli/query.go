@@ -28,10 +28,14 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryExchangeRates(),
 		GetCmdQueryPriceSnapshotHistory(),
 		GetCmdQueryTwaps(),
+		GetCmdQueryWindowAggregates(),
 		GetCmdQueryActives(),
 		GetCmdQueryParams(),
 		GetCmdQueryFeederDelegation(),
 		GetCmdQueryVotePenaltyCounter(),
+		GetCmdQueryValidatorRewards(),
+		GetCmdQueryBallotResults(),
+		GetCmdQuerySlashWindow(),
 		GetCmdQueryVoteTargets(),
 	)
 
@@ -157,6 +161,50 @@ $ seid query oracle twaps
 	return cmd
 }
 
+func GetCmdQueryWindowAggregates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "window-aggregates [start-time] [end-time]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the time weighted average, median, min and max prices of denoms over a historical window",
+		Long: strings.TrimSpace(`
+Query the time weighted average, median, min and max prices of denoms over a window given by unix timestamps in seconds.
+The window must not start before the oldest price snapshot, which is retained for the lookback duration.
+Example:
+
+$ seid query oracle window-aggregates 1669000000 1669000600
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startTime, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			endTime, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.WindowAggregates(
+				context.Background(),
+				&types.QueryWindowAggregatesRequest{StartTime: startTime, EndTime: endTime},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryActives implements the query actives command.
 func GetCmdQueryActives() *cobra.Command {
 	cmd := &cobra.Command{
@@ -214,6 +262,32 @@ func GetCmdQueryParams() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQuerySlashWindow implements the query slash window command.
+func GetCmdQuerySlashWindow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slash-window",
+		Args:  cobra.NoArgs,
+		Short: "Query the progress of the current slash window and the projected outcome for each validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SlashWindow(context.Background(), &types.QuerySlashWindowRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryFeederDelegation implements the query feeder delegation command
 func GetCmdQueryFeederDelegation() *cobra.Command {
 	cmd := &cobra.Command{
@@ -294,6 +368,90 @@ $ seid query oracle miss seivaloper...
 	return cmd
 }
 
+// GetCmdQueryBallotResults implements the query ballot results command.
+func GetCmdQueryBallotResults() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ballot-results [validator]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query the tally results of the most recent vote periods",
+		Long: strings.TrimSpace(`
+Query the weighted median, standard deviation, reward spread and votes of the ballot
+of each denom, and the outcome for each validator, of the most recent vote periods.
+
+$ seid query oracle ballot-results
+
+Or, can filter with validator
+
+$ seid query oracle ballot-results seivaloper...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryBallotResultsRequest{}
+			if len(args) == 1 {
+				validator, err := sdk.ValAddressFromBech32(args[0])
+				if err != nil {
+					return err
+				}
+				req.ValidatorAddr = validator.String()
+			}
+
+			res, err := queryClient.BallotResults(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryValidatorRewards implements the query validator rewards command.
+func GetCmdQueryValidatorRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-rewards [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the total oracle rewards paid to a validator",
+		Long: strings.TrimSpace(`
+Query the total oracle rewards paid to a validator for winning ballots.
+
+$ seid query oracle validator-rewards seivaloper...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorRewards(
+				context.Background(),
+				&types.QueryValidatorRewardsRequest{ValidatorAddr: validator.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryVoteTargets implements the query params command.
 func GetCmdQueryVoteTargets() *cobra.Command {
 	cmd := &cobra.Command{
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -12,10 +13,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/spf13/cobra"
 )
 
+const FlagSalt = "salt"
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	oracleTxCmd := &cobra.Command{
@@ -28,7 +33,9 @@ func GetTxCmd() *cobra.Command {
 
 	oracleTxCmd.AddCommand(
 		GetCmdDelegateFeederPermission(),
+		GetCmdAggregateExchangeRatePrevote(),
 		GetCmdAggregateExchangeRateVote(),
+		GetCmdUpdateDenomParamsProposal(),
 	)
 
 	return oracleTxCmd
@@ -83,6 +90,71 @@ where "terra1..." is the address you want to delegate your voting rights to.
 	return cmd
 }
 
+// GetCmdAggregateExchangeRatePrevote will create a aggregateExchangeRatePrevote tx and sign it with the given key.
+func GetCmdAggregateExchangeRatePrevote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregate-prevote [salt] [exchange-rates] [validator]",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "Submit an oracle aggregate prevote for the exchange_rates of the base denom",
+		Long: strings.TrimSpace(`
+Submit an oracle aggregate prevote for the exchange_rates of the base denom w.r.t the input denom, when commit-reveal voting is enabled.
+The purpose of the aggregate prevote is to hide aggregate exchange rate vote with hash which is formatted
+as hex string in SHA256("{salt}:{exchange_rate}{denom},...,{exchange_rate}{denom}:{voter}")
+
+# Aggregate Prevote
+$ seid tx oracle aggregate-prevote 1234 8888.0ukrw,1.243uusd,0.99usdr
+
+where "ukrw,uusd,usdr" is the denominating currencies, and "8888.0,1.243,0.99" is the exchange rates of micro USD in micro denoms from the voter's point of view.
+The prevote is revealed in the next vote period with "aggregate-vote" and the same salt and exchange rates.
+
+If voting from a voting delegate, set "validator" to the address of the validator to vote on behalf of:
+$ seid tx oracle aggregate-prevote 1234 8888.0ukrw,1.243uusd,0.99usdr seivaloper1...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			salt := args[0]
+			exchangeRatesStr := args[1]
+			_, err = types.ParseExchangeRateTuples(exchangeRatesStr)
+			if err != nil {
+				return fmt.Errorf("given exchange_rates {%s} is not a valid format; exchange_rate should be formatted as DecCoins; %s", exchangeRatesStr, err.Error())
+			}
+
+			// Get from address
+			voter := clientCtx.GetFromAddress()
+
+			// By default the voter is voting on behalf of itself
+			validator := sdk.ValAddress(voter)
+
+			// Override validator if validator is given
+			if len(args) == 3 {
+				parsedVal, err := sdk.ValAddressFromBech32(args[2])
+				if err != nil {
+					return errors.Wrap(err, "validator address is invalid")
+				}
+				validator = parsedVal
+			}
+
+			hash := types.GetAggregateVoteHash(salt, exchangeRatesStr, validator)
+			msgs := []sdk.Msg{types.NewMsgAggregateExchangeRatePrevote(hash, voter, validator)}
+			for _, msg := range msgs {
+				if err := msg.ValidateBasic(); err != nil {
+					return err
+				}
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdAggregateExchangeRateVote will create a aggregateExchangeRateVote tx and sign it with the given key.
 func GetCmdAggregateExchangeRateVote() *cobra.Command {
 	cmd := &cobra.Command{
@@ -98,6 +170,9 @@ where "ukrw,uusd,usdr" is the denominating currencies, and "8888.0,1.243,0.99" i
 
 If voting from a voting delegate, set "validator" to the address of the validator to vote on behalf of:
 $ seid tx oracle aggregate-vote 1234 8888.0ukrw,1.243uusd,0.99usdr seivaloper1....
+
+When commit-reveal voting is enabled, the vote reveals the prevote of the previous vote period and must be given its salt:
+$ seid tx oracle aggregate-vote 8888.0ukrw,1.243uusd,0.99usdr --salt 1234
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -126,7 +201,14 @@ $ seid tx oracle aggregate-vote 1234 8888.0ukrw,1.243uusd,0.99usdr seivaloper1..
 				validator = parsedVal
 			}
 
-			msgs := []sdk.Msg{types.NewMsgAggregateExchangeRateVote(exchangeRatesStr, voter, validator)}
+			salt, err := cmd.Flags().GetString(FlagSalt)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAggregateExchangeRateVote(exchangeRatesStr, voter, validator)
+			msg.Salt = salt
+			msgs := []sdk.Msg{msg}
 			for _, msg := range msgs {
 				if err := msg.ValidateBasic(); err != nil {
 					return err
@@ -137,6 +219,70 @@ $ seid tx oracle aggregate-vote 1234 8888.0ukrw,1.243uusd,0.99usdr seivaloper1..
 		},
 	}
 
+	cmd.Flags().String(FlagSalt, "", "Salt of the prevote revealed by the vote, when commit-reveal voting is enabled")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdUpdateDenomParamsProposal will create an update denom params proposal tx and sign it with the given key.
+func GetCmdUpdateDenomParamsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-denom-params-proposal [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to update the overrides of a whitelisted denom",
+		Long: strings.TrimSpace(`
+Submit a proposal to replace the vote threshold, reward band and max staleness overrides of a single whitelisted denom.
+Overrides that are left out fall back to the module parameters.
+
+$ seid tx oracle update-denom-params-proposal proposal.json --deposit 10000000usei
+
+where proposal.json contains:
+{
+  "title": "Widen the ATOM reward band",
+  "description": "...",
+  "denom": {"name": "uatom", "reward_band": "0.05", "max_staleness": "600"}
+}
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			proposal := types.UpdateDenomParamsProposal{}
+			if err := clientCtx.Codec.UnmarshalJSON(contents, &proposal); err != nil {
+				return err
+			}
+
+			depositInput, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositInput)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(&proposal, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "The proposal deposit")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
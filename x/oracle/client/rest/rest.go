@@ -11,6 +11,8 @@ const (
 	RestDenom           = "denom"
 	RestVoter           = "voter"
 	RestLookbackSeconds = "lookback_seconds"
+	RestStartTime       = "start_time"
+	RestEndTime         = "end_time"
 )
 
 // RegisterRoutes registers oracle-related REST handlers to a router
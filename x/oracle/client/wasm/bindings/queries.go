@@ -7,4 +7,6 @@ type SeiOracleQuery struct {
 	ExchangeRates *types.QueryExchangeRatesRequest `json:"exchange_rates,omitempty"`
 	// queries the oracle TWAPs
 	OracleTwaps *types.QueryTwapsRequest `json:"oracle_twaps,omitempty"`
+	// queries the oracle TWAP, median, min and max prices over a historical window
+	OracleWindowAggregates *types.QueryWindowAggregatesRequest `json:"oracle_window_aggregates,omitempty"`
 }
@@ -27,3 +27,9 @@ func (handler OracleWasmQueryHandler) GetOracleTwaps(ctx sdk.Context, req *types
 	c := sdk.WrapSDKContext(ctx)
 	return querier.Twaps(c, req)
 }
+
+func (handler OracleWasmQueryHandler) GetOracleWindowAggregates(ctx sdk.Context, req *types.QueryWindowAggregatesRequest) (*types.QueryWindowAggregatesResponse, error) {
+	querier := oraclekeeper.NewQuerier(handler.oracleKeeper)
+	c := sdk.WrapSDKContext(ctx)
+	return querier.WindowAggregates(c, req)
+}
@@ -49,10 +49,37 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 		keeper.SetAggregateExchangeRateVote(ctx, valAddr, av)
 	}
 
+	for _, ap := range data.AggregateExchangeRatePrevotes {
+		valAddr, err := sdk.ValAddressFromBech32(ap.Voter)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.SetAggregateExchangeRatePrevote(ctx, valAddr, ap)
+	}
+
 	for _, priceSnapshot := range data.PriceSnapshots {
 		keeper.AddPriceSnapshot(ctx, priceSnapshot)
 	}
 
+	for _, vr := range data.ValidatorRewards {
+		operator, err := sdk.ValAddressFromBech32(vr.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.SetValidatorRewards(ctx, operator, vr.Rewards)
+	}
+
+	for _, sw := range data.SlashWarningCounters {
+		operator, err := sdk.ValAddressFromBech32(sw.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.SetSlashWarningCounter(ctx, operator, sw.WarningCount)
+	}
+
 	// check if the module account exists
 	moduleAcc := keeper.GetOracleAccount(ctx)
 	if moduleAcc == nil {
@@ -95,12 +122,30 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		return false
 	})
 
+	aggregateExchangeRatePrevotes := []types.AggregateExchangeRatePrevote{}
+	keeper.IterateAggregateExchangeRatePrevotes(ctx, func(_ sdk.ValAddress, aggregatePrevote types.AggregateExchangeRatePrevote) bool {
+		aggregateExchangeRatePrevotes = append(aggregateExchangeRatePrevotes, aggregatePrevote)
+		return false
+	})
+
 	priceSnapshots := types.PriceSnapshots{}
 	keeper.IteratePriceSnapshots(ctx, func(snapshot types.PriceSnapshot) bool {
 		priceSnapshots = append(priceSnapshots, snapshot)
 		return false
 	})
 
+	validatorRewards := []types.ValidatorRewards{}
+	keeper.IterateValidatorRewards(ctx, func(rewards types.ValidatorRewards) bool {
+		validatorRewards = append(validatorRewards, rewards)
+		return false
+	})
+
+	slashWarningCounters := []types.SlashWarningCounter{}
+	keeper.IterateSlashWarningCounters(ctx, func(counter types.SlashWarningCounter) bool {
+		slashWarningCounters = append(slashWarningCounters, counter)
+		return false
+	})
+
 	return types.NewGenesisState(
 		params,
 		exchangeRates,
@@ -108,5 +153,8 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		penaltyCounters,
 		aggregateExchangeRateVotes,
 		priceSnapshots,
+		validatorRewards,
+		aggregateExchangeRatePrevotes,
+		slashWarningCounters,
 	)
 }
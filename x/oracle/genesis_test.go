@@ -60,7 +60,12 @@ func TestExportInitGenesis(t *testing.T) {
 		},
 		int64(3700),
 	))
+	input.OracleKeeper.SetValidatorRewards(input.Ctx, keeper.ValAddrs[0], sdk.NewCoins(sdk.NewInt64Coin("usei", 100)))
+	input.OracleKeeper.SetAggregateExchangeRatePrevote(input.Ctx, keeper.ValAddrs[0], types.NewAggregateExchangeRatePrevote(
+		types.GetAggregateVoteHash("salt", "123.0uatom", keeper.ValAddrs[0]), keeper.ValAddrs[0], 2))
+	input.OracleKeeper.SetSlashWarningCounter(input.Ctx, keeper.ValAddrs[1], 2)
 	genesis := oracle.ExportGenesis(input.Ctx, input.OracleKeeper)
+	require.Equal(t, 1, len(genesis.SlashWarningCounters))
 
 	newInput := keeper.CreateTestInput(t)
 	oracle.InitGenesis(newInput.Ctx, newInput.OracleKeeper, genesis)
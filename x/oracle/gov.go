@@ -0,0 +1,11 @@
+package oracle
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/oracle/keeper"
+	"github.com/sei-protocol/sei-chain/x/oracle/types"
+)
+
+func HandleUpdateDenomParamsProposal(ctx sdk.Context, k *keeper.Keeper, p *types.UpdateDenomParamsProposal) error {
+	return k.UpdateDenomParams(ctx, p.Denom)
+}
@@ -68,3 +68,61 @@ func TestFeederDelegation(t *testing.T) {
 	_, err = h(input.Ctx.WithBlockHeight(1), voteMsg)
 	require.NoError(t, err)
 }
+
+func TestCommitReveal(t *testing.T) {
+	input, h := setup(t)
+	exchangeRateStr := randomExchangeRate.String() + utils.MicroAtomDenom
+	salt := "1234"
+	hash := types.GetAggregateVoteHash(salt, exchangeRateStr, keeper.ValAddrs[0])
+	prevoteMsg := types.NewMsgAggregateExchangeRatePrevote(hash, keeper.Addrs[0], keeper.ValAddrs[0])
+
+	// Case 1: prevotes are rejected when commit-reveal is disabled
+	_, err := h(input.Ctx.WithBlockHeight(1), prevoteMsg)
+	require.ErrorIs(t, err, types.ErrCommitRevealDisabled)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.CommitRevealEnabled = true
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// Case 2: votes without a prevote are rejected
+	voteMsg := types.NewMsgAggregateExchangeRateVote(exchangeRateStr, keeper.Addrs[0], keeper.ValAddrs[0])
+	voteMsg.Salt = salt
+	_, err = h(input.Ctx.WithBlockHeight(1), voteMsg)
+	require.ErrorIs(t, err, types.ErrNoAggregatePrevote)
+
+	// Case 3: a prevote from a non-validator fails
+	nonValidatorAddr := secp256k1.GenPrivKey().PubKey().Address()
+	_, err = h(input.Ctx.WithBlockHeight(1), types.NewMsgAggregateExchangeRatePrevote(hash, sdk.AccAddress(nonValidatorAddr), sdk.ValAddress(nonValidatorAddr)))
+	require.Error(t, err)
+
+	_, err = h(input.Ctx.WithBlockHeight(1), prevoteMsg)
+	require.NoError(t, err)
+
+	// Case 4: the prevote cannot be revealed in the same vote period
+	_, err = h(input.Ctx.WithBlockHeight(1), voteMsg)
+	require.ErrorIs(t, err, types.ErrRevealPeriodMissMatch)
+
+	// Case 5: a reveal that does not match the hash fails
+	wrongVoteMsg := types.NewMsgAggregateExchangeRateVote(anotherRandomExchangeRate.String()+utils.MicroAtomDenom, keeper.Addrs[0], keeper.ValAddrs[0])
+	wrongVoteMsg.Salt = salt
+	_, err = h(input.Ctx.WithBlockHeight(2), wrongVoteMsg)
+	require.ErrorIs(t, err, types.ErrVerificationFailed)
+
+	// Case 6: a reveal without salt fails
+	noSaltVoteMsg := types.NewMsgAggregateExchangeRateVote(exchangeRateStr, keeper.Addrs[0], keeper.ValAddrs[0])
+	_, err = h(input.Ctx.WithBlockHeight(2), noSaltVoteMsg)
+	require.ErrorIs(t, err, types.ErrInvalidSaltLength)
+
+	// Case 7: the prevote is revealed in the next vote period
+	_, err = h(input.Ctx.WithBlockHeight(2), voteMsg)
+	require.NoError(t, err)
+	vote, err := input.OracleKeeper.GetAggregateExchangeRateVote(input.Ctx, keeper.ValAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, types.ExchangeRateTuples{{Denom: utils.MicroAtomDenom, ExchangeRate: randomExchangeRate}}, vote.ExchangeRateTuples)
+	_, err = input.OracleKeeper.GetAggregateExchangeRatePrevote(input.Ctx, keeper.ValAddrs[0])
+	require.ErrorIs(t, err, types.ErrNoAggregatePrevote)
+
+	// Case 8: the prevote cannot be revealed twice
+	_, err = h(input.Ctx.WithBlockHeight(2), voteMsg)
+	require.ErrorIs(t, err, types.ErrNoAggregatePrevote)
+}
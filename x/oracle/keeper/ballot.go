@@ -45,6 +45,24 @@ func (k Keeper) OrganizeBallotByDenom(ctx sdk.Context, validatorClaimMap map[str
 
 	k.IterateAggregateExchangeRateVotes(ctx, aggregateHandler)
 
+	// With commit-reveal, prevotes of the previous vote period that were not revealed are
+	// organized as abstain votes for all vote targets, so that a validator cannot withhold
+	// its reveal after seeing the other votes without being counted as a miss
+	if k.CommitRevealEnabled(ctx) {
+		votePeriod := k.VotePeriod(ctx)
+		currentPeriod := uint64(ctx.BlockHeight()) / votePeriod
+		k.IterateAggregateExchangeRatePrevotes(ctx, func(voterAddr sdk.ValAddress, aggregatePrevote types.AggregateExchangeRatePrevote) (stop bool) {
+			if _, ok := validatorClaimMap[aggregatePrevote.Voter]; !ok || aggregatePrevote.SubmitBlock/votePeriod+1 != currentPeriod {
+				return false
+			}
+			k.IterateVoteTargets(ctx, func(denom string, _ types.Denom) bool {
+				votes[denom] = append(votes[denom], types.NewVoteForTally(sdk.ZeroDec(), denom, voterAddr, 0))
+				return false
+			})
+			return false
+		})
+	}
+
 	// sort created ballot
 	for denom, ballot := range votes {
 		sort.Sort(ballot)
@@ -54,8 +72,17 @@ func (k Keeper) OrganizeBallotByDenom(ctx sdk.Context, validatorClaimMap map[str
 	return votes
 }
 
-// ClearBallots clears all tallied votes from the store
-func (k Keeper) ClearBallots(ctx sdk.Context, _ uint64) {
+// ClearBallots clears all tallied votes and the prevotes that can no longer be revealed from the store
+func (k Keeper) ClearBallots(ctx sdk.Context, votePeriod uint64) {
+	// Clear all aggregate prevotes that were not submitted in the current vote period
+	currentPeriod := uint64(ctx.BlockHeight()) / votePeriod
+	k.IterateAggregateExchangeRatePrevotes(ctx, func(voterAddr sdk.ValAddress, aggregatePrevote types.AggregateExchangeRatePrevote) (stop bool) {
+		if aggregatePrevote.SubmitBlock/votePeriod < currentPeriod {
+			k.DeleteAggregateExchangeRatePrevote(ctx, voterAddr)
+		}
+		return false
+	})
+
 	// Clear all aggregate votes
 	k.IterateAggregateExchangeRateVotes(ctx, func(voterAddr sdk.ValAddress, aggregateVote types.AggregateExchangeRateVote) (stop bool) {
 		k.DeleteAggregateExchangeRateVote(ctx, voterAddr)
@@ -63,7 +90,7 @@ func (k Keeper) ClearBallots(ctx sdk.Context, _ uint64) {
 	})
 }
 
-// ApplyWhitelist update vote target denom list with params whitelist
+// ApplyWhitelist update vote target denom list and their overrides with params whitelist
 func (k Keeper) ApplyWhitelist(ctx sdk.Context, whitelist types.DenomList, voteTargets map[string]types.Denom) {
 	// check is there any update in whitelist params
 	updateRequired := false
@@ -71,7 +98,8 @@ func (k Keeper) ApplyWhitelist(ctx sdk.Context, whitelist types.DenomList, voteT
 		updateRequired = true
 	} else {
 		for _, item := range whitelist {
-			if _, ok := voteTargets[item.Name]; !ok {
+			// overrides of a vote target may change without its name changing
+			if target, ok := voteTargets[item.Name]; !ok || !target.Equal(&item) {
 				updateRequired = true
 				break
 			}
@@ -82,7 +110,7 @@ func (k Keeper) ApplyWhitelist(ctx sdk.Context, whitelist types.DenomList, voteT
 		k.ClearVoteTargets(ctx)
 
 		for _, item := range whitelist {
-			k.SetVoteTarget(ctx, item.Name)
+			k.SetVoteTargetDenom(ctx, item)
 
 			// Register meta data to bank module
 			if _, ok := k.bankKeeper.GetDenomMetaData(ctx, item.Name); !ok {
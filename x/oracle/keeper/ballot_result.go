@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/oracle/types"
+)
+
+// AddBallotResult stores the ballot result of a vote period, and evicts the oldest ballot results
+// so that only the results of the last BallotResultHistory vote periods are kept.
+func (k Keeper) AddBallotResult(ctx sdk.Context, ballotResult types.BallotResult) {
+	history := k.BallotResultHistory(ctx)
+	if history > 0 {
+		k.SetBallotResult(ctx, ballotResult)
+	}
+
+	// the heights are read from the keys, as decoding the ballot results is not needed
+	heights := []int64{}
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.BallotResultKey)
+	for ; iterator.Valid(); iterator.Next() {
+		heights = append(heights, int64(sdk.BigEndianToUint64(iterator.Key()[len(types.BallotResultKey):])))
+	}
+	iterator.Close()
+
+	if uint64(len(heights)) <= history {
+		return
+	}
+	for _, height := range heights[:uint64(len(heights))-history] {
+		k.DeleteBallotResult(ctx, height)
+	}
+}
+
+func (k Keeper) SetBallotResult(ctx sdk.Context, ballotResult types.BallotResult) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&ballotResult)
+	store.Set(types.GetBallotResultKey(uint64(ballotResult.Height)), bz)
+}
+
+func (k Keeper) DeleteBallotResult(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetBallotResultKey(uint64(height)))
+}
+
+// IterateBallotResults iterates over the stored ballot results ordered by height, oldest first
+func (k Keeper) IterateBallotResults(ctx sdk.Context, handler func(ballotResult types.BallotResult) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.BallotResultKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.BallotResult
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		if handler(val) {
+			break
+		}
+	}
+}
@@ -114,7 +114,13 @@ func TestClearBallots(t *testing.T) {
 			}, ValAddrs[i]))
 	}
 
-	input.OracleKeeper.ClearBallots(input.Ctx, 5)
+	// prevotes of the current vote period are kept for their reveal in the next one
+	hash := types.GetAggregateVoteHash("salt", "10uatom", ValAddrs[0])
+	input.OracleKeeper.SetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[0], types.NewAggregateExchangeRatePrevote(hash, ValAddrs[0], 4))
+	input.OracleKeeper.SetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[1], types.NewAggregateExchangeRatePrevote(hash, ValAddrs[1], 5))
+	input.OracleKeeper.SetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[2], types.NewAggregateExchangeRatePrevote(hash, ValAddrs[2], 9))
+
+	input.OracleKeeper.ClearBallots(input.Ctx.WithBlockHeight(9), 5)
 
 	voteCounter := 0
 	input.OracleKeeper.IterateAggregateExchangeRateVotes(input.Ctx, func(_ sdk.ValAddress, _ types.AggregateExchangeRateVote) bool {
@@ -122,6 +128,15 @@ func TestClearBallots(t *testing.T) {
 		return false
 	})
 	require.Equal(t, voteCounter, 0)
+
+	prevoteVoters := []sdk.ValAddress{}
+	input.OracleKeeper.IterateAggregateExchangeRatePrevotes(input.Ctx, func(voter sdk.ValAddress, _ types.AggregateExchangeRatePrevote) bool {
+		prevoteVoters = append(prevoteVoters, voter)
+		return false
+	})
+	require.Equal(t, 2, len(prevoteVoters))
+	_, err = input.OracleKeeper.GetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[0])
+	require.ErrorIs(t, err, types.ErrNoAggregatePrevote)
 }
 
 func TestApplyWhitelist(t *testing.T) {
@@ -155,4 +170,25 @@ func TestApplyWhitelist(t *testing.T) {
 	require.Equal(t, metadata.Display, "usdc")
 	require.Equal(t, len(metadata.DenomUnits), 3)
 	require.Equal(t, metadata.Description, "usdc")
+
+	// an override change is applied without the denoms changing
+	rewardBand := sdk.NewDecWithPrec(5, 2)
+	voteTargets := map[string]types.Denom{}
+	input.OracleKeeper.IterateVoteTargets(input.Ctx, func(denom string, denomInfo types.Denom) bool {
+		voteTargets[denom] = denomInfo
+		return false
+	})
+	input.OracleKeeper.ApplyWhitelist(input.Ctx, types.DenomList{
+		types.Denom{
+			Name:       "uatom",
+			RewardBand: &rewardBand,
+		},
+		types.Denom{
+			Name: "uusdc",
+		},
+	}, voteTargets)
+
+	voteTarget, err := input.OracleKeeper.GetVoteTarget(input.Ctx, "uatom")
+	require.NoError(t, err)
+	require.Equal(t, rewardBand, *voteTarget.RewardBand)
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"sort"
+	"strconv"
 
 	"github.com/sei-protocol/sei-chain/utils/metrics"
 
@@ -118,6 +119,44 @@ func (k Keeper) IterateBaseExchangeRates(ctx sdk.Context, handler func(denom str
 	}
 }
 
+// IsStaleExchangeRate returns whether the exchange rate of a denom has not been updated within the
+// max staleness of its vote target, or within the staleness window if the denom has no override
+func (k Keeper) IsStaleExchangeRate(ctx sdk.Context, denom string, rate types.OracleExchangeRate) bool {
+	maxStaleness := k.StalenessWindow(ctx)
+	if voteTarget, err := k.GetVoteTarget(ctx, denom); err == nil && voteTarget.MaxStaleness > 0 {
+		maxStaleness = voteTarget.MaxStaleness
+	}
+	if maxStaleness == 0 {
+		return false
+	}
+	// the last update timestamp is in milliseconds
+	return ctx.BlockTime().UnixMilli()-rate.LastUpdateTimestamp > int64(maxStaleness)*1000
+}
+
+// RemoveStaleRates removes the stale exchange rates so that they can no longer be used
+func (k Keeper) RemoveStaleRates(ctx sdk.Context) {
+	staleDenoms := []string{}
+	staleRates := []types.OracleExchangeRate{}
+	k.IterateBaseExchangeRates(ctx, func(denom string, rate types.OracleExchangeRate) (stop bool) {
+		if k.IsStaleExchangeRate(ctx, denom, rate) {
+			staleDenoms = append(staleDenoms, denom)
+			staleRates = append(staleRates, rate)
+		}
+		return false
+	})
+
+	for i, denom := range staleDenoms {
+		k.DeleteBaseExchangeRate(ctx, denom)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeExchangeRateStale,
+				sdk.NewAttribute(types.AttributeKeyDenom, denom),
+				sdk.NewAttribute(types.AttributeKeyExchangeRate, staleRates[i].ExchangeRate.String()),
+				sdk.NewAttribute(types.AttributeKeyLastUpdateTimestamp, strconv.FormatInt(staleRates[i].LastUpdateTimestamp, 10)),
+			),
+		)
+	}
+}
+
 func (k Keeper) RemoveExcessFeeds(ctx sdk.Context) {
 	// get actives
 	excessActives := make(map[string]struct{})
@@ -270,6 +309,50 @@ func (k Keeper) IterateVotePenaltyCounters(ctx sdk.Context,
 	}
 }
 
+//-----------------------------------
+// AggregateExchangeRatePrevote logic
+
+// GetAggregateExchangeRatePrevote retrieves an oracle prevote from the store
+func (k Keeper) GetAggregateExchangeRatePrevote(ctx sdk.Context, voter sdk.ValAddress) (aggregatePrevote types.AggregateExchangeRatePrevote, err error) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetAggregateExchangeRatePrevoteKey(voter))
+	if b == nil {
+		err = sdkerrors.Wrap(types.ErrNoAggregatePrevote, voter.String())
+		return
+	}
+	k.cdc.MustUnmarshal(b, &aggregatePrevote)
+	return
+}
+
+// SetAggregateExchangeRatePrevote set an oracle aggregate prevote to the store
+func (k Keeper) SetAggregateExchangeRatePrevote(ctx sdk.Context, voter sdk.ValAddress, prevote types.AggregateExchangeRatePrevote) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&prevote)
+	store.Set(types.GetAggregateExchangeRatePrevoteKey(voter), bz)
+}
+
+// DeleteAggregateExchangeRatePrevote deletes an oracle prevote from the store
+func (k Keeper) DeleteAggregateExchangeRatePrevote(ctx sdk.Context, voter sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAggregateExchangeRatePrevoteKey(voter))
+}
+
+// IterateAggregateExchangeRatePrevotes iterates rate over prevotes in the store
+func (k Keeper) IterateAggregateExchangeRatePrevotes(ctx sdk.Context, handler func(voterAddr sdk.ValAddress, aggregatePrevote types.AggregateExchangeRatePrevote) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AggregateExchangeRatePrevoteKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		voterAddr := sdk.ValAddress(iter.Key()[2:])
+
+		var aggregatePrevote types.AggregateExchangeRatePrevote
+		k.cdc.MustUnmarshal(iter.Value(), &aggregatePrevote)
+		if handler(voterAddr, aggregatePrevote) {
+			break
+		}
+	}
+}
+
 //-----------------------------------
 // AggregateExchangeRateVote logic
 
@@ -329,9 +412,14 @@ func (k Keeper) GetVoteTarget(ctx sdk.Context, denom string) (types.Denom, error
 }
 
 func (k Keeper) SetVoteTarget(ctx sdk.Context, denom string) {
+	k.SetVoteTargetDenom(ctx, types.Denom{Name: denom})
+}
+
+// SetVoteTargetDenom sets a vote target along with its per-denom overrides
+func (k Keeper) SetVoteTargetDenom(ctx sdk.Context, denom types.Denom) {
 	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(&types.Denom{Name: denom})
-	store.Set(types.GetVoteTargetKey(denom), bz)
+	bz := k.cdc.MustMarshal(&denom)
+	store.Set(types.GetVoteTargetKey(denom.Name), bz)
 }
 
 func (k Keeper) IterateVoteTargets(ctx sdk.Context, handler func(denom string, denomInfo types.Denom) (stop bool)) {
@@ -563,6 +651,96 @@ func (k Keeper) CalculateTwaps(ctx sdk.Context, lookbackSeconds uint64) (types.O
 	return oracleTwaps, nil
 }
 
+// CalculateWindowAggregates calculates the time weighted average, median, min and max prices of each
+// denom over the [startTime, endTime] window from the price snapshots. A snapshot's prices are in effect
+// from its timestamp until the next snapshot. Snapshots are only retained for the lookback duration, and
+// a window starting before the oldest retained snapshot is rejected rather than clipped, since the
+// prices in effect at its start are unknown.
+func (k Keeper) CalculateWindowAggregates(ctx sdk.Context, startTime int64, endTime int64) (types.OracleWindowAggregates, error) {
+	oracleWindowAggregates := types.OracleWindowAggregates{}
+	if startTime < 0 || startTime >= endTime || endTime > ctx.BlockTime().Unix() {
+		return oracleWindowAggregates, types.ErrInvalidTwapWindow
+	}
+
+	// the snapshot in effect at the start of the window followed by the snapshots within the window
+	snapshots := types.PriceSnapshots{}
+	k.IteratePriceSnapshots(ctx, func(snapshot types.PriceSnapshot) (stop bool) {
+		if snapshot.SnapshotTimestamp > endTime {
+			return true
+		}
+		if snapshot.SnapshotTimestamp <= startTime {
+			snapshots = types.PriceSnapshots{snapshot}
+			return false
+		}
+		snapshots = append(snapshots, snapshot)
+		return false
+	})
+	if len(snapshots) == 0 {
+		return oracleWindowAggregates, types.ErrNoTwapData
+	}
+	if snapshots[0].SnapshotTimestamp > startTime {
+		return oracleWindowAggregates, sdkerrors.Wrapf(types.ErrTwapWindowNotRetained, "oldest snapshot is at %d", snapshots[0].SnapshotTimestamp)
+	}
+
+	denomToTimeWeightedMap := make(map[string]sdk.Dec)
+	denomDurationMap := make(map[string]int64)
+	denomPricesMap := make(map[string][]sdk.Dec)
+	for i, snapshot := range snapshots {
+		from, to := snapshot.SnapshotTimestamp, endTime
+		if from < startTime {
+			from = startTime
+		}
+		if i+1 < len(snapshots) {
+			to = snapshots[i+1].SnapshotTimestamp
+		}
+		for _, priceItem := range snapshot.PriceSnapshotItems {
+			denom, exchangeRate := priceItem.Denom, priceItem.OracleExchangeRate.ExchangeRate
+			if _, exists := denomToTimeWeightedMap[denom]; !exists {
+				denomToTimeWeightedMap[denom] = sdk.ZeroDec()
+			}
+			denomToTimeWeightedMap[denom] = denomToTimeWeightedMap[denom].Add(exchangeRate.MulInt64(to - from))
+			denomDurationMap[denom] += to - from
+			denomPricesMap[denom] = append(denomPricesMap[denom], exchangeRate)
+		}
+	}
+
+	denomKeys := make([]string, 0, len(denomToTimeWeightedMap))
+	for denom := range denomToTimeWeightedMap {
+		denomKeys = append(denomKeys, denom)
+	}
+	sort.Strings(denomKeys)
+
+	for _, denom := range denomKeys {
+		denomDuration := denomDurationMap[denom]
+		denomTwap := sdk.ZeroDec()
+		if denomDuration != 0 {
+			denomTwap = denomToTimeWeightedMap[denom].QuoInt64(denomDuration)
+		}
+
+		prices := denomPricesMap[denom]
+		sort.Slice(prices, func(i, j int) bool { return prices[i].LT(prices[j]) })
+		median := prices[len(prices)/2]
+		if len(prices)%2 == 0 {
+			median = prices[len(prices)/2-1].Add(median).QuoInt64(2)
+		}
+
+		oracleWindowAggregates = append(oracleWindowAggregates, types.OracleWindowAggregate{
+			Denom:    denom,
+			Twap:     denomTwap,
+			Median:   median,
+			Min:      prices[0],
+			Max:      prices[len(prices)-1],
+			Duration: denomDuration,
+		})
+	}
+
+	if len(oracleWindowAggregates) == 0 {
+		return oracleWindowAggregates, types.ErrNoTwapData
+	}
+
+	return oracleWindowAggregates, nil
+}
+
 func (k Keeper) ValidateLookbackSeconds(ctx sdk.Context, lookbackSeconds uint64) error {
 	lookbackDuration := k.LookbackDuration(ctx)
 	if lookbackSeconds > lookbackDuration || lookbackSeconds == 0 {
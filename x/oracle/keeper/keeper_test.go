@@ -123,6 +123,49 @@ func TestIterateSeiExchangeRates(t *testing.T) {
 	})
 }
 
+func TestRemoveStaleRates(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := input.Ctx.WithBlockTime(time.Unix(1000, 0))
+	rate := sdk.NewDec(1700)
+
+	input.OracleKeeper.SetBaseExchangeRate(ctx, utils.MicroAtomDenom, rate)
+	input.OracleKeeper.SetBaseExchangeRate(ctx.WithBlockTime(time.Unix(1050, 0)), utils.MicroEthDenom, rate)
+	input.OracleKeeper.SetBaseExchangeRate(ctx.WithBlockTime(time.Unix(1050, 0)), utils.MicroSeiDenom, rate)
+	// staleness is disabled by default
+	ctx = ctx.WithBlockTime(time.Unix(1100, 0))
+	input.OracleKeeper.RemoveStaleRates(ctx)
+	count := 0
+	input.OracleKeeper.IterateBaseExchangeRates(ctx, func(string, types.OracleExchangeRate) bool {
+		count++
+		return false
+	})
+	require.Equal(t, 3, count)
+
+	params := input.OracleKeeper.GetParams(ctx)
+	params.StalenessWindow = 60
+	input.OracleKeeper.SetParams(ctx, params)
+	// eth has a shorter staleness limit than the staleness window
+	input.OracleKeeper.SetVoteTargetDenom(ctx, types.Denom{Name: utils.MicroEthDenom, MaxStaleness: 20})
+
+	_, _, lastUpdateTimestamp, err := input.OracleKeeper.GetBaseExchangeRate(ctx, utils.MicroSeiDenom)
+	require.NoError(t, err)
+	require.False(t, input.OracleKeeper.IsStaleExchangeRate(ctx, utils.MicroSeiDenom, types.OracleExchangeRate{ExchangeRate: rate, LastUpdateTimestamp: lastUpdateTimestamp}))
+	require.True(t, input.OracleKeeper.IsStaleExchangeRate(ctx, utils.MicroEthDenom, types.OracleExchangeRate{ExchangeRate: rate, LastUpdateTimestamp: lastUpdateTimestamp}))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	input.OracleKeeper.RemoveStaleRates(ctx)
+	_, _, _, err = input.OracleKeeper.GetBaseExchangeRate(ctx, utils.MicroAtomDenom)
+	require.Error(t, err)
+	_, _, _, err = input.OracleKeeper.GetBaseExchangeRate(ctx, utils.MicroEthDenom)
+	require.Error(t, err)
+	_, _, _, err = input.OracleKeeper.GetBaseExchangeRate(ctx, utils.MicroSeiDenom)
+	require.NoError(t, err)
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, 2, len(events))
+	require.Equal(t, types.EventTypeExchangeRateStale, events[0].Type)
+}
+
 func TestRewardPool(t *testing.T) {
 	input := CreateTestInput(t)
 
@@ -151,6 +194,7 @@ func TestParams(t *testing.T) {
 	oracleRewardBand := sdk.NewDecWithPrec(1, 2)
 	slashFraction := sdk.NewDecWithPrec(1, 2)
 	slashWindow := uint64(1000)
+	rewardDistributionWindow := uint64(10000)
 	minValidPerWindow := sdk.NewDecWithPrec(1, 4)
 	whitelist := types.DenomList{
 		{Name: utils.MicroEthDenom},
@@ -159,13 +203,14 @@ func TestParams(t *testing.T) {
 
 	// Should really test validateParams, but skipping because obvious
 	newParams := types.Params{
-		VotePeriod:        votePeriod,
-		VoteThreshold:     voteThreshold,
-		RewardBand:        oracleRewardBand,
-		Whitelist:         whitelist,
-		SlashFraction:     slashFraction,
-		SlashWindow:       slashWindow,
-		MinValidPerWindow: minValidPerWindow,
+		VotePeriod:               votePeriod,
+		VoteThreshold:            voteThreshold,
+		RewardBand:               oracleRewardBand,
+		Whitelist:                whitelist,
+		SlashFraction:            slashFraction,
+		SlashWindow:              slashWindow,
+		MinValidPerWindow:        minValidPerWindow,
+		RewardDistributionWindow: rewardDistributionWindow,
 	}
 	input.OracleKeeper.SetParams(input.Ctx, newParams)
 
@@ -694,6 +739,65 @@ func TestCalculateTwaps(t *testing.T) {
 	require.Equal(t, types.ErrInvalidTwapLookback, err)
 }
 
+func TestCalculateWindowAggregates(t *testing.T) {
+	input := CreateTestInput(t)
+	input.Ctx = input.Ctx.WithBlockTime(time.Unix(5400, 0))
+
+	_, err := input.OracleKeeper.CalculateWindowAggregates(input.Ctx, 2400, 5000)
+	require.Equal(t, types.ErrNoTwapData, err)
+
+	priceSnapshots := types.PriceSnapshots{
+		types.NewPriceSnapshot(types.PriceSnapshotItems{
+			types.NewPriceSnapshotItem(utils.MicroAtomDenom, types.OracleExchangeRate{ExchangeRate: sdk.NewDec(40)}),
+		}, 1200),
+		types.NewPriceSnapshot(types.PriceSnapshotItems{
+			types.NewPriceSnapshotItem(utils.MicroEthDenom, types.OracleExchangeRate{ExchangeRate: sdk.NewDec(10)}),
+			types.NewPriceSnapshotItem(utils.MicroAtomDenom, types.OracleExchangeRate{ExchangeRate: sdk.NewDec(20)}),
+		}, 3600),
+		types.NewPriceSnapshot(types.PriceSnapshotItems{
+			types.NewPriceSnapshotItem(utils.MicroEthDenom, types.OracleExchangeRate{ExchangeRate: sdk.NewDec(20)}),
+			types.NewPriceSnapshotItem(utils.MicroAtomDenom, types.OracleExchangeRate{ExchangeRate: sdk.NewDec(40)}),
+		}, 4500),
+	}
+	for _, snap := range priceSnapshots {
+		input.OracleKeeper.SetPriceSnapshot(input.Ctx, snap)
+	}
+
+	aggregates, err := input.OracleKeeper.CalculateWindowAggregates(input.Ctx, 2400, 5000)
+	require.NoError(t, err)
+	require.Equal(t, types.OracleWindowAggregates{
+		{
+			Denom:    utils.MicroAtomDenom,
+			Twap:     sdk.NewDec(40*1200 + 20*900 + 40*500).QuoInt64(2600),
+			Median:   sdk.NewDec(40),
+			Min:      sdk.NewDec(20),
+			Max:      sdk.NewDec(40),
+			Duration: 2600,
+		},
+		{
+			// eth only has prices from the second snapshot on
+			Denom:    utils.MicroEthDenom,
+			Twap:     sdk.NewDec(10*900 + 20*500).QuoInt64(1400),
+			Median:   sdk.NewDec(15),
+			Min:      sdk.NewDec(10),
+			Max:      sdk.NewDec(20),
+			Duration: 1400,
+		},
+	}, aggregates)
+
+	// the prices in effect before the oldest snapshot are unknown
+	_, err = input.OracleKeeper.CalculateWindowAggregates(input.Ctx, 1000, 2000)
+	require.ErrorIs(t, err, types.ErrTwapWindowNotRetained)
+	_, err = input.OracleKeeper.CalculateWindowAggregates(input.Ctx, 1199, 5000)
+	require.ErrorIs(t, err, types.ErrTwapWindowNotRetained)
+	_, err = input.OracleKeeper.CalculateWindowAggregates(input.Ctx, 1200, 5000)
+	require.NoError(t, err)
+	_, err = input.OracleKeeper.CalculateWindowAggregates(input.Ctx, 3000, 3000)
+	require.Equal(t, types.ErrInvalidTwapWindow, err)
+	_, err = input.OracleKeeper.CalculateWindowAggregates(input.Ctx, 3000, 6000)
+	require.Equal(t, types.ErrInvalidTwapWindow, err)
+}
+
 func TestCalculateTwapsWithUnsupportedDenom(t *testing.T) {
 	input := CreateTestInput(t)
 
@@ -94,3 +94,39 @@ func (m Migrator) Migrate5To6(ctx sdk.Context) error {
 	}
 	return nil
 }
+
+// Migrate6To7 migrates from version 6 to 7
+func (m Migrator) Migrate6To7(ctx sdk.Context) error {
+	// the reward distribution window param is introduced in this migration
+	m.keeper.paramSpace.Set(ctx, types.KeyRewardDistributionWindow, types.DefaultRewardDistributionWindow)
+	return nil
+}
+
+// Migrate7To8 migrates from version 7 to 8
+func (m Migrator) Migrate7To8(ctx sdk.Context) error {
+	// the commit-reveal param is introduced in this migration, and is disabled by default
+	m.keeper.paramSpace.Set(ctx, types.KeyCommitRevealEnabled, types.DefaultCommitRevealEnabled)
+	return nil
+}
+
+// Migrate8To9 migrates from version 8 to 9
+func (m Migrator) Migrate8To9(ctx sdk.Context) error {
+	// the staleness window param is introduced in this migration, and is disabled by default
+	m.keeper.paramSpace.Set(ctx, types.KeyStalenessWindow, uint64(types.DefaultStalenessWindow))
+	return nil
+}
+
+// Migrate9To10 migrates from version 9 to 10
+func (m Migrator) Migrate9To10(ctx sdk.Context) error {
+	// the ballot result history param is introduced in this migration
+	m.keeper.paramSpace.Set(ctx, types.KeyBallotResultHistory, uint64(types.DefaultBallotResultHistory))
+	return nil
+}
+
+// Migrate10To11 migrates from version 10 to 11
+func (m Migrator) Migrate10To11(ctx sdk.Context) error {
+	// the slash tiers and slash warning windows params are introduced in this migration, and keep the former slashing behavior by default
+	m.keeper.paramSpace.Set(ctx, types.KeySlashTiers, types.DefaultSlashTiers)
+	m.keeper.paramSpace.Set(ctx, types.KeySlashWarningWindows, uint64(types.DefaultSlashWarningWindows))
+	return nil
+}
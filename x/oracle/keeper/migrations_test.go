@@ -152,3 +152,70 @@ func TestMigrate5to6(t *testing.T) {
 		SuccessCount: 9975,
 	}, votePenaltyCounter)
 }
+
+func TestMigrate6to7(t *testing.T) {
+	input := CreateTestInput(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.RewardDistributionWindow = 100
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	m := NewMigrator(input.OracleKeeper)
+	err := m.Migrate6To7(input.Ctx)
+	require.NoError(t, err)
+
+	require.Equal(t, types.DefaultRewardDistributionWindow, input.OracleKeeper.RewardDistributionWindow(input.Ctx))
+}
+
+func TestMigrate7to8(t *testing.T) {
+	input := CreateTestInput(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.CommitRevealEnabled = true
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	m := NewMigrator(input.OracleKeeper)
+	err := m.Migrate7To8(input.Ctx)
+	require.NoError(t, err)
+
+	require.False(t, input.OracleKeeper.CommitRevealEnabled(input.Ctx))
+}
+
+func TestMigrate8to9(t *testing.T) {
+	input := CreateTestInput(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.StalenessWindow = 60
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	m := NewMigrator(input.OracleKeeper)
+	err := m.Migrate8To9(input.Ctx)
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(0), input.OracleKeeper.StalenessWindow(input.Ctx))
+}
+
+func TestMigrate9to10(t *testing.T) {
+	input := CreateTestInput(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.BallotResultHistory = 100
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	m := NewMigrator(input.OracleKeeper)
+	err := m.Migrate9To10(input.Ctx)
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(types.DefaultBallotResultHistory), input.OracleKeeper.BallotResultHistory(input.Ctx))
+}
+
+func TestMigrate10to11(t *testing.T) {
+	input := CreateTestInput(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.SlashTiers = types.SlashTiers{{MaxValidPerWindow: params.MinValidPerWindow, SlashFraction: sdk.ZeroDec()}}
+	params.SlashWarningWindows = 2
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	m := NewMigrator(input.OracleKeeper)
+	err := m.Migrate10To11(input.Ctx)
+	require.NoError(t, err)
+
+	require.Empty(t, input.OracleKeeper.SlashTiers(input.Ctx))
+	require.Equal(t, uint64(0), input.OracleKeeper.SlashWarningWindows(input.Ctx))
+}
@@ -20,6 +20,50 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 	return &msgServer{Keeper: keeper}
 }
 
+func (ms msgServer) AggregateExchangeRatePrevote(goCtx context.Context, msg *types.MsgAggregateExchangeRatePrevote) (*types.MsgAggregateExchangeRatePrevoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !ms.CommitRevealEnabled(ctx) {
+		return nil, types.ErrCommitRevealDisabled
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	feederAddr, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.ValidateFeeder(ctx, feederAddr, valAddr); err != nil {
+		return nil, err
+	}
+
+	voteHash, err := types.AggregateVoteHashFromHexString(msg.Hash)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidHash, err.Error())
+	}
+
+	ms.SetAggregateExchangeRatePrevote(ctx, valAddr, types.NewAggregateExchangeRatePrevote(voteHash, valAddr, uint64(ctx.BlockHeight())))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAggregatePrevote,
+			sdk.NewAttribute(types.AttributeKeyVoter, msg.Validator),
+			sdk.NewAttribute(types.AttributeKeyAggregateHash, msg.Hash),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Feeder),
+		),
+	})
+
+	return &types.MsgAggregateExchangeRatePrevoteResponse{}, nil
+}
+
 func (ms msgServer) AggregateExchangeRateVote(goCtx context.Context, msg *types.MsgAggregateExchangeRateVote) (*types.MsgAggregateExchangeRateVoteResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -49,6 +93,14 @@ func (ms msgServer) AggregateExchangeRateVote(goCtx context.Context, msg *types.
 		}
 	}
 
+	// with commit-reveal, the vote must reveal the prevote of the previous vote period
+	if ms.CommitRevealEnabled(ctx) {
+		if err := ms.verifyReveal(ctx, valAddr, msg); err != nil {
+			return nil, err
+		}
+		ms.DeleteAggregateExchangeRatePrevote(ctx, valAddr)
+	}
+
 	ms.SetAggregateExchangeRateVote(ctx, valAddr, types.NewAggregateExchangeRateVote(exchangeRateTuples, valAddr))
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -67,6 +119,35 @@ func (ms msgServer) AggregateExchangeRateVote(goCtx context.Context, msg *types.
 	return &types.MsgAggregateExchangeRateVoteResponse{}, nil
 }
 
+// verifyReveal checks that the vote matches the hash of the validator's prevote, and that
+// the prevote was submitted in the previous vote period
+func (ms msgServer) verifyReveal(ctx sdk.Context, valAddr sdk.ValAddress, msg *types.MsgAggregateExchangeRateVote) error {
+	if len(msg.Salt) == 0 {
+		return types.ErrInvalidSaltLength
+	}
+
+	aggregatePrevote, err := ms.GetAggregateExchangeRatePrevote(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	votePeriod := ms.VotePeriod(ctx)
+	if uint64(ctx.BlockHeight())/votePeriod-aggregatePrevote.SubmitBlock/votePeriod != 1 {
+		return types.ErrRevealPeriodMissMatch
+	}
+
+	voteHash, err := types.AggregateVoteHashFromHexString(aggregatePrevote.Hash)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidHash, err.Error())
+	}
+
+	if revealHash := types.GetAggregateVoteHash(msg.Salt, msg.ExchangeRates, valAddr); !voteHash.Equal(revealHash) {
+		return sdkerrors.Wrapf(types.ErrVerificationFailed, "must be given %s not %s", voteHash, revealHash)
+	}
+
+	return nil
+}
+
 func (ms msgServer) DelegateFeedConsent(goCtx context.Context, msg *types.MsgDelegateFeedConsent) (*types.MsgDelegateFeedConsentResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -4,6 +4,7 @@ import (
 	"github.com/sei-protocol/sei-chain/x/oracle/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // VotePeriod returns the number of blocks during which voting takes place.
@@ -69,3 +70,57 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
 }
+
+// RewardDistributionWindow returns the number of blocks over which the reward pool is distributed
+func (k Keeper) RewardDistributionWindow(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyRewardDistributionWindow, &res)
+	return
+}
+
+// CommitRevealEnabled returns whether exchange rates are voted with a prevote and a reveal
+func (k Keeper) CommitRevealEnabled(ctx sdk.Context) (res bool) {
+	k.paramSpace.Get(ctx, types.KeyCommitRevealEnabled, &res)
+	return
+}
+
+// StalenessWindow returns the number of seconds after which an exchange rate that has not been updated is stale
+func (k Keeper) StalenessWindow(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyStalenessWindow, &res)
+	return
+}
+
+// BallotResultHistory returns the number of most recent vote periods whose ballot results are kept
+func (k Keeper) BallotResultHistory(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyBallotResultHistory, &res)
+	return
+}
+
+// SlashTiers returns the graduated penalties for validators below MinValidPerWindow
+func (k Keeper) SlashTiers(ctx sdk.Context) (res types.SlashTiers) {
+	k.paramSpace.Get(ctx, types.KeySlashTiers, &res)
+	return
+}
+
+// SlashWarningWindows returns the number of consecutive slash windows below MinValidPerWindow
+// for which a validator is only warned before it is penalized
+func (k Keeper) SlashWarningWindows(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeySlashWarningWindows, &res)
+	return
+}
+
+// UpdateDenomParams replaces the whitelist entry of the denom with the same name. The
+// change is applied to the vote targets at the end of the current vote period.
+func (k Keeper) UpdateDenomParams(ctx sdk.Context, denom types.Denom) error {
+	if err := denom.Validate(); err != nil {
+		return err
+	}
+	whitelist := k.Whitelist(ctx)
+	for i, item := range whitelist {
+		if item.Name == denom.Name {
+			whitelist[i] = denom
+			k.SetWhitelist(ctx, whitelist)
+			return nil
+		}
+	}
+	return sdkerrors.Wrap(types.ErrUnknownDenom, denom.Name)
+}
@@ -49,9 +49,10 @@ func (q querier) ExchangeRate(c context.Context, req *types.QueryExchangeRateReq
 		return nil, err
 	}
 
-	return &types.QueryExchangeRateResponse{OracleExchangeRate: types.OracleExchangeRate{
+	rate := types.OracleExchangeRate{
 		ExchangeRate: exchangeRate, LastUpdate: lastUpdate, LastUpdateTimestamp: lastUpdateTimestamp,
-	}}, nil
+	}
+	return &types.QueryExchangeRateResponse{OracleExchangeRate: rate, Stale: q.IsStaleExchangeRate(ctx, req.Denom, rate)}, nil
 }
 
 // ExchangeRates queries exchange rates of all denoms
@@ -60,7 +61,7 @@ func (q querier) ExchangeRates(c context.Context, _ *types.QueryExchangeRatesReq
 
 	exchangeRates := []types.DenomOracleExchangeRatePair{}
 	q.IterateBaseExchangeRates(ctx, func(denom string, rate types.OracleExchangeRate) (stop bool) {
-		exchangeRates = append(exchangeRates, types.DenomOracleExchangeRatePair{Denom: denom, OracleExchangeRate: rate})
+		exchangeRates = append(exchangeRates, types.DenomOracleExchangeRatePair{Denom: denom, OracleExchangeRate: rate, Stale: q.IsStaleExchangeRate(ctx, denom, rate)})
 		return false
 	})
 
@@ -107,6 +108,16 @@ func (q querier) Twaps(c context.Context, req *types.QueryTwapsRequest) (*types.
 	return &response, nil
 }
 
+func (q querier) WindowAggregates(c context.Context, req *types.QueryWindowAggregatesRequest) (*types.QueryWindowAggregatesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	aggregates, err := q.CalculateWindowAggregates(ctx, req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	response := types.QueryWindowAggregatesResponse{OracleWindowAggregates: aggregates}
+	return &response, nil
+}
+
 // FeederDelegation queries the account address that the validator operator delegated oracle vote rights to
 func (q querier) FeederDelegation(c context.Context, req *types.QueryFeederDelegationRequest) (*types.QueryFeederDelegationResponse, error) {
 	if req == nil {
@@ -145,15 +156,65 @@ func (q querier) VotePenaltyCounter(c context.Context, req *types.QueryVotePenal
 	}, nil
 }
 
+// ValidatorRewards queries total oracle rewards paid to a validator
+func (q querier) ValidatorRewards(c context.Context, req *types.QueryValidatorRewardsRequest) (*types.QueryValidatorRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryValidatorRewardsResponse{
+		Rewards: q.GetValidatorRewards(ctx, valAddr),
+	}, nil
+}
+
+// BallotResults queries the tally results of the most recent vote periods
+func (q querier) BallotResults(c context.Context, req *types.QueryBallotResultsRequest) (*types.QueryBallotResultsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddr != "" {
+		if _, err := sdk.ValAddressFromBech32(req.ValidatorAddr); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ballotResults := types.BallotResults{}
+	q.IterateBallotResults(ctx, func(ballotResult types.BallotResult) (stop bool) {
+		if req.ValidatorAddr != "" {
+			ballotResult = ballotResult.FilterByValidator(req.ValidatorAddr)
+		}
+		ballotResults = append(ballotResults, ballotResult)
+		return false
+	})
+
+	return &types.QueryBallotResultsResponse{BallotResults: ballotResults}, nil
+}
+
 func (q querier) SlashWindow(
 	goCtx context.Context,
 	_ *types.QuerySlashWindowRequest,
 ) (*types.QuerySlashWindowResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	params := q.GetParams(ctx)
+	projections := []types.ValidatorSlashProjection{}
+	q.IterateVotePenaltyCounters(ctx, func(operator sdk.ValAddress, votePenaltyCounter types.VotePenaltyCounter) bool {
+		if projection, ok := q.ProjectSlashOutcome(ctx, params, operator, votePenaltyCounter); ok {
+			projections = append(projections, projection)
+		}
+		return false
+	})
 	// The window progress is the number of vote periods that have been completed in the current slashing window. With a vote period of 1, this will be equivalent to the number of blocks that have progressed in the slash window.
 	return &types.QuerySlashWindowResponse{
 		WindowProgress: (uint64(ctx.BlockHeight()) % params.SlashWindow) /
 			params.VotePeriod,
+		ValidatorProjections: projections,
 	}, nil
 }
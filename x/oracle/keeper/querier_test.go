@@ -37,6 +37,22 @@ func TestQueryExchangeRate(t *testing.T) {
 	})
 	require.NoError(t, err)
 	require.Equal(t, rate, res.OracleExchangeRate.ExchangeRate)
+	require.False(t, res.Stale)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.StalenessWindow = 60
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	staleCtx := sdk.WrapSDKContext(input.Ctx.WithBlockTime(input.Ctx.BlockTime().Add(61 * time.Second)))
+
+	res, err = querier.ExchangeRate(staleCtx, &types.QueryExchangeRateRequest{
+		Denom: utils.MicroAtomDenom,
+	})
+	require.NoError(t, err)
+	require.True(t, res.Stale)
+
+	ratesRes, err := querier.ExchangeRates(staleCtx, &types.QueryExchangeRatesRequest{})
+	require.NoError(t, err)
+	require.True(t, ratesRes.DenomOracleExchangeRatePairs[0].Stale)
 }
 
 func TestQueryEmptyExchangeRates(t *testing.T) {
@@ -83,6 +99,69 @@ func TestQueryFeederDelegation(t *testing.T) {
 	require.Equal(t, Addrs[1].String(), res.FeederAddr)
 }
 
+func TestQueryValidatorRewards(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(utils.MicroSeiDenom, 100))
+	input.OracleKeeper.AddValidatorRewards(input.Ctx, ValAddrs[0], rewards)
+
+	res, err := querier.ValidatorRewards(ctx, &types.QueryValidatorRewardsRequest{
+		ValidatorAddr: ValAddrs[0].String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, rewards, res.Rewards)
+
+	res, err = querier.ValidatorRewards(ctx, &types.QueryValidatorRewardsRequest{
+		ValidatorAddr: ValAddrs[1].String(),
+	})
+	require.NoError(t, err)
+	require.True(t, res.Rewards.IsZero())
+}
+
+func TestQueryBallotResults(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	ballotResult := types.BallotResult{
+		Height:         10,
+		ReferenceDenom: utils.MicroAtomDenom,
+		DenomResults: []types.DenomBallotResult{{
+			Denom:             utils.MicroAtomDenom,
+			Passed:            true,
+			WeightedMedian:    sdk.NewDec(10),
+			StandardDeviation: sdk.ZeroDec(),
+			RewardSpread:      sdk.NewDecWithPrec(1, 1),
+			Power:             200,
+			Votes: []types.BallotVoteResult{
+				{Voter: ValAddrs[0].String(), ExchangeRate: sdk.NewDec(10), Power: 100, Win: true},
+				{Voter: ValAddrs[1].String(), ExchangeRate: sdk.NewDec(12), Power: 100, Win: false},
+			},
+		}},
+		ValidatorResults: []types.ValidatorBallotResult{
+			{Validator: ValAddrs[0].String(), WinCount: 1, DidVote: true, Success: true},
+			{Validator: ValAddrs[1].String(), WinCount: 0, DidVote: true, Success: false},
+		},
+	}
+	input.OracleKeeper.AddBallotResult(input.Ctx, ballotResult)
+
+	res, err := querier.BallotResults(ctx, &types.QueryBallotResultsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.BallotResults{ballotResult}, res.BallotResults)
+
+	res, err = querier.BallotResults(ctx, &types.QueryBallotResultsRequest{ValidatorAddr: ValAddrs[1].String()})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.BallotResults))
+	require.Equal(t, []types.BallotVoteResult{ballotResult.DenomResults[0].Votes[1]}, res.BallotResults[0].DenomResults[0].Votes)
+	require.Equal(t, sdk.NewDec(10), res.BallotResults[0].DenomResults[0].WeightedMedian)
+	require.Equal(t, []types.ValidatorBallotResult{ballotResult.ValidatorResults[1]}, res.BallotResults[0].ValidatorResults)
+
+	_, err = querier.BallotResults(ctx, &types.QueryBallotResultsRequest{ValidatorAddr: "invalid"})
+	require.Error(t, err)
+}
+
 func TestQuerySlashingWindow(t *testing.T) {
 	input := CreateTestInput(t)
 	querier := NewQuerier(input.OracleKeeper)
@@ -106,6 +185,39 @@ func TestQuerySlashingWindow(t *testing.T) {
 	res, err = querier.SlashWindow(ctx, &types.QuerySlashWindowRequest{})
 	require.NoError(t, err)
 	require.Equal(t, expectedWindows, res.WindowProgress)
+	require.Empty(t, res.ValidatorProjections)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.SlashWarningWindows = 1
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	input.OracleKeeper.SetVotePenaltyCounter(input.Ctx, ValAddrs[0], 1, 0, 3)
+	input.OracleKeeper.SetVotePenaltyCounter(input.Ctx, ValAddrs[1], 4, 0, 0)
+	input.OracleKeeper.SetVotePenaltyCounter(input.Ctx, ValAddrs[2], 3, 1, 0)
+	input.OracleKeeper.SetSlashWarningCounter(input.Ctx, ValAddrs[2], 1)
+	res, err = querier.SlashWindow(ctx, &types.QuerySlashWindowRequest{})
+	require.NoError(t, err)
+	projections := map[string]types.ValidatorSlashProjection{}
+	for _, projection := range res.ValidatorProjections {
+		projections[projection.Validator] = projection
+	}
+	require.Equal(t, types.ValidatorSlashProjection{
+		Validator:     ValAddrs[0].String(),
+		ValidVoteRate: sdk.NewDecWithPrec(75, 2),
+		SlashFraction: sdk.ZeroDec(),
+	}, projections[ValAddrs[0].String()])
+	require.Equal(t, types.ValidatorSlashProjection{
+		Validator:     ValAddrs[1].String(),
+		ValidVoteRate: sdk.ZeroDec(),
+		Warning:       true,
+		SlashFraction: sdk.ZeroDec(),
+	}, projections[ValAddrs[1].String()])
+	require.Equal(t, types.ValidatorSlashProjection{
+		Validator:     ValAddrs[2].String(),
+		ValidVoteRate: sdk.ZeroDec(),
+		WarningCount:  1,
+		SlashFraction: params.SlashFraction,
+		Jail:          true,
+	}, projections[ValAddrs[2].String()])
 }
 
 func TestQueryVoteTargets(t *testing.T) {
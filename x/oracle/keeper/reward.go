@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/oracle/types"
+)
+
+// RewardBallotWinners distributes votePeriod / rewardDistributionWindow of the oracle reward pool
+// to the ballot winners of a vote period, pro-rata to the weight of their claims. Rewards are
+// allocated to validators through the distribution module.
+func (k Keeper) RewardBallotWinners(
+	ctx sdk.Context,
+	votePeriod uint64,
+	rewardDistributionWindow uint64,
+	ballotWinners map[string]types.Claim,
+) {
+	// Sum weight of the claims
+	ballotPowerSum := int64(0)
+	for _, winner := range ballotWinners {
+		ballotPowerSum += winner.Weight
+	}
+
+	// Exit if the ballot is empty
+	if ballotPowerSum == 0 {
+		return
+	}
+
+	distributionRatio := sdk.NewDec(int64(votePeriod)).QuoInt64(int64(rewardDistributionWindow))
+	periodRewards := sdk.NewDecCoinsFromCoins(k.GetRewardPoolLegacy(ctx)...).MulDecTruncate(distributionRatio)
+	if periodRewards.IsZero() {
+		return
+	}
+
+	// iterate in a deterministic order
+	winnerAddrs := make([]string, 0, len(ballotWinners))
+	for addr := range ballotWinners {
+		winnerAddrs = append(winnerAddrs, addr)
+	}
+	sort.Strings(winnerAddrs)
+
+	distributedReward := sdk.NewCoins()
+	for _, addr := range winnerAddrs {
+		winner := ballotWinners[addr]
+		if winner.Weight == 0 {
+			continue
+		}
+		receiverVal := k.StakingKeeper.Validator(ctx, winner.Recipient)
+		// Reflects contribution
+		rewardCoins, _ := periodRewards.MulDecTruncate(sdk.NewDec(winner.Weight).QuoInt64(ballotPowerSum)).TruncateDecimal()
+
+		// In case absence of the validator, we just skip distribution
+		if receiverVal == nil || rewardCoins.IsZero() {
+			continue
+		}
+		k.distrKeeper.AllocateTokensToValidator(ctx, receiverVal, sdk.NewDecCoinsFromCoins(rewardCoins...))
+		k.AddValidatorRewards(ctx, winner.Recipient, rewardCoins)
+		distributedReward = distributedReward.Add(rewardCoins...)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeRewardDistribution,
+				sdk.NewAttribute(types.AttributeKeyOperator, winner.Recipient.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, rewardCoins.String()),
+			),
+		)
+	}
+
+	// Move distributed reward to distribution module
+	if distributedReward.IsZero() {
+		return
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.distrName, distributedReward); err != nil {
+		panic(fmt.Sprintf("failed to send coins to distribution module %s", err.Error()))
+	}
+}
+
+// GetValidatorRewards returns the total rewards paid to a validator
+func (k Keeper) GetValidatorRewards(ctx sdk.Context, operator sdk.ValAddress) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorRewardsKey(operator))
+	if bz == nil {
+		return sdk.NewCoins()
+	}
+
+	var rewards types.ValidatorRewards
+	k.cdc.MustUnmarshal(bz, &rewards)
+	return rewards.Rewards
+}
+
+// SetValidatorRewards sets the total rewards paid to a validator
+func (k Keeper) SetValidatorRewards(ctx sdk.Context, operator sdk.ValAddress, rewards sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&types.ValidatorRewards{ValidatorAddress: operator.String(), Rewards: rewards})
+	store.Set(types.GetValidatorRewardsKey(operator), bz)
+}
+
+// AddValidatorRewards adds to the total rewards paid to a validator
+func (k Keeper) AddValidatorRewards(ctx sdk.Context, operator sdk.ValAddress, rewards sdk.Coins) {
+	k.SetValidatorRewards(ctx, operator, k.GetValidatorRewards(ctx, operator).Add(rewards...))
+}
+
+// IterateValidatorRewards iterates over the total rewards of validators and performs a callback function.
+func (k Keeper) IterateValidatorRewards(ctx sdk.Context, handler func(rewards types.ValidatorRewards) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorRewardsKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var rewards types.ValidatorRewards
+		k.cdc.MustUnmarshal(iter.Value(), &rewards)
+		if handler(rewards) {
+			break
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/sei-protocol/sei-chain/x/oracle/types"
+	"github.com/sei-protocol/sei-chain/x/oracle/utils"
+)
+
+func TestRewardBallotWinners(t *testing.T) {
+	// initial setup
+	input := CreateTestInput(t)
+	addr, val := ValAddrs[0], ValPubKeys[0]
+	addr1, val1 := ValAddrs[1], ValPubKeys[1]
+	amt := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	sh := staking.NewHandler(input.StakingKeeper)
+	ctx := input.Ctx
+
+	// Validator created
+	_, err := sh(ctx, NewTestMsgCreateValidator(addr, val, amt))
+	require.NoError(t, err)
+	_, err = sh(ctx, NewTestMsgCreateValidator(addr1, val1, amt))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	// Add claim pools
+	claims := map[string]types.Claim{
+		addr.String():  types.NewClaim(10, 10, 0, addr, true),
+		addr1.String(): types.NewClaim(20, 20, 0, addr1, true),
+	}
+
+	// Prepare reward pool
+	givingAmt := sdk.NewCoins(sdk.NewInt64Coin(utils.MicroSeiDenom, 30000000))
+	acc := input.AccountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	err = FundAccount(input, acc.GetAddress(), givingAmt)
+	require.NoError(t, err)
+
+	// no rewards without winners
+	input.OracleKeeper.RewardBallotWinners(ctx, 5, 10, map[string]types.Claim{})
+	require.Equal(t, givingAmt, input.OracleKeeper.GetRewardPoolLegacy(ctx))
+
+	params := input.OracleKeeper.GetParams(ctx)
+	params.RewardDistributionWindow = 10 * params.VotePeriod
+	input.OracleKeeper.SetParams(ctx, params)
+	votePeriod := input.OracleKeeper.VotePeriod(input.Ctx)
+	rewardDistributionWindow := input.OracleKeeper.RewardDistributionWindow(input.Ctx)
+	input.OracleKeeper.RewardBallotWinners(ctx, votePeriod, rewardDistributionWindow, claims)
+	outstandingRewardsDec := input.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, addr)
+	outstandingRewards, _ := outstandingRewardsDec.TruncateDecimal()
+	periodRewards := sdk.NewDecFromInt(givingAmt.AmountOf(utils.MicroSeiDenom)).
+		MulInt64(int64(votePeriod)).QuoInt64(int64(rewardDistributionWindow))
+	expectedRewards := periodRewards.Mul(sdk.NewDec(10).QuoInt64(30)).TruncateInt()
+	require.Equal(t, expectedRewards, outstandingRewards.AmountOf(utils.MicroSeiDenom))
+	require.Equal(t, outstandingRewards, input.OracleKeeper.GetValidatorRewards(ctx, addr))
+
+	outstandingRewardsDec1 := input.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, addr1)
+	outstandingRewards1, _ := outstandingRewardsDec1.TruncateDecimal()
+	expectedRewards1 := periodRewards.Mul(sdk.NewDec(20).QuoInt64(30)).TruncateInt()
+	require.Equal(t, expectedRewards1, outstandingRewards1.AmountOf(utils.MicroSeiDenom))
+	require.Equal(t, outstandingRewards1, input.OracleKeeper.GetValidatorRewards(ctx, addr1))
+
+	// distributed rewards leave the reward pool
+	require.Equal(t, givingAmt.Sub(outstandingRewards.Add(outstandingRewards1...)), input.OracleKeeper.GetRewardPoolLegacy(ctx))
+
+	// rewards accumulate
+	input.OracleKeeper.RewardBallotWinners(ctx, votePeriod, rewardDistributionWindow, claims)
+	require.True(t, input.OracleKeeper.GetValidatorRewards(ctx, addr).AmountOf(utils.MicroSeiDenom).GT(expectedRewards))
+
+	rewards := []types.ValidatorRewards{}
+	input.OracleKeeper.IterateValidatorRewards(ctx, func(vr types.ValidatorRewards) bool {
+		rewards = append(rewards, vr)
+		return false
+	})
+	require.Equal(t, 2, len(rewards))
+}
@@ -8,43 +8,55 @@ import (
 	"github.com/sei-protocol/sei-chain/x/oracle/types"
 )
 
-// SlashAndResetCounters do slash any operator who over criteria & clear all operators miss counter to zero
+// SlashAndResetCounters do slash any operator who over criteria & clear all operators miss counter to zero.
+// Operators below the criteria are warned for the first SlashWarningWindows consecutive slash windows, and
+// then penalized according to their slash tier.
 func (k Keeper) SlashAndResetCounters(ctx sdk.Context) {
 	height := ctx.BlockHeight()
 	distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
-	minValidPerWindow := k.MinValidPerWindow(ctx)
-	slashFraction := k.SlashFraction(ctx)
+	params := k.GetParams(ctx)
 	powerReduction := k.StakingKeeper.PowerReduction(ctx)
 
 	k.IterateVotePenaltyCounters(ctx, func(operator sdk.ValAddress, votePenaltyCounter types.VotePenaltyCounter) bool {
-		// Calculate valid vote rate; (totalVotes - (MissCounter + AbstainCounter))/totalVotes
-		// this accounts for changes in vote period within a window, and will take the overall success rate
-		// as opposed to the one expected based on the number of vote period expected based on the ending slash window or vote period
-		totalVotes := votePenaltyCounter.SuccessCount + votePenaltyCounter.AbstainCount + votePenaltyCounter.MissCount
-		if totalVotes == 0 {
+		projection, ok := k.ProjectSlashOutcome(ctx, params, operator, votePenaltyCounter)
+		if !ok {
 			ctx.Logger().Error("zero votes in penalty counter, this should never happen")
 			return false
 		}
-		validVoteRate := sdk.NewDecFromInt(
-			sdk.NewInt(int64(votePenaltyCounter.SuccessCount))).
-			QuoInt64(int64(totalVotes))
 
-		// Penalize the validator whose the valid vote rate is smaller than min threshold
-		if validVoteRate.LT(minValidPerWindow) {
+		if projection.ValidVoteRate.GTE(params.MinValidPerWindow) {
+			k.DeleteSlashWarningCounter(ctx, operator)
+		} else {
+			// Penalize the validator whose the valid vote rate is smaller than min threshold
 			validator := k.StakingKeeper.Validator(ctx, operator)
 			if validator.IsBonded() && !validator.IsJailed() {
-				consAddr, err := validator.GetConsAddr()
-				if err != nil {
-					panic(err)
-				}
+				if projection.Warning {
+					k.SetSlashWarningCounter(ctx, operator, projection.WarningCount+1)
+					ctx.EventManager().EmitEvent(
+						sdk.NewEvent(types.EventTypeSlashWarning,
+							sdk.NewAttribute(types.AttributeKeyOperator, operator.String()),
+							sdk.NewAttribute(types.AttributeKeyValidVoteRate, projection.ValidVoteRate.String()),
+							sdk.NewAttribute(types.AttributeKeyWarningCount, strconv.FormatUint(projection.WarningCount+1, 10)),
+						),
+					)
+				} else {
+					consAddr, err := validator.GetConsAddr()
+					if err != nil {
+						panic(err)
+					}
 
-				k.StakingKeeper.Slash(
-					ctx, consAddr,
-					distributionHeight, validator.GetConsensusPower(powerReduction), slashFraction,
-				)
-				k.StakingKeeper.Jail(ctx, consAddr)
-				cosmostelemetry.IncrValidatorSlashedCounter(consAddr.String(), "oracle")
+					k.StakingKeeper.Slash(
+						ctx, consAddr,
+						distributionHeight, validator.GetConsensusPower(powerReduction), projection.SlashFraction,
+					)
+					if projection.Jail {
+						k.StakingKeeper.Jail(ctx, consAddr)
+						// a jailed validator is warned again once it is unjailed
+						k.DeleteSlashWarningCounter(ctx, operator)
+					}
+					cosmostelemetry.IncrValidatorSlashedCounter(consAddr.String(), "oracle")
+				}
 			}
 		}
 
@@ -61,3 +73,89 @@ func (k Keeper) SlashAndResetCounters(ctx sdk.Context) {
 		return false
 	})
 }
+
+// ProjectSlashOutcome returns the outcome for an operator with the vote penalty counter at the end
+// of the slash window. It returns false if the counter has no votes.
+func (k Keeper) ProjectSlashOutcome(
+	ctx sdk.Context,
+	params types.Params,
+	operator sdk.ValAddress,
+	votePenaltyCounter types.VotePenaltyCounter,
+) (types.ValidatorSlashProjection, bool) {
+	// Calculate valid vote rate; (totalVotes - (MissCounter + AbstainCounter))/totalVotes
+	// this accounts for changes in vote period within a window, and will take the overall success rate
+	// as opposed to the one expected based on the number of vote period expected based on the ending slash window or vote period
+	totalVotes := votePenaltyCounter.SuccessCount + votePenaltyCounter.AbstainCount + votePenaltyCounter.MissCount
+	if totalVotes == 0 {
+		return types.ValidatorSlashProjection{}, false
+	}
+	validVoteRate := sdk.NewDecFromInt(
+		sdk.NewInt(int64(votePenaltyCounter.SuccessCount))).
+		QuoInt64(int64(totalVotes))
+
+	projection := types.ValidatorSlashProjection{
+		Validator:     operator.String(),
+		ValidVoteRate: validVoteRate,
+		WarningCount:  k.GetSlashWarningCounter(ctx, operator),
+		SlashFraction: sdk.ZeroDec(),
+	}
+	if validVoteRate.GTE(params.MinValidPerWindow) {
+		return projection, true
+	}
+	if projection.WarningCount < params.SlashWarningWindows {
+		projection.Warning = true
+		return projection, true
+	}
+
+	// a rate between the highest tier and MinValidPerWindow falls back to the mildest tier, so that
+	// it is never penalized more than a lower rate
+	tier, found := params.SlashTiers.Find(validVoteRate)
+	if !found {
+		tier, found = params.SlashTiers.Mildest()
+	}
+	if found {
+		projection.SlashFraction, projection.Jail = tier.SlashFraction, tier.Jail
+	} else {
+		projection.SlashFraction, projection.Jail = params.SlashFraction, true
+	}
+	return projection, true
+}
+
+// GetSlashWarningCounter returns the number of consecutive slash windows for which the operator was warned
+func (k Keeper) GetSlashWarningCounter(ctx sdk.Context, operator sdk.ValAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetSlashWarningCounterKey(operator))
+	if bz == nil {
+		return 0
+	}
+
+	var counter types.SlashWarningCounter
+	k.cdc.MustUnmarshal(bz, &counter)
+	return counter.WarningCount
+}
+
+// SetSlashWarningCounter sets the number of consecutive slash windows for which the operator was warned
+func (k Keeper) SetSlashWarningCounter(ctx sdk.Context, operator sdk.ValAddress, warningCount uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&types.SlashWarningCounter{ValidatorAddress: operator.String(), WarningCount: warningCount})
+	store.Set(types.GetSlashWarningCounterKey(operator), bz)
+}
+
+func (k Keeper) DeleteSlashWarningCounter(ctx sdk.Context, operator sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetSlashWarningCounterKey(operator))
+}
+
+// IterateSlashWarningCounters iterates over the slash warning counters and performs a callback function.
+func (k Keeper) IterateSlashWarningCounters(ctx sdk.Context, handler func(counter types.SlashWarningCounter) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.SlashWarningCounterKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var counter types.SlashWarningCounter
+		k.cdc.MustUnmarshal(iter.Value(), &counter)
+		if handler(counter) {
+			break
+		}
+	}
+}
@@ -8,6 +8,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/sei-protocol/sei-chain/x/oracle/types"
 )
 
 func TestSlashAndResetMissCounters(t *testing.T) {
@@ -101,3 +103,96 @@ func TestSlashAndResetMissCounters(t *testing.T) {
 	validator, _ = input.StakingKeeper.GetValidator(input.Ctx, ValAddrs[0])
 	require.Equal(t, amt, validator.Tokens)
 }
+
+func TestSlashTiersAndWarnings(t *testing.T) {
+	input := CreateTestInput(t)
+	amt := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	sh := staking.NewHandler(input.StakingKeeper)
+	_, err := sh(input.Ctx, NewTestMsgCreateValidator(ValAddrs[0], ValPubKeys[0], amt))
+	require.NoError(t, err)
+	staking.EndBlocker(input.Ctx, input.StakingKeeper)
+
+	mildFraction, severeFraction := sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(1, 1)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.MinValidPerWindow = sdk.NewDecWithPrec(5, 1)
+	params.SlashWarningWindows = 1
+	params.SlashTiers = types.SlashTiers{
+		{MaxValidPerWindow: sdk.NewDecWithPrec(5, 1), SlashFraction: mildFraction},
+		{MaxValidPerWindow: sdk.NewDecWithPrec(2, 1), SlashFraction: severeFraction, Jail: true},
+	}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	slashWindow := func(missCount, successCount uint64) stakingtypes.Validator {
+		input.OracleKeeper.SetVotePenaltyCounter(input.Ctx, ValAddrs[0], missCount, 0, successCount)
+		input.OracleKeeper.SlashAndResetCounters(input.Ctx)
+		validator, _ := input.StakingKeeper.GetValidator(input.Ctx, ValAddrs[0])
+		return validator
+	}
+
+	// the first window below the threshold is a warning
+	input.Ctx = input.Ctx.WithEventManager(sdk.NewEventManager())
+	validator := slashWindow(6, 4)
+	require.Equal(t, amt, validator.GetBondedTokens())
+	require.Equal(t, uint64(1), input.OracleKeeper.GetSlashWarningCounter(input.Ctx, ValAddrs[0]))
+	warned := false
+	for _, event := range input.Ctx.EventManager().Events() {
+		if event.Type == types.EventTypeSlashWarning {
+			warned = true
+			require.Equal(t, []byte("1"), event.Attributes[2].Value)
+		}
+	}
+	require.True(t, warned)
+
+	// the mild tier slashes without jailing, and further windows below the threshold are not warnings
+	validator = slashWindow(6, 4)
+	expectedTokens := amt.Sub(mildFraction.MulInt(amt).TruncateInt())
+	require.Equal(t, expectedTokens, validator.GetBondedTokens())
+	require.False(t, validator.IsJailed())
+	require.Equal(t, uint64(1), input.OracleKeeper.GetSlashWarningCounter(input.Ctx, ValAddrs[0]))
+
+	// a window above the threshold resets the warnings
+	validator = slashWindow(4, 6)
+	require.Equal(t, expectedTokens, validator.GetBondedTokens())
+	require.Equal(t, uint64(0), input.OracleKeeper.GetSlashWarningCounter(input.Ctx, ValAddrs[0]))
+
+	// the severe tier slashes and jails after a warning
+	slashWindow(9, 1)
+	validator = slashWindow(9, 1)
+	require.Equal(t, expectedTokens.Sub(severeFraction.MulInt(expectedTokens).TruncateInt()), validator.GetBondedTokens())
+	require.True(t, validator.IsJailed())
+	require.Equal(t, uint64(0), input.OracleKeeper.GetSlashWarningCounter(input.Ctx, ValAddrs[0]))
+}
+
+func TestSlashTierGap(t *testing.T) {
+	input := CreateTestInput(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.MinValidPerWindow = sdk.NewDecWithPrec(5, 1)
+	params.SlashWarningWindows = 0
+	params.SlashTiers = types.SlashTiers{
+		{MaxValidPerWindow: sdk.NewDecWithPrec(3, 1), SlashFraction: sdk.NewDecWithPrec(1, 2)},
+		{MaxValidPerWindow: sdk.NewDecWithPrec(1, 1), SlashFraction: sdk.NewDecWithPrec(1, 1), Jail: true},
+	}
+
+	// a rate between the highest tier and MinValidPerWindow is penalized as the mildest tier
+	projection, ok := input.OracleKeeper.ProjectSlashOutcome(input.Ctx, params, ValAddrs[0], types.VotePenaltyCounter{MissCount: 6, SuccessCount: 4})
+	require.True(t, ok)
+	require.Equal(t, sdk.NewDecWithPrec(1, 2), projection.SlashFraction)
+	require.False(t, projection.Jail)
+
+	projection, ok = input.OracleKeeper.ProjectSlashOutcome(input.Ctx, params, ValAddrs[0], types.VotePenaltyCounter{MissCount: 8, SuccessCount: 2})
+	require.True(t, ok)
+	require.Equal(t, sdk.NewDecWithPrec(1, 2), projection.SlashFraction)
+	require.False(t, projection.Jail)
+
+	projection, ok = input.OracleKeeper.ProjectSlashOutcome(input.Ctx, params, ValAddrs[0], types.VotePenaltyCounter{MissCount: 19, SuccessCount: 1})
+	require.True(t, ok)
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), projection.SlashFraction)
+	require.True(t, projection.Jail)
+
+	// without tiers, validators below MinValidPerWindow are slashed by SlashFraction and jailed
+	params.SlashTiers = types.SlashTiers{}
+	projection, ok = input.OracleKeeper.ProjectSlashOutcome(input.Ctx, params, ValAddrs[0], types.VotePenaltyCounter{MissCount: 6, SuccessCount: 4})
+	require.True(t, ok)
+	require.Equal(t, params.SlashFraction, projection.SlashFraction)
+	require.True(t, projection.Jail)
+}
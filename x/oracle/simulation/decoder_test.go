@@ -34,17 +34,21 @@ func TestDecodeDistributionStore(t *testing.T) {
 	aggregateVote := types.NewAggregateExchangeRateVote(types.ExchangeRateTuples{
 		{Denom: utils.MicroAtomDenom, ExchangeRate: sdk.NewDecWithPrec(1234, 1)},
 	}, valAddr)
+	aggregatePrevote := types.NewAggregateExchangeRatePrevote(types.GetAggregateVoteHash("salt", "123.4uatom", valAddr), valAddr, 123)
 	votePenaltyCounter := types.VotePenaltyCounter{MissCount: missCounter, AbstainCount: abstainCounter}
 
 	denom := "usei"
+	validatorRewards := types.ValidatorRewards{ValidatorAddress: valAddr.String(), Rewards: sdk.NewCoins(sdk.NewInt64Coin(denom, 100))}
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.ExchangeRateKey, Value: cdc.MustMarshal(&sdk.DecProto{Dec: exchangeRate})},
 			{Key: types.FeederDelegationKey, Value: feederAddr.Bytes()},
 			{Key: types.VotePenaltyCounterKey, Value: cdc.MustMarshal(&votePenaltyCounter)},
+			{Key: types.AggregateExchangeRatePrevoteKey, Value: cdc.MustMarshal(&aggregatePrevote)},
 			{Key: types.AggregateExchangeRateVoteKey, Value: cdc.MustMarshal(&aggregateVote)},
 			{Key: types.VoteTargetKey, Value: cdc.MustMarshal(&types.Denom{Name: denom})},
+			{Key: types.ValidatorRewardsKey, Value: cdc.MustMarshal(&validatorRewards)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -56,8 +60,10 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"ExchangeRate", fmt.Sprintf("%v\n%v", exchangeRate, exchangeRate)},
 		{"FeederDelegation", fmt.Sprintf("%v\n%v", feederAddr, feederAddr)},
 		{"VotePenaltyCounter", fmt.Sprintf("%v\n%v", votePenaltyCounter, votePenaltyCounter)},
+		{"AggregatePrevote", fmt.Sprintf("%v\n%v", aggregatePrevote, aggregatePrevote)},
 		{"AggregateVote", fmt.Sprintf("%v\n%v", aggregateVote, aggregateVote)},
 		{"VoteTarget", fmt.Sprintf("name: %v\n\nname: %v\n", denom, denom)},
+		{"ValidatorRewards", fmt.Sprintf("%v\n%v", validatorRewards, validatorRewards)},
 		{"other", ""},
 	}
 
@@ -8,9 +8,9 @@ import (
 )
 
 // Tally calculates the median and returns it. Sets the set of voters to be rewarded, i.e. voted within
-// a reasonable spread from the weighted median to the store
+// a reasonable spread from the weighted median to the store. The tally is also returned as a ballot result.
 // CONTRACT: pb must be sorted
-func Tally(_ sdk.Context, pb types.ExchangeRateBallot, rewardBand sdk.Dec, validatorClaimMap map[string]types.Claim) (weightedMedian sdk.Dec) {
+func Tally(_ sdk.Context, pb types.ExchangeRateBallot, rewardBand sdk.Dec, validatorClaimMap map[string]types.Claim) (weightedMedian sdk.Dec, ballotResult types.DenomBallotResult) {
 	weightedMedian = pb.WeightedMedianWithAssertion()
 
 	standardDeviation := pb.StandardDeviation(weightedMedian)
@@ -20,18 +20,32 @@ func Tally(_ sdk.Context, pb types.ExchangeRateBallot, rewardBand sdk.Dec, valid
 		rewardSpread = standardDeviation
 	}
 
+	ballotResult = types.DenomBallotResult{
+		WeightedMedian:    weightedMedian,
+		StandardDeviation: standardDeviation,
+		RewardSpread:      rewardSpread,
+		Power:             pb.Power(),
+	}
 	for _, vote := range pb {
 		// Filter ballot winners
 		key := vote.Voter.String()
 		claim := validatorClaimMap[key]
+		win := false
 		if vote.ExchangeRate.GTE(weightedMedian.Sub(rewardSpread)) &&
 			vote.ExchangeRate.LTE(weightedMedian.Add(rewardSpread)) {
 
 			claim.Weight += vote.Power
 			claim.WinCount++
+			win = true
 		}
 		claim.DidVote = true
 		validatorClaimMap[key] = claim
+		ballotResult.Votes = append(ballotResult.Votes, types.BallotVoteResult{
+			Voter:        key,
+			ExchangeRate: vote.ExchangeRate,
+			Power:        vote.Power,
+			Win:          win,
+		})
 	}
 
 	return
@@ -46,6 +60,7 @@ func ballotIsPassing(ballot types.ExchangeRateBallot, thresholdVotes sdk.Int) (s
 // choose reference denom with the highest voter turnout
 // If the voting power of the two denominations is the same,
 // select reference denom in alphabetical order.
+// A ballot passes against the vote threshold override of its denom if there is one.
 func pickReferenceDenom(ctx sdk.Context, k keeper.Keeper, voteTargets map[string]types.Denom, voteMap map[string]types.ExchangeRateBallot) (referenceDenom string, belowThresholdVoteMap map[string]types.ExchangeRateBallot) {
 	largestBallotPower := int64(0)
 	referenceDenom = ""
@@ -53,7 +68,6 @@ func pickReferenceDenom(ctx sdk.Context, k keeper.Keeper, voteTargets map[string
 
 	totalBondedPower := sdk.TokensToConsensusPower(k.StakingKeeper.TotalBondedTokens(ctx), k.StakingKeeper.PowerReduction(ctx))
 	voteThreshold := k.VoteThreshold(ctx)
-	thresholdVotes := voteThreshold.MulInt64(totalBondedPower).RoundInt()
 
 	for denom, ballot := range voteMap {
 		// If denom is not in the voteTargets, or the ballot for it has failed, then skip
@@ -64,6 +78,7 @@ func pickReferenceDenom(ctx sdk.Context, k keeper.Keeper, voteTargets map[string
 		}
 
 		ballotPower := int64(0)
+		thresholdVotes := voteTargets[denom].VoteThresholdOr(voteThreshold).MulInt64(totalBondedPower).RoundInt()
 
 		// If the ballot is not passed, remove it from the voteTargets array
 		// to prevent slashing validators who did valid vote.
@@ -0,0 +1,71 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gopkg.in/yaml.v2"
+)
+
+// BallotResults - array of BallotResult
+type BallotResults []BallotResult
+
+// String implements fmt.Stringer interface
+func (results BallotResults) String() string {
+	out, _ := yaml.Marshal(results)
+	return string(out)
+}
+
+// FilterByValidator returns a copy of the ballot result that only contains the votes
+// and the outcome of the validator
+func (result BallotResult) FilterByValidator(validator string) BallotResult {
+	filtered := BallotResult{
+		Height:         result.Height,
+		ReferenceDenom: result.ReferenceDenom,
+	}
+	for _, denomResult := range result.DenomResults {
+		votes := []BallotVoteResult{}
+		for _, vote := range denomResult.Votes {
+			if vote.Voter == validator {
+				votes = append(votes, vote)
+			}
+		}
+		denomResult.Votes = votes
+		filtered.DenomResults = append(filtered.DenomResults, denomResult)
+	}
+	for _, validatorResult := range result.ValidatorResults {
+		if validatorResult.Validator == validator {
+			filtered.ValidatorResults = append(filtered.ValidatorResults, validatorResult)
+		}
+	}
+	return filtered
+}
+
+// ValidatorExchangeRates returns the exchange rates voted by the validator. Votes of denoms
+// which passed are cross rates to the reference denom, so they are converted back with the
+// vote of the validator for the reference denom. Abstained denoms are omitted.
+func (result BallotResult) ValidatorExchangeRates(validator string) sdk.DecCoins {
+	votes := make(map[string]sdk.Dec, len(result.DenomResults))
+	for _, denomResult := range result.DenomResults {
+		for _, vote := range denomResult.Votes {
+			if vote.Voter == validator && vote.ExchangeRate.IsPositive() {
+				votes[denomResult.Denom] = vote.ExchangeRate
+			}
+		}
+	}
+	referenceRate, hasReferenceVote := votes[result.ReferenceDenom]
+
+	exchangeRates := sdk.NewDecCoins()
+	for _, denomResult := range result.DenomResults {
+		exchangeRate, ok := votes[denomResult.Denom]
+		if !ok {
+			continue
+		}
+		if denomResult.Passed && denomResult.Denom != result.ReferenceDenom {
+			if !hasReferenceVote {
+				continue
+			}
+			exchangeRate = referenceRate.Quo(exchangeRate)
+		}
+		exchangeRates = exchangeRates.Add(sdk.NewDecCoinFromDec(denomResult.Denom, exchangeRate))
+	}
+	return exchangeRates
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/oracle/utils"
+)
+
+func TestBallotResultValidatorExchangeRates(t *testing.T) {
+	voter, other := "validator", "other"
+	result := BallotResult{
+		ReferenceDenom: utils.MicroAtomDenom,
+		DenomResults: []DenomBallotResult{
+			{
+				Denom:  utils.MicroAtomDenom,
+				Passed: true,
+				Votes: []BallotVoteResult{
+					{Voter: voter, ExchangeRate: sdk.NewDec(10)},
+					{Voter: other, ExchangeRate: sdk.NewDec(11)},
+				},
+			},
+			{
+				// cross rate of the reference denom
+				Denom:  utils.MicroEthDenom,
+				Passed: true,
+				Votes: []BallotVoteResult{
+					{Voter: voter, ExchangeRate: sdk.NewDecWithPrec(5, 3)},
+				},
+			},
+			{
+				// below threshold denoms are not converted to cross rates
+				Denom:  utils.MicroSeiDenom,
+				Passed: false,
+				Votes: []BallotVoteResult{
+					{Voter: voter, ExchangeRate: sdk.NewDecWithPrec(2, 1)},
+				},
+			},
+			{
+				Denom:  utils.MicroUsdcDenom,
+				Passed: true,
+				Votes: []BallotVoteResult{
+					{Voter: voter, ExchangeRate: sdk.ZeroDec()},
+					{Voter: other, ExchangeRate: sdk.NewDec(10)},
+				},
+			},
+		},
+	}
+
+	require.Equal(t, sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec(utils.MicroAtomDenom, sdk.NewDec(10)),
+		sdk.NewDecCoinFromDec(utils.MicroEthDenom, sdk.NewDec(2000)),
+		sdk.NewDecCoinFromDec(utils.MicroSeiDenom, sdk.NewDecWithPrec(2, 1)),
+	), result.ValidatorExchangeRates(voter))
+
+	// passed denoms cannot be converted without a vote for the reference denom
+	result.DenomResults[0].Votes = result.DenomResults[0].Votes[1:]
+	require.Equal(t, sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec(utils.MicroSeiDenom, sdk.NewDecWithPrec(2, 1)),
+	), result.ValidatorExchangeRates(voter))
+}
@@ -1,8 +1,10 @@
 package types
 
 import (
+	"fmt"
 	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"gopkg.in/yaml.v2"
 )
 
@@ -14,7 +16,48 @@ func (d Denom) String() string {
 
 // Equal implements equal interface
 func (d Denom) Equal(d1 *Denom) bool {
-	return d.Name == d1.Name
+	return d.Name == d1.Name &&
+		decOverrideEqual(d.VoteThreshold, d1.VoteThreshold) &&
+		decOverrideEqual(d.RewardBand, d1.RewardBand) &&
+		d.MaxStaleness == d1.MaxStaleness
+}
+
+func decOverrideEqual(a, b *sdk.Dec) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(*b)
+}
+
+// Validate checks that the denom has a name and that its overrides are within the
+// bounds of the module parameters they override
+func (d Denom) Validate() error {
+	if len(d.Name) == 0 {
+		return fmt.Errorf("oracle parameter Whitelist Denom must have name")
+	}
+	if d.VoteThreshold != nil && (d.VoteThreshold.LTE(sdk.NewDecWithPrec(33, 2)) || d.VoteThreshold.GT(sdk.OneDec())) {
+		return fmt.Errorf("oracle denom %s VoteThreshold must be greater than 33 percent and at most 100 percent", d.Name)
+	}
+	if d.RewardBand != nil && (d.RewardBand.GT(sdk.OneDec()) || d.RewardBand.IsNegative()) {
+		return fmt.Errorf("oracle denom %s RewardBand must be between [0, 1]", d.Name)
+	}
+	return nil
+}
+
+// VoteThresholdOr returns the vote threshold override of the denom, or defaultThreshold if there is none
+func (d Denom) VoteThresholdOr(defaultThreshold sdk.Dec) sdk.Dec {
+	if d.VoteThreshold == nil {
+		return defaultThreshold
+	}
+	return *d.VoteThreshold
+}
+
+// RewardBandOr returns the reward band override of the denom, or defaultRewardBand if there is none
+func (d Denom) RewardBandOr(defaultRewardBand sdk.Dec) sdk.Dec {
+	if d.RewardBand == nil {
+		return defaultRewardBand
+	}
+	return *d.RewardBand
 }
 
 // DenomList is array of Denom
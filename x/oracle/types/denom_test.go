@@ -1,6 +1,11 @@
 package types
 
-import "testing"
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
 
 func TestDenomListContains(t *testing.T) {
 	tests := []struct {
@@ -45,3 +50,26 @@ func TestDenomListContains(t *testing.T) {
 		})
 	}
 }
+
+func TestDenomValidate(t *testing.T) {
+	low, high, band := sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(9, 1), sdk.NewDecWithPrec(5, 2)
+	require.NoError(t, Denom{Name: "uatom"}.Validate())
+	require.NoError(t, Denom{Name: "uatom", VoteThreshold: &high, RewardBand: &band, MaxStaleness: 60}.Validate())
+	require.Error(t, Denom{}.Validate())
+	require.Error(t, Denom{Name: "uatom", VoteThreshold: &low}.Validate())
+	require.Error(t, Denom{Name: "uatom", RewardBand: &high, VoteThreshold: &band}.Validate())
+
+	require.Equal(t, high, Denom{Name: "uatom", VoteThreshold: &high}.VoteThresholdOr(low))
+	require.Equal(t, low, Denom{Name: "uatom"}.VoteThresholdOr(low))
+	require.Equal(t, band, Denom{Name: "uatom", RewardBand: &band}.RewardBandOr(low))
+	require.Equal(t, low, Denom{Name: "uatom"}.RewardBandOr(low))
+}
+
+func TestDenomEqual(t *testing.T) {
+	band, sameBand := sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(50, 3)
+	denom := Denom{Name: "uatom", RewardBand: &band}
+	require.True(t, denom.Equal(&Denom{Name: "uatom", RewardBand: &sameBand}))
+	require.False(t, denom.Equal(&Denom{Name: "uatom"}))
+	require.False(t, denom.Equal(&Denom{Name: "uatom", RewardBand: &band, MaxStaleness: 1}))
+	require.False(t, denom.Equal(&Denom{Name: "ueth", RewardBand: &band}))
+}
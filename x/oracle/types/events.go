@@ -3,21 +3,30 @@ package types
 // Oracle module event types
 const (
 	EventTypeExchangeRateUpdate = "exchange_rate_update"
+	EventTypeExchangeRateStale  = "exchange_rate_stale"
 	EventTypeVote               = "vote"
 	EventTypeFeedDelegate       = "feed_delegate"
 	EventTypeAggregateVote      = "aggregate_vote"
+	EventTypeAggregatePrevote   = "aggregate_prevote"
 	EventTypeEndSlashWindow     = "end_slash_window"
+	EventTypeSlashWarning       = "slash_warning"
+	EventTypeRewardDistribution = "reward_distribution"
 
-	AttributeKeyDenom         = "denom"
-	AttributeKeyVoter         = "voter"
-	AttributeKeyExchangeRate  = "exchange_rate"
-	AttributeKeyExchangeRates = "exchange_rates"
-	AttributeKeyOperator      = "operator"
-	AttributeKeyFeeder        = "feeder"
-	AttributeKeyMissCount     = "miss_count"
-	AttributeKeyAbstainCount  = "abstain_count"
-	AttributeKeyWinCount      = "win_count"
-	AttributeKeySuccessCount  = "success_count"
+	AttributeKeyDenom               = "denom"
+	AttributeKeyVoter               = "voter"
+	AttributeKeyExchangeRate        = "exchange_rate"
+	AttributeKeyExchangeRates       = "exchange_rates"
+	AttributeKeyAggregateHash       = "aggregate_hash"
+	AttributeKeyOperator            = "operator"
+	AttributeKeyFeeder              = "feeder"
+	AttributeKeyMissCount           = "miss_count"
+	AttributeKeyAbstainCount        = "abstain_count"
+	AttributeKeyWinCount            = "win_count"
+	AttributeKeySuccessCount        = "success_count"
+	AttributeKeyAmount              = "amount"
+	AttributeKeyLastUpdateTimestamp = "last_update_timestamp"
+	AttributeKeyValidVoteRate       = "valid_vote_rate"
+	AttributeKeyWarningCount        = "warning_count"
 
 	AttributeValueCategory = ModuleName
 )
@@ -11,27 +11,35 @@ func NewGenesisState(
 	params Params, rates []ExchangeRateTuple,
 	feederDelegations []FeederDelegation, penaltyCounters []PenaltyCounter,
 	aggregateExchangeRateVotes []AggregateExchangeRateVote,
-	priceSnapshots []PriceSnapshot,
+	priceSnapshots []PriceSnapshot, validatorRewards []ValidatorRewards,
+	aggregateExchangeRatePrevotes []AggregateExchangeRatePrevote,
+	slashWarningCounters []SlashWarningCounter,
 ) *GenesisState {
 	return &GenesisState{
-		Params:                     params,
-		ExchangeRates:              rates,
-		FeederDelegations:          feederDelegations,
-		PenaltyCounters:            penaltyCounters,
-		AggregateExchangeRateVotes: aggregateExchangeRateVotes,
-		PriceSnapshots:             priceSnapshots,
+		Params:                        params,
+		ExchangeRates:                 rates,
+		FeederDelegations:             feederDelegations,
+		PenaltyCounters:               penaltyCounters,
+		AggregateExchangeRateVotes:    aggregateExchangeRateVotes,
+		PriceSnapshots:                priceSnapshots,
+		ValidatorRewards:              validatorRewards,
+		AggregateExchangeRatePrevotes: aggregateExchangeRatePrevotes,
+		SlashWarningCounters:          slashWarningCounters,
 	}
 }
 
 // DefaultGenesisState - default GenesisState used by columbus-2
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:                     DefaultParams(),
-		ExchangeRates:              []ExchangeRateTuple{},
-		FeederDelegations:          []FeederDelegation{},
-		PenaltyCounters:            []PenaltyCounter{},
-		AggregateExchangeRateVotes: []AggregateExchangeRateVote{},
-		PriceSnapshots:             PriceSnapshots{},
+		Params:                        DefaultParams(),
+		ExchangeRates:                 []ExchangeRateTuple{},
+		FeederDelegations:             []FeederDelegation{},
+		PenaltyCounters:               []PenaltyCounter{},
+		AggregateExchangeRateVotes:    []AggregateExchangeRateVote{},
+		PriceSnapshots:                PriceSnapshots{},
+		ValidatorRewards:              []ValidatorRewards{},
+		AggregateExchangeRatePrevotes: []AggregateExchangeRatePrevote{},
+		SlashWarningCounters:          []SlashWarningCounter{},
 	}
 }
 
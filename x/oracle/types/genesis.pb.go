@@ -25,12 +25,15 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
-	Params                     Params                      `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	FeederDelegations          []FeederDelegation          `protobuf:"bytes,2,rep,name=feeder_delegations,json=feederDelegations,proto3" json:"feeder_delegations"`
-	ExchangeRates              ExchangeRateTuples          `protobuf:"bytes,3,rep,name=exchange_rates,json=exchangeRates,proto3,castrepeated=ExchangeRateTuples" json:"exchange_rates"`
-	PenaltyCounters            []PenaltyCounter            `protobuf:"bytes,4,rep,name=penalty_counters,json=penaltyCounters,proto3" json:"penalty_counters"`
-	AggregateExchangeRateVotes []AggregateExchangeRateVote `protobuf:"bytes,6,rep,name=aggregate_exchange_rate_votes,json=aggregateExchangeRateVotes,proto3" json:"aggregate_exchange_rate_votes"`
-	PriceSnapshots             PriceSnapshots              `protobuf:"bytes,7,rep,name=price_snapshots,json=priceSnapshots,proto3,castrepeated=PriceSnapshots" json:"price_snapshots"`
+	Params                        Params                         `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	FeederDelegations             []FeederDelegation             `protobuf:"bytes,2,rep,name=feeder_delegations,json=feederDelegations,proto3" json:"feeder_delegations"`
+	ExchangeRates                 ExchangeRateTuples             `protobuf:"bytes,3,rep,name=exchange_rates,json=exchangeRates,proto3,castrepeated=ExchangeRateTuples" json:"exchange_rates"`
+	PenaltyCounters               []PenaltyCounter               `protobuf:"bytes,4,rep,name=penalty_counters,json=penaltyCounters,proto3" json:"penalty_counters"`
+	AggregateExchangeRateVotes    []AggregateExchangeRateVote    `protobuf:"bytes,6,rep,name=aggregate_exchange_rate_votes,json=aggregateExchangeRateVotes,proto3" json:"aggregate_exchange_rate_votes"`
+	PriceSnapshots                PriceSnapshots                 `protobuf:"bytes,7,rep,name=price_snapshots,json=priceSnapshots,proto3,castrepeated=PriceSnapshots" json:"price_snapshots"`
+	ValidatorRewards              []ValidatorRewards             `protobuf:"bytes,8,rep,name=validator_rewards,json=validatorRewards,proto3" json:"validator_rewards"`
+	AggregateExchangeRatePrevotes []AggregateExchangeRatePrevote `protobuf:"bytes,9,rep,name=aggregate_exchange_rate_prevotes,json=aggregateExchangeRatePrevotes,proto3" json:"aggregate_exchange_rate_prevotes"`
+	SlashWarningCounters          []SlashWarningCounter          `protobuf:"bytes,10,rep,name=slash_warning_counters,json=slashWarningCounters,proto3" json:"slash_warning_counters"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -108,6 +111,27 @@ func (m *GenesisState) GetPriceSnapshots() PriceSnapshots {
 	return nil
 }
 
+func (m *GenesisState) GetValidatorRewards() []ValidatorRewards {
+	if m != nil {
+		return m.ValidatorRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAggregateExchangeRatePrevotes() []AggregateExchangeRatePrevote {
+	if m != nil {
+		return m.AggregateExchangeRatePrevotes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSlashWarningCounters() []SlashWarningCounter {
+	if m != nil {
+		return m.SlashWarningCounters
+	}
+	return nil
+}
+
 type FeederDelegation struct {
 	FeederAddress    string `protobuf:"bytes,1,opt,name=feeder_address,json=feederAddress,proto3" json:"feeder_address,omitempty"`
 	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
@@ -212,49 +236,110 @@ func (m *PenaltyCounter) GetVotePenaltyCounter() *VotePenaltyCounter {
 	return nil
 }
 
+type SlashWarningCounter struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// number of consecutive slash windows for which the validator was warned
+	WarningCount uint64 `protobuf:"varint,2,opt,name=warning_count,json=warningCount,proto3" json:"warning_count,omitempty"`
+}
+
+func (m *SlashWarningCounter) Reset()         { *m = SlashWarningCounter{} }
+func (m *SlashWarningCounter) String() string { return proto.CompactTextString(m) }
+func (*SlashWarningCounter) ProtoMessage()    {}
+func (*SlashWarningCounter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce0b3a2b4a184fc3, []int{3}
+}
+func (m *SlashWarningCounter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashWarningCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashWarningCounter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashWarningCounter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashWarningCounter.Merge(m, src)
+}
+func (m *SlashWarningCounter) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashWarningCounter) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashWarningCounter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashWarningCounter proto.InternalMessageInfo
+
+func (m *SlashWarningCounter) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *SlashWarningCounter) GetWarningCount() uint64 {
+	if m != nil {
+		return m.WarningCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "seiprotocol.seichain.oracle.GenesisState")
 	proto.RegisterType((*FeederDelegation)(nil), "seiprotocol.seichain.oracle.FeederDelegation")
 	proto.RegisterType((*PenaltyCounter)(nil), "seiprotocol.seichain.oracle.PenaltyCounter")
+	proto.RegisterType((*SlashWarningCounter)(nil), "seiprotocol.seichain.oracle.SlashWarningCounter")
 }
 
 func init() { proto.RegisterFile("oracle/genesis.proto", fileDescriptor_ce0b3a2b4a184fc3) }
 
 var fileDescriptor_ce0b3a2b4a184fc3 = []byte{
-	// 521 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x8d, 0xdb, 0x10, 0x60, 0x43, 0xd3, 0x74, 0x89, 0x50, 0x14, 0x84, 0x5b, 0x05, 0x21, 0x55,
-	0x54, 0xb5, 0x69, 0x90, 0xb8, 0x27, 0x7c, 0x49, 0x3d, 0x21, 0x17, 0x71, 0x40, 0x48, 0xd6, 0xda,
-	0x9e, 0x38, 0x2b, 0x1c, 0xaf, 0xf1, 0x6c, 0xa2, 0xf6, 0xc4, 0x5f, 0xe0, 0x27, 0x70, 0xe6, 0x97,
-	0xf4, 0xd8, 0x23, 0x27, 0x40, 0xc9, 0xbf, 0xe0, 0x84, 0xbc, 0xbb, 0x2d, 0x75, 0xda, 0xfa, 0x94,
-	0xdd, 0x37, 0xef, 0xcd, 0xcb, 0x9b, 0x59, 0x93, 0x8e, 0xc8, 0x59, 0x98, 0x80, 0x1b, 0x43, 0x0a,
-	0xc8, 0xd1, 0xc9, 0x72, 0x21, 0x05, 0x7d, 0x88, 0xc0, 0xd5, 0x29, 0x14, 0x89, 0x83, 0xc0, 0xc3,
-	0x09, 0xe3, 0xa9, 0xa3, 0xa9, 0xbd, 0x4e, 0x2c, 0x62, 0xa1, 0xaa, 0x6e, 0x71, 0xd2, 0x92, 0xde,
-	0x7d, 0xd3, 0x48, 0xff, 0x18, 0xd0, 0x0e, 0x05, 0x4e, 0x05, 0xba, 0x01, 0x43, 0x70, 0xe7, 0x07,
-	0x01, 0x48, 0x76, 0xe0, 0x86, 0x82, 0xa7, 0xba, 0xde, 0xff, 0x5b, 0x27, 0xf7, 0xde, 0x6a, 0xe7,
-	0x23, 0xc9, 0x24, 0xd0, 0x21, 0x69, 0x64, 0x2c, 0x67, 0x53, 0xec, 0x5a, 0x3b, 0xd6, 0x6e, 0x73,
-	0xf0, 0xd8, 0xa9, 0xf8, 0x27, 0xce, 0x3b, 0x45, 0x1d, 0xd5, 0x4f, 0x7f, 0x6d, 0xd7, 0x3c, 0x23,
-	0xa4, 0x01, 0xa1, 0x63, 0x80, 0x08, 0x72, 0x3f, 0x82, 0x04, 0x62, 0x26, 0xb9, 0x48, 0xb1, 0xbb,
-	0xb6, 0xb3, 0xbe, 0xdb, 0x1c, 0xec, 0x57, 0xb6, 0x7b, 0xa3, 0x64, 0xaf, 0x2e, 0x54, 0xa6, 0xf1,
-	0xd6, 0x78, 0x05, 0x47, 0xfa, 0x85, 0xb4, 0xe0, 0x38, 0x9c, 0xb0, 0x34, 0x06, 0x3f, 0x67, 0x12,
-	0xb0, 0xbb, 0xae, 0xfa, 0x3b, 0x95, 0xfd, 0x5f, 0x1b, 0x89, 0xc7, 0x24, 0xbc, 0x9f, 0x65, 0x09,
-	0x8c, 0x7a, 0x85, 0xc1, 0x8f, 0xdf, 0xdb, 0xf4, 0x4a, 0x09, 0xbd, 0x0d, 0xb8, 0x84, 0x21, 0xfd,
-	0x44, 0xda, 0x19, 0xa4, 0x2c, 0x91, 0x27, 0x7e, 0x28, 0x66, 0xa9, 0x84, 0x1c, 0xbb, 0x75, 0x65,
-	0xba, 0x57, 0x3d, 0x23, 0x2d, 0x7a, 0xa9, 0x35, 0x26, 0xd2, 0x66, 0x56, 0x42, 0x91, 0x7e, 0x25,
-	0x8f, 0x58, 0x1c, 0xe7, 0x45, 0x40, 0xf0, 0x4b, 0xd1, 0xfc, 0xb9, 0x28, 0xf2, 0x35, 0x94, 0xd5,
-	0x8b, 0x4a, 0xab, 0xe1, 0x79, 0x87, 0xcb, 0x69, 0x3e, 0x08, 0x09, 0xc6, 0xb5, 0xc7, 0x6e, 0x22,
-	0x20, 0xfd, 0x4c, 0x36, 0xb3, 0x9c, 0x87, 0xe0, 0x63, 0xca, 0x32, 0x9c, 0x08, 0x89, 0xdd, 0xdb,
-	0xca, 0xf2, 0x69, 0x75, 0xba, 0x42, 0x73, 0x64, 0x24, 0xa3, 0x07, 0x66, 0x9c, 0xad, 0x12, 0x8c,
-	0x5e, 0x2b, 0x2b, 0xdd, 0x0f, 0xeb, 0x77, 0x6e, 0xb5, 0x1b, 0xfd, 0x31, 0x69, 0xaf, 0x6e, 0x9c,
-	0x3e, 0x21, 0x2d, 0xf3, 0x78, 0x58, 0x14, 0xe5, 0x80, 0xfa, 0x1d, 0xde, 0xf5, 0x36, 0x34, 0x3a,
-	0xd4, 0x20, 0xdd, 0x23, 0x5b, 0x73, 0x96, 0xf0, 0x88, 0x49, 0xf1, 0x9f, 0xb9, 0xa6, 0x98, 0xed,
-	0x8b, 0x82, 0x21, 0xf7, 0xbf, 0x5b, 0xa4, 0x55, 0xde, 0xc2, 0xf5, 0x7a, 0xeb, 0x7a, 0x3d, 0x65,
-	0xa4, 0x53, 0xec, 0xc0, 0x5f, 0x59, 0xbf, 0xf2, 0x6b, 0x0e, 0xdc, 0xca, 0xf9, 0x14, 0xc3, 0x2d,
-	0x7b, 0x7b, 0x74, 0x7e, 0x05, 0x1b, 0x1d, 0x9e, 0x2e, 0x6c, 0xeb, 0x6c, 0x61, 0x5b, 0x7f, 0x16,
-	0xb6, 0xf5, 0x6d, 0x69, 0xd7, 0xce, 0x96, 0x76, 0xed, 0xe7, 0xd2, 0xae, 0x7d, 0x7c, 0x16, 0x73,
-	0x39, 0x99, 0x05, 0x4e, 0x28, 0xa6, 0x2e, 0x02, 0xdf, 0x3f, 0x77, 0x52, 0x17, 0x65, 0xe5, 0x1e,
-	0x9b, 0x6f, 0xde, 0x95, 0x27, 0x19, 0x60, 0xd0, 0x50, 0x94, 0xe7, 0xff, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x29, 0xe9, 0x57, 0x70, 0x5a, 0x04, 0x00, 0x00,
+	// 627 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4d, 0x4f, 0xdb, 0x40,
+	0x10, 0x8d, 0x21, 0x0d, 0xb0, 0x40, 0x08, 0x4b, 0x84, 0xa2, 0x54, 0x18, 0x14, 0x54, 0x09, 0x15,
+	0x61, 0x03, 0x95, 0x2a, 0xf5, 0x48, 0xfa, 0x25, 0x71, 0x42, 0xa6, 0xa2, 0x52, 0x55, 0xc9, 0xdd,
+	0x38, 0x83, 0x63, 0xd5, 0x78, 0xdd, 0x9d, 0x25, 0xc0, 0xa9, 0xd7, 0x1e, 0xfb, 0x13, 0x7a, 0xee,
+	0x2f, 0xe1, 0xc8, 0xa5, 0x52, 0x4f, 0x6d, 0x05, 0x7f, 0xa4, 0xf2, 0xee, 0xa6, 0xc4, 0x01, 0xac,
+	0x72, 0x8a, 0xfd, 0x76, 0xde, 0xbc, 0x7d, 0xcf, 0x33, 0x21, 0x75, 0x2e, 0x58, 0x10, 0x83, 0x1b,
+	0x42, 0x02, 0x18, 0xa1, 0x93, 0x0a, 0x2e, 0x39, 0x7d, 0x88, 0x10, 0xa9, 0xa7, 0x80, 0xc7, 0x0e,
+	0x42, 0x14, 0xf4, 0x58, 0x94, 0x38, 0xba, 0xb4, 0x59, 0x0f, 0x79, 0xc8, 0xd5, 0xa9, 0x9b, 0x3d,
+	0x69, 0x4a, 0x73, 0xc1, 0x34, 0xd2, 0x3f, 0x06, 0xb4, 0x03, 0x8e, 0x47, 0x1c, 0xdd, 0x0e, 0x43,
+	0x70, 0xfb, 0x5b, 0x1d, 0x90, 0x6c, 0xcb, 0x0d, 0x78, 0x94, 0xe8, 0xf3, 0xd6, 0x8f, 0x09, 0x32,
+	0xf3, 0x5a, 0x2b, 0xef, 0x4b, 0x26, 0x81, 0xee, 0x90, 0x4a, 0xca, 0x04, 0x3b, 0xc2, 0x86, 0xb5,
+	0x62, 0xad, 0x4d, 0x6f, 0xaf, 0x3a, 0x05, 0x37, 0x71, 0xf6, 0x54, 0x69, 0xbb, 0x7c, 0xfe, 0x6b,
+	0xb9, 0xe4, 0x19, 0x22, 0xed, 0x10, 0x7a, 0x08, 0xd0, 0x05, 0xe1, 0x77, 0x21, 0x86, 0x90, 0xc9,
+	0x88, 0x27, 0xd8, 0x18, 0x5b, 0x19, 0x5f, 0x9b, 0xde, 0xde, 0x28, 0x6c, 0xf7, 0x4a, 0xd1, 0x5e,
+	0xfc, 0x63, 0x99, 0xc6, 0xf3, 0x87, 0x23, 0x38, 0xd2, 0x4f, 0xa4, 0x0a, 0xa7, 0x41, 0x8f, 0x25,
+	0x21, 0xf8, 0x82, 0x49, 0xc0, 0xc6, 0xb8, 0xea, 0xef, 0x14, 0xf6, 0x7f, 0x69, 0x28, 0x1e, 0x93,
+	0xf0, 0xe6, 0x38, 0x8d, 0xa1, 0xdd, 0xcc, 0x04, 0xbe, 0xff, 0x5e, 0xa6, 0x37, 0x8e, 0xd0, 0x9b,
+	0x85, 0x21, 0x0c, 0xe9, 0x7b, 0x52, 0x4b, 0x21, 0x61, 0xb1, 0x3c, 0xf3, 0x03, 0x7e, 0x9c, 0x48,
+	0x10, 0xd8, 0x28, 0x2b, 0xd1, 0xf5, 0xe2, 0x8c, 0x34, 0xe9, 0xb9, 0xe6, 0x18, 0x4b, 0x73, 0x69,
+	0x0e, 0x45, 0xfa, 0x99, 0x2c, 0xb1, 0x30, 0x14, 0x99, 0x41, 0xf0, 0x73, 0xd6, 0xfc, 0x3e, 0xcf,
+	0xfc, 0x55, 0x94, 0xd4, 0xd3, 0x42, 0xa9, 0x9d, 0x41, 0x87, 0x61, 0x37, 0x07, 0x5c, 0x82, 0x51,
+	0x6d, 0xb2, 0xbb, 0x0a, 0x90, 0x7e, 0x24, 0x73, 0xa9, 0x88, 0x02, 0xf0, 0x31, 0x61, 0x29, 0xf6,
+	0xb8, 0xc4, 0xc6, 0x84, 0x92, 0x7c, 0x5c, 0xec, 0x2e, 0xe3, 0xec, 0x1b, 0x4a, 0x7b, 0xd1, 0xc4,
+	0x59, 0xcd, 0xc1, 0xe8, 0x55, 0xd3, 0xdc, 0x3b, 0xfd, 0x40, 0xe6, 0xfb, 0x2c, 0x8e, 0xba, 0x4c,
+	0x72, 0xe1, 0x0b, 0x38, 0x61, 0xa2, 0x8b, 0x8d, 0xc9, 0xff, 0x98, 0x90, 0x83, 0x01, 0xcb, 0xd3,
+	0x24, 0x63, 0xac, 0xd6, 0x1f, 0xc1, 0xe9, 0x17, 0x8b, 0xac, 0xdc, 0x15, 0x68, 0x2a, 0x40, 0x67,
+	0x3a, 0xa5, 0x14, 0x9f, 0xdd, 0x3f, 0xd3, 0x3d, 0xdd, 0xc1, 0xa8, 0x2f, 0xb1, 0x82, 0x1a, 0xa4,
+	0x31, 0x59, 0xc4, 0x98, 0x61, 0xcf, 0x3f, 0x61, 0x22, 0x89, 0x92, 0xf0, 0x7a, 0x7c, 0x88, 0xd2,
+	0xdf, 0x2c, 0xd4, 0xdf, 0xcf, 0xa8, 0x6f, 0x35, 0x33, 0x3f, 0x43, 0x75, 0xbc, 0x79, 0x84, 0xbb,
+	0xe5, 0xc9, 0x07, 0xb5, 0x4a, 0xeb, 0x90, 0xd4, 0x46, 0x97, 0x89, 0x3e, 0x22, 0x55, 0xb3, 0x97,
+	0xac, 0xdb, 0x15, 0x80, 0x7a, 0xc5, 0xa7, 0xbc, 0x59, 0x8d, 0xee, 0x68, 0x90, 0xae, 0x0f, 0x7f,
+	0x9b, 0x41, 0xe5, 0x98, 0xaa, 0xbc, 0x8e, 0xd9, 0x14, 0xb7, 0xbe, 0x59, 0xa4, 0x9a, 0x1f, 0xf0,
+	0xdb, 0xf9, 0xd6, 0xed, 0x7c, 0xca, 0x48, 0x3d, 0x0b, 0xc9, 0x1f, 0xd9, 0x2c, 0xa5, 0x37, 0xbd,
+	0xed, 0x16, 0xcf, 0x02, 0x97, 0x90, 0xd7, 0xf6, 0x68, 0xff, 0x06, 0xd6, 0x0a, 0xc9, 0xc2, 0x2d,
+	0x19, 0xde, 0xef, 0x9a, 0xab, 0x64, 0x36, 0xf7, 0xf1, 0xd4, 0xfd, 0xca, 0xde, 0xcc, 0xc9, 0x50,
+	0xcf, 0xf6, 0xee, 0xf9, 0xa5, 0x6d, 0x5d, 0x5c, 0xda, 0xd6, 0x9f, 0x4b, 0xdb, 0xfa, 0x7a, 0x65,
+	0x97, 0x2e, 0xae, 0xec, 0xd2, 0xcf, 0x2b, 0xbb, 0xf4, 0x6e, 0x33, 0x8c, 0x64, 0xef, 0xb8, 0xe3,
+	0x04, 0xfc, 0xc8, 0x45, 0x88, 0x36, 0x06, 0x96, 0xd4, 0x8b, 0xf2, 0xe4, 0x9e, 0x9a, 0xff, 0x6d,
+	0x57, 0x9e, 0xa5, 0x80, 0x9d, 0x8a, 0x2a, 0x79, 0xf2, 0x77, 0x00, 0xdf, 0xc6, 0x7a, 0x4f, 0x1e,
+	0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -277,6 +362,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SlashWarningCounters) > 0 {
+		for iNdEx := len(m.SlashWarningCounters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashWarningCounters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.AggregateExchangeRatePrevotes) > 0 {
+		for iNdEx := len(m.AggregateExchangeRatePrevotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AggregateExchangeRatePrevotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ValidatorRewards) > 0 {
+		for iNdEx := len(m.ValidatorRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.PriceSnapshots) > 0 {
 		for iNdEx := len(m.PriceSnapshots) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -439,6 +566,41 @@ func (m *PenaltyCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SlashWarningCounter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashWarningCounter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashWarningCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WarningCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.WarningCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -488,6 +650,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ValidatorRewards) > 0 {
+		for _, e := range m.ValidatorRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AggregateExchangeRatePrevotes) > 0 {
+		for _, e := range m.AggregateExchangeRatePrevotes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SlashWarningCounters) > 0 {
+		for _, e := range m.SlashWarningCounters {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -525,6 +705,22 @@ func (m *PenaltyCounter) Size() (n int) {
 	return n
 }
 
+func (m *SlashWarningCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.WarningCount != 0 {
+		n += 1 + sovGenesis(uint64(m.WarningCount))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -763,6 +959,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorRewards = append(m.ValidatorRewards, ValidatorRewards{})
+			if err := m.ValidatorRewards[len(m.ValidatorRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateExchangeRatePrevotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregateExchangeRatePrevotes = append(m.AggregateExchangeRatePrevotes, AggregateExchangeRatePrevote{})
+			if err := m.AggregateExchangeRatePrevotes[len(m.AggregateExchangeRatePrevotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashWarningCounters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashWarningCounters = append(m.SlashWarningCounters, SlashWarningCounter{})
+			if err := m.SlashWarningCounters[len(m.SlashWarningCounters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1016,6 +1314,107 @@ func (m *PenaltyCounter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SlashWarningCounter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashWarningCounter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashWarningCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WarningCount", wireType)
+			}
+			m.WarningCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WarningCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeUpdateDenomParams = "UpdateDenomParams"
+)
+
+func init() {
+	// for routing
+	govtypes.RegisterProposalType(ProposalTypeUpdateDenomParams)
+	// for marshal and unmarshal
+	govtypes.RegisterProposalTypeCodec(&UpdateDenomParamsProposal{}, "oracle/UpdateDenomParamsProposal")
+}
+
+func (p *UpdateDenomParamsProposal) GetTitle() string { return p.Title }
+
+func (p *UpdateDenomParamsProposal) GetDescription() string { return p.Description }
+
+func (p *UpdateDenomParamsProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UpdateDenomParamsProposal) ProposalType() string {
+	return ProposalTypeUpdateDenomParams
+}
+
+func (p *UpdateDenomParamsProposal) ValidateBasic() error {
+	if err := p.Denom.Validate(); err != nil {
+		return err
+	}
+	return govtypes.ValidateAbstract(p)
+}
+
+func (p UpdateDenomParamsProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Update Denom Params Proposal:
+  Title:       %s
+  Description: %s
+  Denom:       %s
+`, p.Title, p.Description, p.Denom.String()))
+	return b.String()
+}
+
+func NewUpdateDenomParamsProposal(title, description string, denom Denom) *UpdateDenomParamsProposal {
+	return &UpdateDenomParamsProposal{title, description, denom}
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AggregateVoteHash is the hash committed to by a prevote, formatted as a hex string of
+// the truncated SHA256("{salt}:{exchange rate}{denom},...,{exchange rate}{denom}:{voter}")
+type AggregateVoteHash []byte
+
+// GetAggregateVoteHash computes the hash value of the aggregate vote
+func GetAggregateVoteHash(salt string, exchangeRatesStr string, voter sdk.ValAddress) AggregateVoteHash {
+	sourceStr := fmt.Sprintf("%s:%s:%s", salt, exchangeRatesStr, voter.String())
+	return tmhash.Sum([]byte(sourceStr))[:tmhash.TruncatedSize]
+}
+
+// AggregateVoteHashFromHexString converts a hex string to an AggregateVoteHash
+func AggregateVoteHashFromHexString(s string) (AggregateVoteHash, error) {
+	h, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// String implements fmt.Stringer interface
+func (h AggregateVoteHash) String() string {
+	return hex.EncodeToString(h)
+}
+
+// Equal checks the equality of two hashes
+func (h AggregateVoteHash) Equal(h2 AggregateVoteHash) bool {
+	return bytes.Equal(h, h2)
+}
+
+// Empty checks if the hash is empty
+func (h AggregateVoteHash) Empty() bool {
+	return len(h) == 0
+}
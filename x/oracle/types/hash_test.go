@@ -0,0 +1,26 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestAggregateVoteHash(t *testing.T) {
+	voter := sdk.ValAddress([]byte("addr1_______________"))
+	hash := GetAggregateVoteHash("salt", "1.0foo,1232.132bar", voter)
+	require.Equal(t, 20, len(hash))
+
+	parsed, err := AggregateVoteHashFromHexString(hash.String())
+	require.NoError(t, err)
+	require.True(t, hash.Equal(parsed))
+
+	require.False(t, hash.Equal(GetAggregateVoteHash("salt2", "1.0foo,1232.132bar", voter)))
+	require.False(t, hash.Equal(GetAggregateVoteHash("salt", "1.1foo,1232.132bar", voter)))
+
+	_, err = AggregateVoteHashFromHexString("zz")
+	require.Error(t, err)
+	require.True(t, AggregateVoteHash{}.Empty())
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -8,18 +10,78 @@ import (
 // ensure Msg interface compliance at compile time
 var (
 	_ sdk.Msg = &MsgDelegateFeedConsent{}
+	_ sdk.Msg = &MsgAggregateExchangeRatePrevote{}
 	_ sdk.Msg = &MsgAggregateExchangeRateVote{}
 )
 
 // oracle message types
 const (
-	TypeMsgDelegateFeedConsent       = "delegate_feeder"
-	TypeMsgAggregateExchangeRateVote = "aggregate_exchange_rate_vote"
+	TypeMsgDelegateFeedConsent          = "delegate_feeder"
+	TypeMsgAggregateExchangeRatePrevote = "aggregate_exchange_rate_prevote"
+	TypeMsgAggregateExchangeRateVote    = "aggregate_exchange_rate_vote"
 )
 
+// MaxSaltLength is the maximum length of the salt of an aggregate vote
+const MaxSaltLength = 64
+
 //-------------------------------------------------
 //-------------------------------------------------
 
+// NewMsgAggregateExchangeRatePrevote returns MsgAggregateExchangeRatePrevote instance
+func NewMsgAggregateExchangeRatePrevote(hash AggregateVoteHash, feeder sdk.AccAddress, validator sdk.ValAddress) *MsgAggregateExchangeRatePrevote {
+	return &MsgAggregateExchangeRatePrevote{
+		Hash:      hash.String(),
+		Feeder:    feeder.String(),
+		Validator: validator.String(),
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgAggregateExchangeRatePrevote) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgAggregateExchangeRatePrevote) Type() string { return TypeMsgAggregateExchangeRatePrevote }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgAggregateExchangeRatePrevote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgAggregateExchangeRatePrevote) GetSigners() []sdk.AccAddress {
+	feeder, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{feeder}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgAggregateExchangeRatePrevote) ValidateBasic() error {
+	_, err := AggregateVoteHashFromHexString(msg.Hash)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidHash, "Invalid vote hash (%s)", err)
+	}
+
+	// HEX encoding doubles the hash length
+	if len(msg.Hash) != tmhash.TruncatedSize*2 {
+		return ErrInvalidHashLength
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid feeder address (%s)", err)
+	}
+
+	_, err = sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid operator address (%s)", err)
+	}
+
+	return nil
+}
+
 // NewMsgAggregateExchangeRateVote returns MsgAggregateExchangeRateVote instance
 func NewMsgAggregateExchangeRateVote(exchangeRates string, feeder sdk.AccAddress, validator sdk.ValAddress) *MsgAggregateExchangeRateVote {
 	return &MsgAggregateExchangeRateVote{
@@ -68,6 +130,10 @@ func (msg MsgAggregateExchangeRateVote) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "exchange rates string can not exceed 4096 characters")
 	}
 
+	if len(msg.Salt) > MaxSaltLength {
+		return ErrInvalidSaltLength
+	}
+
 	exchangeRates, err := ParseExchangeRateTuples(msg.ExchangeRates)
 	if err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "failed to parse exchange rates string cause: "+err.Error())
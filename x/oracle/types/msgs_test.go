@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -65,4 +66,40 @@ func TestMsgAggregateExchangeRateVote(t *testing.T) {
 			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
 		}
 	}
+
+	msg := NewMsgAggregateExchangeRateVote(exchangeRates, addrs[0], sdk.ValAddress(addrs[0]))
+	msg.Salt = strings.Repeat("1", MaxSaltLength)
+	require.Nil(t, msg.ValidateBasic())
+	msg.Salt = strings.Repeat("1", MaxSaltLength+1)
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidSaltLength)
+}
+
+func TestMsgAggregateExchangeRatePrevote(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1_______________")),
+	}
+
+	exchangeRates := "1.0foo,1232.132bar"
+	hash := GetAggregateVoteHash("salt", exchangeRates, sdk.ValAddress(addrs[0]))
+
+	tests := []struct {
+		hash       string
+		voter      sdk.AccAddress
+		expectPass bool
+	}{
+		{hash.String(), addrs[0], true},
+		{hash.String()[1:], addrs[0], false},
+		{hash.String()[2:], addrs[0], false},
+		{"zz" + hash.String()[2:], addrs[0], false},
+		{hash.String(), sdk.AccAddress{}, false},
+	}
+
+	for i, tc := range tests {
+		msg := &MsgAggregateExchangeRatePrevote{Hash: tc.hash, Feeder: tc.voter.String(), Validator: sdk.ValAddress(tc.voter).String()}
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
 }
@@ -5,8 +5,8 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -36,7 +36,19 @@ type Params struct {
 	SlashWindow uint64 `protobuf:"varint,6,opt,name=slash_window,json=slashWindow,proto3" json:"slash_window,omitempty" yaml:"slash_window"`
 	// The minimum percentage of voting windows for which a validator must have `success`es in order to not be penalized at the end of the slash window.
 	MinValidPerWindow github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=min_valid_per_window,json=minValidPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_valid_per_window" yaml:"min_valid_per_window"`
-	LookbackDuration  uint64                                 `protobuf:"varint,9,opt,name=lookback_duration,json=lookbackDuration,proto3" json:"lookback_duration,omitempty" yaml:"lookback_duration"`
+	// The number of blocks over which the oracle reward pool is paid out. At the end of each vote period, vote_period / reward_distribution_window of the pool is distributed to ballot winners.
+	RewardDistributionWindow uint64 `protobuf:"varint,8,opt,name=reward_distribution_window,json=rewardDistributionWindow,proto3" json:"reward_distribution_window,omitempty" yaml:"reward_distribution_window"`
+	LookbackDuration         uint64 `protobuf:"varint,9,opt,name=lookback_duration,json=lookbackDuration,proto3" json:"lookback_duration,omitempty" yaml:"lookback_duration"`
+	// If enabled, exchange rates are committed with a salted hash in a MsgAggregateExchangeRatePrevote and revealed with a MsgAggregateExchangeRateVote in the next vote period.
+	CommitRevealEnabled bool `protobuf:"varint,10,opt,name=commit_reveal_enabled,json=commitRevealEnabled,proto3" json:"commit_reveal_enabled,omitempty" yaml:"commit_reveal_enabled"`
+	// The number of seconds after which an exchange rate that has not been updated is stale, unless overridden by the denom's max_staleness. Stale exchange rates are removed at the end of the vote period. 0 disables staleness.
+	StalenessWindow uint64 `protobuf:"varint,11,opt,name=staleness_window,json=stalenessWindow,proto3" json:"staleness_window,omitempty" yaml:"staleness_window"`
+	// The number of most recent vote periods whose ballot results are kept for introspection. 0 disables recording of ballot results, and it can be at most 1000.
+	BallotResultHistory uint64 `protobuf:"varint,12,opt,name=ballot_result_history,json=ballotResultHistory,proto3" json:"ballot_result_history,omitempty" yaml:"ballot_result_history"`
+	// Graduated penalties for validators whose valid vote rate is below min_valid_per_window. The tier with the lowest max_valid_per_window above the valid vote rate of a validator applies. A validator whose valid vote rate is above every max_valid_per_window falls under the tier with the highest max_valid_per_window. Without tiers, the validator is slashed by slash_fraction and jailed.
+	SlashTiers SlashTiers `protobuf:"bytes,13,rep,name=slash_tiers,json=slashTiers,proto3,castrepeated=SlashTiers" json:"slash_tiers" yaml:"slash_tiers"`
+	// The number of consecutive slash windows below min_valid_per_window for which a validator is only warned before it is penalized.
+	SlashWarningWindows uint64 `protobuf:"varint,14,opt,name=slash_warning_windows,json=slashWarningWindows,proto3" json:"slash_warning_windows,omitempty" yaml:"slash_warning_windows"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -92,6 +104,13 @@ func (m *Params) GetSlashWindow() uint64 {
 	return 0
 }
 
+func (m *Params) GetRewardDistributionWindow() uint64 {
+	if m != nil {
+		return m.RewardDistributionWindow
+	}
+	return 0
+}
+
 func (m *Params) GetLookbackDuration() uint64 {
 	if m != nil {
 		return m.LookbackDuration
@@ -99,14 +118,101 @@ func (m *Params) GetLookbackDuration() uint64 {
 	return 0
 }
 
+func (m *Params) GetCommitRevealEnabled() bool {
+	if m != nil {
+		return m.CommitRevealEnabled
+	}
+	return false
+}
+
+func (m *Params) GetStalenessWindow() uint64 {
+	if m != nil {
+		return m.StalenessWindow
+	}
+	return 0
+}
+
+func (m *Params) GetBallotResultHistory() uint64 {
+	if m != nil {
+		return m.BallotResultHistory
+	}
+	return 0
+}
+
+func (m *Params) GetSlashTiers() SlashTiers {
+	if m != nil {
+		return m.SlashTiers
+	}
+	return nil
+}
+
+func (m *Params) GetSlashWarningWindows() uint64 {
+	if m != nil {
+		return m.SlashWarningWindows
+	}
+	return 0
+}
+
+type SlashTier struct {
+	// the tier applies to validators whose valid vote rate is below this rate
+	MaxValidPerWindow github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=max_valid_per_window,json=maxValidPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_valid_per_window" yaml:"max_valid_per_window"`
+	SlashFraction     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction" yaml:"slash_fraction"`
+	Jail              bool                                   `protobuf:"varint,3,opt,name=jail,proto3" json:"jail,omitempty" yaml:"jail"`
+}
+
+func (m *SlashTier) Reset()      { *m = SlashTier{} }
+func (*SlashTier) ProtoMessage() {}
+func (*SlashTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{1}
+}
+func (m *SlashTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashTier.Merge(m, src)
+}
+func (m *SlashTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashTier proto.InternalMessageInfo
+
+func (m *SlashTier) GetJail() bool {
+	if m != nil {
+		return m.Jail
+	}
+	return false
+}
+
 type Denom struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	// Overrides the module vote_threshold for the denom's ballot if set.
+	VoteThreshold *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=vote_threshold,json=voteThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"vote_threshold,omitempty" yaml:"vote_threshold,omitempty"`
+	// Overrides the module reward_band for the denom's ballot if set.
+	RewardBand *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=reward_band,json=rewardBand,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_band,omitempty" yaml:"reward_band,omitempty"`
+	// The maximum age in seconds of the denom's exchange rate before it is considered stale. If 0, the module staleness_window applies.
+	MaxStaleness uint64 `protobuf:"varint,4,opt,name=max_staleness,json=maxStaleness,proto3" json:"max_staleness,omitempty" yaml:"max_staleness,omitempty"`
 }
 
 func (m *Denom) Reset()      { *m = Denom{} }
 func (*Denom) ProtoMessage() {}
 func (*Denom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc470b50b143d488, []int{1}
+	return fileDescriptor_dc470b50b143d488, []int{2}
 }
 func (m *Denom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -135,6 +241,44 @@ func (m *Denom) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Denom proto.InternalMessageInfo
 
+type AggregateExchangeRatePrevote struct {
+	Hash        string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty" yaml:"hash"`
+	Voter       string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty" yaml:"voter"`
+	SubmitBlock uint64 `protobuf:"varint,3,opt,name=submit_block,json=submitBlock,proto3" json:"submit_block,omitempty" yaml:"submit_block"`
+}
+
+func (m *AggregateExchangeRatePrevote) Reset()      { *m = AggregateExchangeRatePrevote{} }
+func (*AggregateExchangeRatePrevote) ProtoMessage() {}
+func (*AggregateExchangeRatePrevote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{3}
+}
+func (m *AggregateExchangeRatePrevote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregateExchangeRatePrevote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregateExchangeRatePrevote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregateExchangeRatePrevote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregateExchangeRatePrevote.Merge(m, src)
+}
+func (m *AggregateExchangeRatePrevote) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregateExchangeRatePrevote) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregateExchangeRatePrevote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregateExchangeRatePrevote proto.InternalMessageInfo
+
 type AggregateExchangeRateVote struct {
 	ExchangeRateTuples ExchangeRateTuples `protobuf:"bytes,1,rep,name=exchange_rate_tuples,json=exchangeRateTuples,proto3,castrepeated=ExchangeRateTuples" json:"exchange_rate_tuples" yaml:"exchange_rate_tuples"`
 	Voter              string             `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty" yaml:"voter"`
@@ -143,7 +287,7 @@ type AggregateExchangeRateVote struct {
 func (m *AggregateExchangeRateVote) Reset()      { *m = AggregateExchangeRateVote{} }
 func (*AggregateExchangeRateVote) ProtoMessage() {}
 func (*AggregateExchangeRateVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc470b50b143d488, []int{2}
+	return fileDescriptor_dc470b50b143d488, []int{4}
 }
 func (m *AggregateExchangeRateVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -180,7 +324,7 @@ type ExchangeRateTuple struct {
 func (m *ExchangeRateTuple) Reset()      { *m = ExchangeRateTuple{} }
 func (*ExchangeRateTuple) ProtoMessage() {}
 func (*ExchangeRateTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc470b50b143d488, []int{3}
+	return fileDescriptor_dc470b50b143d488, []int{5}
 }
 func (m *ExchangeRateTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -218,7 +362,7 @@ type OracleExchangeRate struct {
 func (m *OracleExchangeRate) Reset()      { *m = OracleExchangeRate{} }
 func (*OracleExchangeRate) ProtoMessage() {}
 func (*OracleExchangeRate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc470b50b143d488, []int{4}
+	return fileDescriptor_dc470b50b143d488, []int{6}
 }
 func (m *OracleExchangeRate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -256,7 +400,7 @@ func (m *PriceSnapshotItem) Reset()         { *m = PriceSnapshotItem{} }
 func (m *PriceSnapshotItem) String() string { return proto.CompactTextString(m) }
 func (*PriceSnapshotItem) ProtoMessage()    {}
 func (*PriceSnapshotItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc470b50b143d488, []int{5}
+	return fileDescriptor_dc470b50b143d488, []int{7}
 }
 func (m *PriceSnapshotItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -308,7 +452,7 @@ func (m *PriceSnapshot) Reset()         { *m = PriceSnapshot{} }
 func (m *PriceSnapshot) String() string { return proto.CompactTextString(m) }
 func (*PriceSnapshot) ProtoMessage()    {}
 func (*PriceSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc470b50b143d488, []int{6}
+	return fileDescriptor_dc470b50b143d488, []int{8}
 }
 func (m *PriceSnapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -361,7 +505,7 @@ func (m *OracleTwap) Reset()         { *m = OracleTwap{} }
 func (m *OracleTwap) String() string { return proto.CompactTextString(m) }
 func (*OracleTwap) ProtoMessage()    {}
 func (*OracleTwap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc470b50b143d488, []int{7}
+	return fileDescriptor_dc470b50b143d488, []int{9}
 }
 func (m *OracleTwap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -404,24 +548,30 @@ func (m *OracleTwap) GetLookbackSeconds() int64 {
 	return 0
 }
 
-type VotePenaltyCounter struct {
-	MissCount    uint64 `protobuf:"varint,1,opt,name=miss_count,json=missCount,proto3" json:"miss_count,omitempty"`
-	AbstainCount uint64 `protobuf:"varint,2,opt,name=abstain_count,json=abstainCount,proto3" json:"abstain_count,omitempty"`
-	SuccessCount uint64 `protobuf:"varint,3,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+// OracleWindowAggregate contains the aggregates of the price snapshots of a denom over a time window
+type OracleWindowAggregate struct {
+	Denom string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Twap  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=twap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"twap" yaml:"twap"`
+	// median of the prices observed in the window
+	Median github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=median,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"median" yaml:"median"`
+	Min    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=min,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min" yaml:"min"`
+	Max    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max" yaml:"max"`
+	// number of seconds of the window for which the denom had a price
+	Duration int64 `protobuf:"varint,6,opt,name=duration,proto3" json:"duration,omitempty"`
 }
 
-func (m *VotePenaltyCounter) Reset()         { *m = VotePenaltyCounter{} }
-func (m *VotePenaltyCounter) String() string { return proto.CompactTextString(m) }
-func (*VotePenaltyCounter) ProtoMessage()    {}
-func (*VotePenaltyCounter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc470b50b143d488, []int{8}
+func (m *OracleWindowAggregate) Reset()         { *m = OracleWindowAggregate{} }
+func (m *OracleWindowAggregate) String() string { return proto.CompactTextString(m) }
+func (*OracleWindowAggregate) ProtoMessage()    {}
+func (*OracleWindowAggregate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{10}
 }
-func (m *VotePenaltyCounter) XXX_Unmarshal(b []byte) error {
+func (m *OracleWindowAggregate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *VotePenaltyCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OracleWindowAggregate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_VotePenaltyCounter.Marshal(b, m, deterministic)
+		return xxx_messageInfo_OracleWindowAggregate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -431,464 +581,748 @@ func (m *VotePenaltyCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *VotePenaltyCounter) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VotePenaltyCounter.Merge(m, src)
+func (m *OracleWindowAggregate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleWindowAggregate.Merge(m, src)
 }
-func (m *VotePenaltyCounter) XXX_Size() int {
+func (m *OracleWindowAggregate) XXX_Size() int {
 	return m.Size()
 }
-func (m *VotePenaltyCounter) XXX_DiscardUnknown() {
-	xxx_messageInfo_VotePenaltyCounter.DiscardUnknown(m)
+func (m *OracleWindowAggregate) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleWindowAggregate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VotePenaltyCounter proto.InternalMessageInfo
+var xxx_messageInfo_OracleWindowAggregate proto.InternalMessageInfo
 
-func (m *VotePenaltyCounter) GetMissCount() uint64 {
+func (m *OracleWindowAggregate) GetDenom() string {
 	if m != nil {
-		return m.MissCount
+		return m.Denom
 	}
-	return 0
+	return ""
 }
 
-func (m *VotePenaltyCounter) GetAbstainCount() uint64 {
+func (m *OracleWindowAggregate) GetDuration() int64 {
 	if m != nil {
-		return m.AbstainCount
+		return m.Duration
 	}
 	return 0
 }
 
-func (m *VotePenaltyCounter) GetSuccessCount() uint64 {
-	if m != nil {
-		return m.SuccessCount
-	}
-	return 0
+// BallotResult contains the tally of all ballots of a vote period
+type BallotResult struct {
+	// last block of the vote period
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// denom against which the cross rates of the other ballots are tallied
+	ReferenceDenom   string                  `protobuf:"bytes,2,opt,name=reference_denom,json=referenceDenom,proto3" json:"reference_denom,omitempty"`
+	DenomResults     []DenomBallotResult     `protobuf:"bytes,3,rep,name=denom_results,json=denomResults,proto3" json:"denom_results"`
+	ValidatorResults []ValidatorBallotResult `protobuf:"bytes,4,rep,name=validator_results,json=validatorResults,proto3" json:"validator_results"`
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "seiprotocol.seichain.oracle.Params")
-	proto.RegisterType((*Denom)(nil), "seiprotocol.seichain.oracle.Denom")
-	proto.RegisterType((*AggregateExchangeRateVote)(nil), "seiprotocol.seichain.oracle.AggregateExchangeRateVote")
-	proto.RegisterType((*ExchangeRateTuple)(nil), "seiprotocol.seichain.oracle.ExchangeRateTuple")
-	proto.RegisterType((*OracleExchangeRate)(nil), "seiprotocol.seichain.oracle.OracleExchangeRate")
-	proto.RegisterType((*PriceSnapshotItem)(nil), "seiprotocol.seichain.oracle.PriceSnapshotItem")
-	proto.RegisterType((*PriceSnapshot)(nil), "seiprotocol.seichain.oracle.PriceSnapshot")
-	proto.RegisterType((*OracleTwap)(nil), "seiprotocol.seichain.oracle.OracleTwap")
-	proto.RegisterType((*VotePenaltyCounter)(nil), "seiprotocol.seichain.oracle.VotePenaltyCounter")
+func (m *BallotResult) Reset()         { *m = BallotResult{} }
+func (m *BallotResult) String() string { return proto.CompactTextString(m) }
+func (*BallotResult) ProtoMessage()    {}
+func (*BallotResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{11}
+}
+func (m *BallotResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BallotResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BallotResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BallotResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BallotResult.Merge(m, src)
+}
+func (m *BallotResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *BallotResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BallotResult.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("oracle/oracle.proto", fileDescriptor_dc470b50b143d488) }
+var xxx_messageInfo_BallotResult proto.InternalMessageInfo
 
-var fileDescriptor_dc470b50b143d488 = []byte{
-	// 992 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xbd, 0x6f, 0x23, 0x45,
-	0x14, 0xf7, 0xe6, 0x0b, 0x3c, 0xb6, 0xb9, 0x78, 0xe2, 0x03, 0xdf, 0x97, 0x37, 0x9a, 0x13, 0xa7,
-	0x50, 0x9c, 0xcd, 0x1d, 0x05, 0xc2, 0x12, 0x05, 0x26, 0x1c, 0x0a, 0x3a, 0x44, 0x98, 0x33, 0x41,
-	0xa2, 0x59, 0x8d, 0x77, 0x07, 0x7b, 0x94, 0xdd, 0x9d, 0xd5, 0xce, 0x38, 0xbe, 0x14, 0x50, 0x50,
-	0x51, 0x22, 0x2a, 0x24, 0x9a, 0xd4, 0xfc, 0x01, 0xfc, 0x0d, 0x57, 0x50, 0x5c, 0x89, 0x28, 0x16,
-	0x94, 0x34, 0x74, 0x48, 0x6e, 0x69, 0xd0, 0x7c, 0xd8, 0xde, 0x64, 0x43, 0x84, 0x85, 0xae, 0xda,
-	0x7d, 0xbf, 0xf7, 0xe6, 0x37, 0x6f, 0x7e, 0xef, 0xbd, 0xd1, 0x80, 0x2d, 0x9e, 0x12, 0x3f, 0xa4,
-	0x1d, 0xf3, 0x69, 0x27, 0x29, 0x97, 0x1c, 0xde, 0x12, 0x94, 0xe9, 0x3f, 0x9f, 0x87, 0x6d, 0x41,
-	0x99, 0x3f, 0x22, 0x2c, 0x6e, 0x9b, 0x90, 0x9b, 0x8d, 0x21, 0x1f, 0x72, 0xed, 0xed, 0xa8, 0x3f,
-	0xb3, 0xe4, 0x66, 0xcb, 0xe7, 0x22, 0xe2, 0xa2, 0x33, 0x20, 0x82, 0x76, 0x8e, 0x1e, 0x0c, 0xa8,
-	0x24, 0x0f, 0x3a, 0x3e, 0x67, 0xb1, 0xf1, 0xa3, 0x6f, 0x36, 0xc0, 0xc6, 0x3e, 0x49, 0x49, 0x24,
-	0xe0, 0xdb, 0xa0, 0x72, 0xc4, 0x25, 0xf5, 0x12, 0x9a, 0x32, 0x1e, 0x34, 0x9d, 0x6d, 0x67, 0x67,
-	0xad, 0xf7, 0xea, 0x34, 0x73, 0xe1, 0x31, 0x89, 0xc2, 0x2e, 0xca, 0x39, 0x11, 0x06, 0xca, 0xda,
-	0xd7, 0x06, 0x8c, 0xc1, 0x2b, 0xda, 0x27, 0x47, 0x29, 0x15, 0x23, 0x1e, 0x06, 0xcd, 0x95, 0x6d,
-	0x67, 0xa7, 0xdc, 0xfb, 0xf0, 0x59, 0xe6, 0x96, 0x7e, 0xcb, 0xdc, 0x7b, 0x43, 0x26, 0x47, 0xe3,
-	0x41, 0xdb, 0xe7, 0x51, 0xc7, 0xa6, 0x63, 0x3e, 0xf7, 0x45, 0x70, 0xd8, 0x91, 0xc7, 0x09, 0x15,
-	0xed, 0x5d, 0xea, 0x4f, 0x33, 0xf7, 0x7a, 0x6e, 0xa7, 0x39, 0x1b, 0xc2, 0x35, 0x05, 0xf4, 0x67,
-	0x36, 0xa4, 0xa0, 0x92, 0xd2, 0x09, 0x49, 0x03, 0x6f, 0x40, 0xe2, 0xa0, 0xb9, 0xaa, 0x37, 0xdb,
-	0x5d, 0x7a, 0x33, 0x7b, 0xac, 0x1c, 0x15, 0xc2, 0xc0, 0x58, 0x3d, 0x12, 0x07, 0x70, 0x08, 0xca,
-	0x93, 0x11, 0x93, 0x34, 0x64, 0x42, 0x36, 0xd7, 0xb6, 0x57, 0x77, 0x2a, 0x0f, 0x51, 0xfb, 0x8a,
-	0x0a, 0xb4, 0x77, 0x69, 0xcc, 0xa3, 0xde, 0xeb, 0x2a, 0x91, 0x69, 0xe6, 0x6e, 0x1a, 0xfa, 0x39,
-	0x05, 0xfa, 0xe9, 0x77, 0xb7, 0xac, 0x43, 0x1e, 0x33, 0x21, 0xf1, 0x82, 0x5b, 0xe9, 0x27, 0x42,
-	0x22, 0x46, 0xde, 0x97, 0x29, 0xf1, 0x25, 0xe3, 0x71, 0x73, 0xfd, 0xff, 0xe9, 0x77, 0x9e, 0x0d,
-	0xe1, 0x9a, 0x06, 0x1e, 0x59, 0x1b, 0x76, 0x41, 0xd5, 0x44, 0x4c, 0x58, 0x1c, 0xf0, 0x49, 0x73,
-	0x43, 0x57, 0xfa, 0xb5, 0x69, 0xe6, 0x6e, 0xe5, 0xd7, 0x1b, 0x2f, 0xc2, 0x15, 0x6d, 0x7e, 0xae,
-	0x2d, 0xf8, 0x35, 0x68, 0x44, 0x2c, 0xf6, 0x8e, 0x48, 0xc8, 0x02, 0xd5, 0x0c, 0x33, 0x8e, 0x97,
-	0x74, 0xc6, 0x1f, 0x2f, 0x9d, 0xf1, 0x2d, 0xb3, 0xe3, 0x65, 0x9c, 0x08, 0xd7, 0x23, 0x16, 0x1f,
-	0x28, 0x74, 0x9f, 0xa6, 0x76, 0xff, 0x3d, 0x50, 0x0f, 0x39, 0x3f, 0x1c, 0x10, 0xff, 0xd0, 0x0b,
-	0xc6, 0x29, 0xd1, 0x72, 0x95, 0xf5, 0x01, 0x6e, 0x4f, 0x33, 0xb7, 0x69, 0xe8, 0x0a, 0x21, 0x08,
-	0x6f, 0xce, 0xb0, 0x5d, 0x0b, 0x75, 0x5f, 0xfe, 0xe1, 0xc4, 0x2d, 0xfd, 0x79, 0xe2, 0x3a, 0xa8,
-	0x0b, 0xd6, 0x75, 0x61, 0xe0, 0x5d, 0xb0, 0x16, 0x93, 0x88, 0xea, 0xde, 0x2f, 0xf7, 0xae, 0x4d,
-	0x33, 0xb7, 0x62, 0x08, 0x15, 0x8a, 0xb0, 0x76, 0x76, 0xab, 0xdf, 0x9e, 0xb8, 0x25, 0xbb, 0xb6,
-	0x84, 0xfe, 0x72, 0xc0, 0x8d, 0xf7, 0x86, 0xc3, 0x94, 0x0e, 0x89, 0xa4, 0x1f, 0x3c, 0xf5, 0x47,
-	0x24, 0x1e, 0x52, 0x4c, 0x24, 0x3d, 0xe0, 0x92, 0xc2, 0x1f, 0x1d, 0xd0, 0xa0, 0x16, 0xf4, 0x52,
-	0xa2, 0xda, 0x7a, 0x9c, 0x84, 0x54, 0x34, 0x1d, 0xdd, 0x4f, 0xed, 0x2b, 0xfb, 0x29, 0xcf, 0xd6,
-	0x57, 0xcb, 0x7a, 0xef, 0xd8, 0xde, 0xb2, 0xaa, 0x5d, 0xc6, 0xac, 0xda, 0x0c, 0x16, 0x56, 0x0a,
-	0x0c, 0x69, 0x01, 0x83, 0xf7, 0xc0, 0xba, 0x9a, 0xac, 0xd4, 0xce, 0xeb, 0xe6, 0x34, 0x73, 0xab,
-	0x8b, 0x09, 0x4c, 0x11, 0x36, 0xee, 0x0b, 0x27, 0xfe, 0xd9, 0x01, 0xf5, 0xc2, 0x06, 0x8a, 0x2b,
-	0x50, 0x1a, 0x5a, 0xed, 0x72, 0x5c, 0x1a, 0x46, 0xd8, 0xb8, 0xe1, 0x21, 0xa8, 0x9d, 0x4b, 0xdb,
-	0xee, 0xfd, 0x68, 0xe9, 0xce, 0x69, 0x5c, 0xa2, 0x01, 0xc2, 0xd5, 0xfc, 0x31, 0x2f, 0x24, 0xfe,
-	0xcb, 0x0a, 0x80, 0x9f, 0x68, 0x69, 0xf3, 0xe9, 0x17, 0x33, 0x72, 0x5e, 0x5c, 0x46, 0xea, 0xee,
-	0x0a, 0x89, 0x90, 0xde, 0x38, 0x09, 0x16, 0x87, 0x5f, 0xe6, 0xee, 0xda, 0x8b, 0xe5, 0xe2, 0xee,
-	0xca, 0x51, 0x21, 0x0c, 0x94, 0xf5, 0x99, 0x36, 0x60, 0x1f, 0x5c, 0xcf, 0xf9, 0x3c, 0xc9, 0x22,
-	0x2a, 0x24, 0x89, 0x12, 0x7d, 0x59, 0xae, 0xf6, 0xb6, 0xa7, 0x99, 0x7b, 0xbb, 0x40, 0xb1, 0x08,
-	0x43, 0x78, 0x6b, 0x41, 0xd6, 0x9f, 0xa1, 0x17, 0xe4, 0xfc, 0xde, 0x01, 0xf5, 0xfd, 0x94, 0xf9,
-	0xf4, 0x49, 0x4c, 0x12, 0x31, 0xe2, 0x72, 0x4f, 0xd2, 0x08, 0x36, 0xce, 0xf5, 0xc1, 0xac, 0xea,
-	0x43, 0xd0, 0x30, 0x4d, 0xed, 0x15, 0x8b, 0x5f, 0x79, 0xd8, 0xb9, 0x72, 0x0c, 0x8a, 0x25, 0xeb,
-	0xad, 0x29, 0xc1, 0x30, 0xe4, 0x05, 0x0f, 0xfa, 0xdb, 0x01, 0xb5, 0x73, 0x49, 0xc1, 0xc7, 0x00,
-	0x0a, 0xfb, 0x9f, 0xd3, 0xc1, 0xd1, 0x3a, 0xdc, 0x99, 0x66, 0xee, 0x0d, 0x7b, 0xe7, 0x15, 0x62,
-	0x10, 0xae, 0xcf, 0xc0, 0xb9, 0x04, 0x7a, 0xa0, 0x13, 0xc5, 0xef, 0xcd, 0x17, 0x30, 0x49, 0x23,
-	0xd1, 0x5c, 0xf9, 0x0f, 0x03, 0x5d, 0x50, 0xeb, 0xe2, 0x40, 0x5f, 0xc6, 0xac, 0x07, 0xba, 0xb0,
-	0x52, 0x60, 0x98, 0x14, 0x30, 0x74, 0xe2, 0x00, 0x60, 0xe4, 0xea, 0x4f, 0x48, 0xf2, 0x2f, 0xb5,
-	0xf8, 0x14, 0xac, 0xc9, 0x09, 0x49, 0x6c, 0xef, 0xbd, 0xbb, 0x74, 0x9b, 0xdb, 0x2b, 0x51, 0x71,
-	0x20, 0xac, 0xa9, 0xe0, 0x1b, 0x60, 0x7e, 0xbd, 0x7a, 0x82, 0xfa, 0x3c, 0x0e, 0x84, 0xe9, 0x34,
-	0x7c, 0x6d, 0x86, 0x3f, 0x31, 0x30, 0xfa, 0x0a, 0xc0, 0x03, 0xfd, 0x74, 0x88, 0x49, 0x28, 0x8f,
-	0xdf, 0xe7, 0xe3, 0x58, 0xd2, 0x14, 0xde, 0x01, 0x20, 0x62, 0x42, 0x78, 0xbe, 0xb2, 0xcd, 0xd3,
-	0x03, 0x97, 0x15, 0xa2, 0x03, 0xe0, 0x5d, 0x50, 0x23, 0x03, 0x21, 0x09, 0x8b, 0x6d, 0xc4, 0x8a,
-	0x8e, 0xa8, 0x5a, 0x70, 0x1e, 0x24, 0xc6, 0xbe, 0x4f, 0xe7, 0x34, 0xab, 0x26, 0xc8, 0x82, 0x3a,
-	0xa8, 0xf7, 0xd1, 0xb3, 0xd3, 0x96, 0xf3, 0xfc, 0xb4, 0xe5, 0xfc, 0x71, 0xda, 0x72, 0xbe, 0x3b,
-	0x6b, 0x95, 0x9e, 0x9f, 0xb5, 0x4a, 0xbf, 0x9e, 0xb5, 0x4a, 0x5f, 0xbc, 0x99, 0x13, 0x40, 0x50,
-	0x76, 0x7f, 0x56, 0x45, 0x6d, 0xe8, 0x32, 0x76, 0x9e, 0xda, 0xe7, 0x98, 0x91, 0x63, 0xb0, 0xa1,
-	0x43, 0xde, 0xfa, 0x27, 0x00, 0x00, 0xff, 0xff, 0xa3, 0x10, 0x21, 0xd7, 0xac, 0x09, 0x00, 0x00,
+func (m *BallotResult) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *BallotResult) GetReferenceDenom() string {
+	if m != nil {
+		return m.ReferenceDenom
 	}
+	return ""
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.VotePeriod != that1.VotePeriod {
-		return false
-	}
-	if !this.VoteThreshold.Equal(that1.VoteThreshold) {
-		return false
-	}
-	if !this.RewardBand.Equal(that1.RewardBand) {
-		return false
+func (m *BallotResult) GetDenomResults() []DenomBallotResult {
+	if m != nil {
+		return m.DenomResults
 	}
-	if len(this.Whitelist) != len(that1.Whitelist) {
-		return false
+	return nil
+}
+
+func (m *BallotResult) GetValidatorResults() []ValidatorBallotResult {
+	if m != nil {
+		return m.ValidatorResults
 	}
-	for i := range this.Whitelist {
-		if !this.Whitelist[i].Equal(&that1.Whitelist[i]) {
-			return false
+	return nil
+}
+
+// DenomBallotResult contains the tally of the ballot of a denom. Rates of passing ballots of denoms
+// other than the reference denom are cross rates, i.e. the exchange rate of the reference denom
+// divided by the exchange rate of the denom.
+type DenomBallotResult struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// whether the ballot passed the vote threshold, in which case the exchange rate was updated
+	Passed            bool                                   `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	WeightedMedian    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=weighted_median,json=weightedMedian,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weighted_median" yaml:"weighted_median"`
+	StandardDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=standard_deviation,json=standardDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"standard_deviation" yaml:"standard_deviation"`
+	// votes within the reward spread of the weighted median win
+	RewardSpread github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=reward_spread,json=rewardSpread,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_spread" yaml:"reward_spread"`
+	// total voting power of the ballot, abstain votes excluded
+	Power int64              `protobuf:"varint,6,opt,name=power,proto3" json:"power,omitempty"`
+	Votes []BallotVoteResult `protobuf:"bytes,7,rep,name=votes,proto3" json:"votes"`
+}
+
+func (m *DenomBallotResult) Reset()         { *m = DenomBallotResult{} }
+func (m *DenomBallotResult) String() string { return proto.CompactTextString(m) }
+func (*DenomBallotResult) ProtoMessage()    {}
+func (*DenomBallotResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{12}
+}
+func (m *DenomBallotResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomBallotResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomBallotResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if !this.SlashFraction.Equal(that1.SlashFraction) {
-		return false
-	}
-	if this.SlashWindow != that1.SlashWindow {
-		return false
+}
+func (m *DenomBallotResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomBallotResult.Merge(m, src)
+}
+func (m *DenomBallotResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomBallotResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomBallotResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomBallotResult proto.InternalMessageInfo
+
+func (m *DenomBallotResult) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if !this.MinValidPerWindow.Equal(that1.MinValidPerWindow) {
-		return false
+	return ""
+}
+
+func (m *DenomBallotResult) GetPassed() bool {
+	if m != nil {
+		return m.Passed
 	}
-	if this.LookbackDuration != that1.LookbackDuration {
-		return false
+	return false
+}
+
+func (m *DenomBallotResult) GetPower() int64 {
+	if m != nil {
+		return m.Power
 	}
-	return true
+	return 0
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *DenomBallotResult) GetVotes() []BallotVoteResult {
+	if m != nil {
+		return m.Votes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type BallotVoteResult struct {
+	Voter        string                                 `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate" yaml:"exchange_rate"`
+	Power        int64                                  `protobuf:"varint,3,opt,name=power,proto3" json:"power,omitempty"`
+	Win          bool                                   `protobuf:"varint,4,opt,name=win,proto3" json:"win,omitempty"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LookbackDuration != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.LookbackDuration))
-		i--
-		dAtA[i] = 0x48
-	}
-	{
-		size := m.MinValidPerWindow.Size()
-		i -= size
-		if _, err := m.MinValidPerWindow.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *BallotVoteResult) Reset()         { *m = BallotVoteResult{} }
+func (m *BallotVoteResult) String() string { return proto.CompactTextString(m) }
+func (*BallotVoteResult) ProtoMessage()    {}
+func (*BallotVoteResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{13}
+}
+func (m *BallotVoteResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BallotVoteResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BallotVoteResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	if m.SlashWindow != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.SlashWindow))
-		i--
-		dAtA[i] = 0x30
+		return b[:n], nil
 	}
-	{
-		size := m.SlashFraction.Size()
-		i -= size
-		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
+}
+func (m *BallotVoteResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BallotVoteResult.Merge(m, src)
+}
+func (m *BallotVoteResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *BallotVoteResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BallotVoteResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BallotVoteResult proto.InternalMessageInfo
+
+func (m *BallotVoteResult) GetVoter() string {
+	if m != nil {
+		return m.Voter
 	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.Whitelist) > 0 {
-		for iNdEx := len(m.Whitelist) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Whitelist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOracle(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+	return ""
+}
+
+func (m *BallotVoteResult) GetPower() int64 {
+	if m != nil {
+		return m.Power
 	}
-	{
-		size := m.RewardBand.Size()
-		i -= size
-		if _, err := m.RewardBand.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *BallotVoteResult) GetWin() bool {
+	if m != nil {
+		return m.Win
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.VoteThreshold.Size()
-		i -= size
-		if _, err := m.VoteThreshold.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return false
+}
+
+// ValidatorBallotResult contains the outcome of a vote period for a validator, as counted in its VotePenaltyCounter
+type ValidatorBallotResult struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// number of denoms for which the vote of the validator won
+	WinCount int64 `protobuf:"varint,2,opt,name=win_count,json=winCount,proto3" json:"win_count,omitempty"`
+	DidVote  bool  `protobuf:"varint,3,opt,name=did_vote,json=didVote,proto3" json:"did_vote,omitempty"`
+	// the validator is counted a success if its vote won for all vote targets, as an abstain if it did not vote, and as a miss otherwise
+	Success bool `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (m *ValidatorBallotResult) Reset()         { *m = ValidatorBallotResult{} }
+func (m *ValidatorBallotResult) String() string { return proto.CompactTextString(m) }
+func (*ValidatorBallotResult) ProtoMessage()    {}
+func (*ValidatorBallotResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{14}
+}
+func (m *ValidatorBallotResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorBallotResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorBallotResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.VotePeriod != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.VotePeriod))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ValidatorBallotResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorBallotResult.Merge(m, src)
+}
+func (m *ValidatorBallotResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorBallotResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorBallotResult.DiscardUnknown(m)
 }
 
-func (m *Denom) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ValidatorBallotResult proto.InternalMessageInfo
+
+func (m *ValidatorBallotResult) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Denom) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidatorBallotResult) GetWinCount() int64 {
+	if m != nil {
+		return m.WinCount
+	}
+	return 0
 }
 
-func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+func (m *ValidatorBallotResult) GetDidVote() bool {
+	if m != nil {
+		return m.DidVote
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *AggregateExchangeRateVote) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorBallotResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *AggregateExchangeRateVote) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ValidatorSlashProjection contains the outcome for a validator if the current slash window ended now
+type ValidatorSlashProjection struct {
+	Validator     string                                 `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	ValidVoteRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=valid_vote_rate,json=validVoteRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"valid_vote_rate" yaml:"valid_vote_rate"`
+	// number of consecutive previous slash windows for which the validator was warned
+	WarningCount uint64 `protobuf:"varint,3,opt,name=warning_count,json=warningCount,proto3" json:"warning_count,omitempty"`
+	// the validator would be warned instead of penalized
+	Warning       bool                                   `protobuf:"varint,4,opt,name=warning,proto3" json:"warning,omitempty"`
+	SlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction" yaml:"slash_fraction"`
+	Jail          bool                                   `protobuf:"varint,6,opt,name=jail,proto3" json:"jail,omitempty"`
 }
 
-func (m *AggregateExchangeRateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Voter) > 0 {
-		i -= len(m.Voter)
-		copy(dAtA[i:], m.Voter)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Voter)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ExchangeRateTuples) > 0 {
-		for iNdEx := len(m.ExchangeRateTuples) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ExchangeRateTuples[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOracle(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *ValidatorSlashProjection) Reset()         { *m = ValidatorSlashProjection{} }
+func (m *ValidatorSlashProjection) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSlashProjection) ProtoMessage()    {}
+func (*ValidatorSlashProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{15}
+}
+func (m *ValidatorSlashProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSlashProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSlashProjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ValidatorSlashProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSlashProjection.Merge(m, src)
+}
+func (m *ValidatorSlashProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSlashProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSlashProjection.DiscardUnknown(m)
 }
 
-func (m *ExchangeRateTuple) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ValidatorSlashProjection proto.InternalMessageInfo
+
+func (m *ValidatorSlashProjection) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ExchangeRateTuple) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidatorSlashProjection) GetWarningCount() uint64 {
+	if m != nil {
+		return m.WarningCount
+	}
+	return 0
 }
 
-func (m *ExchangeRateTuple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ExchangeRate.Size()
-		i -= size
-		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *ValidatorSlashProjection) GetWarning() bool {
+	if m != nil {
+		return m.Warning
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *OracleExchangeRate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorSlashProjection) GetJail() bool {
+	if m != nil {
+		return m.Jail
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *OracleExchangeRate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type VotePenaltyCounter struct {
+	MissCount    uint64 `protobuf:"varint,1,opt,name=miss_count,json=missCount,proto3" json:"miss_count,omitempty"`
+	AbstainCount uint64 `protobuf:"varint,2,opt,name=abstain_count,json=abstainCount,proto3" json:"abstain_count,omitempty"`
+	SuccessCount uint64 `protobuf:"varint,3,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
 }
 
-func (m *OracleExchangeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LastUpdateTimestamp != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.LastUpdateTimestamp))
-		i--
-		dAtA[i] = 0x18
-	}
-	{
-		size := m.LastUpdate.Size()
-		i -= size
-		if _, err := m.LastUpdate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.ExchangeRate.Size()
-		i -= size
-		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *VotePenaltyCounter) Reset()         { *m = VotePenaltyCounter{} }
+func (m *VotePenaltyCounter) String() string { return proto.CompactTextString(m) }
+func (*VotePenaltyCounter) ProtoMessage()    {}
+func (*VotePenaltyCounter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{16}
+}
+func (m *VotePenaltyCounter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VotePenaltyCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VotePenaltyCounter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *VotePenaltyCounter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotePenaltyCounter.Merge(m, src)
+}
+func (m *VotePenaltyCounter) XXX_Size() int {
+	return m.Size()
+}
+func (m *VotePenaltyCounter) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotePenaltyCounter.DiscardUnknown(m)
 }
 
-func (m *PriceSnapshotItem) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_VotePenaltyCounter proto.InternalMessageInfo
+
+func (m *VotePenaltyCounter) GetMissCount() uint64 {
+	if m != nil {
+		return m.MissCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *PriceSnapshotItem) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VotePenaltyCounter) GetAbstainCount() uint64 {
+	if m != nil {
+		return m.AbstainCount
+	}
+	return 0
 }
 
-func (m *PriceSnapshotItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.OracleExchangeRate.MarshalToSizedBuffer(dAtA[:i])
+func (m *VotePenaltyCounter) GetSuccessCount() uint64 {
+	if m != nil {
+		return m.SuccessCount
+	}
+	return 0
+}
+
+type ValidatorRewards struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// total oracle rewards paid to the validator
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *ValidatorRewards) Reset()         { *m = ValidatorRewards{} }
+func (m *ValidatorRewards) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRewards) ProtoMessage()    {}
+func (*ValidatorRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{17}
+}
+func (m *ValidatorRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintOracle(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ValidatorRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRewards.Merge(m, src)
+}
+func (m *ValidatorRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRewards proto.InternalMessageInfo
+
+func (m *ValidatorRewards) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *PriceSnapshot) Marshal() (dAtA []byte, err error) {
+func (m *ValidatorRewards) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "seiprotocol.seichain.oracle.Params")
+	proto.RegisterType((*SlashTier)(nil), "seiprotocol.seichain.oracle.SlashTier")
+	proto.RegisterType((*Denom)(nil), "seiprotocol.seichain.oracle.Denom")
+	proto.RegisterType((*AggregateExchangeRatePrevote)(nil), "seiprotocol.seichain.oracle.AggregateExchangeRatePrevote")
+	proto.RegisterType((*AggregateExchangeRateVote)(nil), "seiprotocol.seichain.oracle.AggregateExchangeRateVote")
+	proto.RegisterType((*ExchangeRateTuple)(nil), "seiprotocol.seichain.oracle.ExchangeRateTuple")
+	proto.RegisterType((*OracleExchangeRate)(nil), "seiprotocol.seichain.oracle.OracleExchangeRate")
+	proto.RegisterType((*PriceSnapshotItem)(nil), "seiprotocol.seichain.oracle.PriceSnapshotItem")
+	proto.RegisterType((*PriceSnapshot)(nil), "seiprotocol.seichain.oracle.PriceSnapshot")
+	proto.RegisterType((*OracleTwap)(nil), "seiprotocol.seichain.oracle.OracleTwap")
+	proto.RegisterType((*OracleWindowAggregate)(nil), "seiprotocol.seichain.oracle.OracleWindowAggregate")
+	proto.RegisterType((*BallotResult)(nil), "seiprotocol.seichain.oracle.BallotResult")
+	proto.RegisterType((*DenomBallotResult)(nil), "seiprotocol.seichain.oracle.DenomBallotResult")
+	proto.RegisterType((*BallotVoteResult)(nil), "seiprotocol.seichain.oracle.BallotVoteResult")
+	proto.RegisterType((*ValidatorBallotResult)(nil), "seiprotocol.seichain.oracle.ValidatorBallotResult")
+	proto.RegisterType((*ValidatorSlashProjection)(nil), "seiprotocol.seichain.oracle.ValidatorSlashProjection")
+	proto.RegisterType((*VotePenaltyCounter)(nil), "seiprotocol.seichain.oracle.VotePenaltyCounter")
+	proto.RegisterType((*ValidatorRewards)(nil), "seiprotocol.seichain.oracle.ValidatorRewards")
+}
+
+func init() { proto.RegisterFile("oracle/oracle.proto", fileDescriptor_dc470b50b143d488) }
+
+var fileDescriptor_dc470b50b143d488 = []byte{
+	// 1894 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4d, 0x6c, 0x23, 0x57,
+	0x1d, 0xcf, 0xc4, 0xf9, 0xb0, 0xff, 0xb1, 0x37, 0xc9, 0x4b, 0xb2, 0x9d, 0xec, 0xa6, 0x99, 0xf0,
+	0x56, 0x5d, 0x82, 0x60, 0xed, 0x76, 0x39, 0x20, 0x56, 0x20, 0x54, 0x37, 0xdd, 0x76, 0xa1, 0x2d,
+	0xe9, 0x4b, 0x48, 0x05, 0x97, 0xd1, 0xf3, 0xcc, 0xab, 0x3d, 0xcd, 0x7c, 0xb8, 0xf3, 0xc6, 0xb1,
+	0x83, 0x04, 0x57, 0x38, 0x02, 0x12, 0x12, 0x12, 0x97, 0x3d, 0x20, 0x0e, 0x7b, 0x87, 0x1b, 0xf7,
+	0x3d, 0x70, 0xe8, 0x11, 0x71, 0x70, 0xd1, 0xee, 0x85, 0x0b, 0x20, 0xf9, 0x8a, 0x84, 0xd0, 0xfb,
+	0x98, 0xf1, 0xd8, 0xe3, 0x0d, 0x6b, 0x56, 0xdd, 0x93, 0xfd, 0xff, 0x78, 0xbf, 0xf7, 0xff, 0x7e,
+	0xef, 0x0d, 0x6c, 0x45, 0x31, 0x75, 0x7c, 0xd6, 0x50, 0x3f, 0xf5, 0x6e, 0x1c, 0x25, 0x11, 0xba,
+	0xc9, 0x99, 0x27, 0xff, 0x39, 0x91, 0x5f, 0xe7, 0xcc, 0x73, 0x3a, 0xd4, 0x0b, 0xeb, 0x4a, 0xe5,
+	0xc6, 0x76, 0x3b, 0x6a, 0x47, 0x52, 0xda, 0x10, 0xff, 0xd4, 0x92, 0x1b, 0xfb, 0x4e, 0xc4, 0x83,
+	0x88, 0x37, 0x5a, 0x94, 0xb3, 0xc6, 0xc5, 0x1b, 0x2d, 0x96, 0xd0, 0x37, 0x1a, 0x4e, 0xe4, 0x85,
+	0x4a, 0x8e, 0x7f, 0x07, 0xb0, 0x72, 0x4c, 0x63, 0x1a, 0x70, 0xf4, 0x0d, 0x58, 0xbb, 0x88, 0x12,
+	0x66, 0x77, 0x59, 0xec, 0x45, 0xae, 0x69, 0x1c, 0x18, 0x87, 0x4b, 0xcd, 0xeb, 0xa3, 0xa1, 0x85,
+	0x2e, 0x69, 0xe0, 0xdf, 0xc3, 0x39, 0x21, 0x26, 0x20, 0xa8, 0x63, 0x49, 0xa0, 0x10, 0xae, 0x49,
+	0x59, 0xd2, 0x89, 0x19, 0xef, 0x44, 0xbe, 0x6b, 0x2e, 0x1e, 0x18, 0x87, 0x95, 0xe6, 0x3b, 0x8f,
+	0x87, 0xd6, 0xc2, 0x5f, 0x87, 0xd6, 0xed, 0xb6, 0x97, 0x74, 0x7a, 0xad, 0xba, 0x13, 0x05, 0x0d,
+	0x6d, 0x8e, 0xfa, 0xb9, 0xc3, 0xdd, 0xf3, 0x46, 0x72, 0xd9, 0x65, 0xbc, 0x7e, 0xc4, 0x9c, 0xd1,
+	0xd0, 0xda, 0xc9, 0xed, 0x94, 0xa1, 0x61, 0x52, 0x13, 0x8c, 0xd3, 0x94, 0x46, 0x0c, 0xd6, 0x62,
+	0xd6, 0xa7, 0xb1, 0x6b, 0xb7, 0x68, 0xe8, 0x9a, 0x25, 0xb9, 0xd9, 0xd1, 0xdc, 0x9b, 0x69, 0xb7,
+	0x72, 0x50, 0x98, 0x80, 0xa2, 0x9a, 0x34, 0x74, 0x51, 0x1b, 0x2a, 0xfd, 0x8e, 0x97, 0x30, 0xdf,
+	0xe3, 0x89, 0xb9, 0x74, 0x50, 0x3a, 0x5c, 0xbb, 0x8b, 0xeb, 0x57, 0x64, 0xa0, 0x7e, 0xc4, 0xc2,
+	0x28, 0x68, 0xbe, 0x26, 0x0c, 0x19, 0x0d, 0xad, 0x0d, 0x05, 0x9f, 0x41, 0xe0, 0x47, 0x9f, 0x5b,
+	0x15, 0xa9, 0xf2, 0x9e, 0xc7, 0x13, 0x32, 0xc6, 0x16, 0xf1, 0xe3, 0x3e, 0xe5, 0x1d, 0xfb, 0xe3,
+	0x98, 0x3a, 0x89, 0x17, 0x85, 0xe6, 0xf2, 0x8b, 0xc5, 0x6f, 0x12, 0x0d, 0x93, 0x9a, 0x64, 0xdc,
+	0xd7, 0x34, 0xba, 0x07, 0x55, 0xa5, 0xd1, 0xf7, 0x42, 0x37, 0xea, 0x9b, 0x2b, 0x32, 0xd3, 0xaf,
+	0x8c, 0x86, 0xd6, 0x56, 0x7e, 0xbd, 0x92, 0x62, 0xb2, 0x26, 0xc9, 0x8f, 0x24, 0x85, 0x7e, 0x0a,
+	0xdb, 0x81, 0x17, 0xda, 0x17, 0xd4, 0xf7, 0x5c, 0x51, 0x0c, 0x29, 0xc6, 0xaa, 0xb4, 0xf8, 0xfd,
+	0xb9, 0x2d, 0xbe, 0xa9, 0x76, 0x9c, 0x85, 0x89, 0xc9, 0x66, 0xe0, 0x85, 0x67, 0x82, 0x7b, 0xcc,
+	0x62, 0xbd, 0xbf, 0x03, 0x37, 0x74, 0xc2, 0x5c, 0x8f, 0x27, 0xb1, 0xd7, 0xea, 0x09, 0x97, 0x52,
+	0x2b, 0xca, 0xd2, 0x93, 0xd7, 0x46, 0x43, 0xeb, 0x4b, 0x13, 0xc9, 0x9d, 0xa1, 0x8b, 0x89, 0xa9,
+	0x84, 0x47, 0x39, 0x99, 0xde, 0xe4, 0x01, 0x6c, 0xfa, 0x51, 0x74, 0xde, 0xa2, 0xce, 0xb9, 0xed,
+	0xf6, 0x62, 0x2a, 0x73, 0x52, 0x91, 0xd8, 0x7b, 0xa3, 0xa1, 0x65, 0x2a, 0xec, 0x82, 0x0a, 0x26,
+	0x1b, 0x29, 0xef, 0x48, 0xb3, 0xd0, 0x29, 0xec, 0x38, 0x51, 0x10, 0x78, 0x89, 0x1d, 0xb3, 0x0b,
+	0x46, 0x7d, 0x9b, 0x85, 0xb4, 0xe5, 0x33, 0xd7, 0x84, 0x03, 0xe3, 0xb0, 0xdc, 0x3c, 0x18, 0x0d,
+	0xad, 0x3d, 0x05, 0x37, 0x53, 0x0d, 0x93, 0x2d, 0xc5, 0x27, 0x92, 0xfd, 0xb6, 0xe2, 0xa2, 0xfb,
+	0xb0, 0xc1, 0x13, 0xea, 0xb3, 0x90, 0x71, 0x9e, 0xfa, 0xbe, 0x26, 0xed, 0xbb, 0x39, 0x1a, 0x5a,
+	0xaf, 0xe8, 0x2c, 0x4e, 0x69, 0x60, 0xb2, 0x9e, 0xb1, 0xb4, 0xa3, 0xa7, 0xb0, 0xd3, 0xa2, 0xbe,
+	0x1f, 0x89, 0x6d, 0x79, 0xcf, 0x4f, 0xec, 0x8e, 0xc7, 0x93, 0x28, 0xbe, 0x34, 0xab, 0x12, 0x2c,
+	0x67, 0xdd, 0x4c, 0x35, 0x4c, 0xb6, 0x14, 0x9f, 0x48, 0xf6, 0xbb, 0x8a, 0x8b, 0x3e, 0x05, 0x55,
+	0x32, 0x76, 0xe2, 0xb1, 0x98, 0x9b, 0x35, 0xd9, 0x3a, 0xb7, 0xaf, 0x6c, 0x9d, 0x13, 0xa1, 0x7f,
+	0xea, 0xb1, 0xb8, 0x79, 0xa8, 0xdb, 0x07, 0xe5, 0x4b, 0x51, 0x02, 0x89, 0x06, 0x82, 0x4c, 0x91,
+	0x13, 0xe0, 0xd9, 0x7f, 0xe1, 0x88, 0x2e, 0x5a, 0x1a, 0x87, 0x5e, 0xd8, 0xd6, 0x2e, 0x73, 0xf3,
+	0xda, 0xb4, 0x23, 0x33, 0xd5, 0x30, 0xd9, 0x52, 0x45, 0xae, 0xd8, 0x2a, 0x3a, 0xfc, 0x5e, 0xf9,
+	0x37, 0x0f, 0xad, 0x85, 0xbf, 0x3f, 0xb4, 0x0c, 0xfc, 0x68, 0x11, 0x2a, 0xd9, 0xd6, 0xb2, 0x09,
+	0xe8, 0xa0, 0xd8, 0x04, 0xc6, 0x0b, 0x36, 0x01, 0x1d, 0xcc, 0x6c, 0x02, 0x3a, 0x98, 0x6a, 0x82,
+	0xe2, 0xc0, 0x58, 0xfc, 0x42, 0x07, 0xc6, 0x2d, 0x58, 0xfa, 0x84, 0x7a, 0xbe, 0x9c, 0xb4, 0xe5,
+	0xe6, 0xfa, 0x68, 0x68, 0xad, 0xa9, 0x75, 0x82, 0x8b, 0x89, 0x14, 0xe6, 0x82, 0xf5, 0xcf, 0x45,
+	0x58, 0x96, 0x83, 0x4e, 0x2c, 0x0c, 0x69, 0xc0, 0x74, 0x60, 0x72, 0x0b, 0x05, 0x17, 0x13, 0x29,
+	0x44, 0x83, 0x67, 0x1c, 0x1f, 0x1f, 0x3e, 0x1e, 0x5a, 0xc6, 0x5c, 0xde, 0x58, 0xb3, 0x8e, 0x8f,
+	0xaf, 0x45, 0x81, 0x97, 0xb0, 0xa0, 0x9b, 0x5c, 0x16, 0x0e, 0x92, 0x68, 0xd6, 0x41, 0xf2, 0xc1,
+	0xdc, 0xdb, 0xee, 0x15, 0x0e, 0x92, 0xfc, 0x9e, 0xf9, 0x23, 0xe5, 0x1d, 0xa8, 0x89, 0x24, 0x67,
+	0x6d, 0x68, 0x2e, 0xc9, 0xf2, 0xc4, 0xa3, 0xa1, 0xb5, 0x3f, 0xae, 0x81, 0x4c, 0x9c, 0x87, 0xa9,
+	0x06, 0x74, 0x70, 0x92, 0x0a, 0xee, 0x55, 0x7f, 0xfe, 0xd0, 0x5a, 0xd0, 0x01, 0x5f, 0xc0, 0x7f,
+	0x30, 0x60, 0xef, 0xcd, 0x76, 0x3b, 0x66, 0x6d, 0x9a, 0xb0, 0xb7, 0x07, 0x4e, 0x87, 0x86, 0x6d,
+	0x46, 0x68, 0xc2, 0x8e, 0x63, 0x26, 0x3c, 0x16, 0x79, 0xe8, 0x50, 0xde, 0x29, 0xe6, 0x41, 0x70,
+	0x31, 0x91, 0x42, 0x74, 0x1b, 0x96, 0x85, 0x72, 0xac, 0xc3, 0xbf, 0x31, 0x1a, 0x5a, 0xd5, 0x71,
+	0x40, 0x63, 0x4c, 0x94, 0x58, 0x1e, 0x1f, 0xbd, 0x96, 0x98, 0x55, 0x2d, 0x3f, 0x72, 0xce, 0xcd,
+	0x52, 0xe1, 0xf8, 0xc8, 0x49, 0xc5, 0xf1, 0x21, 0xc9, 0xa6, 0xa0, 0xa6, 0xec, 0xfe, 0x97, 0x01,
+	0xbb, 0x33, 0xed, 0x3e, 0x13, 0x46, 0xff, 0xd6, 0x80, 0x6d, 0xa6, 0x99, 0x76, 0x4c, 0x45, 0x4e,
+	0x7b, 0x5d, 0x9f, 0x71, 0xd3, 0x90, 0x03, 0xa5, 0x7e, 0xe5, 0x40, 0xc9, 0xa3, 0x9d, 0x8a, 0x65,
+	0xcd, 0x6f, 0xea, 0xc1, 0xa2, 0x9b, 0x6d, 0x16, 0xb2, 0x98, 0x30, 0xa8, 0xb0, 0x92, 0x13, 0xc4,
+	0x0a, 0xbc, 0xe7, 0x8d, 0xd6, 0x94, 0xc7, 0x7f, 0x34, 0x60, 0xb3, 0xb0, 0x81, 0xc0, 0x72, 0x45,
+	0xbf, 0x98, 0xc6, 0x34, 0x96, 0x64, 0x63, 0xa2, 0xc4, 0xe8, 0x1c, 0x6a, 0x13, 0x66, 0xeb, 0xbd,
+	0xef, 0xcf, 0xdd, 0xf6, 0xdb, 0x33, 0x62, 0x80, 0x49, 0x35, 0xef, 0xe6, 0x94, 0xe1, 0x7f, 0x5e,
+	0x04, 0xf4, 0x7d, 0x19, 0xda, 0xbc, 0xf9, 0x45, 0x8b, 0x8c, 0x2f, 0xce, 0x22, 0x71, 0xef, 0xf3,
+	0x29, 0x4f, 0xec, 0x5e, 0xd7, 0x1d, 0x3b, 0x3f, 0xcf, 0xbd, 0xef, 0x41, 0x98, 0x8c, 0x4f, 0x96,
+	0x1c, 0x14, 0x26, 0x20, 0xa8, 0x1f, 0x48, 0x42, 0x9c, 0x25, 0x39, 0x99, 0x9d, 0x78, 0x01, 0xe3,
+	0x09, 0x0d, 0xba, 0xb2, 0xd0, 0x4b, 0xf9, 0xb3, 0x64, 0xa6, 0x1a, 0x26, 0x5b, 0x63, 0xb0, 0xd3,
+	0x94, 0x3b, 0x15, 0xce, 0x5f, 0x19, 0xb0, 0x79, 0x1c, 0x7b, 0x0e, 0x3b, 0x09, 0x69, 0x97, 0x77,
+	0xa2, 0xe4, 0x41, 0xc2, 0x02, 0xb4, 0x3d, 0x51, 0x07, 0x69, 0xd6, 0xdb, 0xb0, 0xad, 0x8a, 0xda,
+	0x2e, 0x26, 0x7f, 0xed, 0x6e, 0xe3, 0xca, 0x36, 0x28, 0xa6, 0xac, 0xb9, 0x24, 0x02, 0x46, 0x50,
+	0x54, 0x90, 0xe0, 0x7f, 0x1b, 0x50, 0x9b, 0x30, 0x0a, 0xbd, 0x07, 0x88, 0xeb, 0xff, 0xb9, 0x38,
+	0x18, 0x32, 0x0e, 0xaf, 0x8e, 0x86, 0xd6, 0xae, 0x6e, 0xf8, 0x82, 0x0e, 0x26, 0x9b, 0x29, 0x33,
+	0x0b, 0x81, 0x6c, 0xe8, 0xae, 0xc0, 0xb7, 0xb3, 0x05, 0x62, 0xbc, 0x71, 0x73, 0xf1, 0x39, 0x1a,
+	0xba, 0x10, 0xad, 0xe9, 0x86, 0x9e, 0x85, 0x2c, 0x1b, 0xba, 0xb0, 0x92, 0x13, 0xd4, 0x2d, 0xf0,
+	0xf0, 0x43, 0x03, 0x40, 0x85, 0xeb, 0xb4, 0x4f, 0xbb, 0xcf, 0xc8, 0xc5, 0x87, 0xb0, 0x94, 0xf4,
+	0x69, 0x57, 0xd7, 0xde, 0xb7, 0xe7, 0x2e, 0x73, 0x3d, 0x76, 0x05, 0x06, 0x26, 0x12, 0x0a, 0x7d,
+	0x05, 0xb2, 0x5b, 0xa3, 0xcd, 0x99, 0x13, 0x85, 0x2e, 0x57, 0x95, 0x46, 0xd6, 0x53, 0xfe, 0x89,
+	0x62, 0xe3, 0x5f, 0x97, 0x60, 0x47, 0x99, 0xa8, 0x2e, 0x02, 0xd9, 0xec, 0x7c, 0x79, 0xd6, 0x7e,
+	0x04, 0x2b, 0x01, 0x73, 0x3d, 0x1a, 0xea, 0xd3, 0xf2, 0x3b, 0x73, 0x83, 0xd6, 0xf4, 0x41, 0x27,
+	0x51, 0x30, 0xd1, 0x70, 0xe8, 0x03, 0x28, 0x05, 0x5e, 0x28, 0x0f, 0xc4, 0x4a, 0xf3, 0x5b, 0x73,
+	0xa3, 0x42, 0xf6, 0x8e, 0xc0, 0x44, 0x00, 0x49, 0x3c, 0x3a, 0x30, 0x97, 0x5f, 0x10, 0x8f, 0x0e,
+	0x04, 0x1e, 0x1d, 0xa0, 0x1b, 0x50, 0xce, 0x9e, 0x02, 0x2b, 0x32, 0x3d, 0x19, 0x8d, 0x7f, 0xb9,
+	0x08, 0xd5, 0x66, 0xee, 0x22, 0x8c, 0xae, 0xc3, 0x4a, 0x87, 0x79, 0xed, 0x4e, 0xa2, 0x7a, 0x85,
+	0x68, 0x0a, 0x7d, 0x19, 0xd6, 0x63, 0xf6, 0x31, 0x8b, 0x59, 0xe8, 0x30, 0x5b, 0x25, 0x4c, 0xe6,
+	0x86, 0x5c, 0xcb, 0xd8, 0xea, 0xe2, 0xf4, 0x43, 0xa8, 0x49, 0xb1, 0xbe, 0x70, 0x8b, 0x8a, 0xf8,
+	0xdf, 0x2d, 0xa2, 0xde, 0x9f, 0x39, 0x3b, 0x74, 0xaf, 0x57, 0x25, 0x94, 0x62, 0x71, 0xc4, 0x60,
+	0x53, 0x5e, 0x32, 0x69, 0x12, 0xc5, 0x19, 0xbc, 0x7a, 0xde, 0xde, 0xbd, 0x12, 0xfe, 0x2c, 0x5d,
+	0x35, 0x63, 0x8b, 0x8d, 0x0c, 0x52, 0x6f, 0x83, 0xff, 0x53, 0x82, 0xcd, 0x82, 0x41, 0xcf, 0xa8,
+	0xd3, 0xeb, 0xb0, 0xd2, 0xa5, 0x9c, 0x33, 0x75, 0xf3, 0x2b, 0x13, 0x4d, 0xa1, 0x4f, 0x61, 0xbd,
+	0x2f, 0x03, 0xc7, 0x5c, 0x7b, 0xa2, 0xea, 0xde, 0x9d, 0x3b, 0x9f, 0xd7, 0xf5, 0x6b, 0x7c, 0x12,
+	0x0e, 0x93, 0x6b, 0x29, 0xe7, 0x7d, 0x55, 0x86, 0x3f, 0x06, 0xc4, 0x13, 0x1a, 0xba, 0xf2, 0xd5,
+	0xc8, 0x2e, 0x3c, 0x95, 0x70, 0x55, 0x95, 0xdf, 0x9b, 0x7b, 0xd7, 0xdd, 0xec, 0x25, 0x36, 0x85,
+	0x28, 0xe6, 0xa3, 0x66, 0x1e, 0xa5, 0x3c, 0x71, 0x98, 0xea, 0x3b, 0x24, 0xef, 0xc6, 0x8c, 0xba,
+	0xe6, 0xf2, 0x8b, 0x1d, 0xa6, 0x13, 0x60, 0x98, 0x54, 0x15, 0x7d, 0x22, 0x49, 0x91, 0x89, 0x6e,
+	0xd4, 0x67, 0xb1, 0x2e, 0x66, 0x45, 0xa0, 0x07, 0xea, 0x56, 0xc3, 0xcd, 0x55, 0x59, 0x10, 0x77,
+	0xae, 0x2c, 0x08, 0x95, 0x59, 0x71, 0x57, 0x9b, 0xa8, 0x05, 0x85, 0x80, 0xff, 0x64, 0xc0, 0xc6,
+	0xb4, 0x86, 0xd8, 0x55, 0xdd, 0x9a, 0x74, 0xfe, 0x25, 0xf1, 0x52, 0xef, 0x35, 0x63, 0xc7, 0x4b,
+	0x79, 0xc7, 0x37, 0xa0, 0xd4, 0xd7, 0xe3, 0xa7, 0x4c, 0xc4, 0x5f, 0xfc, 0x33, 0x03, 0x76, 0x66,
+	0x96, 0x3c, 0xda, 0x83, 0x4a, 0x56, 0xee, 0xda, 0x91, 0x31, 0x03, 0xdd, 0x84, 0x4a, 0xdf, 0x0b,
+	0x6d, 0x27, 0xea, 0x85, 0x89, 0x74, 0xa4, 0x44, 0xca, 0x7d, 0x2f, 0x7c, 0x4b, 0xd0, 0x68, 0x17,
+	0xca, 0xae, 0xe7, 0xda, 0xc2, 0x6d, 0xf5, 0x9a, 0x22, 0xab, 0xae, 0xe7, 0xca, 0xeb, 0xae, 0x09,
+	0xab, 0xbc, 0xe7, 0x38, 0xe9, 0xab, 0xa0, 0x4c, 0x52, 0x12, 0xff, 0x63, 0x11, 0xcc, 0xcc, 0x12,
+	0xf9, 0x0a, 0x3d, 0x8e, 0xa3, 0x4f, 0x98, 0x7a, 0x9b, 0x5d, 0x6d, 0x4c, 0x17, 0xd6, 0x25, 0x21,
+	0x77, 0xcc, 0xc7, 0xf6, 0xff, 0xee, 0xa0, 0x29, 0x38, 0xf1, 0xa6, 0xa2, 0xbe, 0x72, 0x41, 0x86,
+	0xf7, 0x16, 0xd4, 0xd2, 0xc7, 0xb5, 0x0a, 0x81, 0x7c, 0x1e, 0x90, 0xaa, 0x66, 0xaa, 0x30, 0x98,
+	0xb0, 0xaa, 0xe9, 0xd4, 0x57, 0x4d, 0xbe, 0xf4, 0x6f, 0x61, 0x48, 0x3f, 0x6d, 0x57, 0xa4, 0x19,
+	0xf2, 0x3f, 0xfe, 0x09, 0xa0, 0x33, 0xf9, 0x75, 0x33, 0xa4, 0x7e, 0x72, 0x29, 0x2d, 0x66, 0x31,
+	0x7a, 0x15, 0x20, 0xf0, 0x38, 0xd7, 0x5e, 0xc9, 0xaf, 0xa3, 0xa4, 0x22, 0x38, 0xca, 0xa5, 0x5b,
+	0x50, 0xa3, 0x2d, 0x9e, 0xd0, 0x89, 0xd4, 0x2f, 0x91, 0xaa, 0x66, 0x66, 0x4a, 0x3a, 0xa9, 0x93,
+	0xc1, 0xd1, 0x4c, 0xa9, 0x84, 0x7f, 0x6f, 0xc0, 0xc6, 0xd9, 0x78, 0x9c, 0x8a, 0x9e, 0xe5, 0xe8,
+	0xab, 0xf9, 0xa9, 0x4d, 0x5d, 0x37, 0x16, 0x75, 0xa2, 0xd2, 0x3d, 0x9e, 0xbd, 0x6f, 0x2a, 0x3e,
+	0x62, 0xb0, 0xaa, 0x7a, 0x3d, 0xbd, 0x5a, 0xed, 0xd6, 0x55, 0x90, 0xea, 0xe2, 0x33, 0x70, 0x5d,
+	0x7f, 0x06, 0xae, 0xbf, 0x15, 0x79, 0x61, 0xf3, 0x75, 0x11, 0xd8, 0x47, 0x9f, 0x5b, 0x87, 0xcf,
+	0x11, 0x58, 0xb1, 0x80, 0x93, 0x14, 0xbb, 0xf9, 0xdd, 0xc7, 0x4f, 0xf6, 0x8d, 0xcf, 0x9e, 0xec,
+	0x1b, 0x7f, 0x7b, 0xb2, 0x6f, 0xfc, 0xe2, 0xe9, 0xfe, 0xc2, 0x67, 0x4f, 0xf7, 0x17, 0xfe, 0xf2,
+	0x74, 0x7f, 0xe1, 0x47, 0xaf, 0xe7, 0xc0, 0x38, 0xf3, 0xee, 0xa4, 0x23, 0x44, 0x12, 0x72, 0x86,
+	0x34, 0x06, 0xfa, 0xd3, 0xb6, 0x82, 0x6e, 0xad, 0x48, 0x95, 0xaf, 0xff, 0x77, 0x00, 0x4c, 0x16,
+	0x23, 0x7c, 0xf8, 0x16, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.VotePeriod != that1.VotePeriod {
+		return false
+	}
+	if !this.VoteThreshold.Equal(that1.VoteThreshold) {
+		return false
+	}
+	if !this.RewardBand.Equal(that1.RewardBand) {
+		return false
+	}
+	if len(this.Whitelist) != len(that1.Whitelist) {
+		return false
+	}
+	for i := range this.Whitelist {
+		if !this.Whitelist[i].Equal(&that1.Whitelist[i]) {
+			return false
+		}
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
+	if this.SlashWindow != that1.SlashWindow {
+		return false
+	}
+	if !this.MinValidPerWindow.Equal(that1.MinValidPerWindow) {
+		return false
+	}
+	if this.RewardDistributionWindow != that1.RewardDistributionWindow {
+		return false
+	}
+	if this.LookbackDuration != that1.LookbackDuration {
+		return false
+	}
+	if this.CommitRevealEnabled != that1.CommitRevealEnabled {
+		return false
+	}
+	if this.StalenessWindow != that1.StalenessWindow {
+		return false
+	}
+	if this.BallotResultHistory != that1.BallotResultHistory {
+		return false
+	}
+	if len(this.SlashTiers) != len(that1.SlashTiers) {
+		return false
+	}
+	for i := range this.SlashTiers {
+		if !this.SlashTiers[i].Equal(&that1.SlashTiers[i]) {
+			return false
+		}
+	}
+	if this.SlashWarningWindows != that1.SlashWarningWindows {
+		return false
+	}
+	return true
+}
+func (this *SlashTier) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SlashTier)
+	if !ok {
+		that2, ok := that.(SlashTier)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MaxValidPerWindow.Equal(that1.MaxValidPerWindow) {
+		return false
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
+	if this.Jail != that1.Jail {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -898,20 +1332,25 @@ func (m *PriceSnapshot) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PriceSnapshot) MarshalTo(dAtA []byte) (int, error) {
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PriceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PriceSnapshotItems) > 0 {
-		for iNdEx := len(m.PriceSnapshotItems) - 1; iNdEx >= 0; iNdEx-- {
+	if m.SlashWarningWindows != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.SlashWarningWindows))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.SlashTiers) > 0 {
+		for iNdEx := len(m.SlashTiers) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PriceSnapshotItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.SlashTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -919,63 +1358,107 @@ func (m *PriceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintOracle(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x6a
 		}
 	}
-	if m.SnapshotTimestamp != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.SnapshotTimestamp))
+	if m.BallotResultHistory != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.BallotResultHistory))
 		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *OracleTwap) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x60
 	}
-	return dAtA[:n], nil
-}
-
-func (m *OracleTwap) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *OracleTwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LookbackSeconds != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.LookbackSeconds))
+	if m.StalenessWindow != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.StalenessWindow))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x58
 	}
-	{
-		size := m.Twap.Size()
+	if m.CommitRevealEnabled {
+		i--
+		if m.CommitRevealEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.LookbackDuration != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.LookbackDuration))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RewardDistributionWindow != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RewardDistributionWindow))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.MinValidPerWindow.Size()
 		i -= size
-		if _, err := m.Twap.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MinValidPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.SlashWindow != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.SlashWindow))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Whitelist) > 0 {
+		for iNdEx := len(m.Whitelist) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Whitelist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.RewardBand.Size()
+		i -= size
+		if _, err := m.RewardBand.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.VoteThreshold.Size()
+		i -= size
+		if _, err := m.VoteThreshold.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x12
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+	if m.VotePeriod != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VotePeriod))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *VotePenaltyCounter) Marshal() (dAtA []byte, err error) {
+func (m *SlashTier) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -985,216 +1468,2897 @@ func (m *VotePenaltyCounter) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VotePenaltyCounter) MarshalTo(dAtA []byte) (int, error) {
+func (m *SlashTier) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VotePenaltyCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SlashTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.SuccessCount != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.SuccessCount))
+	if m.Jail {
+		i--
+		if m.Jail {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.AbstainCount != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.AbstainCount))
-		i--
-		dAtA[i] = 0x10
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	if m.MissCount != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.MissCount))
-		i--
-		dAtA[i] = 0x8
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxValidPerWindow.Size()
+		i -= size
+		if _, err := m.MaxValidPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOracle(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Denom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *Denom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.VotePeriod != 0 {
-		n += 1 + sovOracle(uint64(m.VotePeriod))
+	if m.MaxStaleness != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxStaleness))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = m.VoteThreshold.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.RewardBand.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if len(m.Whitelist) > 0 {
-		for _, e := range m.Whitelist {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
+	if m.RewardBand != nil {
+		{
+			size := m.RewardBand.Size()
+			i -= size
+			if _, err := m.RewardBand.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.SlashFraction.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.SlashWindow != 0 {
-		n += 1 + sovOracle(uint64(m.SlashWindow))
+	if m.VoteThreshold != nil {
+		{
+			size := m.VoteThreshold.Size()
+			i -= size
+			if _, err := m.VoteThreshold.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.MinValidPerWindow.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.LookbackDuration != 0 {
-		n += 1 + sovOracle(uint64(m.LookbackDuration))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Denom) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+func (m *AggregateExchangeRatePrevote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AggregateExchangeRateVote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AggregateExchangeRatePrevote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregateExchangeRatePrevote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ExchangeRateTuples) > 0 {
-		for _, e := range m.ExchangeRateTuples {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
-		}
+	if m.SubmitBlock != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.SubmitBlock))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ExchangeRateTuple) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+func (m *AggregateExchangeRateVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.ExchangeRate.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *OracleExchangeRate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ExchangeRate.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.LastUpdate.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.LastUpdateTimestamp != 0 {
-		n += 1 + sovOracle(uint64(m.LastUpdateTimestamp))
-	}
-	return n
+func (m *AggregateExchangeRateVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PriceSnapshotItem) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AggregateExchangeRateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.OracleExchangeRate.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
+	if len(m.ExchangeRateTuples) > 0 {
+		for iNdEx := len(m.ExchangeRateTuples) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeRateTuples[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PriceSnapshot) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExchangeRateTuple) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeRateTuple) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeRateTuple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SnapshotTimestamp != 0 {
-		n += 1 + sovOracle(uint64(m.SnapshotTimestamp))
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleExchangeRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleExchangeRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleExchangeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastUpdateTimestamp != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.LastUpdateTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.LastUpdate.Size()
+		i -= size
+		if _, err := m.LastUpdate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceSnapshotItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceSnapshotItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceSnapshotItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.OracleExchangeRate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if len(m.PriceSnapshotItems) > 0 {
-		for _, e := range m.PriceSnapshotItems {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
+		for iNdEx := len(m.PriceSnapshotItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceSnapshotItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.SnapshotTimestamp != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.SnapshotTimestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleTwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleTwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleTwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LookbackSeconds != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.LookbackSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Twap.Size()
+		i -= size
+		if _, err := m.Twap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleWindowAggregate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleWindowAggregate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleWindowAggregate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Duration != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Max.Size()
+		i -= size
+		if _, err := m.Max.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Min.Size()
+		i -= size
+		if _, err := m.Min.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Median.Size()
+		i -= size
+		if _, err := m.Median.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Twap.Size()
+		i -= size
+		if _, err := m.Twap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BallotResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BallotResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BallotResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorResults) > 0 {
+		for iNdEx := len(m.ValidatorResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DenomResults) > 0 {
+		for iNdEx := len(m.DenomResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ReferenceDenom) > 0 {
+		i -= len(m.ReferenceDenom)
+		copy(dAtA[i:], m.ReferenceDenom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ReferenceDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomBallotResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomBallotResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomBallotResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Power != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.RewardSpread.Size()
+		i -= size
+		if _, err := m.RewardSpread.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.StandardDeviation.Size()
+		i -= size
+		if _, err := m.StandardDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.WeightedMedian.Size()
+		i -= size
+		if _, err := m.WeightedMedian.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Passed {
+		i--
+		if m.Passed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BallotVoteResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BallotVoteResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BallotVoteResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Win {
+		i--
+		if m.Win {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Power != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorBallotResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorBallotResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorBallotResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DidVote {
+		i--
+		if m.DidVote {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WinCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.WinCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSlashProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSlashProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSlashProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Jail {
+		i--
+		if m.Jail {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Warning {
+		i--
+		if m.Warning {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WarningCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.WarningCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.ValidVoteRate.Size()
+		i -= size
+		if _, err := m.ValidVoteRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VotePenaltyCounter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotePenaltyCounter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotePenaltyCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SuccessCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.SuccessCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AbstainCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.AbstainCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MissCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MissCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOracle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotePeriod != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriod))
+	}
+	l = m.VoteThreshold.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.RewardBand.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if len(m.Whitelist) > 0 {
+		for _, e := range m.Whitelist {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.SlashWindow != 0 {
+		n += 1 + sovOracle(uint64(m.SlashWindow))
+	}
+	l = m.MinValidPerWindow.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.RewardDistributionWindow != 0 {
+		n += 1 + sovOracle(uint64(m.RewardDistributionWindow))
+	}
+	if m.LookbackDuration != 0 {
+		n += 1 + sovOracle(uint64(m.LookbackDuration))
+	}
+	if m.CommitRevealEnabled {
+		n += 2
+	}
+	if m.StalenessWindow != 0 {
+		n += 1 + sovOracle(uint64(m.StalenessWindow))
+	}
+	if m.BallotResultHistory != 0 {
+		n += 1 + sovOracle(uint64(m.BallotResultHistory))
+	}
+	if len(m.SlashTiers) > 0 {
+		for _, e := range m.SlashTiers {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.SlashWarningWindows != 0 {
+		n += 1 + sovOracle(uint64(m.SlashWarningWindows))
+	}
+	return n
+}
+
+func (m *SlashTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxValidPerWindow.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Jail {
+		n += 2
+	}
+	return n
+}
+
+func (m *Denom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.VoteThreshold != nil {
+		l = m.VoteThreshold.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.RewardBand != nil {
+		l = m.RewardBand.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.MaxStaleness != 0 {
+		n += 1 + sovOracle(uint64(m.MaxStaleness))
+	}
+	return n
+}
+
+func (m *AggregateExchangeRatePrevote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.SubmitBlock != 0 {
+		n += 1 + sovOracle(uint64(m.SubmitBlock))
+	}
+	return n
+}
+
+func (m *AggregateExchangeRateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExchangeRateTuples) > 0 {
+		for _, e := range m.ExchangeRateTuples {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *ExchangeRateTuple) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *OracleExchangeRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.LastUpdate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.LastUpdateTimestamp != 0 {
+		n += 1 + sovOracle(uint64(m.LastUpdateTimestamp))
+	}
+	return n
+}
+
+func (m *PriceSnapshotItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.OracleExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *PriceSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SnapshotTimestamp != 0 {
+		n += 1 + sovOracle(uint64(m.SnapshotTimestamp))
+	}
+	if len(m.PriceSnapshotItems) > 0 {
+		for _, e := range m.PriceSnapshotItems {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OracleTwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.Twap.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.LookbackSeconds != 0 {
+		n += 1 + sovOracle(uint64(m.LookbackSeconds))
+	}
+	return n
+}
+
+func (m *OracleWindowAggregate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.Twap.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.Median.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.Min.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.Max.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Duration != 0 {
+		n += 1 + sovOracle(uint64(m.Duration))
+	}
+	return n
+}
+
+func (m *BallotResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovOracle(uint64(m.Height))
+	}
+	l = len(m.ReferenceDenom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.DenomResults) > 0 {
+		for _, e := range m.DenomResults {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if len(m.ValidatorResults) > 0 {
+		for _, e := range m.ValidatorResults {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomBallotResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Passed {
+		n += 2
+	}
+	l = m.WeightedMedian.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.StandardDeviation.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.RewardSpread.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Power != 0 {
+		n += 1 + sovOracle(uint64(m.Power))
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BallotVoteResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Power != 0 {
+		n += 1 + sovOracle(uint64(m.Power))
+	}
+	if m.Win {
+		n += 2
+	}
+	return n
+}
+
+func (m *ValidatorBallotResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.WinCount != 0 {
+		n += 1 + sovOracle(uint64(m.WinCount))
+	}
+	if m.DidVote {
+		n += 2
+	}
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
+func (m *ValidatorSlashProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.ValidVoteRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.WarningCount != 0 {
+		n += 1 + sovOracle(uint64(m.WarningCount))
+	}
+	if m.Warning {
+		n += 2
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Jail {
+		n += 2
+	}
+	return n
+}
+
+func (m *VotePenaltyCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MissCount != 0 {
+		n += 1 + sovOracle(uint64(m.MissCount))
+	}
+	if m.AbstainCount != 0 {
+		n += 1 + sovOracle(uint64(m.AbstainCount))
+	}
+	if m.SuccessCount != 0 {
+		n += 1 + sovOracle(uint64(m.SuccessCount))
+	}
+	return n
+}
+
+func (m *ValidatorRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovOracle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOracle(x uint64) (n int) {
+	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			}
+			m.VotePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Whitelist = append(m.Whitelist, Denom{})
+			if err := m.Whitelist[len(m.Whitelist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
+			}
+			m.SlashWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDistributionWindow", wireType)
+			}
+			m.RewardDistributionWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardDistributionWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackDuration", wireType)
+			}
+			m.LookbackDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LookbackDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitRevealEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CommitRevealEnabled = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StalenessWindow", wireType)
+			}
+			m.StalenessWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StalenessWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotResultHistory", wireType)
+			}
+			m.BallotResultHistory = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BallotResultHistory |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashTiers = append(m.SlashTiers, SlashTier{})
+			if err := m.SlashTiers[len(m.SlashTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashWarningWindows", wireType)
+			}
+			m.SlashWarningWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashWarningWindows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jail", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jail = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Denom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Denom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.VoteThreshold = &v
+			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.RewardBand = &v
+			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStaleness", wireType)
+			}
+			m.MaxStaleness = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStaleness |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
+			}
+			m.SubmitBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateTuples", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRateTuples = append(m.ExchangeRateTuples, ExchangeRateTuple{})
+			if err := m.ExchangeRateTuples[len(m.ExchangeRateTuples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeRateTuple: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeRateTuple: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleExchangeRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleExchangeRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleExchangeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTimestamp", wireType)
+			}
+			m.LastUpdateTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdateTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceSnapshotItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceSnapshotItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceSnapshotItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleExchangeRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTimestamp", wireType)
+			}
+			m.SnapshotTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSnapshotItems", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceSnapshotItems = append(m.PriceSnapshotItems, PriceSnapshotItem{})
+			if err := m.PriceSnapshotItems[len(m.PriceSnapshotItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleTwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleTwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleTwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
+			}
+			m.LookbackSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LookbackSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *OracleTwap) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
-	l = m.Twap.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.LookbackSeconds != 0 {
-		n += 1 + sovOracle(uint64(m.LookbackSeconds))
-	}
-	return n
-}
 
-func (m *VotePenaltyCounter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MissCount != 0 {
-		n += 1 + sovOracle(uint64(m.MissCount))
-	}
-	if m.AbstainCount != 0 {
-		n += 1 + sovOracle(uint64(m.AbstainCount))
-	}
-	if m.SuccessCount != 0 {
-		n += 1 + sovOracle(uint64(m.SuccessCount))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovOracle(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozOracle(x uint64) (n int) {
-	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *OracleWindowAggregate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1217,17 +4381,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleWindowAggregate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleWindowAggregate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.VotePeriod = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1237,14 +4401,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VotePeriod |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1272,13 +4449,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Median", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1306,15 +4483,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Median.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1324,29 +4501,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Whitelist = append(m.Whitelist, Denom{})
-			if err := m.Whitelist[len(m.Whitelist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Min.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1374,15 +4551,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Max.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			m.SlashWindow = 0
+			m.Duration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1392,16 +4569,117 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlashWindow |= uint64(b&0x7F) << shift
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BallotResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BallotResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BallotResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferenceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomResults", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1411,31 +4689,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DenomResults = append(m.DenomResults, DenomBallotResult{})
+			if err := m.DenomResults[len(m.DenomResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LookbackDuration", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorResults", wireType)
 			}
-			m.LookbackDuration = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1445,11 +4723,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LookbackDuration |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorResults = append(m.ValidatorResults, ValidatorBallotResult{})
+			if err := m.ValidatorResults[len(m.ValidatorResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1471,7 +4764,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Denom) Unmarshal(dAtA []byte) error {
+func (m *DenomBallotResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1494,15 +4787,15 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Denom: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomBallotResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomBallotResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1530,63 +4823,67 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passed", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.Passed = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedMedian", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.WeightedMedian.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateTuples", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StandardDeviation", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1596,29 +4893,29 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExchangeRateTuples = append(m.ExchangeRateTuples, ExchangeRateTuple{})
-			if err := m.ExchangeRateTuples[len(m.ExchangeRateTuples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StandardDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardSpread", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1646,7 +4943,62 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			if err := m.RewardSpread.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, BallotVoteResult{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1669,7 +5021,7 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
+func (m *BallotVoteResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1692,15 +5044,15 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExchangeRateTuple: wiretype end group for non-group")
+			return fmt.Errorf("proto: BallotVoteResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExchangeRateTuple: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BallotVoteResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1728,7 +5080,7 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1764,6 +5116,45 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Win", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Win = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1785,7 +5176,7 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleExchangeRate) Unmarshal(dAtA []byte) error {
+func (m *ValidatorBallotResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1808,15 +5199,15 @@ func (m *OracleExchangeRate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleExchangeRate: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorBallotResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleExchangeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorBallotResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1844,15 +5235,32 @@ func (m *OracleExchangeRate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinCount", wireType)
+			}
+			m.WinCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WinCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidVote", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1862,31 +5270,17 @@ func (m *OracleExchangeRate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.LastUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+			m.DidVote = bool(v != 0)
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			m.LastUpdateTimestamp = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1896,11 +5290,12 @@ func (m *OracleExchangeRate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastUpdateTimestamp |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1922,7 +5317,7 @@ func (m *OracleExchangeRate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PriceSnapshotItem) Unmarshal(dAtA []byte) error {
+func (m *ValidatorSlashProjection) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1945,15 +5340,15 @@ func (m *PriceSnapshotItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PriceSnapshotItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorSlashProjection: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriceSnapshotItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorSlashProjection: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1981,13 +5376,13 @@ func (m *PriceSnapshotItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleExchangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidVoteRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1997,80 +5392,50 @@ func (m *PriceSnapshotItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OracleExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValidVoteRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WarningCount", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.WarningCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WarningCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PriceSnapshot: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Warning", wireType)
 			}
-			m.SnapshotTimestamp = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2080,16 +5445,17 @@ func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SnapshotTimestamp |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			m.Warning = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceSnapshotItems", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2099,26 +5465,46 @@ func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriceSnapshotItems = append(m.PriceSnapshotItems, PriceSnapshotItem{})
-			if err := m.PriceSnapshotItems[len(m.PriceSnapshotItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jail", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jail = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -2140,7 +5526,7 @@ func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleTwap) Unmarshal(dAtA []byte) error {
+func (m *VotePenaltyCounter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2163,17 +5549,17 @@ func (m *OracleTwap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleTwap: wiretype end group for non-group")
+			return fmt.Errorf("proto: VotePenaltyCounter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleTwap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VotePenaltyCounter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCount", wireType)
 			}
-			var stringLen uint64
+			m.MissCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2183,29 +5569,16 @@ func (m *OracleTwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MissCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
 			}
-			var stringLen uint64
+			m.AbstainCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2215,31 +5588,16 @@ func (m *OracleTwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AbstainCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCount", wireType)
 			}
-			m.LookbackSeconds = 0
+			m.SuccessCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2249,7 +5607,7 @@ func (m *OracleTwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LookbackSeconds |= int64(b&0x7F) << shift
+				m.SuccessCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2275,7 +5633,7 @@ func (m *OracleTwap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VotePenaltyCounter) Unmarshal(dAtA []byte) error {
+func (m *ValidatorRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2298,17 +5656,17 @@ func (m *VotePenaltyCounter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VotePenaltyCounter: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VotePenaltyCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			m.MissCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2318,16 +5676,29 @@ func (m *VotePenaltyCounter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MissCount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			m.AbstainCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2337,30 +5708,26 @@ func (m *VotePenaltyCounter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AbstainCount |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCount", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
 			}
-			m.SuccessCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SuccessCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
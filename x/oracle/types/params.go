@@ -13,20 +13,32 @@ import (
 
 // Parameter keys
 var (
-	KeyVotePeriod        = []byte("VotePeriod")
-	KeyVoteThreshold     = []byte("VoteThreshold")
-	KeyRewardBand        = []byte("RewardBand")
-	KeyWhitelist         = []byte("Whitelist")
-	KeySlashFraction     = []byte("SlashFraction")
-	KeySlashWindow       = []byte("SlashWindow")
-	KeyMinValidPerWindow = []byte("MinValidPerWindow")
-	KeyLookbackDuration  = []byte("LookbackDuration")
+	KeyVotePeriod               = []byte("VotePeriod")
+	KeyVoteThreshold            = []byte("VoteThreshold")
+	KeyRewardBand               = []byte("RewardBand")
+	KeyWhitelist                = []byte("Whitelist")
+	KeySlashFraction            = []byte("SlashFraction")
+	KeySlashWindow              = []byte("SlashWindow")
+	KeyMinValidPerWindow        = []byte("MinValidPerWindow")
+	KeyLookbackDuration         = []byte("LookbackDuration")
+	KeyRewardDistributionWindow = []byte("RewardDistributionWindow")
+	KeyCommitRevealEnabled      = []byte("CommitRevealEnabled")
+	KeyStalenessWindow          = []byte("StalenessWindow")
+	KeyBallotResultHistory      = []byte("BallotResultHistory")
+	KeySlashTiers               = []byte("SlashTiers")
+	KeySlashWarningWindows      = []byte("SlashWarningWindows")
 )
 
 // Default parameter values
 const (
-	DefaultVotePeriod  = 2                      // Voting every other block
-	DefaultSlashWindow = utils.BlocksPerDay * 2 // 2 days for oracle slashing
+	DefaultVotePeriod               = 2                      // Voting every other block
+	DefaultSlashWindow              = utils.BlocksPerDay * 2 // 2 days for oracle slashing
+	DefaultRewardDistributionWindow = utils.BlocksPerYear    // 1 year for draining the reward pool
+	DefaultCommitRevealEnabled      = false                  // Votes are submitted in the clear
+	DefaultStalenessWindow          = 0                      // Exchange rates never become stale
+	DefaultBallotResultHistory      = 10                     // Ballot results of the last 10 vote periods are kept
+	DefaultSlashWarningWindows      = 0                      // Validators are penalized without warning
+	MaxBallotResultHistory          = 1000                   // Ballot results of at most the last 1000 vote periods can be kept
 )
 
 // Default parameter values
@@ -42,6 +54,7 @@ var (
 	DefaultSlashFraction     = sdk.NewDecWithPrec(0, 4) // 0.00%
 	DefaultMinValidPerWindow = sdk.NewDecWithPrec(5, 2) // 5%
 	DefaultLookbackDuration  = uint64(3600)             // in seconds
+	DefaultSlashTiers        = SlashTiers{}             // Validators are slashed by SlashFraction and jailed
 )
 
 var _ paramstypes.ParamSet = &Params{}
@@ -49,14 +62,20 @@ var _ paramstypes.ParamSet = &Params{}
 // DefaultParams creates default oracle module parameters
 func DefaultParams() Params {
 	return Params{
-		VotePeriod:        DefaultVotePeriod,
-		VoteThreshold:     DefaultVoteThreshold,
-		RewardBand:        DefaultRewardBand,
-		Whitelist:         DefaultWhitelist,
-		SlashFraction:     DefaultSlashFraction,
-		SlashWindow:       DefaultSlashWindow,
-		MinValidPerWindow: DefaultMinValidPerWindow,
-		LookbackDuration:  DefaultLookbackDuration,
+		VotePeriod:               DefaultVotePeriod,
+		VoteThreshold:            DefaultVoteThreshold,
+		RewardBand:               DefaultRewardBand,
+		Whitelist:                DefaultWhitelist,
+		SlashFraction:            DefaultSlashFraction,
+		SlashWindow:              DefaultSlashWindow,
+		MinValidPerWindow:        DefaultMinValidPerWindow,
+		LookbackDuration:         DefaultLookbackDuration,
+		RewardDistributionWindow: DefaultRewardDistributionWindow,
+		CommitRevealEnabled:      DefaultCommitRevealEnabled,
+		StalenessWindow:          DefaultStalenessWindow,
+		BallotResultHistory:      DefaultBallotResultHistory,
+		SlashTiers:               DefaultSlashTiers,
+		SlashWarningWindows:      DefaultSlashWarningWindows,
 	}
 }
 
@@ -77,6 +96,12 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeySlashWindow, &p.SlashWindow, validateSlashWindow),
 		paramstypes.NewParamSetPair(KeyMinValidPerWindow, &p.MinValidPerWindow, validateMinValidPerWindow),
 		paramstypes.NewParamSetPair(KeyLookbackDuration, &p.LookbackDuration, validateLookbackDuration),
+		paramstypes.NewParamSetPair(KeyRewardDistributionWindow, &p.RewardDistributionWindow, validateRewardDistributionWindow),
+		paramstypes.NewParamSetPair(KeyCommitRevealEnabled, &p.CommitRevealEnabled, validateCommitRevealEnabled),
+		paramstypes.NewParamSetPair(KeyStalenessWindow, &p.StalenessWindow, validateStalenessWindow),
+		paramstypes.NewParamSetPair(KeyBallotResultHistory, &p.BallotResultHistory, validateBallotResultHistory),
+		paramstypes.NewParamSetPair(KeySlashTiers, &p.SlashTiers, validateSlashTiers),
+		paramstypes.NewParamSetPair(KeySlashWarningWindows, &p.SlashWarningWindows, validateSlashWarningWindows),
 	}
 }
 
@@ -111,13 +136,30 @@ func (p Params) Validate() error {
 		return fmt.Errorf("oracle parameter SlashWindow must be divisible by VotePeriod")
 	}
 
+	if p.RewardDistributionWindow < p.VotePeriod {
+		return fmt.Errorf("oracle parameter RewardDistributionWindow must be greater than or equal with VotePeriod")
+	}
+
 	if p.MinValidPerWindow.GT(sdk.OneDec()) || p.MinValidPerWindow.IsNegative() {
 		return fmt.Errorf("oracle parameter MinValidPerWindow must be between [0, 1]")
 	}
 
 	for _, denom := range p.Whitelist {
-		if len(denom.Name) == 0 {
-			return fmt.Errorf("oracle parameter Whitelist Denom must have name")
+		if err := denom.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := validateBallotResultHistory(p.BallotResultHistory); err != nil {
+		return err
+	}
+
+	if err := p.SlashTiers.Validate(); err != nil {
+		return err
+	}
+	for _, tier := range p.SlashTiers {
+		if tier.MaxValidPerWindow.GT(p.MinValidPerWindow) {
+			return fmt.Errorf("oracle slash tier MaxValidPerWindow must be less than or equal with MinValidPerWindow")
 		}
 	}
 	return nil
@@ -177,8 +219,8 @@ func validateWhitelist(i interface{}) error {
 	}
 
 	for _, d := range v {
-		if len(d.Name) == 0 {
-			return fmt.Errorf("oracle parameter Whitelist Denom must have name")
+		if err := d.Validate(); err != nil {
+			return err
 		}
 	}
 
@@ -240,3 +282,65 @@ func validateLookbackDuration(i interface{}) error {
 
 	return nil
 }
+
+func validateRewardDistributionWindow(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("reward distribution window must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateCommitRevealEnabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateStalenessWindow(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateBallotResultHistory(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > MaxBallotResultHistory {
+		return fmt.Errorf("ballot result history must be at most %d: %d", MaxBallotResultHistory, v)
+	}
+
+	return nil
+}
+
+func validateSlashTiers(i interface{}) error {
+	v, ok := i.(SlashTiers)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
+
+func validateSlashWarningWindows(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -48,6 +48,29 @@ func TestParamsValid(t *testing.T) {
 	err = p6.Validate()
 	require.Error(t, err)
 
+	// slash tier above min valid per window
+	p11 := DefaultParams()
+	p11.SlashTiers = SlashTiers{{MaxValidPerWindow: p11.MinValidPerWindow.Add(sdk.NewDecWithPrec(1, 2)), SlashFraction: sdk.ZeroDec()}}
+	err = p11.Validate()
+	require.Error(t, err)
+
+	// duplicate slash tiers
+	p12 := DefaultParams()
+	tier := SlashTier{MaxValidPerWindow: p12.MinValidPerWindow, SlashFraction: sdk.NewDecWithPrec(1, 2)}
+	p12.SlashTiers = SlashTiers{tier}
+	require.NoError(t, p12.Validate())
+	p12.SlashTiers = SlashTiers{tier, tier}
+	err = p12.Validate()
+	require.Error(t, err)
+
+	// ballot result history above the maximum
+	p13 := DefaultParams()
+	p13.BallotResultHistory = MaxBallotResultHistory
+	require.NoError(t, p13.Validate())
+	p13.BallotResultHistory = MaxBallotResultHistory + 1
+	err = p13.Validate()
+	require.Error(t, err)
+
 	// empty name
 	p7 := DefaultParams()
 	p7.Whitelist[0].Name = ""
@@ -61,7 +84,13 @@ func TestParamsValid(t *testing.T) {
 	err = p8.Validate()
 	require.Error(t, err)
 
+	// reward distribution window shorter than vote period
 	p9 := DefaultParams()
-	require.NotNil(t, p9.ParamSetPairs())
-	require.NotNil(t, p9.String())
+	p9.RewardDistributionWindow = p9.VotePeriod - 1
+	err = p9.Validate()
+	require.Error(t, err)
+
+	p10 := DefaultParams()
+	require.NotNil(t, p10.ParamSetPairs())
+	require.NotNil(t, p10.String())
 }
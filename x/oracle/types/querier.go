@@ -11,6 +11,7 @@ const (
 	QueryExchangeRates        = "exchangeRates"
 	QueryPriceSnapshotHistory = "priceSnapshotHistory"
 	QueryTwaps                = "twaps"
+	QueryWindowAggregates     = "windowAggregates"
 	QueryActives              = "actives"
 	QueryFeederDelegation     = "feederDelegation"
 	QueryVotePenaltyCounter   = "votePenaltyCounter"
@@ -41,6 +42,18 @@ func NewQueryTwapsParams(lookbackSeconds int64) QueryTwapsParams {
 	return QueryTwapsParams{lookbackSeconds}
 }
 
+// QueryWindowAggregatesParams defines the params for the following queries:
+// - 'custom/oracle/windowAggregates'
+type QueryWindowAggregatesParams struct {
+	StartTime int64
+	EndTime   int64
+}
+
+// NewQueryWindowAggregatesParams returns params for window aggregates query
+func NewQueryWindowAggregatesParams(startTime int64, endTime int64) QueryWindowAggregatesParams {
+	return QueryWindowAggregatesParams{startTime, endTime}
+}
+
 // QueryVotesParams defines the params for the following queries:
 // - 'custom/oracle/votes'
 type QueryVotesParams struct {
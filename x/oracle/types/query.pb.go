@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -73,6 +75,8 @@ var xxx_messageInfo_QueryExchangeRateRequest proto.InternalMessageInfo
 type QueryExchangeRateResponse struct {
 	// exchange_rate defines the exchange rate of Sei denominated in various Sei
 	OracleExchangeRate OracleExchangeRate `protobuf:"bytes,1,opt,name=oracle_exchange_rate,json=oracleExchangeRate,proto3" json:"oracle_exchange_rate"`
+	// stale is true if the exchange rate has not been updated within its staleness window
+	Stale bool `protobuf:"varint,2,opt,name=stale,proto3" json:"stale,omitempty"`
 }
 
 func (m *QueryExchangeRateResponse) Reset()         { *m = QueryExchangeRateResponse{} }
@@ -115,6 +119,13 @@ func (m *QueryExchangeRateResponse) GetOracleExchangeRate() OracleExchangeRate {
 	return OracleExchangeRate{}
 }
 
+func (m *QueryExchangeRateResponse) GetStale() bool {
+	if m != nil {
+		return m.Stale
+	}
+	return false
+}
+
 // QueryExchangeRatesRequest is the request type for the Query/ExchangeRates RPC method.
 type QueryExchangeRatesRequest struct {
 }
@@ -155,6 +166,8 @@ var xxx_messageInfo_QueryExchangeRatesRequest proto.InternalMessageInfo
 type DenomOracleExchangeRatePair struct {
 	Denom              string             `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 	OracleExchangeRate OracleExchangeRate `protobuf:"bytes,2,opt,name=oracle_exchange_rate,json=oracleExchangeRate,proto3" json:"oracle_exchange_rate"`
+	// stale is true if the exchange rate has not been updated within its staleness window
+	Stale bool `protobuf:"varint,3,opt,name=stale,proto3" json:"stale,omitempty"`
 }
 
 func (m *DenomOracleExchangeRatePair) Reset()         { *m = DenomOracleExchangeRatePair{} }
@@ -204,6 +217,13 @@ func (m *DenomOracleExchangeRatePair) GetOracleExchangeRate() OracleExchangeRate
 	return OracleExchangeRate{}
 }
 
+func (m *DenomOracleExchangeRatePair) GetStale() bool {
+	if m != nil {
+		return m.Stale
+	}
+	return false
+}
+
 // QueryExchangeRatesResponse is response type for the
 // Query/ExchangeRates RPC method.
 type QueryExchangeRatesResponse struct {
@@ -590,6 +610,106 @@ func (m *QueryTwapsResponse) GetOracleTwaps() OracleTwaps {
 	return nil
 }
 
+// request type for window aggregates RPC method
+type QueryWindowAggregatesRequest struct {
+	// unix timestamp in seconds of the start of the window, which must not be before the oldest
+	// price snapshot retained for the lookback duration
+	StartTime int64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// unix timestamp in seconds of the end of the window
+	EndTime int64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (m *QueryWindowAggregatesRequest) Reset()         { *m = QueryWindowAggregatesRequest{} }
+func (m *QueryWindowAggregatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWindowAggregatesRequest) ProtoMessage()    {}
+func (*QueryWindowAggregatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{13}
+}
+func (m *QueryWindowAggregatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWindowAggregatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWindowAggregatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWindowAggregatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWindowAggregatesRequest.Merge(m, src)
+}
+func (m *QueryWindowAggregatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWindowAggregatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWindowAggregatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWindowAggregatesRequest proto.InternalMessageInfo
+
+func (m *QueryWindowAggregatesRequest) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *QueryWindowAggregatesRequest) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+type QueryWindowAggregatesResponse struct {
+	OracleWindowAggregates OracleWindowAggregates `protobuf:"bytes,1,rep,name=oracle_window_aggregates,json=oracleWindowAggregates,proto3,castrepeated=OracleWindowAggregates" json:"oracle_window_aggregates"`
+}
+
+func (m *QueryWindowAggregatesResponse) Reset()         { *m = QueryWindowAggregatesResponse{} }
+func (m *QueryWindowAggregatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWindowAggregatesResponse) ProtoMessage()    {}
+func (*QueryWindowAggregatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{14}
+}
+func (m *QueryWindowAggregatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWindowAggregatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWindowAggregatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWindowAggregatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWindowAggregatesResponse.Merge(m, src)
+}
+func (m *QueryWindowAggregatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWindowAggregatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWindowAggregatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWindowAggregatesResponse proto.InternalMessageInfo
+
+func (m *QueryWindowAggregatesResponse) GetOracleWindowAggregates() OracleWindowAggregates {
+	if m != nil {
+		return m.OracleWindowAggregates
+	}
+	return nil
+}
+
 // QueryFeederDelegationRequest is the request type for the Query/FeederDelegation RPC method.
 type QueryFeederDelegationRequest struct {
 	// validator defines the validator address to query for.
@@ -600,7 +720,7 @@ func (m *QueryFeederDelegationRequest) Reset()         { *m = QueryFeederDelegat
 func (m *QueryFeederDelegationRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFeederDelegationRequest) ProtoMessage()    {}
 func (*QueryFeederDelegationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{13}
+	return fileDescriptor_562b782cb9ac197e, []int{15}
 }
 func (m *QueryFeederDelegationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -640,7 +760,7 @@ func (m *QueryFeederDelegationResponse) Reset()         { *m = QueryFeederDelega
 func (m *QueryFeederDelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFeederDelegationResponse) ProtoMessage()    {}
 func (*QueryFeederDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{14}
+	return fileDescriptor_562b782cb9ac197e, []int{16}
 }
 func (m *QueryFeederDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -686,7 +806,7 @@ func (m *QueryVotePenaltyCounterRequest) Reset()         { *m = QueryVotePenalty
 func (m *QueryVotePenaltyCounterRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVotePenaltyCounterRequest) ProtoMessage()    {}
 func (*QueryVotePenaltyCounterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{15}
+	return fileDescriptor_562b782cb9ac197e, []int{17}
 }
 func (m *QueryVotePenaltyCounterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -725,7 +845,7 @@ func (m *QueryVotePenaltyCounterResponse) Reset()         { *m = QueryVotePenalt
 func (m *QueryVotePenaltyCounterResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVotePenaltyCounterResponse) ProtoMessage()    {}
 func (*QueryVotePenaltyCounterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{16}
+	return fileDescriptor_562b782cb9ac197e, []int{18}
 }
 func (m *QueryVotePenaltyCounterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -761,6 +881,177 @@ func (m *QueryVotePenaltyCounterResponse) GetVotePenaltyCounter() *VotePenaltyCo
 	return nil
 }
 
+// QueryValidatorRewardsRequest is the request type for the Query/ValidatorRewards RPC method.
+type QueryValidatorRewardsRequest struct {
+	// validator defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+}
+
+func (m *QueryValidatorRewardsRequest) Reset()         { *m = QueryValidatorRewardsRequest{} }
+func (m *QueryValidatorRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorRewardsRequest) ProtoMessage()    {}
+func (*QueryValidatorRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{19}
+}
+func (m *QueryValidatorRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorRewardsRequest.Merge(m, src)
+}
+func (m *QueryValidatorRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorRewardsRequest proto.InternalMessageInfo
+
+// QueryValidatorRewardsResponse is response type for the
+// Query/ValidatorRewards RPC method.
+type QueryValidatorRewardsResponse struct {
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *QueryValidatorRewardsResponse) Reset()         { *m = QueryValidatorRewardsResponse{} }
+func (m *QueryValidatorRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorRewardsResponse) ProtoMessage()    {}
+func (*QueryValidatorRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{20}
+}
+func (m *QueryValidatorRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorRewardsResponse.Merge(m, src)
+}
+func (m *QueryValidatorRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorRewardsResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+// QueryBallotResultsRequest is the request type for the Query/BallotResults RPC method.
+type QueryBallotResultsRequest struct {
+	// if set, only the votes and the outcome of this validator are returned
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+}
+
+func (m *QueryBallotResultsRequest) Reset()         { *m = QueryBallotResultsRequest{} }
+func (m *QueryBallotResultsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBallotResultsRequest) ProtoMessage()    {}
+func (*QueryBallotResultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{21}
+}
+func (m *QueryBallotResultsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBallotResultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBallotResultsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBallotResultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBallotResultsRequest.Merge(m, src)
+}
+func (m *QueryBallotResultsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBallotResultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBallotResultsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBallotResultsRequest proto.InternalMessageInfo
+
+// QueryBallotResultsResponse is response type for the
+// Query/BallotResults RPC method.
+type QueryBallotResultsResponse struct {
+	// ballot results ordered by height, oldest first
+	BallotResults BallotResults `protobuf:"bytes,1,rep,name=ballot_results,json=ballotResults,proto3,castrepeated=BallotResults" json:"ballot_results"`
+}
+
+func (m *QueryBallotResultsResponse) Reset()         { *m = QueryBallotResultsResponse{} }
+func (m *QueryBallotResultsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBallotResultsResponse) ProtoMessage()    {}
+func (*QueryBallotResultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{22}
+}
+func (m *QueryBallotResultsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBallotResultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBallotResultsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBallotResultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBallotResultsResponse.Merge(m, src)
+}
+func (m *QueryBallotResultsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBallotResultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBallotResultsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBallotResultsResponse proto.InternalMessageInfo
+
+func (m *QueryBallotResultsResponse) GetBallotResults() BallotResults {
+	if m != nil {
+		return m.BallotResults
+	}
+	return nil
+}
+
 // QuerySlashWindow is the request type for the
 // Query/SlashWindow RPC method.
 type QuerySlashWindowRequest struct {
@@ -770,7 +1061,7 @@ func (m *QuerySlashWindowRequest) Reset()         { *m = QuerySlashWindowRequest
 func (m *QuerySlashWindowRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySlashWindowRequest) ProtoMessage()    {}
 func (*QuerySlashWindowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{17}
+	return fileDescriptor_562b782cb9ac197e, []int{23}
 }
 func (m *QuerySlashWindowRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -805,13 +1096,15 @@ type QuerySlashWindowResponse struct {
 	// window_progress defines the number of voting periods
 	// since the last slashing event would have taken place.
 	WindowProgress uint64 `protobuf:"varint,1,opt,name=window_progress,json=windowProgress,proto3" json:"window_progress,omitempty"`
+	// outcome for each validator with a vote penalty counter if the slash window ended now
+	ValidatorProjections []ValidatorSlashProjection `protobuf:"bytes,2,rep,name=validator_projections,json=validatorProjections,proto3" json:"validator_projections"`
 }
 
 func (m *QuerySlashWindowResponse) Reset()         { *m = QuerySlashWindowResponse{} }
 func (m *QuerySlashWindowResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySlashWindowResponse) ProtoMessage()    {}
 func (*QuerySlashWindowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{18}
+	return fileDescriptor_562b782cb9ac197e, []int{24}
 }
 func (m *QuerySlashWindowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -847,6 +1140,13 @@ func (m *QuerySlashWindowResponse) GetWindowProgress() uint64 {
 	return 0
 }
 
+func (m *QuerySlashWindowResponse) GetValidatorProjections() []ValidatorSlashProjection {
+	if m != nil {
+		return m.ValidatorProjections
+	}
+	return nil
+}
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -855,7 +1155,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{19}
+	return fileDescriptor_562b782cb9ac197e, []int{25}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -894,7 +1194,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{20}
+	return fileDescriptor_562b782cb9ac197e, []int{26}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -944,10 +1244,16 @@ func init() {
 	proto.RegisterType((*QueryPriceSnapshotHistoryResponse)(nil), "seiprotocol.seichain.oracle.QueryPriceSnapshotHistoryResponse")
 	proto.RegisterType((*QueryTwapsRequest)(nil), "seiprotocol.seichain.oracle.QueryTwapsRequest")
 	proto.RegisterType((*QueryTwapsResponse)(nil), "seiprotocol.seichain.oracle.QueryTwapsResponse")
+	proto.RegisterType((*QueryWindowAggregatesRequest)(nil), "seiprotocol.seichain.oracle.QueryWindowAggregatesRequest")
+	proto.RegisterType((*QueryWindowAggregatesResponse)(nil), "seiprotocol.seichain.oracle.QueryWindowAggregatesResponse")
 	proto.RegisterType((*QueryFeederDelegationRequest)(nil), "seiprotocol.seichain.oracle.QueryFeederDelegationRequest")
 	proto.RegisterType((*QueryFeederDelegationResponse)(nil), "seiprotocol.seichain.oracle.QueryFeederDelegationResponse")
 	proto.RegisterType((*QueryVotePenaltyCounterRequest)(nil), "seiprotocol.seichain.oracle.QueryVotePenaltyCounterRequest")
 	proto.RegisterType((*QueryVotePenaltyCounterResponse)(nil), "seiprotocol.seichain.oracle.QueryVotePenaltyCounterResponse")
+	proto.RegisterType((*QueryValidatorRewardsRequest)(nil), "seiprotocol.seichain.oracle.QueryValidatorRewardsRequest")
+	proto.RegisterType((*QueryValidatorRewardsResponse)(nil), "seiprotocol.seichain.oracle.QueryValidatorRewardsResponse")
+	proto.RegisterType((*QueryBallotResultsRequest)(nil), "seiprotocol.seichain.oracle.QueryBallotResultsRequest")
+	proto.RegisterType((*QueryBallotResultsResponse)(nil), "seiprotocol.seichain.oracle.QueryBallotResultsResponse")
 	proto.RegisterType((*QuerySlashWindowRequest)(nil), "seiprotocol.seichain.oracle.QuerySlashWindowRequest")
 	proto.RegisterType((*QuerySlashWindowResponse)(nil), "seiprotocol.seichain.oracle.QuerySlashWindowResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "seiprotocol.seichain.oracle.QueryParamsRequest")
@@ -957,76 +1263,99 @@ func init() {
 func init() { proto.RegisterFile("oracle/query.proto", fileDescriptor_562b782cb9ac197e) }
 
 var fileDescriptor_562b782cb9ac197e = []byte{
-	// 1104 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x94, 0x26, 0xa5, 0xcf, 0x69, 0x52, 0x26, 0x06, 0xd2, 0x4d, 0xb0, 0xd3, 0x85, 0x2a,
-	0x05, 0x14, 0x6f, 0x48, 0x93, 0x02, 0x69, 0x12, 0x35, 0x3f, 0xa8, 0x80, 0x4b, 0x12, 0xa7, 0xa2,
-	0x88, 0xcb, 0x6a, 0x62, 0x0f, 0xeb, 0x55, 0x9c, 0x9d, 0xed, 0xce, 0x26, 0x69, 0x14, 0xe5, 0x82,
-	0x7a, 0xe0, 0x18, 0x89, 0x1b, 0xe2, 0xd0, 0x0b, 0x1c, 0xb8, 0xc0, 0x89, 0x23, 0x07, 0x24, 0xa4,
-	0x1e, 0x2b, 0xc1, 0x01, 0x09, 0x09, 0x50, 0xc2, 0xa1, 0x7f, 0x06, 0xf2, 0xec, 0x5b, 0x67, 0xb7,
-	0x59, 0xaf, 0xd7, 0xa1, 0xa7, 0xf5, 0xbc, 0x37, 0xef, 0x9b, 0xef, 0x1b, 0xbf, 0x79, 0x1f, 0x50,
-	0xe1, 0xb1, 0x6a, 0x83, 0x1b, 0xf7, 0xb7, 0xb9, 0xb7, 0x57, 0x76, 0x3d, 0xe1, 0x0b, 0x3a, 0x2c,
-	0xb9, 0xad, 0x7e, 0x55, 0x45, 0xa3, 0x2c, 0xb9, 0x5d, 0xad, 0x33, 0xdb, 0x29, 0x07, 0x1b, 0xb5,
-	0x82, 0x25, 0x2c, 0xa1, 0xb2, 0x46, 0xf3, 0x57, 0x50, 0xa2, 0x8d, 0x58, 0x42, 0x58, 0x0d, 0x6e,
-	0x30, 0xd7, 0x36, 0x98, 0xe3, 0x08, 0x9f, 0xf9, 0xb6, 0x70, 0x24, 0x66, 0x07, 0xf1, 0x90, 0xe0,
-	0x13, 0x04, 0xf5, 0x19, 0x18, 0x5a, 0x6b, 0x1e, 0xfa, 0xc1, 0x83, 0x6a, 0x9d, 0x39, 0x16, 0xaf,
-	0x30, 0x9f, 0x57, 0xf8, 0xfd, 0x6d, 0x2e, 0x7d, 0x5a, 0x80, 0x9e, 0x1a, 0x77, 0xc4, 0xd6, 0x10,
-	0x19, 0x25, 0xd7, 0x2f, 0x56, 0x82, 0xc5, 0xcc, 0x8b, 0x5f, 0x3e, 0x2a, 0xe5, 0x9e, 0x3e, 0x2a,
-	0xe5, 0xf4, 0x87, 0x04, 0xae, 0x24, 0x14, 0x4b, 0x57, 0x38, 0x92, 0x53, 0x0b, 0x0a, 0xc1, 0x49,
-	0x26, 0xc7, 0xb4, 0xe9, 0x31, 0x9f, 0x2b, 0xb0, 0xfc, 0xa4, 0x51, 0x4e, 0x91, 0x57, 0x5e, 0x51,
-	0x9f, 0x28, 0xec, 0xe2, 0xf9, 0xc7, 0x7f, 0x95, 0x72, 0x15, 0xbc, 0xa8, 0x68, 0x46, 0x1f, 0x4e,
-	0x60, 0x21, 0x51, 0x83, 0xfe, 0x0d, 0x81, 0xe1, 0xe5, 0x26, 0xef, 0xd3, 0x90, 0xab, 0xcc, 0xf6,
-	0x92, 0x35, 0xb6, 0xe5, 0x7e, 0xee, 0x79, 0x73, 0xff, 0x95, 0x80, 0x96, 0x44, 0x1e, 0xef, 0xf0,
-	0x3b, 0x02, 0xa3, 0x8a, 0x91, 0x99, 0x44, 0xc7, 0x74, 0x99, 0xed, 0xc9, 0x21, 0x32, 0xfa, 0xc2,
-	0xf5, 0xfc, 0xe4, 0x7b, 0xa9, 0xa4, 0x52, 0xae, 0x60, 0xf1, 0x8d, 0x26, 0xbb, 0xef, 0xff, 0x2e,
-	0x8d, 0xa4, 0x6c, 0x92, 0x95, 0x91, 0x5a, 0x4a, 0x56, 0x7f, 0x19, 0x06, 0x95, 0x8c, 0x85, 0xaa,
-	0x6f, 0xef, 0x9c, 0xdc, 0xfe, 0x04, 0x14, 0xe2, 0x61, 0xd4, 0x35, 0x04, 0x17, 0x58, 0x10, 0x52,
-	0xec, 0x2f, 0x56, 0xc2, 0xa5, 0x7e, 0x05, 0x5e, 0x55, 0x15, 0x9f, 0x08, 0x9f, 0xdf, 0x65, 0x9e,
-	0xc5, 0xfd, 0x16, 0xd8, 0x1c, 0xb6, 0x6a, 0x2c, 0x85, 0x80, 0x57, 0xa1, 0x6f, 0x47, 0xf8, 0xdc,
-	0xf4, 0x83, 0x38, 0xa2, 0xe6, 0x77, 0x4e, 0xb6, 0xea, 0x3a, 0x8c, 0xaa, 0xf2, 0x55, 0xcf, 0xae,
-	0xf2, 0x75, 0x87, 0xb9, 0xb2, 0x2e, 0xfc, 0x0f, 0x6d, 0xe9, 0x0b, 0x6f, 0x2f, 0x3c, 0xe2, 0x90,
-	0xc0, 0xd5, 0x94, 0x4d, 0x78, 0xd8, 0x26, 0x0c, 0xb8, 0xcd, 0xbc, 0x29, 0x71, 0x43, 0xf8, 0x1f,
-	0xbc, 0x95, 0xfa, 0x1f, 0xc4, 0x30, 0x17, 0x5f, 0xc1, 0x5b, 0xef, 0x8f, 0x85, 0x65, 0xa5, 0xdf,
-	0x8d, 0xad, 0xf5, 0x79, 0x78, 0x49, 0x31, 0xba, 0xbb, 0xcb, 0xdc, 0xf0, 0x2a, 0xe8, 0x9b, 0x70,
-	0xb9, 0x21, 0xc4, 0xe6, 0x06, 0xab, 0x6e, 0x9a, 0x92, 0x57, 0x85, 0x53, 0x93, 0xaa, 0x81, 0xcf,
-	0x57, 0x06, 0xc2, 0xf8, 0x7a, 0x10, 0xd6, 0xb7, 0x81, 0x46, 0xeb, 0x51, 0x82, 0x09, 0x7d, 0xd8,
-	0x51, 0x7e, 0x33, 0x8e, 0xfc, 0xc7, 0x32, 0x34, 0x76, 0x13, 0x67, 0x71, 0x10, 0xc9, 0xe7, 0x4f,
-	0x62, 0xb2, 0x92, 0x17, 0x27, 0x0b, 0x7d, 0x05, 0x46, 0xd4, 0xb1, 0x77, 0x38, 0xaf, 0x71, 0x6f,
-	0x99, 0x37, 0xb8, 0xa5, 0x86, 0x51, 0xa8, 0xe0, 0x1a, 0xf4, 0xef, 0xb0, 0x86, 0x5d, 0x63, 0xbe,
-	0xf0, 0x4c, 0x56, 0xab, 0x79, 0xf8, 0x00, 0x2f, 0xb5, 0xa2, 0x0b, 0xb5, 0x9a, 0x17, 0x19, 0x36,
-	0xb7, 0xe1, 0xb5, 0x36, 0x80, 0x28, 0xa9, 0x04, 0xf9, 0xcf, 0x55, 0x2e, 0x0a, 0x07, 0x41, 0xa8,
-	0x89, 0xa5, 0xaf, 0x41, 0xb1, 0xd5, 0x3f, 0xab, 0xdc, 0x61, 0x0d, 0x7f, 0x6f, 0x49, 0x6c, 0x3b,
-	0x3e, 0xf7, 0xce, 0x4c, 0xea, 0x21, 0x81, 0x52, 0x5b, 0x4c, 0xe4, 0xc5, 0xa0, 0xa0, 0x5a, 0xd3,
-	0x0d, 0xd2, 0x66, 0x35, 0xc8, 0x67, 0x9a, 0x83, 0x09, 0xb0, 0x74, 0xe7, 0x54, 0xac, 0xf5, 0x68,
-	0xd6, 0x1b, 0x4c, 0xd6, 0xef, 0xd9, 0x4e, 0x4d, 0xec, 0x86, 0x1d, 0xbd, 0x84, 0x8f, 0x26, 0x96,
-	0x42, 0x66, 0x63, 0x30, 0xb0, 0xab, 0x22, 0xa6, 0xeb, 0x09, 0xcb, 0xe3, 0x32, 0x6c, 0xa2, 0xfe,
-	0x20, 0xbc, 0x8a, 0x51, 0xbd, 0x80, 0x3d, 0xb4, 0xca, 0x3c, 0xb6, 0xd5, 0x7a, 0x8f, 0x9f, 0xe2,
-	0x9b, 0x0f, 0xa3, 0x88, 0xba, 0x00, 0xbd, 0xae, 0x8a, 0xa0, 0xc2, 0xd7, 0xd3, 0x1f, 0x85, 0xda,
-	0x8a, 0x13, 0x12, 0x0b, 0x27, 0x0f, 0x07, 0xa0, 0x47, 0x41, 0xd3, 0x5f, 0x08, 0xf4, 0x45, 0xa7,
-	0x0d, 0x9d, 0x4e, 0x45, 0x6b, 0x67, 0x65, 0xda, 0xcd, 0x6e, 0xcb, 0x02, 0x31, 0xfa, 0xd2, 0x17,
-	0xbf, 0xfd, 0xfb, 0xd5, 0xb9, 0x39, 0x7a, 0xcb, 0x90, 0xdc, 0x1e, 0x0f, 0x01, 0xd4, 0x42, 0x21,
-	0xa0, 0x99, 0x1a, 0x6a, 0x38, 0x4a, 0x63, 0x5f, 0x7d, 0x0f, 0x8c, 0xd8, 0x98, 0xa6, 0x3f, 0x13,
-	0xb8, 0x14, 0x9b, 0xef, 0xb4, 0x4b, 0x3a, 0xe1, 0x95, 0x6b, 0xef, 0x76, 0x5d, 0x87, 0x3a, 0x66,
-	0x95, 0x8e, 0x9b, 0x74, 0x2a, 0x9b, 0x8e, 0x18, 0x7f, 0x49, 0xbf, 0x25, 0x70, 0x01, 0x47, 0x38,
-	0x9d, 0xe8, 0x4c, 0x21, 0x6e, 0x02, 0xda, 0x3b, 0x5d, 0x54, 0x20, 0xdd, 0x69, 0x45, 0xd7, 0xa0,
-	0xe3, 0xd9, 0xe8, 0xa2, 0x79, 0xd0, 0x9f, 0x08, 0xe4, 0x23, 0xee, 0x40, 0xa7, 0x3a, 0x9f, 0x7c,
-	0xda, 0x67, 0xb4, 0xe9, 0x2e, 0xab, 0x90, 0xf3, 0x8c, 0xe2, 0x3c, 0x45, 0x27, 0xb3, 0x71, 0x8e,
-	0xda, 0x15, 0xfd, 0x93, 0x40, 0x21, 0xc9, 0x72, 0xe8, 0x5c, 0x67, 0x2e, 0x29, 0x7e, 0xa6, 0xcd,
-	0x9f, 0xb5, 0x1c, 0x35, 0x2d, 0x2b, 0x4d, 0xf3, 0x74, 0x36, 0x9b, 0xa6, 0xb8, 0x2b, 0x9a, 0x75,
-	0x14, 0xf1, 0x23, 0x81, 0x1e, 0xe5, 0x0a, 0xb4, 0xdc, 0x99, 0x4f, 0xd4, 0xe7, 0x34, 0x23, 0xf3,
-	0x7e, 0x24, 0x7c, 0x47, 0x11, 0xbe, 0x4d, 0xe7, 0xb3, 0x11, 0x56, 0xe6, 0x67, 0xec, 0x3f, 0xeb,
-	0xa5, 0x07, 0xf4, 0x77, 0x02, 0x97, 0x9f, 0x75, 0x1a, 0xfa, 0x7e, 0x67, 0x36, 0x6d, 0xec, 0x4e,
-	0x9b, 0x39, 0x4b, 0x29, 0x6a, 0xfa, 0x48, 0x69, 0x5a, 0xa2, 0x0b, 0x1d, 0x34, 0xb5, 0x4c, 0x4a,
-	0x1a, 0xfb, 0x71, 0x1b, 0x3b, 0x30, 0x02, 0x1b, 0xa4, 0x4f, 0x09, 0xd0, 0xd3, 0x9e, 0x42, 0x6f,
-	0x65, 0xeb, 0xf8, 0x44, 0xd3, 0xd4, 0x66, 0xcf, 0x56, 0x8c, 0xe2, 0xee, 0x29, 0x71, 0x6b, 0x74,
-	0xe5, 0x7f, 0x88, 0x4b, 0xb2, 0x57, 0xfa, 0x03, 0x81, 0x7c, 0xc4, 0xf4, 0xb2, 0xcc, 0x82, 0xd3,
-	0xf6, 0x99, 0x65, 0x16, 0x24, 0x38, 0xab, 0x7e, 0x43, 0xa9, 0x1a, 0xa7, 0x6f, 0x77, 0x50, 0x25,
-	0x9b, 0xb5, 0x66, 0xe0, 0xb6, 0xf4, 0x6b, 0x02, 0xbd, 0x81, 0x1d, 0xd2, 0x0c, 0x7d, 0x1f, 0xf3,
-	0x62, 0x6d, 0x22, 0x7b, 0x01, 0x52, 0x1c, 0x57, 0x14, 0xc7, 0xe8, 0xb5, 0x0e, 0x14, 0x03, 0x4b,
-	0x5e, 0xfc, 0xf8, 0xf1, 0x51, 0x91, 0x3c, 0x39, 0x2a, 0x92, 0x7f, 0x8e, 0x8a, 0xe4, 0xf0, 0xb8,
-	0x98, 0x7b, 0x72, 0x5c, 0xcc, 0xfd, 0x71, 0x5c, 0xcc, 0x7d, 0x36, 0x61, 0xd9, 0x7e, 0x7d, 0x7b,
-	0xa3, 0x5c, 0x15, 0x5b, 0xed, 0xa0, 0x1e, 0x84, 0x60, 0xfe, 0x9e, 0xcb, 0xe5, 0x46, 0xaf, 0xda,
-	0x72, 0xe3, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x58, 0x07, 0x8e, 0x14, 0xf6, 0x0e, 0x00, 0x00,
+	// 1467 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
+	0x1b, 0xce, 0xa4, 0x3f, 0xd2, 0xbe, 0xdb, 0xa4, 0xf9, 0x26, 0xdb, 0x7e, 0x1b, 0x37, 0xdd, 0xa4,
+	0xfe, 0xbe, 0xaa, 0x29, 0x28, 0xeb, 0x34, 0x6d, 0x5a, 0x48, 0xdb, 0xa8, 0xd9, 0x84, 0x0a, 0x2a,
+	0xa4, 0xa6, 0x6e, 0xd5, 0x56, 0x5c, 0xac, 0xc9, 0x7a, 0xd8, 0x98, 0x6c, 0x3c, 0xae, 0xc7, 0x49,
+	0x1a, 0x45, 0xb9, 0x20, 0x7e, 0x1d, 0x38, 0x54, 0xe2, 0xd6, 0x53, 0x2f, 0x70, 0xe0, 0x00, 0x08,
+	0x09, 0xb8, 0x71, 0x40, 0x42, 0xea, 0xb1, 0x12, 0x20, 0x21, 0x21, 0x01, 0x4a, 0x39, 0xf4, 0xcf,
+	0x40, 0x3b, 0x7e, 0xbd, 0xb1, 0xb3, 0xbf, 0xbc, 0x8b, 0x38, 0x79, 0xfd, 0xbe, 0xf3, 0x3e, 0xf3,
+	0x3c, 0xe3, 0x99, 0x79, 0x9f, 0x05, 0x2a, 0x7c, 0x56, 0xaa, 0x70, 0xe3, 0xc1, 0x1a, 0xf7, 0x37,
+	0x0b, 0x9e, 0x2f, 0x02, 0x41, 0x4f, 0x48, 0xee, 0xa8, 0x5f, 0x25, 0x51, 0x29, 0x48, 0xee, 0x94,
+	0x96, 0x99, 0xe3, 0x16, 0xc2, 0x81, 0x5a, 0xb6, 0x2c, 0xca, 0x42, 0x65, 0x8d, 0xea, 0xaf, 0xb0,
+	0x44, 0x1b, 0x29, 0x0b, 0x51, 0xae, 0x70, 0x83, 0x79, 0x8e, 0xc1, 0x5c, 0x57, 0x04, 0x2c, 0x70,
+	0x84, 0x2b, 0x31, 0x3b, 0x84, 0x93, 0x84, 0x0f, 0x0c, 0xe6, 0x4b, 0x42, 0xae, 0x0a, 0x69, 0x2c,
+	0x31, 0xc9, 0x8d, 0xf5, 0x73, 0x4b, 0x3c, 0x60, 0xe7, 0x8c, 0x92, 0x70, 0xdc, 0x30, 0xaf, 0xcf,
+	0x40, 0xee, 0x56, 0x95, 0xd4, 0x6b, 0x0f, 0x4b, 0xcb, 0xcc, 0x2d, 0x73, 0x93, 0x05, 0xdc, 0xe4,
+	0x0f, 0xd6, 0xb8, 0x0c, 0x68, 0x16, 0x0e, 0xd8, 0xdc, 0x15, 0xab, 0x39, 0x32, 0x46, 0xc6, 0x0f,
+	0x9b, 0xe1, 0xcb, 0xcc, 0xa1, 0x8f, 0x9e, 0x8c, 0xf6, 0xbc, 0x78, 0x32, 0xda, 0xa3, 0x3f, 0x26,
+	0x30, 0xdc, 0xa0, 0x58, 0x7a, 0xc2, 0x95, 0x9c, 0x96, 0x21, 0x1b, 0x32, 0xb1, 0x38, 0xa6, 0x2d,
+	0x9f, 0x05, 0x5c, 0x81, 0x65, 0xa6, 0x8c, 0x42, 0x0b, 0xf9, 0x85, 0x9b, 0xea, 0x11, 0x87, 0x2d,
+	0xee, 0x7f, 0xfa, 0xfb, 0x68, 0x8f, 0x49, 0x45, 0x5d, 0xa6, 0x4a, 0x53, 0x06, 0xac, 0xc2, 0x73,
+	0xbd, 0x63, 0x64, 0xfc, 0x90, 0x19, 0xbe, 0xe8, 0x27, 0x1a, 0x70, 0x93, 0xa8, 0x4c, 0xff, 0x9a,
+	0xc0, 0x89, 0x85, 0xaa, 0x9a, 0xfa, 0x89, 0x16, 0x99, 0xe3, 0x37, 0x56, 0xde, 0x54, 0x51, 0xef,
+	0xbf, 0xa6, 0x68, 0x5f, 0x5c, 0xd1, 0x8f, 0x04, 0xb4, 0x46, 0x92, 0x70, 0xbd, 0x3f, 0x23, 0x30,
+	0xa6, 0x78, 0x5a, 0x8d, 0x48, 0x5a, 0x1e, 0x73, 0x7c, 0x99, 0x23, 0x63, 0xfb, 0xc6, 0x33, 0x53,
+	0xaf, 0xb4, 0xa4, 0xda, 0x62, 0x61, 0x8a, 0xff, 0xaf, 0x72, 0xfe, 0xfc, 0x8f, 0xd1, 0x91, 0x16,
+	0x83, 0xa4, 0x39, 0x62, 0xb7, 0xc8, 0xea, 0xc7, 0x60, 0x48, 0xc9, 0x98, 0x2b, 0x05, 0xce, 0xfa,
+	0xee, 0x37, 0x99, 0x84, 0x6c, 0x32, 0x8c, 0xba, 0x72, 0xd0, 0xc7, 0xc2, 0x90, 0x62, 0x7f, 0xd8,
+	0x8c, 0x5e, 0xf5, 0x61, 0xf8, 0xaf, 0xaa, 0xb8, 0x2b, 0x02, 0x7e, 0x87, 0xf9, 0x65, 0x1e, 0xd4,
+	0xc0, 0xae, 0x42, 0xae, 0x3e, 0x85, 0x80, 0xa7, 0xe0, 0xc8, 0xba, 0x08, 0xb8, 0x15, 0x84, 0x71,
+	0x44, 0xcd, 0xac, 0xef, 0x0e, 0xd5, 0x75, 0x18, 0x53, 0xe5, 0x8b, 0xbe, 0x53, 0xe2, 0xb7, 0x5d,
+	0xe6, 0xc9, 0x65, 0x11, 0xbc, 0xee, 0xc8, 0x40, 0xf8, 0x9b, 0xd1, 0x14, 0x8f, 0x08, 0x9c, 0x6a,
+	0x31, 0x08, 0x27, 0x5b, 0x81, 0xa3, 0x5e, 0x35, 0x6f, 0x49, 0x1c, 0x10, 0x7d, 0x83, 0x97, 0x5a,
+	0x7e, 0x83, 0x04, 0x66, 0xf1, 0x38, 0xae, 0xfa, 0x40, 0x22, 0x2c, 0xcd, 0x01, 0x2f, 0xf1, 0xae,
+	0xcf, 0xc2, 0x7f, 0x14, 0xa3, 0x3b, 0x1b, 0xcc, 0x8b, 0x96, 0x82, 0x9e, 0x85, 0xc1, 0x8a, 0x10,
+	0x2b, 0x4b, 0xac, 0xb4, 0x62, 0x49, 0x5e, 0x12, 0xae, 0x2d, 0xd5, 0xb6, 0xde, 0x6f, 0x1e, 0x8d,
+	0xe2, 0xb7, 0xc3, 0xb0, 0xbe, 0x06, 0x34, 0x5e, 0x8f, 0x12, 0x2c, 0x38, 0x82, 0x3b, 0x2a, 0xa8,
+	0xc6, 0x91, 0xff, 0x99, 0x14, 0xdb, 0xbd, 0x8a, 0x53, 0x1c, 0x42, 0xf2, 0x99, 0xdd, 0x98, 0x34,
+	0x33, 0x62, 0xf7, 0x45, 0xbf, 0x0f, 0x23, 0x6a, 0xda, 0x7b, 0x8e, 0x6b, 0x8b, 0x8d, 0xb9, 0x72,
+	0xd9, 0xe7, 0xe5, 0xd8, 0x69, 0xa5, 0x27, 0x01, 0x64, 0xc0, 0xfc, 0xc0, 0x0a, 0x9c, 0xd5, 0xf0,
+	0xfe, 0xd8, 0x67, 0x1e, 0x56, 0x91, 0x3b, 0xce, 0x2a, 0xa7, 0xc3, 0x70, 0x88, 0xbb, 0x76, 0x98,
+	0xec, 0x55, 0xc9, 0x3e, 0xee, 0xda, 0xd5, 0x94, 0xfe, 0x05, 0x81, 0x93, 0x4d, 0xa0, 0x51, 0xdc,
+	0xc7, 0x04, 0x72, 0xa8, 0x6e, 0x43, 0x8d, 0xb1, 0x58, 0x6d, 0x10, 0x2a, 0x9d, 0x4a, 0xa1, 0x74,
+	0x0f, 0x7e, 0x31, 0x8f, 0xa2, 0x8f, 0x37, 0x4c, 0x4b, 0xf3, 0xb8, 0x68, 0x18, 0xd7, 0x6f, 0xe2,
+	0x52, 0x5c, 0xe7, 0xdc, 0xe6, 0xfe, 0x02, 0xaf, 0x54, 0xe3, 0x8e, 0x70, 0xa3, 0xa5, 0x38, 0x0d,
+	0x03, 0xeb, 0xac, 0xe2, 0xd8, 0x2c, 0x10, 0xbe, 0xc5, 0x6c, 0xdb, 0xc7, 0x1b, 0xaa, 0xbf, 0x16,
+	0x9d, 0xb3, 0x6d, 0x3f, 0x76, 0x47, 0x5f, 0x83, 0x93, 0x4d, 0x00, 0x71, 0x01, 0x46, 0x21, 0xf3,
+	0xb6, 0xca, 0xc5, 0xe1, 0x20, 0x0c, 0x55, 0xb1, 0xf4, 0x5b, 0x90, 0xaf, 0x1d, 0xa5, 0x45, 0xee,
+	0xb2, 0x4a, 0xb0, 0x39, 0x2f, 0xd6, 0xdc, 0x80, 0xfb, 0x5d, 0x93, 0x7a, 0x8f, 0xc0, 0x68, 0x53,
+	0x4c, 0xe4, 0xc5, 0x20, 0xab, 0x4e, 0xa9, 0x17, 0xa6, 0xad, 0x52, 0x98, 0x4f, 0xd5, 0x3e, 0x1a,
+	0xc0, 0xd2, 0xf5, 0xba, 0x58, 0x6d, 0xb1, 0xef, 0x46, 0x34, 0x4d, 0xbe, 0xc1, 0x7c, 0x5b, 0x76,
+	0xad, 0xeb, 0x83, 0x68, 0xbb, 0xd5, 0x23, 0xa2, 0x2a, 0x0e, 0x7d, 0x7e, 0x18, 0xc2, 0xcd, 0x35,
+	0x5c, 0x08, 0x1b, 0x74, 0xa1, 0xda, 0xa0, 0x0b, 0xd8, 0xa0, 0x0b, 0xf3, 0xc2, 0x71, 0x8b, 0x93,
+	0xb8, 0x87, 0xc6, 0xcb, 0x4e, 0xb0, 0xbc, 0xb6, 0x54, 0x28, 0x89, 0x55, 0x03, 0xbb, 0x79, 0xf8,
+	0x98, 0x90, 0xf6, 0x8a, 0x11, 0x6c, 0x7a, 0x5c, 0xaa, 0x02, 0x69, 0x46, 0xd8, 0xfa, 0x9b, 0xd8,
+	0xfc, 0x8a, 0xac, 0x52, 0x11, 0x81, 0xc9, 0xe5, 0x5a, 0x25, 0xe8, 0x5e, 0xd6, 0xfb, 0x51, 0xe3,
+	0xd9, 0x03, 0x57, 0x6b, 0xf4, 0x03, 0x4b, 0x2a, 0x61, 0xf9, 0x61, 0x06, 0xa5, 0x9d, 0x6d, 0xf9,
+	0x8d, 0xe2, 0x58, 0xc5, 0x63, 0x28, 0xb5, 0x3f, 0x39, 0x43, 0xff, 0x52, 0xfc, 0xb5, 0x76, 0xdf,
+	0xdf, 0xae, 0x30, 0xb9, 0x1c, 0x1e, 0x9d, 0xe8, 0x32, 0xfe, 0x86, 0x40, 0xae, 0x3e, 0x87, 0x04,
+	0xcf, 0xc0, 0x51, 0x3c, 0xdb, 0x9e, 0x2f, 0xca, 0x3e, 0x97, 0xd1, 0x05, 0x38, 0x10, 0x86, 0x17,
+	0x31, 0x4a, 0x3d, 0x38, 0xb6, 0xbb, 0x32, 0x9e, 0x2f, 0xde, 0xe1, 0x25, 0x65, 0xb0, 0x72, 0xbd,
+	0x4a, 0xd0, 0x74, 0xeb, 0x4d, 0x17, 0x55, 0x2a, 0x0a, 0x8b, 0xb5, 0x6a, 0xec, 0xf3, 0xd9, 0x1a,
+	0xf2, 0x6e, 0x4a, 0xea, 0x59, 0xbc, 0x71, 0x17, 0x99, 0xcf, 0x56, 0x6b, 0xdd, 0xeb, 0x3e, 0x0c,
+	0x25, 0xa2, 0xa8, 0x63, 0x0e, 0x0e, 0x7a, 0x2a, 0x82, 0x87, 0xe0, 0x7f, 0xad, 0x5b, 0x88, 0x1a,
+	0x8a, 0xb3, 0x63, 0xe1, 0xd4, 0x87, 0x59, 0x38, 0xa0, 0xa0, 0xe9, 0x0f, 0x04, 0x8e, 0x24, 0x4c,
+	0x47, 0x6b, 0x75, 0xcd, 0x4c, 0xa2, 0x76, 0xb1, 0xd3, 0xb2, 0x50, 0x8c, 0x3e, 0xff, 0xee, 0x4f,
+	0x7f, 0x7d, 0xd2, 0x7b, 0x95, 0x5e, 0x36, 0x24, 0x77, 0x26, 0x22, 0x00, 0xf5, 0xa2, 0x10, 0xd0,
+	0xc6, 0x1a, 0xca, 0x4a, 0x48, 0x63, 0x4b, 0x3d, 0xb7, 0x8d, 0x84, 0xa9, 0xa1, 0xdf, 0x13, 0xe8,
+	0x8f, 0xa3, 0x4b, 0xda, 0x21, 0x9d, 0x68, 0xc9, 0xb5, 0x4b, 0x1d, 0xd7, 0xa1, 0x8e, 0x2b, 0x4a,
+	0xc7, 0x45, 0x7a, 0x21, 0x9d, 0x8e, 0x04, 0x7f, 0x49, 0x3f, 0x25, 0xd0, 0x87, 0x86, 0x87, 0x4e,
+	0xb6, 0xa7, 0x90, 0xb4, 0x4c, 0xda, 0xb9, 0x0e, 0x2a, 0x90, 0xee, 0xb4, 0xa2, 0x6b, 0xd0, 0x89,
+	0x74, 0x74, 0xd1, 0x6a, 0xd1, 0x6f, 0x09, 0x64, 0x62, 0x5e, 0x8a, 0x5e, 0x68, 0x3f, 0x73, 0xbd,
+	0x2b, 0xd3, 0xa6, 0x3b, 0xac, 0x42, 0xce, 0x33, 0x8a, 0xf3, 0x05, 0x3a, 0x95, 0x8e, 0x73, 0xdc,
+	0xdc, 0xd1, 0xdf, 0x08, 0x64, 0x1b, 0x19, 0x34, 0x7a, 0xb5, 0x3d, 0x97, 0x16, 0xee, 0x4f, 0x9b,
+	0xed, 0xb6, 0x1c, 0x35, 0x2d, 0x28, 0x4d, 0xb3, 0xf4, 0x4a, 0x3a, 0x4d, 0x49, 0x0f, 0x69, 0x2d,
+	0xa3, 0x88, 0xaf, 0x08, 0x1c, 0x50, 0x1e, 0x8a, 0x16, 0xda, 0xf3, 0x89, 0xbb, 0x42, 0xcd, 0x48,
+	0x3d, 0x1e, 0x09, 0x5f, 0x57, 0x84, 0xaf, 0xd1, 0xd9, 0x74, 0x84, 0x95, 0x55, 0x34, 0xb6, 0xf6,
+	0x3a, 0xcf, 0x6d, 0xba, 0x43, 0x60, 0x70, 0xaf, 0xed, 0xa1, 0xaf, 0xb6, 0x67, 0xd3, 0xc4, 0x1c,
+	0x6a, 0x33, 0xdd, 0x94, 0xa2, 0xa6, 0x7b, 0x4a, 0xd3, 0x2d, 0x7a, 0x33, 0x9d, 0xa6, 0x3a, 0x83,
+	0x68, 0x6c, 0xed, 0xfa, 0xd2, 0x6d, 0x63, 0x2b, 0x72, 0xa1, 0xdb, 0xf4, 0x67, 0x02, 0x83, 0x7b,
+	0x1d, 0x57, 0x1a, 0x91, 0x4d, 0x6c, 0x9f, 0x36, 0xd3, 0x4d, 0x29, 0x8a, 0x7c, 0x43, 0x89, 0x9c,
+	0xa7, 0x73, 0x6d, 0x44, 0xd6, 0xfa, 0x93, 0x34, 0xb6, 0x92, 0xfe, 0x60, 0xdb, 0x08, 0xed, 0x20,
+	0x7d, 0x41, 0x80, 0xd6, 0x7b, 0x2b, 0x7a, 0x39, 0xdd, 0xb1, 0x6e, 0x68, 0x1e, 0xb5, 0x2b, 0xdd,
+	0x15, 0x77, 0xf8, 0x05, 0x5b, 0x89, 0x6b, 0x64, 0x33, 0xe9, 0x2f, 0x04, 0x06, 0xf7, 0xba, 0xb8,
+	0x34, 0x5f, 0xb0, 0x89, 0x97, 0xd4, 0x66, 0xba, 0x29, 0x45, 0x91, 0x37, 0x94, 0xc8, 0x05, 0x5a,
+	0xfc, 0x07, 0x22, 0xd1, 0x19, 0xd2, 0xef, 0x08, 0x24, 0x4d, 0x56, 0x9a, 0x8e, 0xd9, 0xc8, 0x46,
+	0x6a, 0x97, 0x3a, 0xae, 0xeb, 0xb0, 0x05, 0x25, 0x4d, 0x25, 0xfd, 0x92, 0x40, 0x26, 0xe6, 0xee,
+	0xd2, 0xb4, 0xa0, 0x7a, 0xa3, 0xa8, 0x4d, 0x77, 0x58, 0x85, 0x9c, 0xcf, 0x2b, 0xce, 0x13, 0xf4,
+	0xe5, 0x36, 0x9c, 0x65, 0xb5, 0x16, 0xff, 0x49, 0xd2, 0xc7, 0x04, 0x0e, 0x86, 0x2e, 0x8c, 0xa6,
+	0xb8, 0x6e, 0x13, 0x16, 0x50, 0x9b, 0x4c, 0x5f, 0x80, 0x14, 0x27, 0x14, 0xc5, 0x33, 0xf4, 0x74,
+	0x1b, 0x8a, 0xa1, 0x13, 0x2c, 0xde, 0x78, 0xba, 0x93, 0x27, 0xcf, 0x76, 0xf2, 0xe4, 0xcf, 0x9d,
+	0x3c, 0x79, 0xf4, 0x3c, 0xdf, 0xf3, 0xec, 0x79, 0xbe, 0xe7, 0xd7, 0xe7, 0xf9, 0x9e, 0xb7, 0x26,
+	0x63, 0xff, 0x37, 0x9a, 0x40, 0x3d, 0x8c, 0xc0, 0xd4, 0xbf, 0x8f, 0xa5, 0x83, 0x6a, 0xc8, 0xf9,
+	0xbf, 0x07, 0x00, 0x2f, 0x31, 0x94, 0xe8, 0xe7, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1052,10 +1381,18 @@ type QueryClient interface {
 	// PriceSnapshotHistory returns the history of price snapshots for all assets
 	PriceSnapshotHistory(ctx context.Context, in *QueryPriceSnapshotHistoryRequest, opts ...grpc.CallOption) (*QueryPriceSnapshotHistoryResponse, error)
 	Twaps(ctx context.Context, in *QueryTwapsRequest, opts ...grpc.CallOption) (*QueryTwapsResponse, error)
+	// WindowAggregates returns the time weighted average, median, min and max prices of denoms
+	// over a historical time window. Price snapshots are only retained for the lookback duration,
+	// and windows starting before the oldest retained snapshot are rejected
+	WindowAggregates(ctx context.Context, in *QueryWindowAggregatesRequest, opts ...grpc.CallOption) (*QueryWindowAggregatesResponse, error)
 	// FeederDelegation returns feeder delegation of a validator
 	FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error)
 	// MissCounter returns oracle miss counter of a validator
 	VotePenaltyCounter(ctx context.Context, in *QueryVotePenaltyCounterRequest, opts ...grpc.CallOption) (*QueryVotePenaltyCounterResponse, error)
+	// ValidatorRewards returns total oracle rewards paid to a validator
+	ValidatorRewards(ctx context.Context, in *QueryValidatorRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorRewardsResponse, error)
+	// BallotResults returns the tally results of the most recent vote periods
+	BallotResults(ctx context.Context, in *QueryBallotResultsRequest, opts ...grpc.CallOption) (*QueryBallotResultsResponse, error)
 	// SlashWindow returns slash window information
 	SlashWindow(ctx context.Context, in *QuerySlashWindowRequest, opts ...grpc.CallOption) (*QuerySlashWindowResponse, error)
 	// Params queries all parameters.
@@ -1124,6 +1461,15 @@ func (c *queryClient) Twaps(ctx context.Context, in *QueryTwapsRequest, opts ...
 	return out, nil
 }
 
+func (c *queryClient) WindowAggregates(ctx context.Context, in *QueryWindowAggregatesRequest, opts ...grpc.CallOption) (*QueryWindowAggregatesResponse, error) {
+	out := new(QueryWindowAggregatesResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/WindowAggregates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error) {
 	out := new(QueryFeederDelegationResponse)
 	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/FeederDelegation", in, out, opts...)
@@ -1142,6 +1488,24 @@ func (c *queryClient) VotePenaltyCounter(ctx context.Context, in *QueryVotePenal
 	return out, nil
 }
 
+func (c *queryClient) ValidatorRewards(ctx context.Context, in *QueryValidatorRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorRewardsResponse, error) {
+	out := new(QueryValidatorRewardsResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/ValidatorRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BallotResults(ctx context.Context, in *QueryBallotResultsRequest, opts ...grpc.CallOption) (*QueryBallotResultsResponse, error) {
+	out := new(QueryBallotResultsResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/BallotResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) SlashWindow(ctx context.Context, in *QuerySlashWindowRequest, opts ...grpc.CallOption) (*QuerySlashWindowResponse, error) {
 	out := new(QuerySlashWindowResponse)
 	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/SlashWindow", in, out, opts...)
@@ -1173,10 +1537,18 @@ type QueryServer interface {
 	// PriceSnapshotHistory returns the history of price snapshots for all assets
 	PriceSnapshotHistory(context.Context, *QueryPriceSnapshotHistoryRequest) (*QueryPriceSnapshotHistoryResponse, error)
 	Twaps(context.Context, *QueryTwapsRequest) (*QueryTwapsResponse, error)
+	// WindowAggregates returns the time weighted average, median, min and max prices of denoms
+	// over a historical time window. Price snapshots are only retained for the lookback duration,
+	// and windows starting before the oldest retained snapshot are rejected
+	WindowAggregates(context.Context, *QueryWindowAggregatesRequest) (*QueryWindowAggregatesResponse, error)
 	// FeederDelegation returns feeder delegation of a validator
 	FeederDelegation(context.Context, *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error)
 	// MissCounter returns oracle miss counter of a validator
 	VotePenaltyCounter(context.Context, *QueryVotePenaltyCounterRequest) (*QueryVotePenaltyCounterResponse, error)
+	// ValidatorRewards returns total oracle rewards paid to a validator
+	ValidatorRewards(context.Context, *QueryValidatorRewardsRequest) (*QueryValidatorRewardsResponse, error)
+	// BallotResults returns the tally results of the most recent vote periods
+	BallotResults(context.Context, *QueryBallotResultsRequest) (*QueryBallotResultsResponse, error)
 	// SlashWindow returns slash window information
 	SlashWindow(context.Context, *QuerySlashWindowRequest) (*QuerySlashWindowResponse, error)
 	// Params queries all parameters.
@@ -1205,12 +1577,21 @@ func (*UnimplementedQueryServer) PriceSnapshotHistory(ctx context.Context, req *
 func (*UnimplementedQueryServer) Twaps(ctx context.Context, req *QueryTwapsRequest) (*QueryTwapsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Twaps not implemented")
 }
+func (*UnimplementedQueryServer) WindowAggregates(ctx context.Context, req *QueryWindowAggregatesRequest) (*QueryWindowAggregatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WindowAggregates not implemented")
+}
 func (*UnimplementedQueryServer) FeederDelegation(ctx context.Context, req *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FeederDelegation not implemented")
 }
 func (*UnimplementedQueryServer) VotePenaltyCounter(ctx context.Context, req *QueryVotePenaltyCounterRequest) (*QueryVotePenaltyCounterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VotePenaltyCounter not implemented")
 }
+func (*UnimplementedQueryServer) ValidatorRewards(ctx context.Context, req *QueryValidatorRewardsRequest) (*QueryValidatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorRewards not implemented")
+}
+func (*UnimplementedQueryServer) BallotResults(ctx context.Context, req *QueryBallotResultsRequest) (*QueryBallotResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BallotResults not implemented")
+}
 func (*UnimplementedQueryServer) SlashWindow(ctx context.Context, req *QuerySlashWindowRequest) (*QuerySlashWindowResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SlashWindow not implemented")
 }
@@ -1330,6 +1711,24 @@ func _Query_Twaps_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_WindowAggregates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWindowAggregatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WindowAggregates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.oracle.Query/WindowAggregates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WindowAggregates(ctx, req.(*QueryWindowAggregatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_FeederDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryFeederDelegationRequest)
 	if err := dec(in); err != nil {
@@ -1366,6 +1765,42 @@ func _Query_VotePenaltyCounter_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.oracle.Query/ValidatorRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorRewards(ctx, req.(*QueryValidatorRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BallotResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBallotResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BallotResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.oracle.Query/BallotResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BallotResults(ctx, req.(*QueryBallotResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_SlashWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QuerySlashWindowRequest)
 	if err := dec(in); err != nil {
@@ -1430,6 +1865,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Twaps",
 			Handler:    _Query_Twaps_Handler,
 		},
+		{
+			MethodName: "WindowAggregates",
+			Handler:    _Query_WindowAggregates_Handler,
+		},
 		{
 			MethodName: "FeederDelegation",
 			Handler:    _Query_FeederDelegation_Handler,
@@ -1438,6 +1877,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VotePenaltyCounter",
 			Handler:    _Query_VotePenaltyCounter_Handler,
 		},
+		{
+			MethodName: "ValidatorRewards",
+			Handler:    _Query_ValidatorRewards_Handler,
+		},
+		{
+			MethodName: "BallotResults",
+			Handler:    _Query_BallotResults_Handler,
+		},
 		{
 			MethodName: "SlashWindow",
 			Handler:    _Query_SlashWindow_Handler,
@@ -1501,6 +1948,16 @@ func (m *QueryExchangeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.Stale {
+		i--
+		if m.Stale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size, err := m.OracleExchangeRate.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1557,9 +2014,19 @@ func (m *DenomOracleExchangeRatePair) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.OracleExchangeRate.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+	if m.Stale {
+		i--
+		if m.Stale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.OracleExchangeRate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
 		i -= size
@@ -1849,6 +2316,76 @@ func (m *QueryTwapsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryWindowAggregatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWindowAggregatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWindowAggregatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWindowAggregatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWindowAggregatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWindowAggregatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OracleWindowAggregates) > 0 {
+		for iNdEx := len(m.OracleWindowAggregates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleWindowAggregates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryFeederDelegationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1974,6 +2511,140 @@ func (m *QueryVotePenaltyCounterResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryValidatorRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBallotResultsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBallotResultsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBallotResultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBallotResultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBallotResultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBallotResultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BallotResults) > 0 {
+		for iNdEx := len(m.BallotResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BallotResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QuerySlashWindowRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2017,6 +2688,20 @@ func (m *QuerySlashWindowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValidatorProjections) > 0 {
+		for iNdEx := len(m.ValidatorProjections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorProjections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if m.WindowProgress != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.WindowProgress))
 		i--
@@ -2113,6 +2798,9 @@ func (m *QueryExchangeRateResponse) Size() (n int) {
 	_ = l
 	l = m.OracleExchangeRate.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	if m.Stale {
+		n += 2
+	}
 	return n
 }
 
@@ -2137,6 +2825,9 @@ func (m *DenomOracleExchangeRatePair) Size() (n int) {
 	}
 	l = m.OracleExchangeRate.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	if m.Stale {
+		n += 2
+	}
 	return n
 }
 
@@ -2254,6 +2945,36 @@ func (m *QueryTwapsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryWindowAggregatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartTime != 0 {
+		n += 1 + sovQuery(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovQuery(uint64(m.EndTime))
+	}
+	return n
+}
+
+func (m *QueryWindowAggregatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OracleWindowAggregates) > 0 {
+		for _, e := range m.OracleWindowAggregates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryFeederDelegationRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2306,61 +3027,123 @@ func (m *QueryVotePenaltyCounterResponse) Size() (n int) {
 	return n
 }
 
-func (m *QuerySlashWindowRequest) Size() (n int) {
+func (m *QueryValidatorRewardsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QuerySlashWindowResponse) Size() (n int) {
+func (m *QueryValidatorRewardsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.WindowProgress != 0 {
-		n += 1 + sovQuery(uint64(m.WindowProgress))
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
+func (m *QueryBallotResultsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
+func (m *QueryBallotResultsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
+	if len(m.BallotResults) > 0 {
+		for _, e := range m.BallotResults {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QuerySlashWindowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
+
+func (m *QuerySlashWindowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WindowProgress != 0 {
+		n += 1 + sovQuery(uint64(m.WindowProgress))
+	}
+	if len(m.ValidatorProjections) > 0 {
+		for _, e := range m.ValidatorProjections {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
 			if iNdEx >= l {
@@ -2497,6 +3280,26 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stale = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2662,6 +3465,26 @@ func (m *DenomOracleExchangeRatePair) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stale = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2793,9 +3616,426 @@ func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: QueryActivesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActivesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actives", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actives = append(m.Actives, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteTargetsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteTargetsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteTargetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteTargetsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteTargetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteTargets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteTargets = append(m.VoteTargets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceSnapshotHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceSnapshotHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceSnapshotHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceSnapshotHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceSnapshotHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceSnapshotHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceSnapshots = append(m.PriceSnapshots, PriceSnapshot{})
+			if err := m.PriceSnapshots[len(m.PriceSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTwapsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTwapsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTwapsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
+			}
+			m.LookbackSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LookbackSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2817,7 +4057,7 @@ func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTwapsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2840,17 +4080,17 @@ func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActivesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTwapsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTwapsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Actives", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleTwaps", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2860,23 +4100,25 @@ func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Actives = append(m.Actives, string(dAtA[iNdEx:postIndex]))
+			m.OracleTwaps = append(m.OracleTwaps, OracleTwap{})
+			if err := m.OracleTwaps[len(m.OracleTwaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2899,7 +4141,7 @@ func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteTargetsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWindowAggregatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2922,12 +4164,50 @@ func (m *QueryVoteTargetsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteTargetsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWindowAggregatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteTargetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWindowAggregatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2949,7 +4229,7 @@ func (m *QueryVoteTargetsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWindowAggregatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2972,17 +4252,17 @@ func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteTargetsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWindowAggregatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteTargetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWindowAggregatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteTargets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleWindowAggregates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2992,23 +4272,25 @@ func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VoteTargets = append(m.VoteTargets, string(dAtA[iNdEx:postIndex]))
+			m.OracleWindowAggregates = append(m.OracleWindowAggregates, OracleWindowAggregate{})
+			if err := m.OracleWindowAggregates[len(m.OracleWindowAggregates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3031,7 +4313,7 @@ func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPriceSnapshotHistoryRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3054,12 +4336,44 @@ func (m *QueryPriceSnapshotHistoryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPriceSnapshotHistoryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeederDelegationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPriceSnapshotHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeederDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3081,7 +4395,7 @@ func (m *QueryPriceSnapshotHistoryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPriceSnapshotHistoryResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3104,17 +4418,17 @@ func (m *QueryPriceSnapshotHistoryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPriceSnapshotHistoryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeederDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPriceSnapshotHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeederDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceSnapshots", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3124,25 +4438,23 @@ func (m *QueryPriceSnapshotHistoryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriceSnapshots = append(m.PriceSnapshots, PriceSnapshot{})
-			if err := m.PriceSnapshots[len(m.PriceSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FeederAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3165,7 +4477,7 @@ func (m *QueryPriceSnapshotHistoryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTwapsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVotePenaltyCounterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3188,17 +4500,17 @@ func (m *QueryTwapsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTwapsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotePenaltyCounterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTwapsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotePenaltyCounterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
 			}
-			m.LookbackSeconds = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3208,11 +4520,24 @@ func (m *QueryTwapsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LookbackSeconds |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3234,7 +4559,7 @@ func (m *QueryTwapsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTwapsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVotePenaltyCounterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3257,15 +4582,15 @@ func (m *QueryTwapsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTwapsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotePenaltyCounterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTwapsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotePenaltyCounterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleTwaps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePenaltyCounter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3292,8 +4617,10 @@ func (m *QueryTwapsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OracleTwaps = append(m.OracleTwaps, OracleTwap{})
-			if err := m.OracleTwaps[len(m.OracleTwaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.VotePenaltyCounter == nil {
+				m.VotePenaltyCounter = &VotePenaltyCounter{}
+			}
+			if err := m.VotePenaltyCounter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3318,7 +4645,7 @@ func (m *QueryTwapsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3341,10 +4668,10 @@ func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeederDelegationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeederDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3400,7 +4727,7 @@ func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3423,17 +4750,17 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeederDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeederDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3443,23 +4770,25 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeederAddr = string(dAtA[iNdEx:postIndex])
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3482,7 +4811,7 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotePenaltyCounterRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBallotResultsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3505,10 +4834,10 @@ func (m *QueryVotePenaltyCounterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotePenaltyCounterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBallotResultsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotePenaltyCounterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBallotResultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3564,7 +4893,7 @@ func (m *QueryVotePenaltyCounterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotePenaltyCounterResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBallotResultsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3587,15 +4916,15 @@ func (m *QueryVotePenaltyCounterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotePenaltyCounterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBallotResultsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotePenaltyCounterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBallotResultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotePenaltyCounter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotResults", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3622,10 +4951,8 @@ func (m *QueryVotePenaltyCounterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VotePenaltyCounter == nil {
-				m.VotePenaltyCounter = &VotePenaltyCounter{}
-			}
-			if err := m.VotePenaltyCounter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BallotResults = append(m.BallotResults, BallotResult{})
+			if err := m.BallotResults[len(m.BallotResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3748,6 +5075,40 @@ func (m *QuerySlashWindowResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorProjections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorProjections = append(m.ValidatorProjections, ValidatorSlashProjection{})
+			if err := m.ValidatorProjections[len(m.ValidatorProjections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gopkg.in/yaml.v2"
+)
+
+// SlashTiers - array of SlashTier
+type SlashTiers []SlashTier
+
+// String implements fmt.Stringer interface
+func (t SlashTier) String() string {
+	out, _ := yaml.Marshal(t)
+	return string(out)
+}
+
+// String implements fmt.Stringer interface
+func (tiers SlashTiers) String() string {
+	out, _ := yaml.Marshal(tiers)
+	return string(out)
+}
+
+// Validate checks that the bounds and slash fractions of the tiers are within [0, 1], and
+// that no two tiers have the same bound
+func (tiers SlashTiers) Validate() error {
+	bounds := map[string]bool{}
+	for _, tier := range tiers {
+		if !tier.MaxValidPerWindow.IsPositive() || tier.MaxValidPerWindow.GT(sdk.OneDec()) {
+			return fmt.Errorf("oracle slash tier MaxValidPerWindow must be between (0, 1]")
+		}
+		if tier.SlashFraction.IsNegative() || tier.SlashFraction.GT(sdk.OneDec()) {
+			return fmt.Errorf("oracle slash tier SlashFraction must be between [0, 1]")
+		}
+		if bounds[tier.MaxValidPerWindow.String()] {
+			return fmt.Errorf("duplicate oracle slash tier for MaxValidPerWindow %s", tier.MaxValidPerWindow)
+		}
+		bounds[tier.MaxValidPerWindow.String()] = true
+	}
+	return nil
+}
+
+// Find returns the tier with the lowest MaxValidPerWindow above the valid vote rate, if any
+func (tiers SlashTiers) Find(validVoteRate sdk.Dec) (tier SlashTier, found bool) {
+	for _, t := range tiers {
+		if validVoteRate.LT(t.MaxValidPerWindow) && (!found || t.MaxValidPerWindow.LT(tier.MaxValidPerWindow)) {
+			tier, found = t, true
+		}
+	}
+	return
+}
+
+// Mildest returns the tier with the highest MaxValidPerWindow, if any
+func (tiers SlashTiers) Mildest() (tier SlashTier, found bool) {
+	for _, t := range tiers {
+		if !found || t.MaxValidPerWindow.GT(tier.MaxValidPerWindow) {
+			tier, found = t, true
+		}
+	}
+	return
+}
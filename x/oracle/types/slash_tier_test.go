@@ -0,0 +1,42 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlashTiersFind(t *testing.T) {
+	mild := SlashTier{MaxValidPerWindow: sdk.NewDecWithPrec(5, 1), SlashFraction: sdk.NewDecWithPrec(1, 2)}
+	severe := SlashTier{MaxValidPerWindow: sdk.NewDecWithPrec(2, 1), SlashFraction: sdk.NewDecWithPrec(1, 1), Jail: true}
+	tiers := SlashTiers{mild, severe}
+
+	_, found := tiers.Find(sdk.NewDecWithPrec(5, 1))
+	require.False(t, found)
+
+	tier, found := tiers.Find(sdk.NewDecWithPrec(4, 1))
+	require.True(t, found)
+	require.Equal(t, mild, tier)
+
+	tier, found = tiers.Find(sdk.NewDecWithPrec(1, 1))
+	require.True(t, found)
+	require.Equal(t, severe, tier)
+}
+
+func TestSlashTiersMildest(t *testing.T) {
+	_, found := SlashTiers{}.Mildest()
+	require.False(t, found)
+
+	mild := SlashTier{MaxValidPerWindow: sdk.NewDecWithPrec(5, 1), SlashFraction: sdk.NewDecWithPrec(1, 2)}
+	severe := SlashTier{MaxValidPerWindow: sdk.NewDecWithPrec(2, 1), SlashFraction: sdk.NewDecWithPrec(1, 1), Jail: true}
+	tier, found := SlashTiers{severe, mild}.Mildest()
+	require.True(t, found)
+	require.Equal(t, mild, tier)
+}
+
+func TestSlashTiersValidate(t *testing.T) {
+	require.NoError(t, SlashTiers{}.Validate())
+	require.Error(t, SlashTiers{{MaxValidPerWindow: sdk.ZeroDec(), SlashFraction: sdk.ZeroDec()}}.Validate())
+	require.Error(t, SlashTiers{{MaxValidPerWindow: sdk.OneDec(), SlashFraction: sdk.NewDec(2)}}.Validate())
+}
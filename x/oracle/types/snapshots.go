@@ -8,8 +8,9 @@ import (
 type PriceSnapshots []PriceSnapshot
 
 type (
-	PriceSnapshotItems []PriceSnapshotItem
-	OracleTwaps        []OracleTwap
+	PriceSnapshotItems     []PriceSnapshotItem
+	OracleTwaps            []OracleTwap
+	OracleWindowAggregates []OracleWindowAggregate
 )
 
 // String implements fmt.Stringer interface
@@ -27,6 +27,9 @@ func GetQueryCmd() *cobra.Command {
 		GetParams(),
 		GetCmdDenomAuthorityMetadata(),
 		GetCmdDenomsFromCreator(),
+		GetCmdDenomCompliance(),
+		GetCmdFrozenAddresses(),
+		GetCmdBeforeSendHookAddress(),
 	)
 
 	return cmd
@@ -120,3 +123,91 @@ func GetCmdDenomsFromCreator() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdDenomCompliance returns the compliance state for a queried denom
+func GetCmdDenomCompliance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-compliance [denom] [flags]",
+		Short: "Get whether the compliance features of a specific denom are enabled, and whether it is paused",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DenomCompliance(cmd.Context(), &types.QueryDenomComplianceRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdFrozenAddresses returns the frozen addresses for a queried denom
+func GetCmdFrozenAddresses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "frozen-addresses [denom] [flags]",
+		Short: "Returns a list of all addresses frozen for a specific denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FrozenAddresses(cmd.Context(), &types.QueryFrozenAddressesRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdBeforeSendHookAddress returns the before send hook contract of a
+// queried denom
+func GetCmdBeforeSendHookAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "before-send-hook [denom] [flags]",
+		Short: "Returns the address of the CosmWasm contract called before every transfer of a specific denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BeforeSendHookAddress(cmd.Context(), &types.QueryBeforeSendHookAddressRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
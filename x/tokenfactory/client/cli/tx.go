@@ -17,6 +17,15 @@ import (
 	"github.com/sei-protocol/sei-chain/x/tokenfactory/types"
 )
 
+const (
+	// FlagComplianceEnabled enables the compliance features of a created denom
+	FlagComplianceEnabled = "compliance-enabled"
+	// FlagMintTo sets the address minted tokens are sent to
+	FlagMintTo = "mint-to"
+	// FlagBurnFrom sets the address tokens are burned from
+	FlagBurnFrom = "burn-from"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -31,9 +40,21 @@ func GetTxCmd() *cobra.Command {
 		NewCreateDenomCmd(),
 		NewMintCmd(),
 		NewBurnCmd(),
-		// NewForceTransferCmd(),
 		NewChangeAdminCmd(),
 		NewSetDenomMetadataCmd(),
+		NewSetMinterCmd(),
+		NewSetBurnerCmd(),
+		NewSetMetadataUpdaterCmd(),
+		NewSetMaxSupplyCmd(),
+		NewSetComplianceOfficerCmd(),
+		NewForceTransferCmd(),
+		NewFreezeCmd(),
+		NewUnfreezeCmd(),
+		NewPauseCmd(),
+		NewUnpauseCmd(),
+		NewApproveBurnFromCmd(),
+		NewRevokeBurnFromCmd(),
+		NewSetBeforeSendHookCmd(),
 	)
 
 	return cmd
@@ -57,11 +78,13 @@ func NewCreateDenomCmd() *cobra.Command {
 				clientCtx.GetFromAddress().String(),
 				args[0],
 			)
+			msg.ComplianceEnabled, _ = cmd.Flags().GetBool(FlagComplianceEnabled)
 
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
 		},
 	}
 
+	cmd.Flags().Bool(FlagComplianceEnabled, false, "Enable force transfers, freezes and pauses of the denom, which can't be enabled later")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -70,7 +93,7 @@ func NewCreateDenomCmd() *cobra.Command {
 func NewMintCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "mint [amount] [flags]",
-		Short: "Mint a denom to an address. Must have admin authority to do so.",
+		Short: "Mint a denom to an address. Must have admin authority or a mint allowance to do so.",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -85,15 +108,18 @@ func NewMintCmd() *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgMint(
+			mintTo, _ := cmd.Flags().GetString(FlagMintTo)
+			msg := types.NewMsgMintTo(
 				clientCtx.GetFromAddress().String(),
 				amount,
+				mintTo,
 			)
 
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
 		},
 	}
 
+	cmd.Flags().String(FlagMintTo, "", "Address to mint the tokens to, defaulting to the sender")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -102,7 +128,7 @@ func NewMintCmd() *cobra.Command {
 func NewBurnCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "burn [amount] [flags]",
-		Short: "Burn tokens from an address. Must have admin authority to do so.",
+		Short: "Burn tokens from an address. Must have admin or burner authority to do so.",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -117,15 +143,18 @@ func NewBurnCmd() *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgBurn(
+			burnFrom, _ := cmd.Flags().GetString(FlagBurnFrom)
+			msg := types.NewMsgBurnFrom(
 				clientCtx.GetFromAddress().String(),
 				amount,
+				burnFrom,
 			)
 
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
 		},
 	}
 
+	cmd.Flags().String(FlagBurnFrom, "", "Address to burn the tokens from, which must have approved burns of the amount by the sender unless the sender has its compliance role, defaulting to the sender")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -161,7 +190,7 @@ func NewChangeAdminCmd() *cobra.Command {
 func NewSetDenomMetadataCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "set-denom-metadata [metadata-file] [flags]",
-		Short: "Set metadata for a factory-created denom. Must have admin authority to do so.",
+		Short: "Set metadata for a factory-created denom. Must have admin or metadata updater authority to do so.",
 		Long: strings.TrimSpace(
 			`
 Example:
@@ -215,6 +244,129 @@ Where metadata.json contains:
 	return cmd
 }
 
+// NewSetMinterCmd broadcast MsgSetMinter
+func NewSetMinterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-minter [denom] [minter-address] [allowance] [flags]",
+		Short: "Sets the amount a minter may still mint of a factory-created denom. A zero allowance removes the minter. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			allowance, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid allowance: %s", args[2])
+			}
+
+			msg := types.NewMsgSetMinter(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				allowance,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetBurnerCmd broadcast MsgSetBurner
+func NewSetBurnerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-burner [denom] [burner-address] [flags]",
+		Short: "Sets the burner of a factory-created denom. An empty address removes the burner. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgSetBurner(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetMetadataUpdaterCmd broadcast MsgSetMetadataUpdater
+func NewSetMetadataUpdaterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-metadata-updater [denom] [metadata-updater-address] [flags]",
+		Short: "Sets the metadata updater of a factory-created denom. An empty address removes the metadata updater. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgSetMetadataUpdater(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetMaxSupplyCmd broadcast MsgSetMaxSupply
+func NewSetMaxSupplyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-max-supply [denom] [max-supply] [flags]",
+		Short: "Sets the max supply of a factory-created denom, which can only be lowered once set. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			maxSupply, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid max supply: %s", args[1])
+			}
+
+			msg := types.NewMsgSetMaxSupply(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				maxSupply,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func ParseMetadataJSON(cdc *codec.LegacyAmino, metadataFile string) (banktypes.Metadata, error) {
 	proposal := banktypes.Metadata{}
 
@@ -229,3 +381,270 @@ func ParseMetadataJSON(cdc *codec.LegacyAmino, metadataFile string) (banktypes.M
 
 	return proposal, nil
 }
+
+// NewSetComplianceOfficerCmd broadcast MsgSetComplianceOfficer
+func NewSetComplianceOfficerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-compliance-officer [denom] [compliance-officer-address] [flags]",
+		Short: "Sets the compliance officer of a factory-created denom. An empty address removes the compliance officer. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgSetComplianceOfficer(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewForceTransferCmd broadcast MsgForceTransfer
+func NewForceTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "force-transfer [amount] [transfer-from-address] [transfer-to-address] [flags]",
+		Short: "Force transfer tokens from one address to another of a compliance enabled denom. Must have admin or compliance officer authority to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgForceTransfer(
+				clientCtx.GetFromAddress().String(),
+				amount,
+				args[1],
+				args[2],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewFreezeCmd broadcast MsgFreeze
+func NewFreezeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze [denom] [address] [flags]",
+		Short: "Freezes an address, so that it can neither send nor receive a compliance enabled denom. Must have admin or compliance officer authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgFreeze(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				true,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewUnfreezeCmd broadcast MsgFreeze
+func NewUnfreezeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze [denom] [address] [flags]",
+		Short: "Unfreezes an address for a compliance enabled denom. Must have admin or compliance officer authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgFreeze(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				false,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewPauseCmd broadcast MsgPause
+func NewPauseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause [denom] [flags]",
+		Short: "Pauses all transfers of a compliance enabled denom. Must have admin or compliance officer authority to do so.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgPause(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				true,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewUnpauseCmd broadcast MsgPause
+func NewUnpauseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unpause [denom] [flags]",
+		Short: "Unpauses the transfers of a compliance enabled denom. Must have admin or compliance officer authority to do so.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgPause(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				false,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewApproveBurnFromCmd broadcast MsgApproveBurnFrom
+func NewApproveBurnFromCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-burn-from [denom] [burner-address] [allowance] [flags]",
+		Short: "Sets the amount of the sender's balance of a denom that its admin or burner may still burn. A zero allowance revokes the approval.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			allowance, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid allowance: %s", args[2])
+			}
+
+			msg := types.NewMsgApproveBurnFrom(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				allowance,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRevokeBurnFromCmd broadcast MsgApproveBurnFrom
+func NewRevokeBurnFromCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-burn-from [denom] [burner-address] [flags]",
+		Short: "Revokes the approval of burns of the sender's balance of a denom by a burner.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgApproveBurnFrom(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				sdk.ZeroInt(),
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetBeforeSendHookCmd broadcast MsgSetBeforeSendHook
+func NewSetBeforeSendHookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-before-send-hook [denom] [cosmwasm-address] [flags]",
+		Short: "Sets the CosmWasm contract called before every transfer of a factory-created denom. An empty address removes the hook. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgSetBeforeSendHook(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -7,4 +7,6 @@ type SeiTokenFactoryQuery struct {
 	DenomAuthorityMetadata *types.QueryDenomAuthorityMetadataRequest `json:"denom_authority_metadata,omitempty"`
 	// queries the tokenfactory denoms from a creator
 	DenomsFromCreator *types.QueryDenomsFromCreatorRequest `json:"denoms_from_creator,omitempty"`
+	// queries the tokenfactory compliance state of a denom
+	DenomCompliance *types.QueryDenomComplianceRequest `json:"denom_compliance,omitempty"`
 }
@@ -14,8 +14,9 @@ func EncodeTokenFactoryCreateDenom(rawMsg json.RawMessage, sender sdk.AccAddress
 		return []sdk.Msg{}, types.ErrEncodeTokenFactoryCreateDenom
 	}
 	createDenomMsg := types.MsgCreateDenom{
-		Sender:   sender.String(),
-		Subdenom: encodedCreateDenomMsg.Subdenom,
+		Sender:            sender.String(),
+		Subdenom:          encodedCreateDenomMsg.Subdenom,
+		ComplianceEnabled: encodedCreateDenomMsg.ComplianceEnabled,
 	}
 	return []sdk.Msg{&createDenomMsg}, nil
 }
@@ -26,8 +27,9 @@ func EncodeTokenFactoryMint(rawMsg json.RawMessage, sender sdk.AccAddress) ([]sd
 		return []sdk.Msg{}, types.ErrEncodeTokenFactoryMint
 	}
 	mintMsg := types.MsgMint{
-		Sender: sender.String(),
-		Amount: encodedMintMsg.Amount,
+		Sender:        sender.String(),
+		Amount:        encodedMintMsg.Amount,
+		MintToAddress: encodedMintMsg.MintToAddress,
 	}
 	return []sdk.Msg{&mintMsg}, nil
 }
@@ -38,8 +40,9 @@ func EncodeTokenFactoryBurn(rawMsg json.RawMessage, sender sdk.AccAddress) ([]sd
 		return []sdk.Msg{}, types.ErrEncodeTokenFactoryBurn
 	}
 	burnMsg := types.MsgBurn{
-		Sender: sender.String(),
-		Amount: encodedBurnMsg.Amount,
+		Sender:          sender.String(),
+		Amount:          encodedBurnMsg.Amount,
+		BurnFromAddress: encodedBurnMsg.BurnFromAddress,
 	}
 	return []sdk.Msg{&burnMsg}, nil
 }
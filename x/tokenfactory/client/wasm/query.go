@@ -25,3 +25,8 @@ func (handler TokenFactoryWasmQueryHandler) GetDenomsFromCreator(ctx sdk.Context
 	c := sdk.WrapSDKContext(ctx)
 	return handler.tokenfactoryKeeper.DenomsFromCreator(c, req)
 }
+
+func (handler TokenFactoryWasmQueryHandler) GetDenomCompliance(ctx sdk.Context, req *types.QueryDenomComplianceRequest) (*types.QueryDenomComplianceResponse, error) {
+	c := sdk.WrapSDKContext(ctx)
+	return handler.tokenfactoryKeeper.DenomCompliance(c, req)
+}
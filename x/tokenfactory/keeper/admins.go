@@ -47,3 +47,67 @@ func (k Keeper) setAdmin(ctx sdk.Context, denom string, admin string) error {
 
 	return k.setAuthorityMetadata(ctx, denom, metadata)
 }
+
+func (k Keeper) setMintAllowance(ctx sdk.Context, denom string, minter string, allowance sdk.Int) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	metadata.SetMintAllowance(minter, allowance)
+
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
+
+func (k Keeper) setBurner(ctx sdk.Context, denom string, burner string) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	metadata.Burner = burner
+
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
+
+func (k Keeper) setMetadataUpdater(ctx sdk.Context, denom string, metadataUpdater string) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	metadata.MetadataUpdater = metadataUpdater
+
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
+
+func (k Keeper) setComplianceOfficer(ctx sdk.Context, denom string, complianceOfficer string) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	metadata.ComplianceOfficer = complianceOfficer
+
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
+
+// setMaxSupply sets the max supply of a denom. A max supply that is already set
+// can only be lowered, and never below the current supply.
+func (k Keeper) setMaxSupply(ctx sdk.Context, denom string, maxSupply sdk.Int) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if metadata.MaxSupply != nil && maxSupply.GT(*metadata.MaxSupply) {
+		return types.ErrInvalidMaxSupply.Wrapf("max supply can only be lowered from %s", metadata.MaxSupply)
+	}
+	if supply := k.bankKeeper.GetSupply(ctx, denom); maxSupply.LT(supply.Amount) {
+		return types.ErrInvalidMaxSupply.Wrapf("max supply is below the current supply %s", supply.Amount)
+	}
+
+	metadata.MaxSupply = &maxSupply
+
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
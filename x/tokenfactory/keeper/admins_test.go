@@ -294,3 +294,164 @@ func (suite *KeeperTestSuite) TestSetDenomMetaData() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMinterAllowance() {
+	suite.SetupTest()
+	suite.CreateDefaultDenom()
+
+	admin, minter := suite.TestAccs[0].String(), suite.TestAccs[1].String()
+
+	// non-admins can't set minters
+	_, err := suite.msgServer.SetMinter(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetMinter(minter, suite.defaultDenom, minter, sdk.NewInt(10)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// accounts without allowance can't mint
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(minter, sdk.NewInt64Coin(suite.defaultDenom, 1)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = suite.msgServer.SetMinter(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetMinter(admin, suite.defaultDenom, minter, sdk.NewInt(10)))
+	suite.Require().NoError(err)
+
+	// minting spends the allowance
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(minter, sdk.NewInt64Coin(suite.defaultDenom, 6)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(6), suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[1], suite.defaultDenom).Amount.Int64())
+
+	authorityMetadata, err := suite.App.TokenFactoryKeeper.GetAuthorityMetadata(suite.Ctx, suite.defaultDenom)
+	suite.Require().NoError(err)
+	allowance, isMinter := authorityMetadata.GetMintAllowance(minter)
+	suite.Require().True(isMinter)
+	suite.Require().Equal(sdk.NewInt(4), allowance)
+
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(minter, sdk.NewInt64Coin(suite.defaultDenom, 5)))
+	suite.Require().ErrorIs(err, types.ErrMintAllowanceExceeded)
+
+	// spending the whole allowance removes the minter
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(minter, sdk.NewInt64Coin(suite.defaultDenom, 4)))
+	suite.Require().NoError(err)
+	authorityMetadata, err = suite.App.TokenFactoryKeeper.GetAuthorityMetadata(suite.Ctx, suite.defaultDenom)
+	suite.Require().NoError(err)
+	suite.Require().Empty(authorityMetadata.Minters)
+
+	// minters can't burn
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurn(minter, sdk.NewInt64Coin(suite.defaultDenom, 1)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// a zero allowance removes the minter
+	_, err = suite.msgServer.SetMinter(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetMinter(admin, suite.defaultDenom, minter, sdk.NewInt(10)))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.SetMinter(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetMinter(admin, suite.defaultDenom, minter, sdk.ZeroInt()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(minter, sdk.NewInt64Coin(suite.defaultDenom, 1)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+}
+
+func (suite *KeeperTestSuite) TestBurnerAndMetadataUpdater() {
+	suite.SetupTest()
+	suite.CreateDefaultDenom()
+
+	admin, burner, updater := suite.TestAccs[0].String(), suite.TestAccs[1].String(), suite.TestAccs[2].String()
+
+	_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().NoError(err)
+	err = suite.App.BankKeeper.SendCoins(suite.Ctx, suite.TestAccs[0], suite.TestAccs[1], sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurn(burner, sdk.NewInt64Coin(suite.defaultDenom, 5)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = suite.msgServer.SetBurner(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetBurner(burner, suite.defaultDenom, burner))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.SetBurner(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetBurner(admin, suite.defaultDenom, burner))
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurn(burner, sdk.NewInt64Coin(suite.defaultDenom, 5)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(5), suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[1], suite.defaultDenom).Amount.Int64())
+
+	// burners can't mint
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(burner, sdk.NewInt64Coin(suite.defaultDenom, 1)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	metadata := banktypes.Metadata{
+		Description: "test",
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    suite.defaultDenom,
+				Exponent: 0,
+			},
+		},
+		Base:    suite.defaultDenom,
+		Display: suite.defaultDenom,
+		Name:    "test",
+		Symbol:  "TEST",
+	}
+
+	_, err = suite.msgServer.SetDenomMetadata(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetDenomMetadata(updater, metadata))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = suite.msgServer.SetMetadataUpdater(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetMetadataUpdater(admin, suite.defaultDenom, updater))
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.SetDenomMetadata(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetDenomMetadata(updater, metadata))
+	suite.Require().NoError(err)
+	bankMetadata, found := suite.App.BankKeeper.GetDenomMetaData(suite.Ctx, suite.defaultDenom)
+	suite.Require().True(found)
+	suite.Require().Equal("test", bankMetadata.Description)
+
+	// unsetting the roles revokes them
+	_, err = suite.msgServer.SetBurner(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetBurner(admin, suite.defaultDenom, ""))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurn(burner, sdk.NewInt64Coin(suite.defaultDenom, 1)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = suite.msgServer.SetMetadataUpdater(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetMetadataUpdater(admin, suite.defaultDenom, ""))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.SetDenomMetadata(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetDenomMetadata(updater, metadata))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+}
+
+func (suite *KeeperTestSuite) TestMaxSupply() {
+	suite.SetupTest()
+	suite.CreateDefaultDenom()
+
+	admin, minter := suite.TestAccs[0].String(), suite.TestAccs[1].String()
+
+	_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.SetMaxSupply(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetMaxSupply(minter, suite.defaultDenom, sdk.NewInt(20)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// the max supply can't be below the current supply
+	_, err = suite.msgServer.SetMaxSupply(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetMaxSupply(admin, suite.defaultDenom, sdk.NewInt(9)))
+	suite.Require().ErrorIs(err, types.ErrInvalidMaxSupply)
+
+	_, err = suite.msgServer.SetMaxSupply(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetMaxSupply(admin, suite.defaultDenom, sdk.NewInt(20)))
+	suite.Require().NoError(err)
+
+	// the max supply can only be lowered
+	_, err = suite.msgServer.SetMaxSupply(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetMaxSupply(admin, suite.defaultDenom, sdk.NewInt(21)))
+	suite.Require().ErrorIs(err, types.ErrInvalidMaxSupply)
+	_, err = suite.msgServer.SetMaxSupply(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetMaxSupply(admin, suite.defaultDenom, sdk.NewInt(15)))
+	suite.Require().NoError(err)
+
+	// the max supply caps the admin and the minters
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(suite.defaultDenom, 6)))
+	suite.Require().ErrorIs(err, types.ErrMaxSupplyExceeded)
+
+	_, err = suite.msgServer.SetMinter(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetMinter(admin, suite.defaultDenom, minter, sdk.NewInt(10)))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(minter, sdk.NewInt64Coin(suite.defaultDenom, 6)))
+	suite.Require().ErrorIs(err, types.ErrMaxSupplyExceeded)
+
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(minter, sdk.NewInt64Coin(suite.defaultDenom, 5)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(15), suite.App.BankKeeper.GetSupply(suite.Ctx, suite.defaultDenom).Amount)
+
+	// burning frees up supply
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurn(admin, sdk.NewInt64Coin(suite.defaultDenom, 5)))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(suite.defaultDenom, 5)))
+	suite.Require().NoError(err)
+}
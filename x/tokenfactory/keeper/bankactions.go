@@ -15,6 +15,17 @@ func (k Keeper) mintTo(ctx sdk.Context, amount sdk.Coin, mintTo string) error {
 		return err
 	}
 
+	authorityMetadata, err := k.GetAuthorityMetadata(ctx, amount.Denom)
+	if err != nil {
+		return err
+	}
+	if maxSupply := authorityMetadata.MaxSupply; maxSupply != nil {
+		supply := k.bankKeeper.GetSupply(ctx, amount.Denom)
+		if supply.Amount.Add(amount.Amount).GT(*maxSupply) {
+			return types.ErrMaxSupplyExceeded.Wrapf("supply: %s, max supply: %s", supply.Amount, maxSupply)
+		}
+	}
+
 	ctx.Logger().Info(fmt.Sprintf("Minting amount=%s for module=%s", amount.String(), types.ModuleName))
 	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
 	if err != nil {
@@ -57,22 +68,31 @@ func (k Keeper) burnFrom(ctx sdk.Context, amount sdk.Coin, burnFrom string) erro
 	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
 }
 
-// func (k Keeper) forceTransfer(ctx sdk.Context, amount sdk.Coin, fromAddr string, toAddr string) error {
-// 	// verify that denom is an x/tokenfactory denom
-// 	_, _, err := types.DeconstructDenom(amount.Denom)
-// 	if err != nil {
-// 		return err
-// 	}
-
-// 	fromSdkAddr, err := sdk.AccAddressFromBech32(fromAddr)
-// 	if err != nil {
-// 		return err
-// 	}
-
-// 	toSdkAddr, err := sdk.AccAddressFromBech32(toAddr)
-// 	if err != nil {
-// 		return err
-// 	}
-
-// 	return k.bankKeeper.SendCoins(ctx, fromSdkAddr, toSdkAddr, sdk.NewCoins(amount))
-// }
+func (k Keeper) forceTransfer(ctx sdk.Context, amount sdk.Coin, fromAddr string, toAddr string) error {
+	// verify that denom is an x/tokenfactory denom
+	_, _, err := types.DeconstructDenom(amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	fromSdkAddr, err := sdk.AccAddressFromBech32(fromAddr)
+	if err != nil {
+		return err
+	}
+
+	toSdkAddr, err := sdk.AccAddressFromBech32(toAddr)
+	if err != nil {
+		return err
+	}
+
+	// the balances of module accounts are accounted for by their modules
+	for _, addr := range []sdk.AccAddress{fromSdkAddr, toSdkAddr} {
+		if k.bankKeeper.BlockedAddr(addr) || k.isModuleAccount(ctx, addr) {
+			return types.ErrForceTransferNotAllowed.Wrapf("address: %s", addr)
+		}
+	}
+
+	// the bank keeper of the module does not run the bank hooks, so force
+	// transfers are not restricted by freezes and pauses
+	return k.bankKeeper.SendCoins(ctx, fromSdkAddr, toSdkAddr, sdk.NewCoins(amount))
+}
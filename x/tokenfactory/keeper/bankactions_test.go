@@ -207,3 +207,91 @@ func (suite *KeeperTestSuite) TestBurnDenom() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMintToAndBurnFrom() {
+	suite.SetupTest()
+	suite.CreateDefaultDenom()
+
+	admin, holder, burner := suite.TestAccs[0], suite.TestAccs[1], suite.TestAccs[2]
+	amount := sdk.NewInt64Coin(suite.defaultDenom, 10)
+	bankKeeper := suite.App.BankKeeper
+
+	// mint to another address
+	_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMintTo(admin.String(), amount, holder.String()))
+	suite.Require().NoError(err)
+	suite.Require().True(bankKeeper.GetBalance(suite.Ctx, admin, suite.defaultDenom).IsZero())
+	suite.Require().Equal(amount, bankKeeper.GetBalance(suite.Ctx, holder, suite.defaultDenom))
+
+	// burning from another address requires its approval
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurnFrom(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 4), holder.String()))
+	suite.Require().ErrorIs(err, types.ErrBurnFromNotApproved)
+
+	_, err = suite.msgServer.ApproveBurnFrom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgApproveBurnFrom(holder.String(), suite.defaultDenom, admin.String(), sdk.NewInt(6)))
+	suite.Require().NoError(err)
+	allowance, err := suite.App.TokenFactoryKeeper.GetBurnFromAllowance(suite.Ctx, suite.defaultDenom, holder.String(), admin.String())
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(6), allowance)
+
+	// and burn authority over the denom
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurnFrom(burner.String(), sdk.NewInt64Coin(suite.defaultDenom, 4), holder.String()))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.SetBurner(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetBurner(admin.String(), suite.defaultDenom, burner.String()))
+	suite.Require().NoError(err)
+
+	// the approval only covers the approved burner
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurnFrom(burner.String(), sdk.NewInt64Coin(suite.defaultDenom, 4), holder.String()))
+	suite.Require().ErrorIs(err, types.ErrBurnFromNotApproved)
+
+	// and each burn spends the allowance
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurnFrom(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 4), holder.String()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurnFrom(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 4), holder.String()))
+	suite.Require().ErrorIs(err, types.ErrBurnFromAllowanceExceeded)
+	allowance, err = suite.App.TokenFactoryKeeper.GetBurnFromAllowance(suite.Ctx, suite.defaultDenom, holder.String(), admin.String())
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(2), allowance)
+
+	_, err = suite.msgServer.ApproveBurnFrom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgApproveBurnFrom(holder.String(), suite.defaultDenom, burner.String(), sdk.NewInt(4)))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurnFrom(burner.String(), sdk.NewInt64Coin(suite.defaultDenom, 4), holder.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(2), bankKeeper.GetBalance(suite.Ctx, holder, suite.defaultDenom).Amount.Int64())
+
+	// the approval is removed once its allowance is spent
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurnFrom(burner.String(), sdk.NewInt64Coin(suite.defaultDenom, 1), holder.String()))
+	suite.Require().ErrorIs(err, types.ErrBurnFromNotApproved)
+	approvals, err := suite.App.TokenFactoryKeeper.GetBurnFromApprovals(suite.Ctx, suite.defaultDenom)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.BurnFromApproval{{Address: holder.String(), Burner: admin.String(), Allowance: sdk.NewInt(2)}}, approvals)
+
+	// or when it is revoked
+	_, err = suite.msgServer.ApproveBurnFrom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgApproveBurnFrom(holder.String(), suite.defaultDenom, admin.String(), sdk.ZeroInt()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurnFrom(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 2), holder.String()))
+	suite.Require().ErrorIs(err, types.ErrBurnFromNotApproved)
+}
+
+func (suite *KeeperTestSuite) TestComplianceBurnFrom() {
+	suite.SetupTest()
+	denom := suite.createComplianceDenom()
+
+	admin, holder, officer := suite.TestAccs[0], suite.TestAccs[1], suite.TestAccs[2]
+
+	_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMintTo(admin.String(), sdk.NewInt64Coin(denom, 10), holder.String()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.SetComplianceOfficer(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetComplianceOfficer(admin.String(), denom, officer.String()))
+	suite.Require().NoError(err)
+
+	// the compliance role can burn from any address without its approval
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurnFrom(officer.String(), sdk.NewInt64Coin(denom, 5), holder.String()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurnFrom(admin.String(), sdk.NewInt64Coin(denom, 5), holder.String()))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, holder, denom).IsZero())
+
+	// but can't burn its own balance without burn authority
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMintTo(admin.String(), sdk.NewInt64Coin(denom, 10), officer.String()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurn(officer.String(), sdk.NewInt64Coin(denom, 5)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+}
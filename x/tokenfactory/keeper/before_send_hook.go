@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sei-protocol/sei-chain/utils/metrics"
+	"github.com/sei-protocol/sei-chain/x/tokenfactory/types"
+)
+
+const (
+	blockBeforeSendMsgType = "block_before_send"
+	trackBeforeSendMsgType = "track_before_send"
+
+	runningBeforeSendHooksContextKey beforeSendHooksContextKeyType = "tokenfactory-running-before-send-hooks"
+)
+
+// beforeSendHooksContextKeyType is the type of the context key of the denoms
+// whose before send hook is running
+type beforeSendHooksContextKeyType string
+
+// isBeforeSendHookRunning returns whether the before send hook of the denom is
+// running in the context
+func isBeforeSendHookRunning(ctx sdk.Context, denom string) bool {
+	running, _ := ctx.Context().Value(runningBeforeSendHooksContextKey).(map[string]struct{})
+	_, ok := running[denom]
+	return ok
+}
+
+// withBeforeSendHookRunning returns a context in which the before send hook of
+// the denom is running
+func withBeforeSendHookRunning(ctx sdk.Context, denom string) sdk.Context {
+	previous, _ := ctx.Context().Value(runningBeforeSendHooksContextKey).(map[string]struct{})
+	running := make(map[string]struct{}, len(previous)+1)
+	for d := range previous {
+		running[d] = struct{}{}
+	}
+	running[denom] = struct{}{}
+	return ctx.WithContext(context.WithValue(ctx.Context(), runningBeforeSendHooksContextKey, running))
+}
+
+// GetBeforeSendHook returns the address of the before send hook contract of a
+// specific denom, or an empty string if it has none
+func (k Keeper) GetBeforeSendHook(ctx sdk.Context, denom string) string {
+	return string(k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.BeforeSendHookKey)))
+}
+
+func (k Keeper) setBeforeSendHook(ctx sdk.Context, denom string, cosmwasmAddress string) {
+	store := k.GetDenomPrefixStore(ctx, denom)
+	if cosmwasmAddress == "" {
+		store.Delete([]byte(types.BeforeSendHookKey))
+	} else {
+		store.Set([]byte(types.BeforeSendHookKey), []byte(cosmwasmAddress))
+	}
+}
+
+// isContract returns whether a contract is instantiated at the address
+func (k Keeper) isContract(ctx sdk.Context, address string) bool {
+	contractAddr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return false
+	}
+	return k.contractKeeper != nil && k.contractKeeper.HasContractInfo(ctx, contractAddr)
+}
+
+// callBeforeSendHook calls the before send hook contract of the denom of the
+// coin, if it has one. Blocking calls return the errors of the contract so
+// that the transfer is rejected, while the errors of tracking calls are only
+// logged. Transfers of the denom made while its hook is running, e.g. by the
+// contract itself, do not call the hook again.
+func (k Keeper) callBeforeSendHook(ctx sdk.Context, from, to sdk.AccAddress, coin sdk.Coin, blocking bool) error {
+	cosmwasmAddress := k.GetBeforeSendHook(ctx, coin.Denom)
+	if cosmwasmAddress == "" || k.contractKeeper == nil || isBeforeSendHookRunning(ctx, coin.Denom) {
+		return nil
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(cosmwasmAddress)
+	if err != nil {
+		return err
+	}
+
+	transfer := types.BeforeSendMsg{Amount: coin}
+	if !from.Empty() {
+		transfer.From = from.String()
+	}
+	if !to.Empty() {
+		transfer.To = to.String()
+	}
+	var msg interface{} = types.SudoTrackBeforeSendMsg{TrackBeforeSend: transfer}
+	msgType := trackBeforeSendMsgType
+	if blocking {
+		msg = types.SudoBlockBeforeSendMsg{BlockBeforeSend: transfer}
+		msgType = blockBeforeSendMsgType
+	}
+	wasmMsg, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	if err := k.sudoBeforeSendHook(withBeforeSendHookRunning(ctx, coin.Denom), contractAddr, wasmMsg, msgType); err != nil {
+		metrics.IncrementSudoFailCount(msgType)
+		if !blocking {
+			k.Logger(ctx).Error(fmt.Sprintf("before send hook %s of denom %s failed to track a transfer: %s", cosmwasmAddress, coin.Denom, err))
+			return nil
+		}
+		return types.ErrBeforeSendHookRejected.Wrapf("denom: %s, %s", coin.Denom, err)
+	}
+	return nil
+}
+
+// sudoBeforeSendHook sudo calls a before send hook contract with its own gas
+// meter, limited by the before send hook gas limit param and the gas left to
+// the transfer if it is limited, and charges the gas consumed by the contract
+// to the transfer. Running out of gas is returned as an error, and the state
+// changes of the contract are only written if it succeeds.
+func (k Keeper) sudoBeforeSendHook(ctx sdk.Context, contractAddr sdk.AccAddress, wasmMsg []byte, msgType string) (err error) {
+	defer metrics.MeasureSudoExecutionDuration(time.Now(), msgType)
+
+	gasLimit := k.GetParams(ctx).BeforeSendHookGasLimit
+	// infinite gas meters have no limit
+	if limit := ctx.GasMeter().Limit(); limit > 0 {
+		if gasLeft := limit - ctx.GasMeter().GasConsumedToLimit(); gasLeft < gasLimit {
+			gasLimit = gasLeft
+		}
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	tmpCtx := cacheCtx.WithGasMeter(sdk.NewGasMeter(gasLimit))
+	defer func() {
+		if r := recover(); r != nil {
+			// only propagate panic if the error is NOT out of gas
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "before send hook %s is out of gas", contractAddr)
+		}
+		ctx.GasMeter().ConsumeGas(tmpCtx.GasMeter().GasConsumedToLimit(), "before send hook")
+		if err == nil {
+			write()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		}
+	}()
+
+	_, err = k.contractKeeper.Sudo(tmpCtx, contractAddr, wasmMsg)
+	return err
+}
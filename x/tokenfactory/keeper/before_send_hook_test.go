@@ -0,0 +1,237 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/sei-protocol/sei-chain/x/tokenfactory/keeper"
+	"github.com/sei-protocol/sei-chain/x/tokenfactory/types"
+)
+
+// mockContractKeeper mocks a before send hook contract, which records the
+// sudo messages it handles in the store, consuming gas, calling onSudo and
+// failing as set.
+type mockContractKeeper struct {
+	storeKey sdk.StoreKey
+	contract sdk.AccAddress
+	gas      uint64
+	err      error
+	onSudo   func(ctx sdk.Context) error
+	calls    int
+}
+
+func (m *mockContractKeeper) HasContractInfo(_ sdk.Context, contractAddress sdk.AccAddress) bool {
+	return contractAddress.Equals(m.contract)
+}
+
+func (m *mockContractKeeper) Sudo(ctx sdk.Context, _ sdk.AccAddress, msg []byte) ([]byte, error) {
+	m.calls++
+	ctx.KVStore(m.storeKey).Set([]byte("lastsudo"), msg)
+	ctx.GasMeter().ConsumeGas(m.gas, "mock sudo")
+	if m.onSudo != nil {
+		if err := m.onSudo(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return nil, m.err
+}
+
+func (m *mockContractKeeper) lastSudo(ctx sdk.Context) string {
+	return string(ctx.KVStore(m.storeKey).Get([]byte("lastsudo")))
+}
+
+// setupBeforeSendHook returns a copy of the keeper calling the mock contract.
+func (suite *KeeperTestSuite) setupBeforeSendHook() (keeper.Keeper, *mockContractKeeper) {
+	contractKeeper := &mockContractKeeper{
+		storeKey: suite.App.GetKey(types.StoreKey),
+		contract: suite.TestAccs[2],
+	}
+	k := suite.App.TokenFactoryKeeper
+	k.SetContractKeeper(contractKeeper)
+	return k, contractKeeper
+}
+
+func (suite *KeeperTestSuite) TestSetBeforeSendHook() {
+	suite.SetupTest()
+	suite.CreateDefaultDenom()
+
+	k, contractKeeper := suite.setupBeforeSendHook()
+	msgServer := keeper.NewMsgServerImpl(k)
+	admin, contract := suite.TestAccs[0].String(), contractKeeper.contract.String()
+
+	// only the admin can set the hook, which must be a contract
+	_, err := msgServer.SetBeforeSendHook(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetBeforeSendHook(suite.TestAccs[1].String(), suite.defaultDenom, contract))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = msgServer.SetBeforeSendHook(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetBeforeSendHook(admin, suite.defaultDenom, suite.TestAccs[1].String()))
+	suite.Require().ErrorIs(err, types.ErrInvalidBeforeSendHook)
+	_, err = msgServer.SetBeforeSendHook(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetBeforeSendHook(admin, suite.defaultDenom, contract))
+	suite.Require().NoError(err)
+
+	queryRes, err := suite.queryClient.BeforeSendHookAddress(suite.Ctx.Context(), &types.QueryBeforeSendHookAddressRequest{
+		Denom: suite.defaultDenom,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(contract, queryRes.CosmwasmAddress)
+
+	// an empty address removes the hook
+	_, err = msgServer.SetBeforeSendHook(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetBeforeSendHook(admin, suite.defaultDenom, ""))
+	suite.Require().NoError(err)
+	suite.Require().Empty(k.GetBeforeSendHook(suite.Ctx, suite.defaultDenom))
+}
+
+func (suite *KeeperTestSuite) TestBeforeSendHook() {
+	suite.SetupTest()
+	suite.CreateDefaultDenom()
+
+	k, contractKeeper := suite.setupBeforeSendHook()
+	hooks := k.Hooks()
+	from, to := suite.TestAccs[0], suite.TestAccs[1]
+	coin := sdk.NewInt64Coin(suite.defaultDenom, 10)
+
+	// denoms without a hook are not sent to the contract
+	suite.Require().NoError(hooks.BlockBeforeSend(suite.Ctx, from, to, sdk.NewCoins(coin)))
+	suite.Require().Empty(contractKeeper.lastSudo(suite.Ctx))
+
+	_, err := keeper.NewMsgServerImpl(k).SetBeforeSendHook(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetBeforeSendHook(from.String(), suite.defaultDenom, contractKeeper.contract.String()))
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(hooks.BlockBeforeSend(suite.Ctx, from, to, sdk.NewCoins(coin, sdk.NewInt64Coin("usei", 5))))
+	suite.Require().Equal(
+		fmt.Sprintf(`{"block_before_send":{"from":"%s","to":"%s","amount":{"denom":"%s","amount":"10"}}}`, from, to, suite.defaultDenom),
+		contractKeeper.lastSudo(suite.Ctx),
+	)
+	hooks.TrackBeforeSend(suite.Ctx, nil, to, sdk.NewCoins(coin))
+	suite.Require().Equal(
+		fmt.Sprintf(`{"track_before_send":{"from":"","to":"%s","amount":{"denom":"%s","amount":"10"}}}`, to, suite.defaultDenom),
+		contractKeeper.lastSudo(suite.Ctx),
+	)
+
+	// the contract rejects transfers by failing, and its state changes are
+	// discarded
+	contractKeeper.err = fmt.Errorf("transfer not allowed")
+	err = hooks.BlockBeforeSend(suite.Ctx, to, from, sdk.NewCoins(coin))
+	suite.Require().ErrorIs(err, types.ErrBeforeSendHookRejected)
+	suite.Require().Contains(contractKeeper.lastSudo(suite.Ctx), "track_before_send")
+
+	// but cannot reject tracked transfers
+	hooks.TrackBeforeSend(suite.Ctx, to, from, sdk.NewCoins(coin))
+	suite.Require().Contains(contractKeeper.lastSudo(suite.Ctx), `"from":""`)
+
+	// nor payouts of module accounts, which are only tracked
+	calls := contractKeeper.calls
+	moduleAddr := suite.App.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+	suite.Require().NoError(hooks.BlockBeforeSend(suite.Ctx, moduleAddr, to, sdk.NewCoins(coin)))
+	suite.Require().Equal(calls+1, contractKeeper.calls)
+	contractKeeper.err = nil
+	suite.Require().NoError(hooks.BlockBeforeSend(suite.Ctx, moduleAddr, to, sdk.NewCoins(coin)))
+	suite.Require().Equal(
+		fmt.Sprintf(`{"track_before_send":{"from":"%s","to":"%s","amount":{"denom":"%s","amount":"10"}}}`, moduleAddr, to, suite.defaultDenom),
+		contractKeeper.lastSudo(suite.Ctx),
+	)
+
+	// the gas consumed by the contract is charged to the transfer, up to the
+	// gas limit beyond which the transfer is rejected
+	contractKeeper.err = nil
+	contractKeeper.gas = 1000
+	gasConsumed := suite.Ctx.GasMeter().GasConsumed()
+	suite.Require().NoError(hooks.BlockBeforeSend(suite.Ctx, from, to, sdk.NewCoins(coin)))
+	suite.Require().GreaterOrEqual(suite.Ctx.GasMeter().GasConsumed()-gasConsumed, contractKeeper.gas)
+
+	params := k.GetParams(suite.Ctx)
+	contractKeeper.gas = params.BeforeSendHookGasLimit + 1
+	gasConsumed = suite.Ctx.GasMeter().GasConsumed()
+	err = hooks.BlockBeforeSend(suite.Ctx, to, from, sdk.NewCoins(coin))
+	suite.Require().ErrorIs(err, types.ErrBeforeSendHookRejected)
+	suite.Require().ErrorContains(err, "out of gas")
+	suite.Require().GreaterOrEqual(suite.Ctx.GasMeter().GasConsumed()-gasConsumed, params.BeforeSendHookGasLimit)
+}
+
+func (suite *KeeperTestSuite) TestBeforeSendHookGasLimitedByTransfer() {
+	suite.SetupTest()
+	suite.CreateDefaultDenom()
+
+	k, contractKeeper := suite.setupBeforeSendHook()
+	hooks := k.Hooks()
+	from, to := suite.TestAccs[0], suite.TestAccs[1]
+	coins := sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10))
+	_, err := keeper.NewMsgServerImpl(k).SetBeforeSendHook(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetBeforeSendHook(from.String(), suite.defaultDenom, contractKeeper.contract.String()))
+	suite.Require().NoError(err)
+
+	// the contract cannot consume more gas than is left to the transfer, even
+	// if the gas limit param allows it
+	params := k.GetParams(suite.Ctx)
+	contractKeeper.gas = params.BeforeSendHookGasLimit / 2
+	ctx := suite.Ctx.WithGasMeter(sdk.NewGasMeter(params.BeforeSendHookGasLimit))
+	ctx.GasMeter().ConsumeGas(params.BeforeSendHookGasLimit-contractKeeper.gas+1, "transfer")
+	err = hooks.BlockBeforeSend(ctx, from, to, coins)
+	suite.Require().ErrorIs(err, types.ErrBeforeSendHookRejected)
+	suite.Require().ErrorContains(err, "out of gas")
+	suite.Require().Equal(params.BeforeSendHookGasLimit, ctx.GasMeter().GasConsumed())
+
+	// the transfer also consumes gas reading the denom
+	ctx = suite.Ctx.WithGasMeter(sdk.NewGasMeter(params.BeforeSendHookGasLimit))
+	ctx.GasMeter().ConsumeGas(params.BeforeSendHookGasLimit-2*contractKeeper.gas, "transfer")
+	suite.Require().NoError(hooks.BlockBeforeSend(ctx, from, to, coins))
+	suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), params.BeforeSendHookGasLimit-contractKeeper.gas)
+}
+
+func (suite *KeeperTestSuite) TestBeforeSendHookReentrancy() {
+	suite.SetupTest()
+	suite.CreateDefaultDenom()
+
+	k, contractKeeper := suite.setupBeforeSendHook()
+	hooks := k.Hooks()
+	from, to, contract := suite.TestAccs[0], suite.TestAccs[1], contractKeeper.contract
+	coins := sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10))
+	_, err := keeper.NewMsgServerImpl(k).SetBeforeSendHook(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetBeforeSendHook(from.String(), suite.defaultDenom, contract.String()))
+	suite.Require().NoError(err)
+
+	// the contract sends the denom again while handling the transfer, which
+	// does not call the hook again
+	contractKeeper.onSudo = func(ctx sdk.Context) error {
+		if err := hooks.BlockBeforeSend(ctx, contract, to, coins); err != nil {
+			return err
+		}
+		hooks.TrackBeforeSend(ctx, contract, to, coins)
+		return nil
+	}
+	suite.Require().NoError(hooks.BlockBeforeSend(suite.Ctx, from, to, coins))
+	suite.Require().Equal(1, contractKeeper.calls)
+	hooks.TrackBeforeSend(suite.Ctx, from, to, coins)
+	suite.Require().Equal(2, contractKeeper.calls)
+
+	// but transfers of the denom after the hook returned do
+	contractKeeper.onSudo = nil
+	suite.Require().NoError(hooks.BlockBeforeSend(suite.Ctx, from, to, coins))
+	suite.Require().Equal(3, contractKeeper.calls)
+}
+
+func (suite *KeeperTestSuite) TestBeforeSendHookBankKeeper() {
+	suite.SetupTest()
+	suite.CreateDefaultDenom()
+
+	k, contractKeeper := suite.setupBeforeSendHook()
+	admin := suite.TestAccs[0]
+	coins := sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10))
+	_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 20)))
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.App.BankKeeper.SendCoinsFromAccountToModule(suite.Ctx, admin, authtypes.FeeCollectorName, coins))
+
+	_, err = keeper.NewMsgServerImpl(k).SetBeforeSendHook(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetBeforeSendHook(admin.String(), suite.defaultDenom, contractKeeper.contract.String()))
+	suite.Require().NoError(err)
+
+	// the bank keeper of the app calls the hook with the wasm keeper, which
+	// fails as the mock contract is not instantiated
+	err = suite.App.BankKeeper.SendCoins(suite.Ctx, admin, suite.TestAccs[1], coins)
+	suite.Require().ErrorIs(err, types.ErrBeforeSendHookRejected)
+
+	// transfers between modules are only tracked
+	suite.Require().NoError(suite.App.BankKeeper.SendCoinsFromModuleToModule(suite.Ctx, authtypes.FeeCollectorName, distrtypes.ModuleName, coins))
+
+	// and so are payouts of modules to accounts
+	suite.Require().NoError(suite.App.BankKeeper.SendCoinsFromModuleToAccount(suite.Ctx, distrtypes.ModuleName, suite.TestAccs[1], coins))
+	suite.Require().Equal(coins[0], suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[1], suite.defaultDenom))
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/sei-protocol/sei-chain/x/tokenfactory/types"
+)
+
+// GetBurnFromAllowance returns the amount of its balance of a specific denom
+// that the address approved the burner to burn, which is zero if there is no
+// approval
+func (k Keeper) GetBurnFromAllowance(ctx sdk.Context, denom string, address string, burner string) (sdk.Int, error) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get(types.GetBurnFromApprovalKey(address, burner))
+	if bz == nil {
+		return sdk.ZeroInt(), nil
+	}
+
+	approval := types.BurnFromApproval{}
+	err := proto.Unmarshal(bz, &approval)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	return approval.Allowance, nil
+}
+
+// setBurnFromAllowance sets the amount of its balance the address approved the
+// burner to burn, removing the approval when the allowance is not positive
+func (k Keeper) setBurnFromAllowance(ctx sdk.Context, denom string, address string, burner string, allowance sdk.Int) error {
+	store := k.GetDenomPrefixStore(ctx, denom)
+	if !allowance.IsPositive() {
+		store.Delete(types.GetBurnFromApprovalKey(address, burner))
+		return nil
+	}
+
+	bz, err := proto.Marshal(&types.BurnFromApproval{Address: address, Burner: burner, Allowance: allowance})
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.GetBurnFromApprovalKey(address, burner), bz)
+	return nil
+}
+
+// GetBurnFromApprovals returns the allowances approved by addresses for burns
+// of their balance of a specific denom
+func (k Keeper) GetBurnFromApprovals(ctx sdk.Context, denom string) ([]types.BurnFromApproval, error) {
+	store := prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.GetBurnFromApprovalsPrefix())
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var approvals []types.BurnFromApproval
+	for ; iterator.Valid(); iterator.Next() {
+		approval := types.BurnFromApproval{}
+		err := proto.Unmarshal(iterator.Value(), &approval)
+		if err != nil {
+			return nil, err
+		}
+		approvals = append(approvals, approval)
+	}
+	return approvals, nil
+}
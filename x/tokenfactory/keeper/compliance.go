@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/sei-protocol/sei-chain/x/tokenfactory/types"
+)
+
+// GetDenomCompliance returns the compliance state of a specific denom
+func (k Keeper) GetDenomCompliance(ctx sdk.Context, denom string) (types.DenomCompliance, error) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.DenomComplianceKey))
+
+	compliance := types.DenomCompliance{}
+	err := proto.Unmarshal(bz, &compliance)
+	if err != nil {
+		return types.DenomCompliance{}, err
+	}
+	return compliance, nil
+}
+
+// setDenomCompliance stores the compliance state of a specific denom
+func (k Keeper) setDenomCompliance(ctx sdk.Context, denom string, compliance types.DenomCompliance) error {
+	store := k.GetDenomPrefixStore(ctx, denom)
+
+	bz, err := proto.Marshal(&compliance)
+	if err != nil {
+		return err
+	}
+
+	store.Set([]byte(types.DenomComplianceKey), bz)
+	return nil
+}
+
+func (k Keeper) setPaused(ctx sdk.Context, denom string, paused bool) error {
+	compliance, err := k.GetDenomCompliance(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	compliance.Paused = paused
+
+	return k.setDenomCompliance(ctx, denom, compliance)
+}
+
+// IsFrozen returns whether the address is frozen for a specific denom
+func (k Keeper) IsFrozen(ctx sdk.Context, denom string, address string) bool {
+	return k.GetDenomPrefixStore(ctx, denom).Has(types.GetFrozenAddressKey(address))
+}
+
+func (k Keeper) setFrozen(ctx sdk.Context, denom string, address string, frozen bool) {
+	store := k.GetDenomPrefixStore(ctx, denom)
+	if frozen {
+		store.Set(types.GetFrozenAddressKey(address), []byte(address))
+	} else {
+		store.Delete(types.GetFrozenAddressKey(address))
+	}
+}
+
+// GetFrozenAddresses returns the addresses frozen for a specific denom
+func (k Keeper) GetFrozenAddresses(ctx sdk.Context, denom string) []string {
+	store := prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.GetFrozenAddressesPrefix())
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var addresses []string
+	for ; iterator.Valid(); iterator.Next() {
+		addresses = append(addresses, string(iterator.Value()))
+	}
+	return addresses
+}
+
+// isModuleAccount returns whether the address is the address of a module
+// account
+func (k Keeper) isModuleAccount(ctx sdk.Context, addr sdk.AccAddress) bool {
+	if addr.Empty() {
+		return false
+	}
+	_, ok := k.accountKeeper.GetAccount(ctx, addr).(authtypes.ModuleAccountI)
+	return ok
+}
+
+// checkCompliance rejects transfers of a paused denom, and transfers from or
+// to frozen addresses, if the compliance features of the denom are enabled.
+func (k Keeper) checkCompliance(ctx sdk.Context, from, to sdk.AccAddress, denom string) error {
+	compliance, err := k.GetDenomCompliance(ctx, denom)
+	if err != nil {
+		return err
+	}
+	if !compliance.Enabled {
+		return nil
+	}
+
+	if compliance.Paused {
+		return types.ErrDenomPaused.Wrapf("denom: %s", denom)
+	}
+	for _, addr := range []sdk.AccAddress{from, to} {
+		if !addr.Empty() && k.IsFrozen(ctx, denom, addr.String()) {
+			return types.ErrAddressFrozen.Wrapf("address: %s, denom: %s", addr, denom)
+		}
+	}
+	return nil
+}
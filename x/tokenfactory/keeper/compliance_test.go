@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/sei-protocol/sei-chain/x/tokenfactory/types"
+)
+
+func (suite *KeeperTestSuite) createComplianceDenom() string {
+	msg := types.NewMsgCreateDenom(suite.TestAccs[0].String(), "regulated")
+	msg.ComplianceEnabled = true
+	res, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().NoError(err)
+	return res.GetNewTokenDenom()
+}
+
+func (suite *KeeperTestSuite) TestComplianceNotEnabled() {
+	suite.SetupTest()
+	suite.CreateDefaultDenom()
+
+	admin := suite.TestAccs[0].String()
+
+	queryRes, err := suite.queryClient.DenomCompliance(suite.Ctx.Context(), &types.QueryDenomComplianceRequest{
+		Denom: suite.defaultDenom,
+	})
+	suite.Require().NoError(err)
+	suite.Require().False(queryRes.Compliance.Enabled)
+
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.Freeze(sdk.WrapSDKContext(suite.Ctx), types.NewMsgFreeze(admin, suite.defaultDenom, suite.TestAccs[1].String(), true))
+	suite.Require().ErrorIs(err, types.ErrComplianceNotEnabled)
+	_, err = suite.msgServer.Pause(sdk.WrapSDKContext(suite.Ctx), types.NewMsgPause(admin, suite.defaultDenom, true))
+	suite.Require().ErrorIs(err, types.ErrComplianceNotEnabled)
+	_, err = suite.msgServer.ForceTransfer(sdk.WrapSDKContext(suite.Ctx), types.NewMsgForceTransfer(admin, sdk.NewInt64Coin(suite.defaultDenom, 5), admin, suite.TestAccs[1].String()))
+	suite.Require().ErrorIs(err, types.ErrComplianceNotEnabled)
+}
+
+func (suite *KeeperTestSuite) TestFreeze() {
+	suite.SetupTest()
+	denom := suite.createComplianceDenom()
+
+	admin, holder, officer := suite.TestAccs[0], suite.TestAccs[1], suite.TestAccs[2]
+
+	queryRes, err := suite.queryClient.DenomCompliance(suite.Ctx.Context(), &types.QueryDenomComplianceRequest{
+		Denom: denom,
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(queryRes.Compliance.Enabled)
+
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(admin.String(), sdk.NewInt64Coin(denom, 100)))
+	suite.Require().NoError(err)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 10))
+	suite.Require().NoError(suite.App.BankKeeper.SendCoins(suite.Ctx, admin, holder, coins))
+
+	// only the admin and the compliance officer can freeze
+	_, err = suite.msgServer.Freeze(sdk.WrapSDKContext(suite.Ctx), types.NewMsgFreeze(officer.String(), denom, holder.String(), true))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.SetComplianceOfficer(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetComplianceOfficer(admin.String(), denom, officer.String()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Freeze(sdk.WrapSDKContext(suite.Ctx), types.NewMsgFreeze(officer.String(), denom, holder.String(), true))
+	suite.Require().NoError(err)
+
+	frozenRes, err := suite.queryClient.FrozenAddresses(suite.Ctx.Context(), &types.QueryFrozenAddressesRequest{
+		Denom: denom,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{holder.String()}, frozenRes.Addresses)
+
+	// frozen addresses can neither send nor receive the denom
+	err = suite.App.BankKeeper.SendCoins(suite.Ctx, holder, admin, coins)
+	suite.Require().ErrorIs(err, types.ErrAddressFrozen)
+	err = suite.App.BankKeeper.SendCoins(suite.Ctx, admin, holder, coins)
+	suite.Require().ErrorIs(err, types.ErrAddressFrozen)
+	err = suite.App.BankKeeper.InputOutputCoins(suite.Ctx,
+		[]banktypes.Input{banktypes.NewInput(holder, coins)},
+		[]banktypes.Output{banktypes.NewOutput(admin, coins)},
+	)
+	suite.Require().ErrorIs(err, types.ErrAddressFrozen)
+	err = suite.App.BankKeeper.SendCoinsFromAccountToModule(suite.Ctx, holder, types.ModuleName, coins)
+	suite.Require().ErrorIs(err, types.ErrAddressFrozen)
+
+	// but can still move other denoms
+	suite.FundAcc(holder, sdk.NewCoins(sdk.NewInt64Coin("usei", 10)))
+	suite.Require().NoError(suite.App.BankKeeper.SendCoins(suite.Ctx, holder, admin, sdk.NewCoins(sdk.NewInt64Coin("usei", 10))))
+
+	// force transfers are not restricted by freezes
+	_, err = suite.msgServer.ForceTransfer(sdk.WrapSDKContext(suite.Ctx), types.NewMsgForceTransfer(holder.String(), sdk.NewInt64Coin(denom, 5), holder.String(), admin.String()))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.ForceTransfer(sdk.WrapSDKContext(suite.Ctx), types.NewMsgForceTransfer(officer.String(), sdk.NewInt64Coin(denom, 5), holder.String(), admin.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(5), suite.App.BankKeeper.GetBalance(suite.Ctx, holder, denom).Amount.Int64())
+
+	// but cannot move the balances of module accounts
+	suite.Require().NoError(suite.App.BankKeeper.SendCoinsFromAccountToModule(suite.Ctx, admin, distrtypes.ModuleName, coins))
+	distrAddr := suite.App.AccountKeeper.GetModuleAddress(distrtypes.ModuleName).String()
+	_, err = suite.msgServer.ForceTransfer(sdk.WrapSDKContext(suite.Ctx), types.NewMsgForceTransfer(officer.String(), sdk.NewInt64Coin(denom, 5), distrAddr, admin.String()))
+	suite.Require().ErrorIs(err, types.ErrForceTransferNotAllowed)
+	_, err = suite.msgServer.ForceTransfer(sdk.WrapSDKContext(suite.Ctx), types.NewMsgForceTransfer(officer.String(), sdk.NewInt64Coin(denom, 5), holder.String(), distrAddr))
+	suite.Require().ErrorIs(err, types.ErrForceTransferNotAllowed)
+	suite.Require().Equal(coins, suite.App.BankKeeper.GetAllBalances(suite.Ctx, suite.App.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)))
+
+	_, err = suite.msgServer.Freeze(sdk.WrapSDKContext(suite.Ctx), types.NewMsgFreeze(admin.String(), denom, holder.String(), false))
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.App.BankKeeper.SendCoins(suite.Ctx, holder, admin, sdk.NewCoins(sdk.NewInt64Coin(denom, 5))))
+
+	frozenRes, err = suite.queryClient.FrozenAddresses(suite.Ctx.Context(), &types.QueryFrozenAddressesRequest{
+		Denom: denom,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Empty(frozenRes.Addresses)
+}
+
+func (suite *KeeperTestSuite) TestPause() {
+	suite.SetupTest()
+	denom := suite.createComplianceDenom()
+
+	admin, holder := suite.TestAccs[0], suite.TestAccs[1]
+
+	_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(admin.String(), sdk.NewInt64Coin(denom, 100)))
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.Pause(sdk.WrapSDKContext(suite.Ctx), types.NewMsgPause(holder.String(), denom, true))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.Pause(sdk.WrapSDKContext(suite.Ctx), types.NewMsgPause(admin.String(), denom, true))
+	suite.Require().NoError(err)
+
+	queryRes, err := suite.queryClient.DenomCompliance(suite.Ctx.Context(), &types.QueryDenomComplianceRequest{
+		Denom: denom,
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(queryRes.Compliance.Paused)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 10))
+	err = suite.App.BankKeeper.SendCoins(suite.Ctx, admin, holder, coins)
+	suite.Require().ErrorIs(err, types.ErrDenomPaused)
+
+	_, err = suite.msgServer.Pause(sdk.WrapSDKContext(suite.Ctx), types.NewMsgPause(admin.String(), denom, false))
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.App.BankKeeper.SendCoins(suite.Ctx, admin, holder, coins))
+}
+
+func (suite *KeeperTestSuite) TestPauseDoesNotBlockModulePayouts() {
+	suite.SetupTest()
+	denom := suite.createComplianceDenom()
+	admin := suite.TestAccs[0]
+
+	valAddr := suite.SetupValidator(stakingtypes.Bonded)
+	validator, found := suite.App.StakingKeeper.GetValidator(suite.Ctx, valAddr)
+	suite.Require().True(found)
+	delegator := sdk.AccAddress(valAddr)
+
+	// the denom is paid as rewards, e.g. after being paid as fees
+	_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(admin.String(), sdk.NewInt64Coin(denom, 100)))
+	suite.Require().NoError(err)
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(denom, 100))
+	suite.Require().NoError(suite.App.BankKeeper.SendCoinsFromAccountToModule(suite.Ctx, admin, distrtypes.ModuleName, rewards))
+	suite.Ctx = suite.Ctx.WithBlockHeight(suite.Ctx.BlockHeight() + 1)
+	suite.App.DistrKeeper.AllocateTokensToValidator(suite.Ctx, validator, sdk.NewDecCoinsFromCoins(rewards...))
+
+	_, err = suite.msgServer.Pause(sdk.WrapSDKContext(suite.Ctx), types.NewMsgPause(admin.String(), denom, true))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Freeze(sdk.WrapSDKContext(suite.Ctx), types.NewMsgFreeze(admin.String(), denom, delegator.String(), true))
+	suite.Require().NoError(err)
+
+	// withdrawing the rewards of a paused denom to a frozen address does not fail
+	withdrawn, err := suite.App.DistrKeeper.WithdrawDelegationRewards(suite.Ctx, delegator, valAddr)
+	suite.Require().NoError(err)
+	suite.Require().True(withdrawn.AmountOf(denom).IsPositive())
+	_, err = suite.App.DistrKeeper.WithdrawValidatorCommission(suite.Ctx, valAddr)
+	suite.Require().NoError(err)
+	suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, delegator, denom).Amount.GTE(withdrawn.AmountOf(denom)))
+
+	// while transfers between accounts are still rejected
+	err = suite.App.BankKeeper.SendCoins(suite.Ctx, admin, suite.TestAccs[1], sdk.NewCoins(sdk.NewInt64Coin(denom, 1)))
+	suite.Require().ErrorIs(err, types.ErrDenomPaused)
+}
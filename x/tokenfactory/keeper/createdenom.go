@@ -16,6 +16,11 @@ func (k Keeper) CreateDenom(ctx sdk.Context, creatorAddr string, subdenom string
 		return "", err
 	}
 
+	err = k.chargeForCreateDenom(ctx, creatorAddr)
+	if err != nil {
+		return "", err
+	}
+
 	err = k.createDenomAfterValidation(ctx, creatorAddr, denom)
 	return denom, err
 }
@@ -66,5 +71,46 @@ func (k Keeper) validateCreateDenom(ctx sdk.Context, creatorAddr string, subdeno
 		return "", types.ErrDenomExists
 	}
 
+	if maxDenoms := k.GetParams(ctx).MaxDenomsPerCreator; maxDenoms != 0 {
+		if uint64(len(k.getDenomsFromCreator(ctx, creatorAddr))) >= maxDenoms {
+			return "", types.ErrMaxDenomsPerCreator.Wrapf("max denoms per creator: %d", maxDenoms)
+		}
+	}
+
 	return denom, nil
 }
+
+func (k Keeper) chargeForCreateDenom(ctx sdk.Context, creatorAddr string) error {
+	params := k.GetParams(ctx)
+
+	// charge the denom creation fee, if any
+	if !params.DenomCreationFee.IsZero() {
+		accAddr, err := sdk.AccAddressFromBech32(creatorAddr)
+		if err != nil {
+			return err
+		}
+
+		if params.BurnDenomCreationFee {
+			err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, accAddr, types.ModuleName, params.DenomCreationFee)
+			if err != nil {
+				return types.ErrInsufficientDenomCreationFee.Wrap(err.Error())
+			}
+			err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, params.DenomCreationFee)
+			if err != nil {
+				return err
+			}
+		} else {
+			err = k.distrKeeper.FundCommunityPool(ctx, params.DenomCreationFee, accAddr)
+			if err != nil {
+				return types.ErrInsufficientDenomCreationFee.Wrap(err.Error())
+			}
+		}
+	}
+
+	// consume the denom creation gas, if any
+	if params.DenomCreationGasConsume != 0 {
+		ctx.GasMeter().ConsumeGas(params.DenomCreationGasConsume, "consume denom creation gas")
+	}
+
+	return nil
+}
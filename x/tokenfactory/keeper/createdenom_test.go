@@ -110,3 +110,70 @@ func (suite *KeeperTestSuite) TestCreateDenom() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestCreateDenomFee() {
+	for _, burn := range []bool{false, true} {
+		suite.Run(fmt.Sprintf("Case burn %t", burn), func() {
+			suite.SetupTest()
+
+			fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+			params := types.DefaultParams()
+			params.DenomCreationFee = fee
+			params.BurnDenomCreationFee = burn
+			params.DenomCreationGasConsume = 100000
+			suite.App.TokenFactoryKeeper.SetParams(suite.Ctx, params)
+
+			queryRes, err := suite.queryClient.Params(suite.Ctx.Context(), &types.QueryParamsRequest{})
+			suite.Require().NoError(err)
+			suite.Require().Equal(params, queryRes.Params)
+
+			// the creator can't pay the fee
+			creator := sdk.AccAddress("creator_____________")
+			_, err = suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateDenom(creator.String(), "bitcoin"))
+			suite.Require().ErrorIs(err, types.ErrInsufficientDenomCreationFee)
+
+			suite.FundAcc(creator, fee)
+			communityPool := suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx)
+			supply := suite.App.BankKeeper.GetSupply(suite.Ctx, sdk.DefaultBondDenom)
+			gasConsumed := suite.Ctx.GasMeter().GasConsumed()
+
+			_, err = suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateDenom(creator.String(), "bitcoin"))
+			suite.Require().NoError(err)
+			suite.Require().True(suite.App.BankKeeper.GetAllBalances(suite.Ctx, creator).IsZero())
+			suite.Require().GreaterOrEqual(suite.Ctx.GasMeter().GasConsumed()-gasConsumed, params.DenomCreationGasConsume)
+
+			if burn {
+				suite.Require().True(supply.Sub(fee[0]).IsEqual(suite.App.BankKeeper.GetSupply(suite.Ctx, sdk.DefaultBondDenom)))
+				suite.Require().Equal(communityPool, suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx))
+			} else {
+				suite.Require().True(supply.IsEqual(suite.App.BankKeeper.GetSupply(suite.Ctx, sdk.DefaultBondDenom)))
+				suite.Require().Equal(communityPool.Add(sdk.NewDecCoinsFromCoins(fee...)...), suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMaxDenomsPerCreator() {
+	suite.SetupTest()
+
+	params := types.DefaultParams()
+	params.MaxDenomsPerCreator = 2
+	suite.App.TokenFactoryKeeper.SetParams(suite.Ctx, params)
+
+	for _, subdenom := range []string{"bitcoin", "litecoin"} {
+		_, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateDenom(suite.TestAccs[0].String(), subdenom))
+		suite.Require().NoError(err)
+	}
+	_, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateDenom(suite.TestAccs[0].String(), "dogecoin"))
+	suite.Require().ErrorIs(err, types.ErrMaxDenomsPerCreator)
+
+	// the limit is per creator
+	_, err = suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateDenom(suite.TestAccs[1].String(), "dogecoin"))
+	suite.Require().NoError(err)
+
+	// and can be lifted
+	params.MaxDenomsPerCreator = 0
+	suite.App.TokenFactoryKeeper.SetParams(suite.Ctx, params)
+	_, err = suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateDenom(suite.TestAccs[0].String(), "dogecoin"))
+	suite.Require().NoError(err)
+}
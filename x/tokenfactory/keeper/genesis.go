@@ -26,6 +26,22 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
+		if genDenom.Compliance.Enabled {
+			err = k.setDenomCompliance(ctx, genDenom.GetDenom(), genDenom.GetCompliance())
+			if err != nil {
+				panic(err)
+			}
+		}
+		for _, address := range genDenom.GetFrozenAddresses() {
+			k.setFrozen(ctx, genDenom.GetDenom(), address, true)
+		}
+		for _, approval := range genDenom.GetBurnFromApprovals() {
+			err = k.setBurnFromAllowance(ctx, genDenom.GetDenom(), approval.Address, approval.Burner, approval.Allowance)
+			if err != nil {
+				panic(err)
+			}
+		}
+		k.setBeforeSendHook(ctx, genDenom.GetDenom(), genDenom.GetBeforeSendHook())
 	}
 }
 
@@ -42,9 +58,23 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 			panic(err)
 		}
 
+		compliance, err := k.GetDenomCompliance(ctx, denom)
+		if err != nil {
+			panic(err)
+		}
+
+		burnFromApprovals, err := k.GetBurnFromApprovals(ctx, denom)
+		if err != nil {
+			panic(err)
+		}
+
 		genDenoms = append(genDenoms, types.GenesisDenom{
 			Denom:             denom,
 			AuthorityMetadata: authorityMetadata,
+			Compliance:        compliance,
+			FrozenAddresses:   k.GetFrozenAddresses(ctx, denom),
+			BurnFromApprovals: burnFromApprovals,
+			BeforeSendHook:    k.GetBeforeSendHook(ctx, denom),
 		})
 	}
 
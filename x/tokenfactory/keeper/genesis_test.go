@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
@@ -28,6 +29,24 @@ func (suite *KeeperTestSuite) TestGenesis() {
 					Admin: "sei1y3pxq5dp900czh0mkudhjdqjq5m8cpmmps8yjw",
 				},
 			},
+			{
+				Denom: "factory/sei1y3pxq5dp900czh0mkudhjdqjq5m8cpmmps8yjw/regulated",
+				AuthorityMetadata: types.DenomAuthorityMetadata{
+					Admin:             "sei1y3pxq5dp900czh0mkudhjdqjq5m8cpmmps8yjw",
+					ComplianceOfficer: "sei1hjfwcza3e3uzeznf3qthhakdr9juetl7g6esl4",
+				},
+				Compliance: types.DenomCompliance{
+					Enabled: true,
+					Paused:  true,
+				},
+				FrozenAddresses: []string{"sei1hjfwcza3e3uzeznf3qthhakdr9juetl7g6esl4"},
+				BurnFromApprovals: []types.BurnFromApproval{{
+					Address:   "sei1hjfwcza3e3uzeznf3qthhakdr9juetl7g6esl4",
+					Burner:    "sei1y3pxq5dp900czh0mkudhjdqjq5m8cpmmps8yjw",
+					Allowance: sdk.NewInt(1000),
+				}},
+				BeforeSendHook: "sei1hjfwcza3e3uzeznf3qthhakdr9juetl7g6esl4",
+			},
 		},
 	}
 	app := suite.App
@@ -36,6 +36,29 @@ func (k Keeper) DenomsFromCreator(ctx context.Context, req *types.QueryDenomsFro
 	return &types.QueryDenomsFromCreatorResponse{Denoms: denoms}, nil
 }
 
+func (k Keeper) DenomCompliance(ctx context.Context, req *types.QueryDenomComplianceRequest) (*types.QueryDenomComplianceResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	compliance, err := k.GetDenomCompliance(sdkCtx, req.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomComplianceResponse{Compliance: compliance}, nil
+}
+
+func (k Keeper) FrozenAddresses(ctx context.Context, req *types.QueryFrozenAddressesRequest) (*types.QueryFrozenAddressesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	addresses := k.GetFrozenAddresses(sdkCtx, req.GetDenom())
+	return &types.QueryFrozenAddressesResponse{Addresses: addresses}, nil
+}
+
+func (k Keeper) BeforeSendHookAddress(ctx context.Context, req *types.QueryBeforeSendHookAddressRequest) (*types.QueryBeforeSendHookAddressResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cosmwasmAddress := k.GetBeforeSendHook(sdkCtx, req.GetDenom())
+	return &types.QueryBeforeSendHookAddressResponse{CosmwasmAddress: cosmwasmAddress}, nil
+}
+
 // DenomMetadata implements Query/DenomMetadata gRPC method.
 func (k Keeper) DenomMetadata(c context.Context, req *types.QueryDenomMetadataRequest) (*types.QueryDenomMetadataResponse, error) {
 	if req == nil {
@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/sei-protocol/sei-chain/x/tokenfactory/types"
+)
+
+var _ bankkeeper.Keeper = HookedBankKeeper{}
+
+// HookedBankKeeper wraps the bank keeper to run the bank hooks before coins are
+// transferred from or to an account or module. Transfers between modules, which
+// must not fail, are only tracked, and so are payouts of module accounts to
+// accounts. Minting and burning coins are not hooked.
+type HookedBankKeeper struct {
+	bankkeeper.BaseKeeper
+
+	// hooks is shared by the copies of the keeper, so the hooks can be set
+	// after the keeper is given to other modules
+	hooks *types.BankHooks
+}
+
+// NewHookedBankKeeper returns a bank keeper running the bank hooks, once they
+// are set, before transfers.
+func NewHookedBankKeeper(bankKeeper bankkeeper.BaseKeeper) HookedBankKeeper {
+	return HookedBankKeeper{
+		BaseKeeper: bankKeeper,
+		hooks:      new(types.BankHooks),
+	}
+}
+
+// SetHooks sets the bank hooks of the keeper and of all its copies.
+func (k HookedBankKeeper) SetHooks(hooks types.BankHooks) HookedBankKeeper {
+	if *k.hooks != nil {
+		panic("cannot set bank hooks twice")
+	}
+
+	*k.hooks = hooks
+	return k
+}
+
+func (k HookedBankKeeper) blockBeforeSend(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+	if *k.hooks == nil {
+		return nil
+	}
+	return (*k.hooks).BlockBeforeSend(ctx, from, to, amt)
+}
+
+func (k HookedBankKeeper) trackBeforeSend(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) {
+	if *k.hooks == nil {
+		return
+	}
+	(*k.hooks).TrackBeforeSend(ctx, from, to, amt)
+}
+
+// SendCoins runs the bank hooks before transferring coins between accounts.
+func (k HookedBankKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.blockBeforeSend(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+	return k.BaseKeeper.SendCoins(ctx, fromAddr, toAddr, amt)
+}
+
+// InputOutputCoins runs the bank hooks for each input and output of a
+// multi-send, which do not pair senders with recipients.
+func (k HookedBankKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
+	for _, in := range inputs {
+		inAddress, err := sdk.AccAddressFromBech32(in.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.blockBeforeSend(ctx, inAddress, nil, in.Coins); err != nil {
+			return err
+		}
+	}
+	for _, out := range outputs {
+		outAddress, err := sdk.AccAddressFromBech32(out.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.blockBeforeSend(ctx, nil, outAddress, out.Coins); err != nil {
+			return err
+		}
+	}
+	return k.BaseKeeper.InputOutputCoins(ctx, inputs, outputs)
+}
+
+// SendCoinsFromModuleToAccount runs the bank hooks before transferring coins
+// from a module to an account.
+func (k HookedBankKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.blockBeforeSend(ctx, authtypes.NewModuleAddress(senderModule), recipientAddr, amt); err != nil {
+		return err
+	}
+	return k.BaseKeeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+}
+
+// SendCoinsFromModuleToModule runs the tracking bank hooks before transferring
+// coins between modules, which cannot be rejected.
+func (k HookedBankKeeper) SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	k.trackBeforeSend(ctx, authtypes.NewModuleAddress(senderModule), authtypes.NewModuleAddress(recipientModule), amt)
+	return k.BaseKeeper.SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt)
+}
+
+// SendCoinsFromAccountToModule runs the bank hooks before transferring coins
+// from an account to a module.
+func (k HookedBankKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	if err := k.blockBeforeSend(ctx, senderAddr, authtypes.NewModuleAddress(recipientModule), amt); err != nil {
+		return err
+	}
+	return k.BaseKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+}
+
+// DeferredSendCoinsFromAccountToModule runs the bank hooks before deferring a
+// transfer of coins from an account to a module.
+func (k HookedBankKeeper) DeferredSendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	if err := k.blockBeforeSend(ctx, senderAddr, authtypes.NewModuleAddress(recipientModule), amt); err != nil {
+		return err
+	}
+	return k.BaseKeeper.DeferredSendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+}
+
+// DelegateCoinsFromAccountToModule runs the bank hooks before delegating coins
+// from an account to a module.
+func (k HookedBankKeeper) DelegateCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	if err := k.blockBeforeSend(ctx, senderAddr, authtypes.NewModuleAddress(recipientModule), amt); err != nil {
+		return err
+	}
+	return k.BaseKeeper.DelegateCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+}
+
+// UndelegateCoinsFromModuleToAccount runs the bank hooks before undelegating
+// coins from a module to an account.
+func (k HookedBankKeeper) UndelegateCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.blockBeforeSend(ctx, authtypes.NewModuleAddress(senderModule), recipientAddr, amt); err != nil {
+		return err
+	}
+	return k.BaseKeeper.UndelegateCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+}
+
+// DelegateCoins runs the bank hooks before delegating coins from an account to
+// a module account.
+func (k HookedBankKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.blockBeforeSend(ctx, delegatorAddr, moduleAccAddr, amt); err != nil {
+		return err
+	}
+	return k.BaseKeeper.DelegateCoins(ctx, delegatorAddr, moduleAccAddr, amt)
+}
+
+// UndelegateCoins runs the bank hooks before undelegating coins from a module
+// account to an account.
+func (k HookedBankKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.blockBeforeSend(ctx, moduleAccAddr, delegatorAddr, amt); err != nil {
+		return err
+	}
+	return k.BaseKeeper.UndelegateCoins(ctx, moduleAccAddr, delegatorAddr, amt)
+}
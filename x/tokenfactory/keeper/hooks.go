@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/tokenfactory/types"
+)
+
+// Hooks implements the bank hooks restricting the transfers of compliance
+// enabled denoms, and calling the before send hook contracts of denoms.
+type Hooks struct {
+	k Keeper
+}
+
+var _ types.BankHooks = Hooks{}
+
+// Hooks returns the bank hooks of the module
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// BlockBeforeSend rejects transfers of paused denoms, transfers from or to
+// frozen addresses, and transfers rejected by the before send hook contract
+// of their denom. Payouts of module accounts, such as reward withdrawals, must
+// not fail, so they are only tracked.
+func (h Hooks) BlockBeforeSend(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	coins := tokenfactoryCoins(amount)
+	if len(coins) == 0 {
+		return nil
+	}
+	if h.k.isModuleAccount(ctx, from) {
+		h.TrackBeforeSend(ctx, from, to, coins)
+		return nil
+	}
+
+	for _, coin := range coins {
+		if err := h.k.checkCompliance(ctx, from, to, coin.Denom); err != nil {
+			return err
+		}
+		if err := h.k.callBeforeSendHook(ctx, from, to, coin, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TrackBeforeSend notifies the before send hook contracts of transfers of
+// their denom, which cannot be rejected.
+func (h Hooks) TrackBeforeSend(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) {
+	for _, coin := range tokenfactoryCoins(amount) {
+		// errors of tracking calls are logged by the call
+		_ = h.k.callBeforeSendHook(ctx, from, to, coin, false)
+	}
+}
+
+// tokenfactoryCoins returns the coins of tokenfactory denoms
+func tokenfactoryCoins(amount sdk.Coins) sdk.Coins {
+	coins := sdk.Coins{}
+	for _, coin := range amount {
+		if strings.HasPrefix(coin.Denom, types.ModuleDenomPrefix+"/") {
+			coins = append(coins, coin)
+		}
+	}
+	return coins
+}
@@ -24,6 +24,8 @@ type (
 		accountKeeper types.AccountKeeper
 		bankKeeper    types.BankKeeper
 		distrKeeper   types.DistrKeeper
+
+		contractKeeper types.ContractKeeper
 	}
 )
 
@@ -50,6 +52,12 @@ func NewKeeper(
 	}
 }
 
+// SetContractKeeper sets the wasm keeper calling the before send hook
+// contracts, which is created after the tokenfactory keeper.
+func (k *Keeper) SetContractKeeper(contractKeeper types.ContractKeeper) {
+	k.contractKeeper = contractKeeper
+}
+
 // Logger returns a logger for the x/tokenfactory module
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -59,6 +59,15 @@ func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 	return nil
 }
 
+// Migrate4to5 migrates from version 4 to 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	// Set the denom creation params, which default to no fee, gas nor limit,
+	// and the before send hook gas limit
+	defaultParams := types.DefaultParams()
+	m.keeper.paramSpace.SetParamSet(ctx, &defaultParams)
+	return nil
+}
+
 func (m Migrator) SetMetadata(denomMetadata *banktypes.Metadata) {
 	if len(denomMetadata.Base) == 0 {
 		panic(fmt.Errorf("no base exists for denom %v", denomMetadata))
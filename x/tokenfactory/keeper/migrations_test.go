@@ -62,16 +62,16 @@ func TestMigrate2to3(t *testing.T) {
 	require.False(t, store.Has(oldCreateDenomFeeWhitelistPrefix))
 	require.False(t, store.Has(oldCreatorSpecificPrefix))
 
-	// Params should also be empty
+	// Params should also be the defaults
 	params := types.Params{}
 	paramsSubspace.GetParamSet(ctx, &params)
-	require.Equal(t, types.Params{}, params)
+	require.Equal(t, types.NewParams(nil, false, 0, 0, types.DefaultBeforeSendHookGasLimit), params)
 }
 
 func TestMigrate3To4(t *testing.T) {
 	// Test migration with all metadata denom
 	metadata := banktypes.Metadata{Description: sdk.DefaultBondDenom, Base: sdk.DefaultBondDenom, Display: sdk.DefaultBondDenom, Name: sdk.DefaultBondDenom, Symbol: sdk.DefaultBondDenom}
-	keeper := NewKeeper(nil, nil, typesparams.Subspace{}, nil, nil, nil)
+	keeper := NewKeeper(nil, nil, typesparams.NewSubspace(nil, nil, nil, nil, "TokenfactoryParams"), nil, nil, nil)
 	m := NewMigrator(keeper)
 	m.SetMetadata(&metadata)
 	require.Equal(t, sdk.DefaultBondDenom, metadata.Display)
@@ -85,3 +85,38 @@ func TestMigrate3To4(t *testing.T) {
 	require.Equal(t, testDenom, metadata.Name)
 	require.Equal(t, testDenom, metadata.Symbol)
 }
+
+func TestMigrate4to5(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
+
+	db := tmdb.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db)
+	stateStore.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(memStoreKey, sdk.StoreTypeMemory, nil)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	registry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(registry)
+
+	paramsSubspace := typesparams.NewSubspace(cdc,
+		types.Amino,
+		storeKey,
+		memStoreKey,
+		"TokenfactoryParams",
+	)
+	paramsSubspace = paramsSubspace.WithKeyTable(types.ParamKeyTable())
+
+	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+	newKeeper := NewKeeper(cdc, storeKey, paramsSubspace, nil, nil, nil)
+	m := NewMigrator(newKeeper)
+	require.NoError(t, m.Migrate4to5(ctx))
+
+	// The denom creation and before send hook params should be set to their defaults
+	params := newKeeper.GetParams(ctx)
+	require.True(t, params.DenomCreationFee.IsZero())
+	require.False(t, params.BurnDenomCreationFee)
+	require.Equal(t, uint64(0), params.DenomCreationGasConsume)
+	require.Equal(t, uint64(0), params.MaxDenomsPerCreator)
+	require.Equal(t, uint64(types.DefaultBeforeSendHookGasLimit), params.BeforeSendHookGasLimit)
+}
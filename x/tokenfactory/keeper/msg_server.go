@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -28,11 +29,19 @@ func (server msgServer) CreateDenom(goCtx context.Context, msg *types.MsgCreateD
 		return nil, err
 	}
 
+	if msg.ComplianceEnabled {
+		err = server.Keeper.setDenomCompliance(ctx, denom, types.DenomCompliance{Enabled: true})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.TypeMsgCreateDenom,
 			sdk.NewAttribute(types.AttributeCreator, msg.Sender),
 			sdk.NewAttribute(types.AttributeNewTokenDenom, denom),
+			sdk.NewAttribute(types.AttributeComplianceEnabled, strconv.FormatBool(msg.ComplianceEnabled)),
 		),
 	})
 
@@ -55,19 +64,35 @@ func (server msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
-		return nil, types.ErrUnauthorized
+	// minters other than the admin spend their allowance
+	isAdmin := msg.Sender == authorityMetadata.GetAdmin()
+	allowance, isMinter := authorityMetadata.GetMintAllowance(msg.Sender)
+	if !isAdmin {
+		if !isMinter {
+			return nil, types.ErrUnauthorized
+		}
+		if msg.Amount.Amount.GT(allowance) {
+			return nil, types.ErrMintAllowanceExceeded.Wrapf("allowance: %s", allowance)
+		}
 	}
 
-	err = server.Keeper.mintTo(ctx, msg.Amount, msg.Sender)
+	err = server.Keeper.mintTo(ctx, msg.Amount, msg.GetRecipient())
 	if err != nil {
 		return nil, err
 	}
 
+	if !isAdmin {
+		err = server.Keeper.setMintAllowance(ctx, msg.Amount.Denom, msg.Sender, allowance.Sub(msg.Amount.Amount))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.TypeMsgMint,
-			sdk.NewAttribute(types.AttributeMintToAddress, msg.Sender),
+			sdk.NewAttribute(types.AttributeMinter, msg.Sender),
+			sdk.NewAttribute(types.AttributeMintToAddress, msg.GetRecipient()),
 			sdk.NewAttribute(types.AttributeAmount, msg.Amount.String()),
 		),
 	})
@@ -83,11 +108,19 @@ func (server msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
-		return nil, types.ErrUnauthorized
+	holder := msg.GetHolder()
+	if holder == msg.Sender {
+		if !authorityMetadata.CanBurn(msg.Sender) {
+			return nil, types.ErrUnauthorized
+		}
+	} else {
+		err = server.spendBurnFromAuthority(ctx, authorityMetadata, msg.Amount, msg.Sender, holder)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	err = server.Keeper.burnFrom(ctx, msg.Amount, msg.Sender)
+	err = server.Keeper.burnFrom(ctx, msg.Amount, holder)
 	if err != nil {
 		return nil, err
 	}
@@ -95,7 +128,8 @@ func (server msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.TypeMsgBurn,
-			sdk.NewAttribute(types.AttributeBurnFromAddress, msg.Sender),
+			sdk.NewAttribute(types.AttributeBurner, msg.Sender),
+			sdk.NewAttribute(types.AttributeBurnFromAddress, holder),
 			sdk.NewAttribute(types.AttributeAmount, msg.Amount.String()),
 		),
 	})
@@ -149,7 +183,7 @@ func (server msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSe
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
+	if !authorityMetadata.CanSetMetadata(msg.Sender) {
 		return nil, types.ErrUnauthorized
 	}
 
@@ -165,3 +199,323 @@ func (server msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSe
 
 	return &types.MsgSetDenomMetadataResponse{}, nil
 }
+
+func (server msgServer) SetMinter(goCtx context.Context, msg *types.MsgSetMinter) (*types.MsgSetMinterResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.setMintAllowance(ctx, msg.Denom, msg.Minter, msg.Allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetMinter,
+			sdk.NewAttribute(types.AttributeDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeMinter, msg.Minter),
+			sdk.NewAttribute(types.AttributeAllowance, msg.Allowance.String()),
+		),
+	})
+
+	return &types.MsgSetMinterResponse{}, nil
+}
+
+func (server msgServer) SetBurner(goCtx context.Context, msg *types.MsgSetBurner) (*types.MsgSetBurnerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.setBurner(ctx, msg.Denom, msg.Burner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetBurner,
+			sdk.NewAttribute(types.AttributeDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeBurner, msg.Burner),
+		),
+	})
+
+	return &types.MsgSetBurnerResponse{}, nil
+}
+
+func (server msgServer) SetMetadataUpdater(goCtx context.Context, msg *types.MsgSetMetadataUpdater) (*types.MsgSetMetadataUpdaterResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.setMetadataUpdater(ctx, msg.Denom, msg.MetadataUpdater)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetMetadataUpdater,
+			sdk.NewAttribute(types.AttributeDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeMetadataUpdater, msg.MetadataUpdater),
+		),
+	})
+
+	return &types.MsgSetMetadataUpdaterResponse{}, nil
+}
+
+func (server msgServer) SetMaxSupply(goCtx context.Context, msg *types.MsgSetMaxSupply) (*types.MsgSetMaxSupplyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.setMaxSupply(ctx, msg.Denom, msg.MaxSupply)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetMaxSupply,
+			sdk.NewAttribute(types.AttributeDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeMaxSupply, msg.MaxSupply.String()),
+		),
+	})
+
+	return &types.MsgSetMaxSupplyResponse{}, nil
+}
+
+func (server msgServer) SetComplianceOfficer(goCtx context.Context, msg *types.MsgSetComplianceOfficer) (*types.MsgSetComplianceOfficerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.setComplianceOfficer(ctx, msg.Denom, msg.ComplianceOfficer)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetComplianceOfficer,
+			sdk.NewAttribute(types.AttributeDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeComplianceOfficer, msg.ComplianceOfficer),
+		),
+	})
+
+	return &types.MsgSetComplianceOfficerResponse{}, nil
+}
+
+func (server msgServer) ForceTransfer(goCtx context.Context, msg *types.MsgForceTransfer) (*types.MsgForceTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := server.validateComplianceAuthority(ctx, msg.Sender, msg.Amount.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.Keeper.forceTransfer(ctx, msg.Amount, msg.TransferFromAddress, msg.TransferToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgForceTransfer,
+			sdk.NewAttribute(types.AttributeTransferFromAddress, msg.TransferFromAddress),
+			sdk.NewAttribute(types.AttributeTransferToAddress, msg.TransferToAddress),
+			sdk.NewAttribute(types.AttributeAmount, msg.Amount.String()),
+		),
+	})
+
+	return &types.MsgForceTransferResponse{}, nil
+}
+
+func (server msgServer) Freeze(goCtx context.Context, msg *types.MsgFreeze) (*types.MsgFreezeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := server.validateComplianceAuthority(ctx, msg.Sender, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	server.Keeper.setFrozen(ctx, msg.Denom, msg.Address, msg.Frozen)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgFreeze,
+			sdk.NewAttribute(types.AttributeDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeFrozen, strconv.FormatBool(msg.Frozen)),
+		),
+	})
+
+	return &types.MsgFreezeResponse{}, nil
+}
+
+func (server msgServer) Pause(goCtx context.Context, msg *types.MsgPause) (*types.MsgPauseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := server.validateComplianceAuthority(ctx, msg.Sender, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.Keeper.setPaused(ctx, msg.Denom, msg.Paused)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgPause,
+			sdk.NewAttribute(types.AttributeDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributePaused, strconv.FormatBool(msg.Paused)),
+		),
+	})
+
+	return &types.MsgPauseResponse{}, nil
+}
+
+func (server msgServer) ApproveBurnFrom(goCtx context.Context, msg *types.MsgApproveBurnFrom) (*types.MsgApproveBurnFromResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, denomExists := server.bankKeeper.GetDenomMetaData(ctx, msg.Denom)
+	if !denomExists {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", msg.Denom)
+	}
+
+	err := server.Keeper.setBurnFromAllowance(ctx, msg.Denom, msg.Sender, msg.Burner, msg.Allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgApproveBurnFrom,
+			sdk.NewAttribute(types.AttributeDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeAddress, msg.Sender),
+			sdk.NewAttribute(types.AttributeBurner, msg.Burner),
+			sdk.NewAttribute(types.AttributeAllowance, msg.Allowance.String()),
+		),
+	})
+
+	return &types.MsgApproveBurnFromResponse{}, nil
+}
+
+func (server msgServer) SetBeforeSendHook(goCtx context.Context, msg *types.MsgSetBeforeSendHook) (*types.MsgSetBeforeSendHookResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	if msg.CosmwasmAddress != "" && !server.Keeper.isContract(ctx, msg.CosmwasmAddress) {
+		return nil, types.ErrInvalidBeforeSendHook.Wrapf("address: %s", msg.CosmwasmAddress)
+	}
+
+	server.Keeper.setBeforeSendHook(ctx, msg.Denom, msg.CosmwasmAddress)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetBeforeSendHook,
+			sdk.NewAttribute(types.AttributeDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeBeforeSendHook, msg.CosmwasmAddress),
+		),
+	})
+
+	return &types.MsgSetBeforeSendHookResponse{}, nil
+}
+
+// spendBurnFromAuthority checks that the sender may burn the amount from the
+// balance of another address, either as a burner approved by the address, in
+// which case the amount is spent from the allowance it approved, or through
+// the compliance role of the denom.
+func (server msgServer) spendBurnFromAuthority(ctx sdk.Context, authorityMetadata types.DenomAuthorityMetadata, amount sdk.Coin, sender string, holder string) error {
+	compliance, err := server.Keeper.GetDenomCompliance(ctx, amount.Denom)
+	if err != nil {
+		return err
+	}
+	if compliance.Enabled && authorityMetadata.CanEnforceCompliance(sender) {
+		return nil
+	}
+
+	if !authorityMetadata.CanBurn(sender) {
+		return types.ErrUnauthorized
+	}
+	allowance, err := server.Keeper.GetBurnFromAllowance(ctx, amount.Denom, holder, sender)
+	if err != nil {
+		return err
+	}
+	if !allowance.IsPositive() {
+		return types.ErrBurnFromNotApproved.Wrapf("address: %s, burner: %s, denom: %s", holder, sender, amount.Denom)
+	}
+	if amount.Amount.GT(allowance) {
+		return types.ErrBurnFromAllowanceExceeded.Wrapf("allowance: %s", allowance)
+	}
+	return server.Keeper.setBurnFromAllowance(ctx, amount.Denom, holder, sender, allowance.Sub(amount.Amount))
+}
+
+// validateComplianceAuthority checks that the compliance features of the denom
+// are enabled, and that the sender may enforce them.
+func (server msgServer) validateComplianceAuthority(ctx sdk.Context, sender string, denom string) error {
+	compliance, err := server.Keeper.GetDenomCompliance(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if !compliance.Enabled {
+		return types.ErrComplianceNotEnabled.Wrapf("denom: %s", denom)
+	}
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if !authorityMetadata.CanEnforceCompliance(sender) {
+		return types.ErrUnauthorized
+	}
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -11,5 +13,92 @@ func (metadata DenomAuthorityMetadata) Validate() error {
 			return err
 		}
 	}
+
+	minters := make(map[string]struct{}, len(metadata.Minters))
+	for _, minter := range metadata.Minters {
+		if _, err := sdk.AccAddressFromBech32(minter.Address); err != nil {
+			return err
+		}
+		if _, ok := minters[minter.Address]; ok {
+			return fmt.Errorf("duplicate minter %s", minter.Address)
+		}
+		minters[minter.Address] = struct{}{}
+		if minter.Allowance.IsNil() || !minter.Allowance.IsPositive() {
+			return fmt.Errorf("minter %s must have a positive allowance", minter.Address)
+		}
+	}
+
+	if metadata.Burner != "" {
+		_, err := sdk.AccAddressFromBech32(metadata.Burner)
+		if err != nil {
+			return err
+		}
+	}
+
+	if metadata.MetadataUpdater != "" {
+		_, err := sdk.AccAddressFromBech32(metadata.MetadataUpdater)
+		if err != nil {
+			return err
+		}
+	}
+
+	if metadata.ComplianceOfficer != "" {
+		_, err := sdk.AccAddressFromBech32(metadata.ComplianceOfficer)
+		if err != nil {
+			return err
+		}
+	}
+
+	if metadata.MaxSupply != nil && (metadata.MaxSupply.IsNil() || metadata.MaxSupply.IsNegative()) {
+		return fmt.Errorf("max supply must not be negative")
+	}
 	return nil
 }
+
+// GetMintAllowance returns the remaining allowance of a minter, if it is one.
+func (metadata DenomAuthorityMetadata) GetMintAllowance(minter string) (sdk.Int, bool) {
+	for _, m := range metadata.Minters {
+		if m.Address == minter {
+			return m.Allowance, true
+		}
+	}
+	return sdk.ZeroInt(), false
+}
+
+// SetMintAllowance sets the remaining allowance of a minter, removing the
+// minter when the allowance is not positive.
+func (metadata *DenomAuthorityMetadata) SetMintAllowance(minter string, allowance sdk.Int) {
+	minters := make([]MinterAllowance, 0, len(metadata.Minters)+1)
+	found := false
+	for _, m := range metadata.Minters {
+		if m.Address == minter {
+			found = true
+			if !allowance.IsPositive() {
+				continue
+			}
+			m.Allowance = allowance
+		}
+		minters = append(minters, m)
+	}
+	if !found && allowance.IsPositive() {
+		minters = append(minters, MinterAllowance{Address: minter, Allowance: allowance})
+	}
+	metadata.Minters = minters
+}
+
+// CanBurn returns whether the address is allowed to burn the denom.
+func (metadata DenomAuthorityMetadata) CanBurn(address string) bool {
+	return address == metadata.Admin || (metadata.Burner != "" && address == metadata.Burner)
+}
+
+// CanSetMetadata returns whether the address is allowed to set the bank
+// metadata of the denom.
+func (metadata DenomAuthorityMetadata) CanSetMetadata(address string) bool {
+	return address == metadata.Admin || (metadata.MetadataUpdater != "" && address == metadata.MetadataUpdater)
+}
+
+// CanEnforceCompliance returns whether the address is allowed to force
+// transfer, freeze and pause the denom, if its compliance features are enabled.
+func (metadata DenomAuthorityMetadata) CanEnforceCompliance(address string) bool {
+	return address == metadata.Admin || (metadata.ComplianceOfficer != "" && address == metadata.ComplianceOfficer)
+}
@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -25,11 +26,23 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // DenomAuthorityMetadata specifies metadata for addresses that have specific
-// capabilities over a token factory denom. Right now there is only one Admin
-// permission, but is planned to be extended to the future.
+// capabilities over a token factory denom. The admin has every capability and
+// manages the other roles, which each grant a single capability.
 type DenomAuthorityMetadata struct {
 	// Can be empty for no admin, or a valid sei address
 	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// Addresses allowed to mint up to their remaining allowance
+	Minters []MinterAllowance `protobuf:"bytes,2,rep,name=minters,proto3" json:"minters" yaml:"minters"`
+	// Can be empty for no burner, or a valid sei address allowed to burn
+	Burner string `protobuf:"bytes,3,opt,name=burner,proto3" json:"burner,omitempty" yaml:"burner"`
+	// Can be empty for no metadata updater, or a valid sei address allowed to
+	// set the bank metadata of the denom
+	MetadataUpdater string `protobuf:"bytes,4,opt,name=metadata_updater,json=metadataUpdater,proto3" json:"metadata_updater,omitempty" yaml:"metadata_updater"`
+	// Maximum total supply of the denom, or empty for no maximum
+	MaxSupply *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply,omitempty" yaml:"max_supply"`
+	// Can be empty for no compliance officer, or a valid sei address allowed to
+	// force transfer, freeze and pause a compliance enabled denom
+	ComplianceOfficer string `protobuf:"bytes,6,opt,name=compliance_officer,json=complianceOfficer,proto3" json:"compliance_officer,omitempty" yaml:"compliance_officer"`
 }
 
 func (m *DenomAuthorityMetadata) Reset()         { *m = DenomAuthorityMetadata{} }
@@ -72,8 +85,139 @@ func (m *DenomAuthorityMetadata) GetAdmin() string {
 	return ""
 }
 
+func (m *DenomAuthorityMetadata) GetMinters() []MinterAllowance {
+	if m != nil {
+		return m.Minters
+	}
+	return nil
+}
+
+func (m *DenomAuthorityMetadata) GetBurner() string {
+	if m != nil {
+		return m.Burner
+	}
+	return ""
+}
+
+func (m *DenomAuthorityMetadata) GetMetadataUpdater() string {
+	if m != nil {
+		return m.MetadataUpdater
+	}
+	return ""
+}
+
+func (m *DenomAuthorityMetadata) GetComplianceOfficer() string {
+	if m != nil {
+		return m.ComplianceOfficer
+	}
+	return ""
+}
+
+// MinterAllowance defines the amount of a denom a minter may still mint.
+type MinterAllowance struct {
+	Address   string                                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Allowance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=allowance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"allowance" yaml:"allowance"`
+}
+
+func (m *MinterAllowance) Reset()         { *m = MinterAllowance{} }
+func (m *MinterAllowance) String() string { return proto.CompactTextString(m) }
+func (*MinterAllowance) ProtoMessage()    {}
+func (*MinterAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b180705dfb8b5c4, []int{1}
+}
+func (m *MinterAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinterAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MinterAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MinterAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinterAllowance.Merge(m, src)
+}
+func (m *MinterAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinterAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinterAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinterAllowance proto.InternalMessageInfo
+
+func (m *MinterAllowance) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// BurnFromApproval defines the amount of a denom a burner may still burn from
+// the balance of the address which approved it.
+type BurnFromApproval struct {
+	Address   string                                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Burner    string                                 `protobuf:"bytes,2,opt,name=burner,proto3" json:"burner,omitempty" yaml:"burner"`
+	Allowance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=allowance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"allowance" yaml:"allowance"`
+}
+
+func (m *BurnFromApproval) Reset()         { *m = BurnFromApproval{} }
+func (m *BurnFromApproval) String() string { return proto.CompactTextString(m) }
+func (*BurnFromApproval) ProtoMessage()    {}
+func (*BurnFromApproval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b180705dfb8b5c4, []int{2}
+}
+func (m *BurnFromApproval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BurnFromApproval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BurnFromApproval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BurnFromApproval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurnFromApproval.Merge(m, src)
+}
+func (m *BurnFromApproval) XXX_Size() int {
+	return m.Size()
+}
+func (m *BurnFromApproval) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurnFromApproval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BurnFromApproval proto.InternalMessageInfo
+
+func (m *BurnFromApproval) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BurnFromApproval) GetBurner() string {
+	if m != nil {
+		return m.Burner
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*DenomAuthorityMetadata)(nil), "seiprotocol.seichain.tokenfactory.DenomAuthorityMetadata")
+	proto.RegisterType((*MinterAllowance)(nil), "seiprotocol.seichain.tokenfactory.MinterAllowance")
+	proto.RegisterType((*BurnFromApproval)(nil), "seiprotocol.seichain.tokenfactory.BurnFromApproval")
 }
 
 func init() {
@@ -81,23 +225,41 @@ func init() {
 }
 
 var fileDescriptor_5b180705dfb8b5c4 = []byte{
-	// 242 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x29, 0xc9, 0xcf, 0x4e,
-	0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa, 0xd4, 0x4f, 0x2c, 0x2d, 0xc9, 0xc8, 0x2f, 0xca,
-	0x2c, 0xa9, 0xf4, 0x4d, 0x2d, 0x49, 0x4c, 0x49, 0x2c, 0x49, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x52, 0x2c, 0x4e, 0xcd, 0x04, 0xb3, 0x92, 0xf3, 0x73, 0xf4, 0x8a, 0x53, 0x33, 0x93, 0x33,
-	0x12, 0x33, 0xf3, 0xf4, 0x90, 0xb5, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0xd5, 0xe8, 0x83,
-	0x58, 0x10, 0x8d, 0x52, 0x72, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xfa, 0x49, 0x89, 0xc5, 0xa9,
-	0xfa, 0x65, 0x86, 0x49, 0xa9, 0x25, 0x89, 0x86, 0xfa, 0xc9, 0xf9, 0x99, 0x79, 0x10, 0x79, 0x25,
-	0x37, 0x2e, 0x31, 0x97, 0xd4, 0xbc, 0xfc, 0x5c, 0x47, 0x74, 0x8b, 0x85, 0xd4, 0xb8, 0x58, 0x13,
-	0x53, 0x72, 0x33, 0xf3, 0x24, 0x18, 0x15, 0x18, 0x35, 0x38, 0x9d, 0x04, 0x3e, 0xdd, 0x93, 0xe7,
-	0xa9, 0x4c, 0xcc, 0xcd, 0xb1, 0x52, 0x02, 0x0b, 0x2b, 0x05, 0x41, 0xa4, 0xad, 0x58, 0x5e, 0x2c,
-	0x90, 0x67, 0x74, 0x0a, 0x38, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4,
-	0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xb3,
-	0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xe2, 0xd4, 0x4c, 0x5d, 0x98,
-	0x37, 0xc0, 0x1c, 0xb0, 0x3f, 0xf4, 0x2b, 0xf4, 0x51, 0x02, 0xa1, 0xa4, 0xb2, 0x20, 0xb5, 0x38,
-	0x89, 0x0d, 0xac, 0xd0, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xb6, 0x2e, 0x50, 0x16, 0x21, 0x01,
-	0x00, 0x00,
+	// 531 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcf, 0x6e, 0xd3, 0x30,
+	0x18, 0x6f, 0xda, 0xad, 0x53, 0xcd, 0x9f, 0xb5, 0x16, 0x1a, 0x61, 0x88, 0x64, 0x58, 0x68, 0x1a,
+	0x12, 0x4b, 0xb4, 0x21, 0x71, 0xd8, 0xad, 0x05, 0x4d, 0x42, 0x62, 0x02, 0x05, 0x71, 0xe1, 0x52,
+	0x9c, 0xc4, 0x6d, 0xad, 0xc5, 0x71, 0x64, 0x3b, 0xa3, 0x7d, 0x0b, 0x1e, 0x81, 0x1b, 0xaf, 0xd2,
+	0xe3, 0x10, 0x17, 0xc4, 0x21, 0x42, 0xed, 0x85, 0x73, 0x9e, 0x00, 0xd5, 0x49, 0xe8, 0x56, 0x0e,
+	0xd3, 0x0e, 0x3b, 0xd5, 0xfd, 0xf9, 0xf7, 0xe7, 0xfb, 0x3e, 0xdb, 0x01, 0x4f, 0x14, 0x3f, 0x25,
+	0xf1, 0x00, 0x07, 0x8a, 0x8b, 0x89, 0x8b, 0x53, 0x35, 0xe2, 0x82, 0xaa, 0xc9, 0x09, 0x51, 0x38,
+	0xc4, 0x0a, 0x3b, 0x89, 0xe0, 0x8a, 0xc3, 0xc7, 0x92, 0x50, 0xbd, 0x0a, 0x78, 0xe4, 0x48, 0x42,
+	0x83, 0x11, 0xa6, 0xb1, 0x73, 0x51, 0xba, 0x7d, 0x6f, 0xc8, 0x87, 0x5c, 0x73, 0xdc, 0xc5, 0xaa,
+	0x10, 0x6e, 0x5b, 0x01, 0x97, 0x8c, 0x4b, 0xd7, 0xc7, 0x92, 0xb8, 0x67, 0x07, 0x3e, 0x51, 0xf8,
+	0xc0, 0x0d, 0x38, 0x8d, 0x8b, 0x7d, 0xf4, 0xbd, 0x01, 0xb6, 0x5e, 0x91, 0x98, 0xb3, 0xee, 0x6a,
+	0x32, 0xdc, 0x05, 0xeb, 0x38, 0x64, 0x34, 0x36, 0x8d, 0x1d, 0x63, 0xaf, 0xd5, 0x6b, 0xe7, 0x99,
+	0x7d, 0x7b, 0x82, 0x59, 0x74, 0x84, 0x34, 0x8c, 0xbc, 0x62, 0x1b, 0x86, 0x60, 0x83, 0xd1, 0x58,
+	0x11, 0x21, 0xcd, 0xfa, 0x4e, 0x63, 0xef, 0xd6, 0xe1, 0xa1, 0x73, 0x65, 0xb5, 0xce, 0x89, 0x56,
+	0x74, 0xa3, 0x88, 0x7f, 0xc6, 0x71, 0x40, 0x7a, 0x5b, 0xd3, 0xcc, 0xae, 0xe5, 0x99, 0x7d, 0xb7,
+	0x48, 0x28, 0x0d, 0x91, 0x57, 0x59, 0xc3, 0xa7, 0xa0, 0xe9, 0xa7, 0x22, 0x26, 0xc2, 0x6c, 0xe8,
+	0x72, 0x3a, 0x79, 0x66, 0xdf, 0x29, 0xc8, 0x05, 0x8e, 0xbc, 0x92, 0x00, 0x8f, 0x41, 0x9b, 0x95,
+	0x4d, 0xf4, 0xd3, 0x24, 0xc4, 0x8a, 0x08, 0x73, 0x4d, 0x8b, 0x1e, 0xe6, 0x99, 0x7d, 0xbf, 0x4c,
+	0x58, 0x61, 0x20, 0x6f, 0xb3, 0x82, 0x3e, 0x14, 0x08, 0xf4, 0x01, 0x60, 0x78, 0xdc, 0x97, 0x69,
+	0x92, 0x44, 0x13, 0x73, 0x5d, 0x3b, 0xbc, 0x9c, 0x66, 0xb6, 0xf1, 0x2b, 0xb3, 0x77, 0x87, 0x54,
+	0x8d, 0x52, 0xdf, 0x09, 0x38, 0x73, 0xcb, 0x11, 0x17, 0x3f, 0xfb, 0x32, 0x3c, 0x75, 0xd5, 0x24,
+	0x21, 0xd2, 0x79, 0x1d, 0xab, 0x3c, 0xb3, 0x3b, 0x65, 0xde, 0x3f, 0x27, 0xe4, 0xb5, 0x18, 0x1e,
+	0xbf, 0xd7, 0x6b, 0xf8, 0x06, 0xc0, 0x80, 0xb3, 0x24, 0xa2, 0x8b, 0x29, 0xf4, 0xf9, 0x60, 0x40,
+	0x03, 0x22, 0xcc, 0xa6, 0xce, 0x7a, 0x94, 0x67, 0xf6, 0x83, 0x42, 0xfd, 0x3f, 0x07, 0x79, 0x9d,
+	0x25, 0xf8, 0xb6, 0xc0, 0x8e, 0xd6, 0xfe, 0x7c, 0xb5, 0x0d, 0xf4, 0xcd, 0x00, 0x9b, 0x2b, 0xf3,
+	0x85, 0xcf, 0xc0, 0x06, 0x0e, 0x43, 0x41, 0xa4, 0x2c, 0x8f, 0x13, 0x2e, 0x87, 0x5d, 0x6e, 0x20,
+	0xaf, 0xa2, 0xc0, 0x4f, 0xa0, 0x85, 0x2b, 0xa9, 0x59, 0xd7, 0xfc, 0xde, 0xe2, 0x80, 0xae, 0xd5,
+	0x78, 0xbb, 0x74, 0xaf, 0x8c, 0x90, 0xb7, 0x34, 0x2d, 0x2b, 0xfd, 0x61, 0x80, 0x76, 0x2f, 0x15,
+	0xf1, 0xb1, 0xe0, 0xac, 0x9b, 0x24, 0x82, 0x9f, 0xe1, 0xe8, 0x9a, 0xa5, 0x2e, 0xef, 0x45, 0xfd,
+	0xaa, 0x7b, 0x71, 0xa9, 0xab, 0xc6, 0x8d, 0x75, 0xd5, 0x7b, 0x37, 0x9d, 0x59, 0xc6, 0xf9, 0xcc,
+	0x32, 0x7e, 0xcf, 0x2c, 0xe3, 0xcb, 0xdc, 0xaa, 0x9d, 0xcf, 0xad, 0xda, 0xcf, 0xb9, 0x55, 0xfb,
+	0xf8, 0xe2, 0x42, 0x8c, 0x24, 0x74, 0xbf, 0x7a, 0x24, 0xfa, 0x8f, 0x7e, 0x25, 0xee, 0xd8, 0xbd,
+	0xf4, 0x41, 0xd0, 0xd1, 0x7e, 0x53, 0x13, 0x9f, 0xff, 0x1d, 0x00, 0xca, 0xdb, 0x0d, 0x1a, 0x2d,
+	0x04, 0x00, 0x00,
 }
 
 func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
@@ -122,6 +284,87 @@ func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
 	if this.Admin != that1.Admin {
 		return false
 	}
+	if len(this.Minters) != len(that1.Minters) {
+		return false
+	}
+	for i := range this.Minters {
+		if !this.Minters[i].Equal(&that1.Minters[i]) {
+			return false
+		}
+	}
+	if this.Burner != that1.Burner {
+		return false
+	}
+	if this.MetadataUpdater != that1.MetadataUpdater {
+		return false
+	}
+	if that1.MaxSupply == nil {
+		if this.MaxSupply != nil {
+			return false
+		}
+	} else if !this.MaxSupply.Equal(*that1.MaxSupply) {
+		return false
+	}
+	if this.ComplianceOfficer != that1.ComplianceOfficer {
+		return false
+	}
+	return true
+}
+func (this *MinterAllowance) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MinterAllowance)
+	if !ok {
+		that2, ok := that.(MinterAllowance)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.Allowance.Equal(that1.Allowance) {
+		return false
+	}
+	return true
+}
+func (this *BurnFromApproval) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BurnFromApproval)
+	if !ok {
+		that2, ok := that.(BurnFromApproval)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Burner != that1.Burner {
+		return false
+	}
+	if !this.Allowance.Equal(that1.Allowance) {
+		return false
+	}
 	return true
 }
 func (m *DenomAuthorityMetadata) Marshal() (dAtA []byte, err error) {
@@ -144,6 +387,53 @@ func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ComplianceOfficer) > 0 {
+		i -= len(m.ComplianceOfficer)
+		copy(dAtA[i:], m.ComplianceOfficer)
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.ComplianceOfficer)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MaxSupply != nil {
+		{
+			size := m.MaxSupply.Size()
+			i -= size
+			if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MetadataUpdater) > 0 {
+		i -= len(m.MetadataUpdater)
+		copy(dAtA[i:], m.MetadataUpdater)
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.MetadataUpdater)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Burner) > 0 {
+		i -= len(m.Burner)
+		copy(dAtA[i:], m.Burner)
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Burner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Minters) > 0 {
+		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthorityMetadata(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
 		copy(dAtA[i:], m.Admin)
@@ -154,6 +444,93 @@ func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MinterAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MinterAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinterAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Allowance.Size()
+		i -= size
+		if _, err := m.Allowance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BurnFromApproval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BurnFromApproval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BurnFromApproval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Allowance.Size()
+		i -= size
+		if _, err := m.Allowance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Burner) > 0 {
+		i -= len(m.Burner)
+		copy(dAtA[i:], m.Burner)
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Burner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuthorityMetadata(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuthorityMetadata(v)
 	base := offset
@@ -175,6 +552,62 @@ func (m *DenomAuthorityMetadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAuthorityMetadata(uint64(l))
 	}
+	if len(m.Minters) > 0 {
+		for _, e := range m.Minters {
+			l = e.Size()
+			n += 1 + l + sovAuthorityMetadata(uint64(l))
+		}
+	}
+	l = len(m.Burner)
+	if l > 0 {
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	l = len(m.MetadataUpdater)
+	if l > 0 {
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	if m.MaxSupply != nil {
+		l = m.MaxSupply.Size()
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	l = len(m.ComplianceOfficer)
+	if l > 0 {
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	return n
+}
+
+func (m *MinterAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	l = m.Allowance.Size()
+	n += 1 + l + sovAuthorityMetadata(uint64(l))
+	return n
+}
+
+func (m *BurnFromApproval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	l = len(m.Burner)
+	if l > 0 {
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	l = m.Allowance.Size()
+	n += 1 + l + sovAuthorityMetadata(uint64(l))
 	return n
 }
 
@@ -245,6 +678,436 @@ func (m *DenomAuthorityMetadata) Unmarshal(dAtA []byte) error {
 			}
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minters = append(m.Minters, MinterAllowance{})
+			if err := m.Minters[len(m.Minters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataUpdater", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataUpdater = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MaxSupply = &v
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComplianceOfficer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComplianceOfficer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinterAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthorityMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinterAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinterAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BurnFromApproval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthorityMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BurnFromApproval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BurnFromApproval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
@@ -13,8 +13,17 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateDenom{}, "tokenfactory/create-denom", nil)
 	cdc.RegisterConcrete(&MsgMint{}, "tokenfactory/mint", nil)
 	cdc.RegisterConcrete(&MsgBurn{}, "tokenfactory/burn", nil)
-	// cdc.RegisterConcrete(&MsgForceTransfer{}, "tokenfactory/force-transfer", nil)
 	cdc.RegisterConcrete(&MsgChangeAdmin{}, "tokenfactory/change-admin", nil)
+	cdc.RegisterConcrete(&MsgSetMinter{}, "tokenfactory/set-minter", nil)
+	cdc.RegisterConcrete(&MsgSetBurner{}, "tokenfactory/set-burner", nil)
+	cdc.RegisterConcrete(&MsgSetMetadataUpdater{}, "tokenfactory/set-metadata-updater", nil)
+	cdc.RegisterConcrete(&MsgSetMaxSupply{}, "tokenfactory/set-max-supply", nil)
+	cdc.RegisterConcrete(&MsgSetComplianceOfficer{}, "tokenfactory/set-compliance-officer", nil)
+	cdc.RegisterConcrete(&MsgForceTransfer{}, "tokenfactory/force-transfer", nil)
+	cdc.RegisterConcrete(&MsgFreeze{}, "tokenfactory/freeze", nil)
+	cdc.RegisterConcrete(&MsgPause{}, "tokenfactory/pause", nil)
+	cdc.RegisterConcrete(&MsgApproveBurnFrom{}, "tokenfactory/approve-burn-from", nil)
+	cdc.RegisterConcrete(&MsgSetBeforeSendHook{}, "tokenfactory/set-before-send-hook", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -30,9 +39,24 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgChangeAdmin{},
 	)
-	// registry.RegisterImplementations((*govtypes.Content)(nil),
-	// 	&MsgForceTransfer{},
-	// )
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetMinter{},
+		&MsgSetBurner{},
+		&MsgSetMetadataUpdater{},
+		&MsgSetMaxSupply{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetComplianceOfficer{},
+		&MsgForceTransfer{},
+		&MsgFreeze{},
+		&MsgPause{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgApproveBurnFrom{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetBeforeSendHook{},
+	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -29,4 +29,18 @@ var (
 	ErrEncodingDenomAuthorityMetadata = sdkerrors.Register(ModuleName, 18, "Error encoding denom authority metadata as JSON")
 	ErrEncodingDenomsFromCreator      = sdkerrors.Register(ModuleName, 19, "Error encoding denoms from creator as JSON")
 	ErrUnknownSeiTokenFactoryQuery    = sdkerrors.Register(ModuleName, 23, "Error unknown sei token factory query")
+	ErrMintAllowanceExceeded          = sdkerrors.Register(ModuleName, 24, "mint amount exceeds the minter allowance")
+	ErrMaxSupplyExceeded              = sdkerrors.Register(ModuleName, 25, "mint amount exceeds the max supply")
+	ErrInvalidMaxSupply               = sdkerrors.Register(ModuleName, 26, "invalid max supply")
+	ErrComplianceNotEnabled           = sdkerrors.Register(ModuleName, 27, "compliance features are not enabled for the denom")
+	ErrDenomPaused                    = sdkerrors.Register(ModuleName, 28, "transfers of the denom are paused")
+	ErrAddressFrozen                  = sdkerrors.Register(ModuleName, 29, "address is frozen for the denom")
+	ErrEncodingDenomCompliance        = sdkerrors.Register(ModuleName, 30, "Error encoding denom compliance as JSON")
+	ErrMaxDenomsPerCreator            = sdkerrors.Register(ModuleName, 31, "creator has reached the max number of denoms")
+	ErrInsufficientDenomCreationFee   = sdkerrors.Register(ModuleName, 32, "insufficient funds to pay the denom creation fee")
+	ErrBurnFromNotApproved            = sdkerrors.Register(ModuleName, 33, "address has not approved burns of the denom by the burner")
+	ErrInvalidBeforeSendHook          = sdkerrors.Register(ModuleName, 34, "before send hook address is not a contract")
+	ErrBeforeSendHookRejected         = sdkerrors.Register(ModuleName, 35, "transfer rejected by the before send hook")
+	ErrForceTransferNotAllowed        = sdkerrors.Register(ModuleName, 36, "force transfers from or to module accounts and blocked addresses are not allowed")
+	ErrBurnFromAllowanceExceeded      = sdkerrors.Register(ModuleName, 37, "burn amount exceeds the burn from allowance")
 )
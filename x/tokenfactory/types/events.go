@@ -15,4 +15,15 @@ const (
 	AttributeDenom               = "denom"
 	AttributeNewAdmin            = "new_admin"
 	AttributeDenomMetadata       = "denom_metadata"
+	AttributeMinter              = "minter"
+	AttributeAllowance           = "allowance"
+	AttributeBurner              = "burner"
+	AttributeMetadataUpdater     = "metadata_updater"
+	AttributeMaxSupply           = "max_supply"
+	AttributeComplianceEnabled   = "compliance_enabled"
+	AttributeComplianceOfficer   = "compliance_officer"
+	AttributeAddress             = "address"
+	AttributeFrozen              = "frozen"
+	AttributePaused              = "paused"
+	AttributeBeforeSendHook      = "before_send_hook"
 )
@@ -45,6 +45,40 @@ func (gs GenesisState) Validate() error {
 				return sdkerrors.Wrapf(ErrInvalidAuthorityMetadata, "Invalid admin address (%s)", err)
 			}
 		}
+
+		err = denom.AuthorityMetadata.Validate()
+		if err != nil {
+			return sdkerrors.Wrap(ErrInvalidAuthorityMetadata, err.Error())
+		}
+
+		if !denom.Compliance.Enabled && (denom.Compliance.Paused || len(denom.FrozenAddresses) > 0) {
+			return sdkerrors.Wrapf(ErrComplianceNotEnabled, "denom: %s", denom.GetDenom())
+		}
+		for _, address := range denom.FrozenAddresses {
+			_, err = sdk.AccAddressFromBech32(address)
+			if err != nil {
+				return sdkerrors.Wrapf(ErrInvalidGenesis, "Invalid frozen address (%s)", err)
+			}
+		}
+		for _, approval := range denom.BurnFromApprovals {
+			_, err = sdk.AccAddressFromBech32(approval.Address)
+			if err != nil {
+				return sdkerrors.Wrapf(ErrInvalidGenesis, "Invalid burn from approval address (%s)", err)
+			}
+			_, err = sdk.AccAddressFromBech32(approval.Burner)
+			if err != nil {
+				return sdkerrors.Wrapf(ErrInvalidGenesis, "Invalid burn from approval burner (%s)", err)
+			}
+			if approval.Allowance.IsNil() || !approval.Allowance.IsPositive() {
+				return sdkerrors.Wrapf(ErrInvalidGenesis, "burn from approval of %s for %s must have a positive allowance", approval.Address, approval.Burner)
+			}
+		}
+		if denom.BeforeSendHook != "" {
+			_, err = sdk.AccAddressFromBech32(denom.BeforeSendHook)
+			if err != nil {
+				return sdkerrors.Wrapf(ErrInvalidGenesis, "Invalid before send hook address (%s)", err)
+			}
+		}
 	}
 
 	return nil
@@ -79,10 +79,15 @@ func (m *GenesisState) GetFactoryDenoms() []GenesisDenom {
 
 // GenesisDenom defines a tokenfactory denom that is defined within genesis
 // state. The structure contains DenomAuthorityMetadata which defines the
-// denom's admin.
+// denom's admin, the compliance state of the denom and its before send hook
+// contract.
 type GenesisDenom struct {
 	Denom             string                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
 	AuthorityMetadata DenomAuthorityMetadata `protobuf:"bytes,2,opt,name=authority_metadata,json=authorityMetadata,proto3" json:"authority_metadata" yaml:"authority_metadata"`
+	Compliance        DenomCompliance        `protobuf:"bytes,3,opt,name=compliance,proto3" json:"compliance" yaml:"compliance"`
+	FrozenAddresses   []string               `protobuf:"bytes,4,rep,name=frozen_addresses,json=frozenAddresses,proto3" json:"frozen_addresses,omitempty" yaml:"frozen_addresses"`
+	BurnFromApprovals []BurnFromApproval     `protobuf:"bytes,5,rep,name=burn_from_approvals,json=burnFromApprovals,proto3" json:"burn_from_approvals" yaml:"burn_from_approvals"`
+	BeforeSendHook    string                 `protobuf:"bytes,6,opt,name=before_send_hook,json=beforeSendHook,proto3" json:"before_send_hook,omitempty" yaml:"before_send_hook"`
 }
 
 func (m *GenesisDenom) Reset()         { *m = GenesisDenom{} }
@@ -132,6 +137,34 @@ func (m *GenesisDenom) GetAuthorityMetadata() DenomAuthorityMetadata {
 	return DenomAuthorityMetadata{}
 }
 
+func (m *GenesisDenom) GetCompliance() DenomCompliance {
+	if m != nil {
+		return m.Compliance
+	}
+	return DenomCompliance{}
+}
+
+func (m *GenesisDenom) GetFrozenAddresses() []string {
+	if m != nil {
+		return m.FrozenAddresses
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetBurnFromApprovals() []BurnFromApproval {
+	if m != nil {
+		return m.BurnFromApprovals
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetBeforeSendHook() string {
+	if m != nil {
+		return m.BeforeSendHook
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "seiprotocol.seichain.tokenfactory.GenesisState")
 	proto.RegisterType((*GenesisDenom)(nil), "seiprotocol.seichain.tokenfactory.GenesisDenom")
@@ -140,30 +173,40 @@ func init() {
 func init() { proto.RegisterFile("tokenfactory/genesis.proto", fileDescriptor_415d5acd9b7bd461) }
 
 var fileDescriptor_415d5acd9b7bd461 = []byte{
-	// 359 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2a, 0xc9, 0xcf, 0x4e,
-	0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x52, 0x2c, 0x4e, 0xcd, 0x04, 0xb3, 0x92, 0xf3, 0x73,
-	0xf4, 0x8a, 0x53, 0x33, 0x93, 0x33, 0x12, 0x33, 0xf3, 0xf4, 0x90, 0x35, 0x48, 0x89, 0xa4, 0xe7,
-	0xa7, 0xe7, 0x83, 0xd5, 0xe8, 0x83, 0x58, 0x10, 0x8d, 0x52, 0x2a, 0x28, 0x86, 0x26, 0x96, 0x96,
-	0x64, 0xe4, 0x17, 0x65, 0x96, 0x54, 0xfa, 0xa6, 0x96, 0x24, 0xa6, 0x24, 0x96, 0x24, 0x42, 0x55,
-	0x49, 0xa2, 0xa8, 0x2a, 0x48, 0x2c, 0x4a, 0xcc, 0x85, 0xda, 0xac, 0x74, 0x8e, 0x91, 0x8b, 0xc7,
-	0x1d, 0xe2, 0x96, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x77, 0x2e, 0x36, 0x88, 0x02, 0x09, 0x46,
-	0x05, 0x46, 0x0d, 0x6e, 0x23, 0x4d, 0x3d, 0x82, 0x6e, 0xd3, 0x0b, 0x00, 0x6b, 0x70, 0x62, 0x39,
-	0x71, 0x4f, 0x9e, 0x21, 0x08, 0xaa, 0x5d, 0xa8, 0x94, 0x8b, 0x0f, 0x2a, 0x1f, 0x9f, 0x92, 0x9a,
-	0x97, 0x9f, 0x5b, 0x2c, 0xc1, 0xa4, 0xc0, 0xac, 0xc1, 0x6d, 0xa4, 0x4f, 0x84, 0x81, 0x50, 0x17,
-	0xb9, 0x80, 0xf4, 0x39, 0xc9, 0x82, 0x8c, 0xfd, 0x74, 0x4f, 0x5e, 0xb4, 0x32, 0x31, 0x37, 0xc7,
-	0x4a, 0x09, 0xd5, 0x50, 0xa5, 0x20, 0x5e, 0xa8, 0x80, 0x0b, 0x84, 0x7f, 0x0a, 0xe1, 0x21, 0xb0,
-	0x88, 0x90, 0x1a, 0x17, 0x2b, 0x58, 0x29, 0xd8, 0x3f, 0x9c, 0x4e, 0x02, 0x9f, 0xee, 0xc9, 0xf3,
-	0x40, 0x4c, 0x02, 0x0b, 0x2b, 0x05, 0x41, 0xa4, 0x85, 0xba, 0x19, 0xb9, 0x84, 0xe0, 0x01, 0x18,
-	0x9f, 0x0b, 0x0d, 0x41, 0x09, 0x26, 0x70, 0x28, 0x58, 0x12, 0xe1, 0x68, 0xb0, 0x75, 0x8e, 0xe8,
-	0x51, 0xe0, 0xa4, 0x08, 0x75, 0xbe, 0x24, 0xc4, 0x52, 0x4c, 0x2b, 0x94, 0x82, 0x04, 0x31, 0x22,
-	0xce, 0x8a, 0xe5, 0xc5, 0x02, 0x79, 0x46, 0xa7, 0x80, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92,
-	0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c,
-	0x96, 0x63, 0x88, 0x32, 0x4b, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x2f,
-	0x4e, 0xcd, 0xd4, 0x85, 0xb9, 0x0d, 0xcc, 0x01, 0x3b, 0x4e, 0xbf, 0x42, 0x1f, 0x25, 0xda, 0x4b,
-	0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x0a, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x65,
-	0xab, 0x9e, 0x40, 0x8e, 0x02, 0x00, 0x00,
+	// 525 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x8d, 0x9b, 0x34, 0x52, 0xb7, 0xa5, 0x24, 0x06, 0x84, 0x13, 0x54, 0x27, 0xb5, 0x10, 0x0a,
+	0x07, 0x6c, 0x29, 0x95, 0x90, 0xe8, 0x2d, 0xa6, 0xb4, 0x5c, 0x90, 0x2a, 0xf7, 0xc6, 0xc5, 0xda,
+	0xd8, 0x9b, 0xc4, 0x4a, 0xbc, 0x63, 0xed, 0x6e, 0x10, 0xe1, 0x07, 0x38, 0x70, 0xe1, 0x13, 0xf8,
+	0x9c, 0x9e, 0x50, 0xc5, 0x89, 0x53, 0x84, 0x92, 0x0b, 0xe7, 0x7c, 0x01, 0xca, 0xee, 0x36, 0xad,
+	0x53, 0xa4, 0xe6, 0xe6, 0x9d, 0x79, 0xef, 0xcd, 0x7b, 0x33, 0x32, 0xaa, 0x0b, 0x18, 0x12, 0xda,
+	0xc3, 0x91, 0x00, 0x36, 0xf1, 0xfa, 0x84, 0x12, 0x9e, 0x70, 0x37, 0x63, 0x20, 0xc0, 0x3c, 0xe4,
+	0x24, 0x91, 0x5f, 0x11, 0x8c, 0x5c, 0x4e, 0x92, 0x68, 0x80, 0x13, 0xea, 0xde, 0x26, 0xd4, 0x1f,
+	0xf7, 0xa1, 0x0f, 0x12, 0xe3, 0x2d, 0xbf, 0x14, 0xb1, 0xfe, 0x3c, 0x27, 0x8a, 0xc7, 0x62, 0x00,
+	0x2c, 0x11, 0x93, 0x0f, 0x44, 0xe0, 0x18, 0x0b, 0xac, 0x51, 0x07, 0x39, 0x54, 0x04, 0x69, 0x36,
+	0x4a, 0x30, 0x8d, 0x88, 0x6e, 0xd7, 0x72, 0xed, 0x0c, 0x33, 0x9c, 0x6a, 0x63, 0xce, 0x4f, 0x03,
+	0xed, 0x9d, 0x29, 0xab, 0x17, 0x02, 0x0b, 0x62, 0x9e, 0xa1, 0xb2, 0x02, 0x58, 0x46, 0xd3, 0x68,
+	0xed, 0xb6, 0x5f, 0xba, 0xf7, 0x5a, 0x77, 0xcf, 0x25, 0xc1, 0x2f, 0x5d, 0x4e, 0x1b, 0x85, 0x40,
+	0xd3, 0xcd, 0x31, 0xda, 0xd7, 0xfd, 0x30, 0x26, 0x14, 0x52, 0x6e, 0x6d, 0x35, 0x8b, 0xad, 0xdd,
+	0xb6, 0xb7, 0x81, 0xa0, 0x76, 0x74, 0xb2, 0xe4, 0xf9, 0x07, 0x4b, 0xd9, 0xc5, 0xb4, 0xf1, 0x64,
+	0x82, 0xd3, 0xd1, 0xb1, 0x93, 0x17, 0x75, 0x82, 0x07, 0xba, 0x70, 0xa2, 0xde, 0xbf, 0x4a, 0xab,
+	0x40, 0xb2, 0x62, 0xbe, 0x40, 0xdb, 0x12, 0x2a, 0xf3, 0xec, 0xf8, 0x95, 0xc5, 0xb4, 0xb1, 0xa7,
+	0x94, 0x64, 0xd9, 0x09, 0x54, 0xdb, 0xfc, 0x66, 0x20, 0x73, 0xb5, 0xdf, 0x30, 0xd5, 0x0b, 0xb6,
+	0xb6, 0xe4, 0x16, 0xde, 0x6c, 0x60, 0x5a, 0x8e, 0xeb, 0xac, 0x5f, 0xc8, 0x3f, 0xd4, 0xf6, 0x6b,
+	0x6a, 0xe8, 0xdd, 0x11, 0x4e, 0x50, 0xbd, 0x73, 0x57, 0x33, 0x45, 0xe8, 0xe6, 0x8c, 0x56, 0x51,
+	0x9a, 0x68, 0x6f, 0x6a, 0xe2, 0xed, 0x8a, 0xe9, 0xd7, 0xf4, 0xf4, 0xaa, 0x9a, 0x7e, 0xa3, 0xe9,
+	0x04, 0xb7, 0x06, 0x98, 0xa7, 0xa8, 0xd2, 0x63, 0xf0, 0x85, 0xd0, 0x10, 0xc7, 0x31, 0x23, 0x9c,
+	0x13, 0x6e, 0x95, 0x9a, 0xc5, 0xd6, 0x8e, 0xff, 0x6c, 0x31, 0x6d, 0x3c, 0xd5, 0x9b, 0x5f, 0x43,
+	0x38, 0xc1, 0x43, 0x55, 0xea, 0x5c, 0x57, 0xcc, 0xaf, 0x06, 0x7a, 0xd4, 0x1d, 0x33, 0x1a, 0xf6,
+	0x18, 0xa4, 0x21, 0xce, 0x32, 0x06, 0x9f, 0xf0, 0x88, 0x5b, 0xdb, 0xf2, 0xf4, 0x47, 0x1b, 0x04,
+	0xf0, 0xc7, 0x8c, 0x9e, 0x32, 0x48, 0x3b, 0x9a, 0xeb, 0x3b, 0x3a, 0x41, 0x5d, 0x99, 0xf8, 0x8f,
+	0xba, 0x13, 0x54, 0xbb, 0x6b, 0x2c, 0x6e, 0xbe, 0x43, 0x95, 0x2e, 0xe9, 0x01, 0x23, 0x21, 0x27,
+	0x34, 0x0e, 0x07, 0x00, 0x43, 0xab, 0xdc, 0x34, 0xf2, 0x89, 0xd6, 0x11, 0x4e, 0xb0, 0xaf, 0x4a,
+	0x17, 0x84, 0xc6, 0xef, 0x01, 0x86, 0xc7, 0xa5, 0xbf, 0x3f, 0x1a, 0x86, 0x7f, 0x7e, 0x39, 0xb3,
+	0x8d, 0xab, 0x99, 0x6d, 0xfc, 0x99, 0xd9, 0xc6, 0xf7, 0xb9, 0x5d, 0xb8, 0x9a, 0xdb, 0x85, 0xdf,
+	0x73, 0xbb, 0xf0, 0xf1, 0x75, 0x3f, 0x11, 0x83, 0x71, 0xd7, 0x8d, 0x20, 0xf5, 0x38, 0x49, 0x5e,
+	0x5d, 0xa7, 0x93, 0x0f, 0x19, 0xcf, 0xfb, 0xec, 0xe5, 0x7e, 0x3f, 0x31, 0xc9, 0x08, 0xef, 0x96,
+	0x25, 0xf0, 0xe8, 0xdf, 0x00, 0xec, 0xf1, 0x3b, 0x6b, 0x35, 0x04, 0x00, 0x00,
 }
 
 func (this *GenesisDenom) Equal(that interface{}) bool {
@@ -191,6 +234,28 @@ func (this *GenesisDenom) Equal(that interface{}) bool {
 	if !this.AuthorityMetadata.Equal(&that1.AuthorityMetadata) {
 		return false
 	}
+	if !this.Compliance.Equal(&that1.Compliance) {
+		return false
+	}
+	if len(this.FrozenAddresses) != len(that1.FrozenAddresses) {
+		return false
+	}
+	for i := range this.FrozenAddresses {
+		if this.FrozenAddresses[i] != that1.FrozenAddresses[i] {
+			return false
+		}
+	}
+	if len(this.BurnFromApprovals) != len(that1.BurnFromApprovals) {
+		return false
+	}
+	for i := range this.BurnFromApprovals {
+		if !this.BurnFromApprovals[i].Equal(&that1.BurnFromApprovals[i]) {
+			return false
+		}
+	}
+	if this.BeforeSendHook != that1.BeforeSendHook {
+		return false
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -260,6 +325,46 @@ func (m *GenesisDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BeforeSendHook) > 0 {
+		i -= len(m.BeforeSendHook)
+		copy(dAtA[i:], m.BeforeSendHook)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BeforeSendHook)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.BurnFromApprovals) > 0 {
+		for iNdEx := len(m.BurnFromApprovals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnFromApprovals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.FrozenAddresses) > 0 {
+		for iNdEx := len(m.FrozenAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FrozenAddresses[iNdEx])
+			copy(dAtA[i:], m.FrozenAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.FrozenAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Compliance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.AuthorityMetadata.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -320,6 +425,24 @@ func (m *GenesisDenom) Size() (n int) {
 	}
 	l = m.AuthorityMetadata.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Compliance.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FrozenAddresses) > 0 {
+		for _, s := range m.FrozenAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BurnFromApprovals) > 0 {
+		for _, e := range m.BurnFromApprovals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.BeforeSendHook)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -540,6 +663,137 @@ func (m *GenesisDenom) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compliance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Compliance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenAddresses = append(m.FrozenAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnFromApprovals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnFromApprovals = append(m.BurnFromApprovals, BurnFromApproval{})
+			if err := m.BurnFromApprovals[len(m.BurnFromApprovals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeSendHook", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeforeSendHook = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/sei-protocol/sei-chain/x/tokenfactory/types"
@@ -106,6 +107,121 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "compliance enabled denom",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/sei1y3pxq5dp900czh0mkudhjdqjq5m8cpmmps8yjw/bitcoin",
+						Compliance: types.DenomCompliance{
+							Enabled: true,
+							Paused:  true,
+						},
+						FrozenAddresses: []string{"sei1hjfwcza3e3uzeznf3qthhakdr9juetl7g6esl4"},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "frozen addresses without compliance",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom:           "factory/sei1y3pxq5dp900czh0mkudhjdqjq5m8cpmmps8yjw/bitcoin",
+						FrozenAddresses: []string{"sei1hjfwcza3e3uzeznf3qthhakdr9juetl7g6esl4"},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid frozen address",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/sei1y3pxq5dp900czh0mkudhjdqjq5m8cpmmps8yjw/bitcoin",
+						Compliance: types.DenomCompliance{
+							Enabled: true,
+						},
+						FrozenAddresses: []string{"moose"},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "burn from approval",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/sei1y3pxq5dp900czh0mkudhjdqjq5m8cpmmps8yjw/bitcoin",
+						BurnFromApprovals: []types.BurnFromApproval{{
+							Address:   "sei1hjfwcza3e3uzeznf3qthhakdr9juetl7g6esl4",
+							Burner:    "sei1y3pxq5dp900czh0mkudhjdqjq5m8cpmmps8yjw",
+							Allowance: sdk.NewInt(100),
+						}},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "burn from approval without allowance",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/sei1y3pxq5dp900czh0mkudhjdqjq5m8cpmmps8yjw/bitcoin",
+						BurnFromApprovals: []types.BurnFromApproval{{
+							Address:   "sei1hjfwcza3e3uzeznf3qthhakdr9juetl7g6esl4",
+							Burner:    "sei1y3pxq5dp900czh0mkudhjdqjq5m8cpmmps8yjw",
+							Allowance: sdk.ZeroInt(),
+						}},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid burn from approval burner",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/sei1y3pxq5dp900czh0mkudhjdqjq5m8cpmmps8yjw/bitcoin",
+						BurnFromApprovals: []types.BurnFromApproval{{
+							Address:   "sei1hjfwcza3e3uzeznf3qthhakdr9juetl7g6esl4",
+							Burner:    "moose",
+							Allowance: sdk.NewInt(100),
+						}},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "before send hook",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom:          "factory/sei1y3pxq5dp900czh0mkudhjdqjq5m8cpmmps8yjw/bitcoin",
+						BeforeSendHook: "sei1hjfwcza3e3uzeznf3qthhakdr9juetl7g6esl4",
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid before send hook address",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom:          "factory/sei1y3pxq5dp900czh0mkudhjdqjq5m8cpmmps8yjw/bitcoin",
+						BeforeSendHook: "moose",
+					},
+				},
+			},
+			valid: false,
+		},
 		{
 			desc: "duplicate denoms",
 			genState: &types.GenesisState{
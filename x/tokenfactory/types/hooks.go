@@ -0,0 +1,38 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BankHooks defines the hooks run by the bank keeper before coins are
+// transferred between accounts, so that transfers can be restricted or
+// tracked.
+type BankHooks interface {
+	// BlockBeforeSend returns an error to reject a transfer of the amount.
+	// Either address is empty when the transfer is part of a multi-send.
+	BlockBeforeSend(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) error
+	// TrackBeforeSend is run before transfers that must not fail, such as
+	// transfers between modules, so it cannot reject them.
+	TrackBeforeSend(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins)
+}
+
+// SudoBlockBeforeSendMsg is the sudo message sent to the before send hook
+// contract of a denom before a transfer of the denom, which the contract can
+// reject by returning an error.
+type SudoBlockBeforeSendMsg struct {
+	BlockBeforeSend BeforeSendMsg `json:"block_before_send"`
+}
+
+// SudoTrackBeforeSendMsg is the sudo message sent to the before send hook
+// contract of a denom before a transfer of the denom that cannot be rejected.
+type SudoTrackBeforeSendMsg struct {
+	TrackBeforeSend BeforeSendMsg `json:"track_before_send"`
+}
+
+// BeforeSendMsg describes a transfer of a denom to its before send hook
+// contract. Either address is empty when the transfer is part of a multi-send.
+type BeforeSendMsg struct {
+	From   string   `json:"from"`
+	To     string   `json:"to"`
+	Amount sdk.Coin `json:"amount"`
+}
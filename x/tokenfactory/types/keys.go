@@ -30,6 +30,10 @@ var (
 	CreatorPrefixKey           = "creator"
 	AdminPrefixKey             = "admin"
 	CreateDenomFeeWhitelistKey = "createdenomfeewhitelist"
+	DenomComplianceKey         = "compliance"
+	FrozenAddressPrefixKey     = "frozen"
+	BurnFromApprovalPrefixKey  = "burnfromapproval"
+	BeforeSendHookKey          = "beforesendhook"
 )
 
 // GetDenomPrefixStore returns the store prefix where all the data associated with a specific denom
@@ -48,3 +52,34 @@ func GetCreatorPrefix(creator string) []byte {
 func GetCreatorsPrefix() []byte {
 	return []byte(strings.Join([]string{CreatorPrefixKey, ""}, KeySeparator))
 }
+
+// GetFrozenAddressesPrefix returns the prefix, within the store of a denom, where
+// the frozen addresses of the denom are stored
+func GetFrozenAddressesPrefix() []byte {
+	return []byte(strings.Join([]string{FrozenAddressPrefixKey, ""}, KeySeparator))
+}
+
+// GetFrozenAddressKey returns the key, within the store of a denom, marking an
+// address as frozen
+func GetFrozenAddressKey(address string) []byte {
+	return []byte(strings.Join([]string{FrozenAddressPrefixKey, address}, KeySeparator))
+}
+
+// GetBurnFromApprovalsPrefix returns the prefix, within the store of a denom,
+// where the allowances approved by addresses for burns of their balance are
+// stored
+func GetBurnFromApprovalsPrefix() []byte {
+	return []byte(strings.Join([]string{BurnFromApprovalPrefixKey, ""}, KeySeparator))
+}
+
+// GetBurnFromApprovalKey returns the key, within the store of a denom, of the
+// allowance approved by an address for burns of its balance by a burner
+func GetBurnFromApprovalKey(address string, burner string) []byte {
+	return []byte(strings.Join([]string{BurnFromApprovalPrefixKey, address, burner}, KeySeparator))
+}
+
+// GetBeforeSendHookKey returns the full store key where the address of the
+// before send hook contract of a specific denom is stored
+func GetBeforeSendHookKey(denom string) []byte {
+	return append(GetDenomPrefixStore(denom), []byte(BeforeSendHookKey)...)
+}
@@ -8,12 +8,21 @@ import (
 
 // constants
 const (
-	TypeMsgCreateDenom      = "create_denom"
-	TypeMsgMint             = "mint"
-	TypeMsgBurn             = "burn"
-	TypeMsgForceTransfer    = "force_transfer"
-	TypeMsgChangeAdmin      = "change_admin"
-	TypeMsgSetDenomMetadata = "set_denom_metadata"
+	TypeMsgCreateDenom          = "create_denom"
+	TypeMsgMint                 = "mint"
+	TypeMsgBurn                 = "burn"
+	TypeMsgForceTransfer        = "force_transfer"
+	TypeMsgChangeAdmin          = "change_admin"
+	TypeMsgSetDenomMetadata     = "set_denom_metadata"
+	TypeMsgSetMinter            = "set_minter"
+	TypeMsgSetBurner            = "set_burner"
+	TypeMsgSetMetadataUpdater   = "set_metadata_updater"
+	TypeMsgSetMaxSupply         = "set_max_supply"
+	TypeMsgSetComplianceOfficer = "set_compliance_officer"
+	TypeMsgFreeze               = "freeze"
+	TypeMsgPause                = "pause"
+	TypeMsgApproveBurnFrom      = "approve_burn_from"
+	TypeMsgSetBeforeSendHook    = "set_before_send_hook"
 )
 
 var _ sdk.Msg = &MsgCreateDenom{}
@@ -61,6 +70,15 @@ func NewMsgMint(sender string, amount sdk.Coin) *MsgMint {
 	}
 }
 
+// NewMsgMintTo creates a message to mint tokens to an address
+func NewMsgMintTo(sender string, amount sdk.Coin, mintToAddress string) *MsgMint {
+	return &MsgMint{
+		Sender:        sender,
+		Amount:        amount,
+		MintToAddress: mintToAddress,
+	}
+}
+
 func (m MsgMint) Route() string { return RouterKey }
 func (m MsgMint) Type() string  { return TypeMsgMint }
 func (m MsgMint) ValidateBasic() error {
@@ -73,9 +91,24 @@ func (m MsgMint) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
 	}
 
+	if m.MintToAddress != "" {
+		_, err = sdk.AccAddressFromBech32(m.MintToAddress)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid mint to address (%s)", err)
+		}
+	}
+
 	return nil
 }
 
+// GetRecipient returns the address the tokens are minted to.
+func (m MsgMint) GetRecipient() string {
+	if m.MintToAddress != "" {
+		return m.MintToAddress
+	}
+	return m.Sender
+}
+
 func (m MsgMint) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
 }
@@ -95,6 +128,15 @@ func NewMsgBurn(sender string, amount sdk.Coin) *MsgBurn {
 	}
 }
 
+// NewMsgBurnFrom creates a message to burn tokens from an address
+func NewMsgBurnFrom(sender string, amount sdk.Coin, burnFromAddress string) *MsgBurn {
+	return &MsgBurn{
+		Sender:          sender,
+		Amount:          amount,
+		BurnFromAddress: burnFromAddress,
+	}
+}
+
 func (m MsgBurn) Route() string { return RouterKey }
 func (m MsgBurn) Type() string  { return TypeMsgBurn }
 func (m MsgBurn) ValidateBasic() error {
@@ -107,9 +149,24 @@ func (m MsgBurn) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
 	}
 
+	if m.BurnFromAddress != "" {
+		_, err = sdk.AccAddressFromBech32(m.BurnFromAddress)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid burn from address (%s)", err)
+		}
+	}
+
 	return nil
 }
 
+// GetHolder returns the address the tokens are burned from.
+func (m MsgBurn) GetHolder() string {
+	if m.BurnFromAddress != "" {
+		return m.BurnFromAddress
+	}
+	return m.Sender
+}
+
 func (m MsgBurn) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
 }
@@ -119,50 +176,55 @@ func (m MsgBurn) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
-// var _ sdk.Msg = &MsgForceTransfer{}
-
-// // NewMsgForceTransfer creates a transfer funds from one account to another
-// func NewMsgForceTransfer(sender string, amount sdk.Coin, fromAddr, toAddr string) *MsgForceTransfer {
-// 	return &MsgForceTransfer{
-// 		Sender:              sender,
-// 		Amount:              amount,
-// 		TransferFromAddress: fromAddr,
-// 		TransferToAddress:   toAddr,
-// 	}
-// }
-
-// func (m MsgForceTransfer) Route() string { return RouterKey }
-// func (m MsgForceTransfer) Type() string  { return TypeMsgForceTransfer }
-// func (m MsgForceTransfer) ValidateBasic() error {
-// 	_, err := sdk.AccAddressFromBech32(m.Sender)
-// 	if err != nil {
-// 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
-// 	}
-
-// 	_, err = sdk.AccAddressFromBech32(m.TransferFromAddress)
-// 	if err != nil {
-// 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid address (%s)", err)
-// 	}
-// 	_, err = sdk.AccAddressFromBech32(m.TransferToAddress)
-// 	if err != nil {
-// 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid address (%s)", err)
-// 	}
-
-// 	if !m.Amount.IsValid() {
-// 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
-// 	}
-
-// 	return nil
-// }
-
-// func (m MsgForceTransfer) GetSignBytes() []byte {
-// 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
-// }
-
-// func (m MsgForceTransfer) GetSigners() []sdk.AccAddress {
-// 	sender, _ := sdk.AccAddressFromBech32(m.Sender)
-// 	return []sdk.AccAddress{sender}
-// }
+var _ sdk.Msg = &MsgForceTransfer{}
+
+// NewMsgForceTransfer creates a message to transfer funds from one account to another
+func NewMsgForceTransfer(sender string, amount sdk.Coin, fromAddr, toAddr string) *MsgForceTransfer {
+	return &MsgForceTransfer{
+		Sender:              sender,
+		Amount:              amount,
+		TransferFromAddress: fromAddr,
+		TransferToAddress:   toAddr,
+	}
+}
+
+func (m MsgForceTransfer) Route() string { return RouterKey }
+func (m MsgForceTransfer) Type() string  { return TypeMsgForceTransfer }
+func (m MsgForceTransfer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.TransferFromAddress)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(m.TransferToAddress)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid address (%s)", err)
+	}
+
+	if !m.Amount.IsValid() || m.Amount.Amount.Equal(sdk.ZeroInt()) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
+	}
+
+	_, _, err = DeconstructDenom(m.Amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgForceTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgForceTransfer) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
 
 var _ sdk.Msg = &MsgChangeAdmin{}
 
@@ -244,3 +306,387 @@ func (m MsgSetDenomMetadata) GetSigners() []sdk.AccAddress {
 	sender, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgSetMinter{}
+
+// NewMsgSetMinter creates a message to set the mint allowance of a minter
+func NewMsgSetMinter(sender, denom, minter string, allowance sdk.Int) *MsgSetMinter {
+	return &MsgSetMinter{
+		Sender:    sender,
+		Denom:     denom,
+		Minter:    minter,
+		Allowance: allowance,
+	}
+}
+
+func (m MsgSetMinter) Route() string { return RouterKey }
+func (m MsgSetMinter) Type() string  { return TypeMsgSetMinter }
+func (m MsgSetMinter) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Minter)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid minter address (%s)", err)
+	}
+
+	if m.Allowance.IsNil() || m.Allowance.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "allowance must not be negative")
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgSetMinter) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetMinter) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetBurner{}
+
+// NewMsgSetBurner creates a message to set the burner of a denom
+func NewMsgSetBurner(sender, denom, burner string) *MsgSetBurner {
+	return &MsgSetBurner{
+		Sender: sender,
+		Denom:  denom,
+		Burner: burner,
+	}
+}
+
+func (m MsgSetBurner) Route() string { return RouterKey }
+func (m MsgSetBurner) Type() string  { return TypeMsgSetBurner }
+func (m MsgSetBurner) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if m.Burner != "" {
+		_, err = sdk.AccAddressFromBech32(m.Burner)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid burner address (%s)", err)
+		}
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgSetBurner) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetBurner) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetMetadataUpdater{}
+
+// NewMsgSetMetadataUpdater creates a message to set the metadata updater of a denom
+func NewMsgSetMetadataUpdater(sender, denom, metadataUpdater string) *MsgSetMetadataUpdater {
+	return &MsgSetMetadataUpdater{
+		Sender:          sender,
+		Denom:           denom,
+		MetadataUpdater: metadataUpdater,
+	}
+}
+
+func (m MsgSetMetadataUpdater) Route() string { return RouterKey }
+func (m MsgSetMetadataUpdater) Type() string  { return TypeMsgSetMetadataUpdater }
+func (m MsgSetMetadataUpdater) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if m.MetadataUpdater != "" {
+		_, err = sdk.AccAddressFromBech32(m.MetadataUpdater)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid metadata updater address (%s)", err)
+		}
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgSetMetadataUpdater) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetMetadataUpdater) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetMaxSupply{}
+
+// NewMsgSetMaxSupply creates a message to set the max supply of a denom
+func NewMsgSetMaxSupply(sender, denom string, maxSupply sdk.Int) *MsgSetMaxSupply {
+	return &MsgSetMaxSupply{
+		Sender:    sender,
+		Denom:     denom,
+		MaxSupply: maxSupply,
+	}
+}
+
+func (m MsgSetMaxSupply) Route() string { return RouterKey }
+func (m MsgSetMaxSupply) Type() string  { return TypeMsgSetMaxSupply }
+func (m MsgSetMaxSupply) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if m.MaxSupply.IsNil() || m.MaxSupply.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidMaxSupply, "max supply must not be negative")
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgSetMaxSupply) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetMaxSupply) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetComplianceOfficer{}
+
+// NewMsgSetComplianceOfficer creates a message to set the compliance officer of a denom
+func NewMsgSetComplianceOfficer(sender, denom, complianceOfficer string) *MsgSetComplianceOfficer {
+	return &MsgSetComplianceOfficer{
+		Sender:            sender,
+		Denom:             denom,
+		ComplianceOfficer: complianceOfficer,
+	}
+}
+
+func (m MsgSetComplianceOfficer) Route() string { return RouterKey }
+func (m MsgSetComplianceOfficer) Type() string  { return TypeMsgSetComplianceOfficer }
+func (m MsgSetComplianceOfficer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if m.ComplianceOfficer != "" {
+		_, err = sdk.AccAddressFromBech32(m.ComplianceOfficer)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid compliance officer address (%s)", err)
+		}
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgSetComplianceOfficer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetComplianceOfficer) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgFreeze{}
+
+// NewMsgFreeze creates a message to freeze or unfreeze an address for a denom
+func NewMsgFreeze(sender, denom, address string, frozen bool) *MsgFreeze {
+	return &MsgFreeze{
+		Sender:  sender,
+		Denom:   denom,
+		Address: address,
+		Frozen:  frozen,
+	}
+}
+
+func (m MsgFreeze) Route() string { return RouterKey }
+func (m MsgFreeze) Type() string  { return TypeMsgFreeze }
+func (m MsgFreeze) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Address)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgFreeze) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgFreeze) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgPause{}
+
+// NewMsgPause creates a message to pause or unpause the transfers of a denom
+func NewMsgPause(sender, denom string, paused bool) *MsgPause {
+	return &MsgPause{
+		Sender: sender,
+		Denom:  denom,
+		Paused: paused,
+	}
+}
+
+func (m MsgPause) Route() string { return RouterKey }
+func (m MsgPause) Type() string  { return TypeMsgPause }
+func (m MsgPause) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgPause) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgPause) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgApproveBurnFrom{}
+
+// NewMsgApproveBurnFrom creates a message to set the amount of the balance of
+// the sender that a burner may burn of a denom
+func NewMsgApproveBurnFrom(sender, denom, burner string, allowance sdk.Int) *MsgApproveBurnFrom {
+	return &MsgApproveBurnFrom{
+		Sender:    sender,
+		Denom:     denom,
+		Burner:    burner,
+		Allowance: allowance,
+	}
+}
+
+func (m MsgApproveBurnFrom) Route() string { return RouterKey }
+func (m MsgApproveBurnFrom) Type() string  { return TypeMsgApproveBurnFrom }
+func (m MsgApproveBurnFrom) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Burner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid burner address (%s)", err)
+	}
+
+	if m.Allowance.IsNil() || m.Allowance.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "allowance must not be negative")
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgApproveBurnFrom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgApproveBurnFrom) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetBeforeSendHook{}
+
+// NewMsgSetBeforeSendHook creates a message to set or remove the before send
+// hook contract of a denom
+func NewMsgSetBeforeSendHook(sender, denom, cosmwasmAddress string) *MsgSetBeforeSendHook {
+	return &MsgSetBeforeSendHook{
+		Sender:          sender,
+		Denom:           denom,
+		CosmwasmAddress: cosmwasmAddress,
+	}
+}
+
+func (m MsgSetBeforeSendHook) Route() string { return RouterKey }
+func (m MsgSetBeforeSendHook) Type() string  { return TypeMsgSetBeforeSendHook }
+func (m MsgSetBeforeSendHook) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if m.CosmwasmAddress != "" {
+		_, err = sdk.AccAddressFromBech32(m.CosmwasmAddress)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid cosmwasm address (%s)", err)
+		}
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgSetBeforeSendHook) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetBeforeSendHook) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
@@ -139,6 +139,22 @@ func TestMsgMint(t *testing.T) {
 			}),
 			expectPass: false,
 		},
+		{
+			name: "mint to address",
+			msg: createMsg(func(msg types.MsgMint) types.MsgMint {
+				msg.MintToAddress = addr1.String()
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid mint to address",
+			msg: createMsg(func(msg types.MsgMint) types.MsgMint {
+				msg.MintToAddress = "sei1invalid"
+				return msg
+			}),
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -209,6 +225,20 @@ func TestMsgBurn(t *testing.T) {
 			},
 			expectPass: false,
 		},
+		{
+			name: "burn from address",
+			msg: func() *types.MsgBurn {
+				return types.NewMsgBurnFrom(addr1.String(), sdk.NewCoin("bitcoin", sdk.NewInt(500000000)), addr1.String())
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid burn from address",
+			msg: func() *types.MsgBurn {
+				return types.NewMsgBurnFrom(addr1.String(), sdk.NewCoin("bitcoin", sdk.NewInt(500000000)), "sei1invalid")
+			},
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -293,3 +323,317 @@ func TestMsgChangeAdmin(t *testing.T) {
 		}
 	}
 }
+
+// TestMsgSetMinter tests if valid/invalid set minter messages are properly validated/invalidated
+func TestMsgSetMinter(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper setMinter message
+	baseMsg := types.NewMsgSetMinter(
+		addr1.String(),
+		tokenFactoryDenom,
+		addr2.String(),
+		sdk.NewInt(100),
+	)
+
+	// validate setMinter message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "set_minter")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgSetMinter
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgSetMinter {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "zero allowance",
+			msg: func() *types.MsgSetMinter {
+				msg := *baseMsg
+				msg.Allowance = sdk.ZeroInt()
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "negative allowance",
+			msg: func() *types.MsgSetMinter {
+				msg := *baseMsg
+				msg.Allowance = sdk.NewInt(-1)
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "empty minter",
+			msg: func() *types.MsgSetMinter {
+				msg := *baseMsg
+				msg.Minter = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgSetMinter {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgApproveBurnFrom tests if valid/invalid approve burn from messages are properly validated/invalidated
+func TestMsgApproveBurnFrom(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr2.String())
+
+	// make a proper approveBurnFrom message
+	baseMsg := types.NewMsgApproveBurnFrom(
+		addr1.String(),
+		tokenFactoryDenom,
+		addr2.String(),
+		sdk.NewInt(100),
+	)
+
+	// validate approveBurnFrom message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "approve_burn_from")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgApproveBurnFrom
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgApproveBurnFrom {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "zero allowance",
+			msg: func() *types.MsgApproveBurnFrom {
+				msg := *baseMsg
+				msg.Allowance = sdk.ZeroInt()
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "negative allowance",
+			msg: func() *types.MsgApproveBurnFrom {
+				msg := *baseMsg
+				msg.Allowance = sdk.NewInt(-1)
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "empty burner",
+			msg: func() *types.MsgApproveBurnFrom {
+				msg := *baseMsg
+				msg.Burner = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgApproveBurnFrom {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgSetMaxSupply tests if valid/invalid set max supply messages are properly validated/invalidated
+func TestMsgSetMaxSupply(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper setMaxSupply message
+	baseMsg := types.NewMsgSetMaxSupply(
+		addr1.String(),
+		tokenFactoryDenom,
+		sdk.NewInt(1000),
+	)
+
+	// validate setMaxSupply message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "set_max_supply")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgSetMaxSupply
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgSetMaxSupply {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "negative max supply",
+			msg: func() *types.MsgSetMaxSupply {
+				msg := *baseMsg
+				msg.MaxSupply = sdk.NewInt(-1)
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgSetMaxSupply {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgSetBeforeSendHook tests if valid/invalid set before send hook messages are properly validated/invalidated
+func TestMsgSetBeforeSendHook(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper setBeforeSendHook message
+	baseMsg := types.NewMsgSetBeforeSendHook(
+		addr1.String(),
+		tokenFactoryDenom,
+		addr2.String(),
+	)
+
+	// validate setBeforeSendHook message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "set_before_send_hook")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgSetBeforeSendHook
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgSetBeforeSendHook {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty cosmwasm address",
+			msg: func() *types.MsgSetBeforeSendHook {
+				msg := *baseMsg
+				msg.CosmwasmAddress = ""
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid cosmwasm address",
+			msg: func() *types.MsgSetBeforeSendHook {
+				msg := *baseMsg
+				msg.CosmwasmAddress = "moose"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgSetBeforeSendHook {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgSetBeforeSendHook {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
@@ -1,25 +1,102 @@
 package types
 
 import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
+// Parameter store keys.
+var (
+	KeyDenomCreationFee        = []byte("DenomCreationFee")
+	KeyBurnDenomCreationFee    = []byte("BurnDenomCreationFee")
+	KeyDenomCreationGasConsume = []byte("DenomCreationGasConsume")
+	KeyMaxDenomsPerCreator     = []byte("MaxDenomsPerCreator")
+	KeyBeforeSendHookGasLimit  = []byte("BeforeSendHookGasLimit")
+)
+
+const DefaultBeforeSendHookGasLimit = 500000
+
 // ParamTable for tokenfactory module.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
+func NewParams(denomCreationFee sdk.Coins, burnDenomCreationFee bool, denomCreationGasConsume uint64, maxDenomsPerCreator uint64, beforeSendHookGasLimit uint64) Params {
+	return Params{
+		DenomCreationFee:        denomCreationFee,
+		BurnDenomCreationFee:    burnDenomCreationFee,
+		DenomCreationGasConsume: denomCreationGasConsume,
+		MaxDenomsPerCreator:     maxDenomsPerCreator,
+		BeforeSendHookGasLimit:  beforeSendHookGasLimit,
+	}
+}
+
 // default tokenfactory module parameters.
 func DefaultParams() Params {
-	return Params{}
+	return Params{
+		DenomCreationFee:        sdk.NewCoins(),
+		BurnDenomCreationFee:    false,
+		DenomCreationGasConsume: 0,
+		MaxDenomsPerCreator:     0,
+		BeforeSendHookGasLimit:  DefaultBeforeSendHookGasLimit,
+	}
 }
 
 // validate params.
 func (p Params) Validate() error {
-	return nil
+	if err := validateDenomCreationFee(p.DenomCreationFee); err != nil {
+		return err
+	}
+	if err := validateBool(p.BurnDenomCreationFee); err != nil {
+		return err
+	}
+	if err := validateUint64(p.DenomCreationGasConsume); err != nil {
+		return err
+	}
+	if err := validateUint64(p.MaxDenomsPerCreator); err != nil {
+		return err
+	}
+	return validateUint64(p.BeforeSendHookGasLimit)
 }
 
 // Implements params.ParamSet.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyDenomCreationFee, &p.DenomCreationFee, validateDenomCreationFee),
+		paramtypes.NewParamSetPair(KeyBurnDenomCreationFee, &p.BurnDenomCreationFee, validateBool),
+		paramtypes.NewParamSetPair(KeyDenomCreationGasConsume, &p.DenomCreationGasConsume, validateUint64),
+		paramtypes.NewParamSetPair(KeyMaxDenomsPerCreator, &p.MaxDenomsPerCreator, validateUint64),
+		paramtypes.NewParamSetPair(KeyBeforeSendHookGasLimit, &p.BeforeSendHookGasLimit, validateUint64),
+	}
+}
+
+func validateDenomCreationFee(i interface{}) error {
+	fee, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := fee.Validate(); err != nil {
+		return fmt.Errorf("invalid denom creation fee: %w", err)
+	}
+
+	return nil
+}
+
+func validateBool(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateUint64(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
 }
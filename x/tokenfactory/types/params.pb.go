@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -24,6 +27,19 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the tokenfactory module.
 type Params struct {
+	// Fee charged to the creator of a denom, either burned or sent to the
+	// community pool
+	DenomCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=denom_creation_fee,json=denomCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"denom_creation_fee" yaml:"denom_creation_fee"`
+	// Whether the denom creation fee is burned instead of sent to the community
+	// pool
+	BurnDenomCreationFee bool `protobuf:"varint,2,opt,name=burn_denom_creation_fee,json=burnDenomCreationFee,proto3" json:"burn_denom_creation_fee,omitempty" yaml:"burn_denom_creation_fee"`
+	// Gas consumed by the creation of a denom, on top of the gas of the
+	// creation itself
+	DenomCreationGasConsume uint64 `protobuf:"varint,3,opt,name=denom_creation_gas_consume,json=denomCreationGasConsume,proto3" json:"denom_creation_gas_consume,omitempty" yaml:"denom_creation_gas_consume"`
+	// Max number of denoms an account can create, or 0 for no limit
+	MaxDenomsPerCreator uint64 `protobuf:"varint,4,opt,name=max_denoms_per_creator,json=maxDenomsPerCreator,proto3" json:"max_denoms_per_creator,omitempty" yaml:"max_denoms_per_creator"`
+	// Gas limit of each call of the before send hook contract of a denom
+	BeforeSendHookGasLimit uint64 `protobuf:"varint,5,opt,name=before_send_hook_gas_limit,json=beforeSendHookGasLimit,proto3" json:"before_send_hook_gas_limit,omitempty" yaml:"before_send_hook_gas_limit"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -59,6 +75,41 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetDenomCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DenomCreationFee
+	}
+	return nil
+}
+
+func (m *Params) GetBurnDenomCreationFee() bool {
+	if m != nil {
+		return m.BurnDenomCreationFee
+	}
+	return false
+}
+
+func (m *Params) GetDenomCreationGasConsume() uint64 {
+	if m != nil {
+		return m.DenomCreationGasConsume
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDenomsPerCreator() uint64 {
+	if m != nil {
+		return m.MaxDenomsPerCreator
+	}
+	return 0
+}
+
+func (m *Params) GetBeforeSendHookGasLimit() uint64 {
+	if m != nil {
+		return m.BeforeSendHookGasLimit
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "seiprotocol.seichain.tokenfactory.Params")
 }
@@ -66,17 +117,35 @@ func init() {
 func init() { proto.RegisterFile("tokenfactory/params.proto", fileDescriptor_0f39a375875b281a) }
 
 var fileDescriptor_0f39a375875b281a = []byte{
-	// 146 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2c, 0xc9, 0xcf, 0x4e,
-	0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x52, 0x2c, 0x4e, 0xcd, 0x04, 0xb3, 0x92, 0xf3, 0x73, 0xf4,
-	0x8a, 0x53, 0x33, 0x93, 0x33, 0x12, 0x33, 0xf3, 0xf4, 0x90, 0xd5, 0x2b, 0x71, 0x70, 0xb1, 0x05,
-	0x80, 0xb5, 0x38, 0x05, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72,
-	0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x59,
-	0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e, 0x71, 0x6a, 0xa6, 0x2e, 0xcc,
-	0x48, 0x30, 0x07, 0x6c, 0xa6, 0x7e, 0x85, 0x3e, 0x8a, 0x2b, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93,
-	0xd8, 0xc0, 0x0a, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x32, 0xb1, 0x98, 0xb4, 0xa2, 0x00,
-	0x00, 0x00,
+	// 439 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xc1, 0x8a, 0xd3, 0x40,
+	0x18, 0xc7, 0x1b, 0xb7, 0x2e, 0x12, 0x2f, 0x12, 0x97, 0xdd, 0xb6, 0x60, 0xd2, 0x06, 0x84, 0x5e,
+	0x36, 0xc3, 0x2a, 0x78, 0xf0, 0xd8, 0x8a, 0xeb, 0x41, 0xa1, 0x44, 0x10, 0xf4, 0x12, 0x26, 0xe9,
+	0xd7, 0x76, 0x68, 0x67, 0xbe, 0x32, 0xdf, 0x54, 0xda, 0xb7, 0xf0, 0xe4, 0xc5, 0x37, 0xf0, 0x49,
+	0xf6, 0xb8, 0x47, 0x4f, 0x51, 0xda, 0x37, 0xe8, 0x13, 0x48, 0x66, 0x2a, 0x74, 0x6b, 0xf7, 0x94,
+	0x49, 0xfe, 0xbf, 0xf9, 0xcd, 0x3f, 0xcc, 0xe7, 0x37, 0x0d, 0x4e, 0x41, 0x8d, 0x78, 0x61, 0x50,
+	0xaf, 0xd8, 0x9c, 0x6b, 0x2e, 0x29, 0x99, 0x6b, 0x34, 0x18, 0x74, 0x08, 0x84, 0x5d, 0x15, 0x38,
+	0x4b, 0x08, 0x44, 0x31, 0xe1, 0x42, 0x25, 0xfb, 0x7c, 0xeb, 0x6c, 0x8c, 0x63, 0xb4, 0x0c, 0xab,
+	0x56, 0x6e, 0x63, 0x2b, 0x2c, 0x90, 0x24, 0x12, 0xcb, 0x39, 0x01, 0xfb, 0x7a, 0x95, 0x83, 0xe1,
+	0x57, 0xac, 0x40, 0xa1, 0x5c, 0x1e, 0xff, 0xa8, 0xfb, 0xa7, 0x03, 0x7b, 0x52, 0xf0, 0xdd, 0xf3,
+	0x83, 0x21, 0x28, 0x94, 0x59, 0xa1, 0x81, 0x1b, 0x81, 0x2a, 0x1b, 0x01, 0x34, 0xbc, 0xf6, 0x49,
+	0xf7, 0xf1, 0x8b, 0x66, 0xe2, 0x44, 0x49, 0x25, 0x4a, 0x76, 0xa2, 0xa4, 0x8f, 0x42, 0xf5, 0x3e,
+	0xdc, 0x94, 0x51, 0x6d, 0x5b, 0x46, 0xcd, 0x15, 0x97, 0xb3, 0xd7, 0xf1, 0xff, 0x8a, 0xf8, 0xe7,
+	0xef, 0xa8, 0x3b, 0x16, 0x66, 0xb2, 0xc8, 0x93, 0x02, 0x25, 0xdb, 0x55, 0x72, 0x8f, 0x4b, 0x1a,
+	0x4e, 0x99, 0x59, 0xcd, 0x81, 0xac, 0x8d, 0xd2, 0x27, 0x56, 0xd0, 0xdf, 0xed, 0x7f, 0x0b, 0x10,
+	0x7c, 0xf6, 0x2f, 0xf2, 0x85, 0x56, 0xd9, 0x91, 0x72, 0x0f, 0xda, 0x5e, 0xf7, 0x51, 0x2f, 0xde,
+	0x96, 0x51, 0xe8, 0x4e, 0xbf, 0x07, 0x8c, 0xd3, 0xb3, 0x2a, 0x79, 0x73, 0xa8, 0xce, 0xfd, 0xd6,
+	0x01, 0x3c, 0xe6, 0x94, 0x15, 0xa8, 0x68, 0x21, 0xa1, 0x71, 0xd2, 0xf6, 0xba, 0xf5, 0xde, 0xf3,
+	0x6d, 0x19, 0x75, 0x8e, 0xfe, 0xdb, 0x1e, 0x1b, 0xa7, 0x17, 0x77, 0x7a, 0x5f, 0x73, 0xea, 0xbb,
+	0x24, 0xf8, 0xe4, 0x9f, 0x4b, 0xbe, 0x74, 0xa5, 0x28, 0x9b, 0x83, 0x76, 0x02, 0xd4, 0x8d, 0xba,
+	0xf5, 0x77, 0xb6, 0x65, 0xf4, 0xcc, 0xf9, 0x8f, 0x73, 0x71, 0xfa, 0x54, 0xf2, 0xa5, 0xed, 0x4e,
+	0x03, 0xd0, 0x7d, 0xf7, 0x35, 0xe0, 0x7e, 0x2b, 0x87, 0x11, 0x6a, 0xc8, 0x08, 0xd4, 0x30, 0x9b,
+	0x20, 0x4e, 0x6d, 0xa3, 0x99, 0x90, 0xc2, 0x34, 0x1e, 0x1e, 0x76, 0xbf, 0x9f, 0x8d, 0xd3, 0x73,
+	0x17, 0x7e, 0x04, 0x35, 0x7c, 0x87, 0x38, 0xbd, 0xe6, 0xf4, 0xbe, 0x0a, 0x7a, 0x83, 0x9b, 0x75,
+	0xe8, 0xdd, 0xae, 0x43, 0xef, 0xcf, 0x3a, 0xf4, 0xbe, 0x6d, 0xc2, 0xda, 0xed, 0x26, 0xac, 0xfd,
+	0xda, 0x84, 0xb5, 0x2f, 0xaf, 0xf6, 0xee, 0x93, 0x40, 0x5c, 0xfe, 0x1b, 0x4e, 0xfb, 0x62, 0xa7,
+	0x93, 0x2d, 0xd9, 0x9d, 0x79, 0xb6, 0x77, 0x9c, 0x9f, 0x5a, 0xf0, 0xe5, 0xdf, 0x01, 0x00, 0x82,
+	0x96, 0x8d, 0x85, 0xec, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -99,6 +168,45 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BeforeSendHookGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BeforeSendHookGasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxDenomsPerCreator != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDenomsPerCreator))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DenomCreationGasConsume != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DenomCreationGasConsume))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BurnDenomCreationFee {
+		i--
+		if m.BurnDenomCreationFee {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DenomCreationFee) > 0 {
+		for iNdEx := len(m.DenomCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -119,6 +227,24 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if len(m.DenomCreationFee) > 0 {
+		for _, e := range m.DenomCreationFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.BurnDenomCreationFee {
+		n += 2
+	}
+	if m.DenomCreationGasConsume != 0 {
+		n += 1 + sovParams(uint64(m.DenomCreationGasConsume))
+	}
+	if m.MaxDenomsPerCreator != 0 {
+		n += 1 + sovParams(uint64(m.MaxDenomsPerCreator))
+	}
+	if m.BeforeSendHookGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.BeforeSendHookGasLimit))
+	}
 	return n
 }
 
@@ -157,6 +283,117 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomCreationFee = append(m.DenomCreationFee, types.Coin{})
+			if err := m.DenomCreationFee[len(m.DenomCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnDenomCreationFee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnDenomCreationFee = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCreationGasConsume", wireType)
+			}
+			m.DenomCreationGasConsume = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DenomCreationGasConsume |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDenomsPerCreator", wireType)
+			}
+			m.MaxDenomsPerCreator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDenomsPerCreator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeSendHookGasLimit", wireType)
+			}
+			m.BeforeSendHookGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeforeSendHookGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
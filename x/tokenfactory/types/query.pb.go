@@ -344,7 +344,7 @@ func (m *QueryDenomMetadataRequest) GetDenom() string {
 	return ""
 }
 
-// QueryDenomMetadataResponse is the response type for the Query/DenomMetadata RPC
+// QueryDenomMetadataResponse is the response type for the Query/DenomMetadata gRPC
 // method.
 type QueryDenomMetadataResponse struct {
 	// metadata describes and provides all the client information for the requested token.
@@ -391,6 +391,282 @@ func (m *QueryDenomMetadataResponse) GetMetadata() types.Metadata {
 	return types.Metadata{}
 }
 
+// QueryDenomComplianceRequest defines the request structure for the
+// DenomCompliance gRPC query.
+type QueryDenomComplianceRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *QueryDenomComplianceRequest) Reset()         { *m = QueryDenomComplianceRequest{} }
+func (m *QueryDenomComplianceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomComplianceRequest) ProtoMessage()    {}
+func (*QueryDenomComplianceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{8}
+}
+func (m *QueryDenomComplianceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomComplianceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomComplianceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomComplianceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomComplianceRequest.Merge(m, src)
+}
+func (m *QueryDenomComplianceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomComplianceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomComplianceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomComplianceRequest proto.InternalMessageInfo
+
+func (m *QueryDenomComplianceRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryDenomComplianceResponse defines the response structure for the
+// DenomCompliance gRPC query.
+type QueryDenomComplianceResponse struct {
+	Compliance DenomCompliance `protobuf:"bytes,1,opt,name=compliance,proto3" json:"compliance" yaml:"compliance"`
+}
+
+func (m *QueryDenomComplianceResponse) Reset()         { *m = QueryDenomComplianceResponse{} }
+func (m *QueryDenomComplianceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomComplianceResponse) ProtoMessage()    {}
+func (*QueryDenomComplianceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{9}
+}
+func (m *QueryDenomComplianceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomComplianceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomComplianceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomComplianceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomComplianceResponse.Merge(m, src)
+}
+func (m *QueryDenomComplianceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomComplianceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomComplianceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomComplianceResponse proto.InternalMessageInfo
+
+func (m *QueryDenomComplianceResponse) GetCompliance() DenomCompliance {
+	if m != nil {
+		return m.Compliance
+	}
+	return DenomCompliance{}
+}
+
+// QueryFrozenAddressesRequest defines the request structure for the
+// FrozenAddresses gRPC query.
+type QueryFrozenAddressesRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *QueryFrozenAddressesRequest) Reset()         { *m = QueryFrozenAddressesRequest{} }
+func (m *QueryFrozenAddressesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenAddressesRequest) ProtoMessage()    {}
+func (*QueryFrozenAddressesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{10}
+}
+func (m *QueryFrozenAddressesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenAddressesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenAddressesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFrozenAddressesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenAddressesRequest.Merge(m, src)
+}
+func (m *QueryFrozenAddressesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenAddressesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenAddressesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFrozenAddressesRequest proto.InternalMessageInfo
+
+func (m *QueryFrozenAddressesRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryFrozenAddressesResponse defines the response structure for the
+// FrozenAddresses gRPC query.
+type QueryFrozenAddressesResponse struct {
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty" yaml:"addresses"`
+}
+
+func (m *QueryFrozenAddressesResponse) Reset()         { *m = QueryFrozenAddressesResponse{} }
+func (m *QueryFrozenAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenAddressesResponse) ProtoMessage()    {}
+func (*QueryFrozenAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{11}
+}
+func (m *QueryFrozenAddressesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenAddressesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFrozenAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenAddressesResponse.Merge(m, src)
+}
+func (m *QueryFrozenAddressesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenAddressesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFrozenAddressesResponse proto.InternalMessageInfo
+
+func (m *QueryFrozenAddressesResponse) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+// QueryBeforeSendHookAddressRequest defines the request structure for the
+// BeforeSendHookAddress gRPC query.
+type QueryBeforeSendHookAddressRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *QueryBeforeSendHookAddressRequest) Reset()         { *m = QueryBeforeSendHookAddressRequest{} }
+func (m *QueryBeforeSendHookAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBeforeSendHookAddressRequest) ProtoMessage()    {}
+func (*QueryBeforeSendHookAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{12}
+}
+func (m *QueryBeforeSendHookAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBeforeSendHookAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBeforeSendHookAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBeforeSendHookAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBeforeSendHookAddressRequest.Merge(m, src)
+}
+func (m *QueryBeforeSendHookAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBeforeSendHookAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBeforeSendHookAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBeforeSendHookAddressRequest proto.InternalMessageInfo
+
+func (m *QueryBeforeSendHookAddressRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryBeforeSendHookAddressResponse defines the response structure for the
+// BeforeSendHookAddress gRPC query.
+type QueryBeforeSendHookAddressResponse struct {
+	CosmwasmAddress string `protobuf:"bytes,1,opt,name=cosmwasm_address,json=cosmwasmAddress,proto3" json:"cosmwasm_address,omitempty" yaml:"cosmwasm_address"`
+}
+
+func (m *QueryBeforeSendHookAddressResponse) Reset()         { *m = QueryBeforeSendHookAddressResponse{} }
+func (m *QueryBeforeSendHookAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBeforeSendHookAddressResponse) ProtoMessage()    {}
+func (*QueryBeforeSendHookAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{13}
+}
+func (m *QueryBeforeSendHookAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBeforeSendHookAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBeforeSendHookAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBeforeSendHookAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBeforeSendHookAddressResponse.Merge(m, src)
+}
+func (m *QueryBeforeSendHookAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBeforeSendHookAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBeforeSendHookAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBeforeSendHookAddressResponse proto.InternalMessageInfo
+
+func (m *QueryBeforeSendHookAddressResponse) GetCosmwasmAddress() string {
+	if m != nil {
+		return m.CosmwasmAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "seiprotocol.seichain.tokenfactory.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "seiprotocol.seichain.tokenfactory.QueryParamsResponse")
@@ -400,54 +676,75 @@ func init() {
 	proto.RegisterType((*QueryDenomsFromCreatorResponse)(nil), "seiprotocol.seichain.tokenfactory.QueryDenomsFromCreatorResponse")
 	proto.RegisterType((*QueryDenomMetadataRequest)(nil), "seiprotocol.seichain.tokenfactory.QueryDenomMetadataRequest")
 	proto.RegisterType((*QueryDenomMetadataResponse)(nil), "seiprotocol.seichain.tokenfactory.QueryDenomMetadataResponse")
+	proto.RegisterType((*QueryDenomComplianceRequest)(nil), "seiprotocol.seichain.tokenfactory.QueryDenomComplianceRequest")
+	proto.RegisterType((*QueryDenomComplianceResponse)(nil), "seiprotocol.seichain.tokenfactory.QueryDenomComplianceResponse")
+	proto.RegisterType((*QueryFrozenAddressesRequest)(nil), "seiprotocol.seichain.tokenfactory.QueryFrozenAddressesRequest")
+	proto.RegisterType((*QueryFrozenAddressesResponse)(nil), "seiprotocol.seichain.tokenfactory.QueryFrozenAddressesResponse")
+	proto.RegisterType((*QueryBeforeSendHookAddressRequest)(nil), "seiprotocol.seichain.tokenfactory.QueryBeforeSendHookAddressRequest")
+	proto.RegisterType((*QueryBeforeSendHookAddressResponse)(nil), "seiprotocol.seichain.tokenfactory.QueryBeforeSendHookAddressResponse")
 }
 
 func init() { proto.RegisterFile("tokenfactory/query.proto", fileDescriptor_78516c77a1ba9513) }
 
 var fileDescriptor_78516c77a1ba9513 = []byte{
-	// 659 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x4f, 0x13, 0x41,
-	0x14, 0xee, 0xaa, 0x54, 0x19, 0xc5, 0xc8, 0x48, 0x0c, 0x6c, 0x64, 0x2b, 0xa3, 0x31, 0x40, 0x60,
-	0x27, 0x40, 0x20, 0xf1, 0x57, 0x94, 0x22, 0x10, 0xa3, 0x24, 0xb8, 0x47, 0x13, 0x25, 0xd3, 0x65,
-	0x58, 0x36, 0xb0, 0x3b, 0xcb, 0xce, 0xd4, 0xd8, 0x10, 0x2e, 0x5e, 0xbd, 0x98, 0xf8, 0x67, 0x78,
-	0xf4, 0x9f, 0xc0, 0x1b, 0x89, 0x17, 0x4f, 0x0d, 0x69, 0xfd, 0x0b, 0x7a, 0xf0, 0x6c, 0x3a, 0x33,
-	0x5d, 0xd8, 0x6e, 0xc5, 0x56, 0x4e, 0x9d, 0xce, 0x7b, 0xef, 0x7b, 0xdf, 0xf7, 0xde, 0x37, 0x0b,
-	0x86, 0x05, 0xdb, 0xa1, 0xe1, 0x16, 0x71, 0x05, 0x8b, 0x2b, 0x78, 0xaf, 0x4c, 0xe3, 0x8a, 0x1d,
-	0xc5, 0x4c, 0x30, 0x38, 0xc6, 0xa9, 0x2f, 0x4f, 0x2e, 0xdb, 0xb5, 0x39, 0xf5, 0xdd, 0x6d, 0xe2,
-	0x87, 0xf6, 0xe9, 0x74, 0x73, 0xc8, 0x63, 0x1e, 0x93, 0x39, 0xb8, 0x79, 0x52, 0x85, 0xe6, 0x6d,
-	0x8f, 0x31, 0x6f, 0x97, 0x62, 0x12, 0xf9, 0x98, 0x84, 0x21, 0x13, 0x44, 0xf8, 0x2c, 0xe4, 0x3a,
-	0x6a, 0xb9, 0x8c, 0x07, 0x8c, 0xe3, 0x12, 0x09, 0x77, 0xf0, 0xfb, 0x99, 0x12, 0x15, 0x64, 0x46,
-	0xfe, 0xd1, 0xf1, 0xc9, 0x24, 0xce, 0xa9, 0xe2, 0x93, 0x64, 0x45, 0xc4, 0xf3, 0x43, 0x09, 0xa6,
-	0x73, 0xef, 0xa5, 0xc8, 0x93, 0xb2, 0xd8, 0x66, 0xb1, 0x2f, 0x2a, 0x6b, 0x54, 0x90, 0x4d, 0x22,
-	0x88, 0xce, 0x1a, 0x49, 0x65, 0x45, 0x24, 0x26, 0x81, 0x26, 0x83, 0x86, 0x00, 0x7c, 0xdd, 0x6c,
-	0xb1, 0x2e, 0x2f, 0x1d, 0xba, 0x57, 0xa6, 0x5c, 0xa0, 0x77, 0xe0, 0x66, 0xea, 0x96, 0x47, 0x2c,
-	0xe4, 0x14, 0xae, 0x82, 0xbc, 0x2a, 0x1e, 0x36, 0xee, 0x18, 0xe3, 0x57, 0x67, 0x27, 0xec, 0x7f,
-	0x4e, 0xc8, 0x56, 0x10, 0xc5, 0x4b, 0x87, 0xd5, 0x42, 0xce, 0xd1, 0xe5, 0xe8, 0x15, 0x40, 0x12,
-	0xff, 0x39, 0x0d, 0x59, 0xb0, 0xd8, 0xce, 0x5a, 0xb3, 0x80, 0xf7, 0x41, 0xdf, 0x66, 0x33, 0x41,
-	0x76, 0xeb, 0x2f, 0xde, 0x68, 0x54, 0x0b, 0xd7, 0x2a, 0x24, 0xd8, 0x7d, 0x88, 0xe4, 0x35, 0x72,
-	0x54, 0x18, 0x7d, 0x33, 0xc0, 0xdd, 0x33, 0xe1, 0x34, 0xfd, 0x4f, 0x06, 0x80, 0xc9, 0x88, 0x36,
-	0x02, 0x1d, 0xd6, 0x5a, 0x1e, 0x74, 0xa1, 0xa5, 0x33, 0x7e, 0x71, 0xac, 0xa9, 0xad, 0x51, 0x2d,
-	0x8c, 0x28, 0x72, 0xd9, 0x16, 0xc8, 0x19, 0xcc, 0xac, 0x06, 0xad, 0x81, 0xd1, 0x13, 0xd2, 0x7c,
-	0x25, 0x66, 0xc1, 0x52, 0x4c, 0x89, 0x60, 0x71, 0x4b, 0xfe, 0x14, 0xb8, 0xec, 0xaa, 0x1b, 0x3d,
-	0x00, 0xd8, 0xa8, 0x16, 0xae, 0xab, 0x1e, 0x3a, 0x80, 0x9c, 0x56, 0x0a, 0x7a, 0x09, 0xac, 0xbf,
-	0xc1, 0x69, 0xf9, 0x13, 0x20, 0x2f, 0xe7, 0xd5, 0xdc, 0xde, 0xc5, 0xf1, 0xfe, 0xe2, 0x60, 0xa3,
-	0x5a, 0x18, 0x38, 0x35, 0x4f, 0x8e, 0x1c, 0x9d, 0x80, 0x66, 0xc0, 0xc8, 0x09, 0x58, 0xfb, 0x5a,
-	0x86, 0x52, 0x6b, 0x69, 0x2d, 0xe1, 0x2d, 0x30, 0x3b, 0x95, 0xe8, 0xde, 0x4f, 0xc1, 0x95, 0xb6,
-	0x79, 0x8f, 0xda, 0xca, 0xe6, 0xb6, 0x74, 0xbe, 0x36, 0xb8, 0x9d, 0xcc, 0x54, 0xf9, 0x25, 0x29,
-	0x9a, 0x3d, 0xce, 0x83, 0x3e, 0x89, 0x0f, 0xbf, 0x1a, 0x20, 0xaf, 0x4c, 0x05, 0xe7, 0xbb, 0xd8,
-	0x59, 0xd6, 0xdd, 0xe6, 0x42, 0xaf, 0x65, 0x4a, 0x04, 0x9a, 0xfd, 0xf8, 0xe3, 0xd7, 0x97, 0x0b,
-	0x53, 0x70, 0x12, 0x73, 0xea, 0x4f, 0xb7, 0x00, 0x70, 0x0b, 0x00, 0x77, 0x78, 0x65, 0xf0, 0xb7,
-	0x01, 0x6e, 0x75, 0xb6, 0x0d, 0x5c, 0xee, 0x96, 0xc6, 0x99, 0xaf, 0xc4, 0x5c, 0x39, 0x2f, 0x8c,
-	0x56, 0xb7, 0x26, 0xd5, 0xad, 0xc2, 0xe5, 0x6e, 0xd4, 0x29, 0x9f, 0xe0, 0x7d, 0xf9, 0x7b, 0x80,
-	0xb3, 0x96, 0x87, 0xdf, 0x0d, 0x30, 0x90, 0xf2, 0x02, 0x7c, 0xdc, 0x13, 0xd1, 0x76, 0x99, 0x4f,
-	0xfe, 0xb3, 0x5a, 0xab, 0x7b, 0x24, 0xd5, 0xcd, 0xc3, 0xb9, 0x1e, 0xd4, 0x25, 0x5a, 0xea, 0x06,
-	0x18, 0xcc, 0xbc, 0x2b, 0xf8, 0xac, 0x27, 0x46, 0x1d, 0x5e, 0xb8, 0xb9, 0x78, 0x0e, 0x04, 0xad,
-	0xeb, 0x85, 0xd4, 0xb5, 0x04, 0x17, 0xbb, 0xd7, 0xb5, 0xb1, 0x15, 0xb3, 0x60, 0x43, 0x7f, 0x37,
-	0xf0, 0xbe, 0x3e, 0x1c, 0x14, 0xd7, 0x0f, 0x6b, 0x96, 0x71, 0x54, 0xb3, 0x8c, 0xe3, 0x9a, 0x65,
-	0x7c, 0xae, 0x5b, 0xb9, 0xa3, 0xba, 0x95, 0xfb, 0x59, 0xb7, 0x72, 0x6f, 0x16, 0x3c, 0x5f, 0x6c,
-	0x97, 0x4b, 0xb6, 0xcb, 0x82, 0x4c, 0x9b, 0x69, 0xd5, 0xe7, 0x43, 0xba, 0x93, 0xa8, 0x44, 0x94,
-	0x97, 0xf2, 0x32, 0x71, 0xee, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x35, 0x3d, 0xcc, 0x7b, 0x63,
-	0x07, 0x00, 0x00,
+	// 910 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xdf, 0x6f, 0xdb, 0x54,
+	0x14, 0xae, 0x81, 0x15, 0x7a, 0xd8, 0x68, 0x7b, 0x29, 0xb0, 0x7a, 0xad, 0x43, 0x2f, 0x08, 0x6d,
+	0xd3, 0x66, 0xab, 0x99, 0x36, 0x89, 0x1f, 0xa3, 0x34, 0x5d, 0x3a, 0x50, 0xa9, 0x34, 0xcc, 0x1b,
+	0x12, 0x44, 0x37, 0xce, 0x6d, 0x6a, 0x25, 0xf6, 0xcd, 0x7c, 0x1d, 0x20, 0x4c, 0x7b, 0xe1, 0x15,
+	0x21, 0x21, 0xf8, 0x33, 0x78, 0xe4, 0x9f, 0x18, 0x6f, 0x95, 0x78, 0x81, 0x97, 0x80, 0x1a, 0xfe,
+	0x01, 0xf2, 0xc0, 0x33, 0xca, 0xf5, 0xb1, 0x53, 0x27, 0x26, 0xd8, 0x29, 0x4f, 0x71, 0xee, 0x39,
+	0xe7, 0x3b, 0xdf, 0x77, 0xcf, 0xf1, 0x67, 0xb8, 0x1c, 0x8a, 0x16, 0xf7, 0x8f, 0x98, 0x13, 0x8a,
+	0xa0, 0x67, 0x3d, 0xec, 0xf2, 0xa0, 0x67, 0x76, 0x02, 0x11, 0x0a, 0xb2, 0x25, 0xb9, 0xab, 0x9e,
+	0x1c, 0xd1, 0x36, 0x25, 0x77, 0x9d, 0x63, 0xe6, 0xfa, 0xe6, 0xd9, 0x74, 0x7d, 0xad, 0x29, 0x9a,
+	0x42, 0xe5, 0x58, 0xa3, 0xa7, 0xa8, 0x50, 0xdf, 0x68, 0x0a, 0xd1, 0x6c, 0x73, 0x8b, 0x75, 0x5c,
+	0x8b, 0xf9, 0xbe, 0x08, 0x59, 0xe8, 0x0a, 0x5f, 0x62, 0xd4, 0x70, 0x84, 0xf4, 0x84, 0xb4, 0xea,
+	0xcc, 0x6f, 0x59, 0x9f, 0x6f, 0xd7, 0x79, 0xc8, 0xb6, 0xd5, 0x1f, 0x8c, 0x5f, 0x4f, 0xe2, 0x92,
+	0x47, 0x7c, 0x92, 0xac, 0x0e, 0x6b, 0xba, 0xbe, 0x02, 0xc3, 0xdc, 0xd7, 0x53, 0xe4, 0x59, 0x37,
+	0x3c, 0x16, 0x81, 0x1b, 0xf6, 0x0e, 0x79, 0xc8, 0x1a, 0x2c, 0x64, 0x98, 0xb5, 0x99, 0xca, 0x72,
+	0x84, 0xd7, 0x69, 0xbb, 0xcc, 0x77, 0x38, 0x86, 0xd7, 0x53, 0xe1, 0x0e, 0x0b, 0x98, 0x87, 0x5c,
+	0xe9, 0x1a, 0x90, 0x8f, 0x46, 0x0c, 0x1e, 0xa8, 0x43, 0x9b, 0x3f, 0xec, 0x72, 0x19, 0xd2, 0xcf,
+	0xe0, 0xc5, 0xd4, 0xa9, 0xec, 0x08, 0x5f, 0x72, 0x72, 0x1f, 0x16, 0xa3, 0xe2, 0xcb, 0xda, 0xab,
+	0xda, 0xd5, 0xe7, 0xcb, 0xd7, 0xcc, 0xff, 0xbc, 0x40, 0x33, 0x82, 0xa8, 0x3c, 0xf3, 0xa4, 0x5f,
+	0x5a, 0xb0, 0xb1, 0x9c, 0x7e, 0x08, 0x54, 0xe1, 0xdf, 0xe3, 0xbe, 0xf0, 0x76, 0x27, 0x45, 0x21,
+	0x0b, 0xf2, 0x06, 0x5c, 0x68, 0x8c, 0x12, 0x54, 0xb7, 0xa5, 0xca, 0xca, 0xb0, 0x5f, 0xba, 0xd8,
+	0x63, 0x5e, 0xfb, 0x2d, 0xaa, 0x8e, 0xa9, 0x1d, 0x85, 0xe9, 0x4f, 0x1a, 0xbc, 0x36, 0x13, 0x0e,
+	0xe9, 0x7f, 0xa3, 0x01, 0x49, 0x6e, 0xb0, 0xe6, 0x61, 0x18, 0xb5, 0xbc, 0x99, 0x43, 0x4b, 0x36,
+	0x7e, 0x65, 0x6b, 0xa4, 0x6d, 0xd8, 0x2f, 0xad, 0x47, 0xe4, 0xa6, 0x5b, 0x50, 0x7b, 0x75, 0x6a,
+	0x72, 0xf4, 0x10, 0x36, 0xc7, 0xa4, 0xe5, 0x7e, 0x20, 0xbc, 0xbd, 0x80, 0xb3, 0x50, 0x04, 0xb1,
+	0xfc, 0x1b, 0xf0, 0xac, 0x13, 0x9d, 0xe0, 0x05, 0x90, 0x61, 0xbf, 0xf4, 0x42, 0xd4, 0x03, 0x03,
+	0xd4, 0x8e, 0x53, 0xe8, 0x01, 0x18, 0xff, 0x06, 0x87, 0xf2, 0xaf, 0xc1, 0xa2, 0xba, 0xaf, 0xd1,
+	0xf4, 0x9e, 0xbe, 0xba, 0x54, 0x59, 0x1d, 0xf6, 0x4b, 0x97, 0xce, 0xdc, 0xa7, 0xa4, 0x36, 0x26,
+	0xd0, 0x6d, 0x58, 0x1f, 0x83, 0x4d, 0x8e, 0x65, 0x2d, 0x35, 0x96, 0x78, 0x08, 0x9f, 0x82, 0x9e,
+	0x55, 0x82, 0xbd, 0x77, 0xe0, 0xb9, 0x89, 0xfb, 0xde, 0x34, 0xa3, 0xb7, 0xc0, 0x54, 0x2f, 0x06,
+	0xee, 0xbf, 0x99, 0xdc, 0x69, 0xb4, 0x2f, 0x49, 0x11, 0xad, 0xc2, 0x95, 0x31, 0xfc, 0x5e, 0xb2,
+	0xe0, 0x45, 0x57, 0xe5, 0x5b, 0x0d, 0x36, 0xb2, 0x71, 0x90, 0xa8, 0x07, 0x30, 0x7e, 0x7d, 0x90,
+	0x6a, 0x39, 0xef, 0x6a, 0x8c, 0xf1, 0x2a, 0xeb, 0xb8, 0x13, 0xab, 0x38, 0xaf, 0x24, 0x42, 0xed,
+	0x33, 0x0d, 0x12, 0x59, 0xfb, 0x81, 0xf8, 0x8a, 0xfb, 0xbb, 0x8d, 0x46, 0xc0, 0xa5, 0xe4, 0xb2,
+	0xa8, 0x2c, 0x1b, 0x36, 0xb2, 0x61, 0x50, 0x55, 0x19, 0x96, 0x58, 0x7c, 0x88, 0xd3, 0x5f, 0x1b,
+	0xf6, 0x4b, 0x2b, 0xb8, 0xb0, 0x71, 0x88, 0xda, 0xe3, 0x34, 0x7a, 0x00, 0x5b, 0x0a, 0xb3, 0xc2,
+	0x8f, 0x44, 0xc0, 0x3f, 0xe6, 0x7e, 0xe3, 0x7d, 0x21, 0x5a, 0x88, 0x5d, 0x94, 0x60, 0x1b, 0xe8,
+	0x2c, 0x30, 0xa4, 0xb9, 0x0f, 0x2b, 0xa3, 0xa5, 0xf8, 0x82, 0x49, 0xaf, 0x86, 0x44, 0x10, 0xf8,
+	0xca, 0xb0, 0x5f, 0x7a, 0x25, 0xbe, 0xca, 0x74, 0x06, 0xb5, 0x97, 0xe3, 0x23, 0xc4, 0x2b, 0x7f,
+	0x7f, 0x11, 0x2e, 0xa8, 0x76, 0xe4, 0x47, 0x0d, 0x16, 0x23, 0x07, 0x22, 0xb7, 0x73, 0x4c, 0x71,
+	0xda, 0x0a, 0xf5, 0x3b, 0x45, 0xcb, 0x22, 0x2d, 0xb4, 0xfc, 0xf5, 0x2f, 0x7f, 0xfe, 0xf0, 0xd4,
+	0x0d, 0x72, 0xdd, 0x92, 0xdc, 0xbd, 0x19, 0x03, 0x58, 0x31, 0x80, 0x95, 0x61, 0xc9, 0xe4, 0x6f,
+	0x0d, 0x5e, 0xce, 0xf6, 0x18, 0x52, 0xcd, 0x4b, 0x63, 0xa6, 0xa5, 0xea, 0xfb, 0xe7, 0x85, 0x41,
+	0x75, 0x87, 0x4a, 0xdd, 0x7d, 0x52, 0xcd, 0xa3, 0x2e, 0x32, 0x15, 0xeb, 0x91, 0xfa, 0x7d, 0x6c,
+	0x4d, 0xfb, 0x23, 0xf9, 0x59, 0x83, 0x4b, 0x29, 0xe3, 0x20, 0xef, 0x14, 0x22, 0x3a, 0x29, 0xf3,
+	0xee, 0x9c, 0xd5, 0xa8, 0xee, 0x6d, 0xa5, 0xee, 0x36, 0xb9, 0x55, 0x40, 0x5d, 0xa2, 0x65, 0xa0,
+	0xc1, 0xea, 0x94, 0x09, 0x93, 0xf7, 0x0a, 0x31, 0xca, 0xf8, 0x1c, 0xe8, 0xbb, 0xe7, 0x40, 0x40,
+	0x5d, 0x1f, 0x28, 0x5d, 0x7b, 0x64, 0x37, 0xbf, 0xae, 0xda, 0x51, 0x20, 0xbc, 0x1a, 0x7e, 0x64,
+	0xac, 0x47, 0xf8, 0xf0, 0x98, 0xfc, 0xa6, 0xc1, 0xf2, 0x84, 0xe7, 0x91, 0x77, 0x0b, 0x31, 0x9c,
+	0x32, 0x71, 0x7d, 0x67, 0xee, 0x7a, 0xd4, 0x57, 0x55, 0xfa, 0x76, 0xc8, 0xdd, 0x39, 0xb6, 0x72,
+	0x6c, 0xca, 0xe4, 0x77, 0x0d, 0x96, 0x27, 0x9c, 0x34, 0xbf, 0xb6, 0x6c, 0x27, 0xd7, 0x77, 0xe6,
+	0xae, 0x47, 0x6d, 0x07, 0x4a, 0x5b, 0x95, 0xec, 0xcd, 0xa1, 0xed, 0x48, 0x61, 0xd6, 0x12, 0x6f,
+	0x27, 0x7f, 0x69, 0xf0, 0x52, 0xa6, 0x15, 0x93, 0x7b, 0x79, 0x79, 0xce, 0xfa, 0x2c, 0xe8, 0xd5,
+	0x73, 0xa2, 0xfc, 0x0f, 0x9a, 0xeb, 0x0a, 0xb9, 0x26, 0xb9, 0xdf, 0xa8, 0x1d, 0x0b, 0xd1, 0xaa,
+	0x3c, 0x78, 0x72, 0x6a, 0x68, 0x27, 0xa7, 0x86, 0xf6, 0xc7, 0xa9, 0xa1, 0x7d, 0x37, 0x30, 0x16,
+	0x4e, 0x06, 0xc6, 0xc2, 0xaf, 0x03, 0x63, 0xe1, 0x93, 0x3b, 0x4d, 0x37, 0x3c, 0xee, 0xd6, 0x4d,
+	0x47, 0x78, 0x53, 0x8d, 0x6e, 0x46, 0x9d, 0xbe, 0x4c, 0xf7, 0x0a, 0x7b, 0x1d, 0x2e, 0xeb, 0x8b,
+	0x2a, 0xf1, 0xd6, 0x3f, 0x03, 0x00, 0xe0, 0x09, 0xfd, 0xc7, 0x61, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -474,6 +771,15 @@ type QueryClient interface {
 	// DenomsFromCreator defines a gRPC query method for fetching all
 	// denominations created by a specific admin/creator.
 	DenomsFromCreator(ctx context.Context, in *QueryDenomsFromCreatorRequest, opts ...grpc.CallOption) (*QueryDenomsFromCreatorResponse, error)
+	// DenomCompliance defines a gRPC query method for fetching the compliance
+	// state of a particular denom.
+	DenomCompliance(ctx context.Context, in *QueryDenomComplianceRequest, opts ...grpc.CallOption) (*QueryDenomComplianceResponse, error)
+	// FrozenAddresses defines a gRPC query method for fetching the frozen
+	// addresses of a particular denom.
+	FrozenAddresses(ctx context.Context, in *QueryFrozenAddressesRequest, opts ...grpc.CallOption) (*QueryFrozenAddressesResponse, error)
+	// BeforeSendHookAddress defines a gRPC query method for fetching the address
+	// of the before send hook contract of a particular denom.
+	BeforeSendHookAddress(ctx context.Context, in *QueryBeforeSendHookAddressRequest, opts ...grpc.CallOption) (*QueryBeforeSendHookAddressResponse, error)
 }
 
 type queryClient struct {
@@ -520,6 +826,33 @@ func (c *queryClient) DenomsFromCreator(ctx context.Context, in *QueryDenomsFrom
 	return out, nil
 }
 
+func (c *queryClient) DenomCompliance(ctx context.Context, in *QueryDenomComplianceRequest, opts ...grpc.CallOption) (*QueryDenomComplianceResponse, error) {
+	out := new(QueryDenomComplianceResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.tokenfactory.Query/DenomCompliance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FrozenAddresses(ctx context.Context, in *QueryFrozenAddressesRequest, opts ...grpc.CallOption) (*QueryFrozenAddressesResponse, error) {
+	out := new(QueryFrozenAddressesResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.tokenfactory.Query/FrozenAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BeforeSendHookAddress(ctx context.Context, in *QueryBeforeSendHookAddressRequest, opts ...grpc.CallOption) (*QueryBeforeSendHookAddressResponse, error) {
+	out := new(QueryBeforeSendHookAddressResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.tokenfactory.Query/BeforeSendHookAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params defines a gRPC query method that returns the tokenfactory module's
@@ -534,6 +867,15 @@ type QueryServer interface {
 	// DenomsFromCreator defines a gRPC query method for fetching all
 	// denominations created by a specific admin/creator.
 	DenomsFromCreator(context.Context, *QueryDenomsFromCreatorRequest) (*QueryDenomsFromCreatorResponse, error)
+	// DenomCompliance defines a gRPC query method for fetching the compliance
+	// state of a particular denom.
+	DenomCompliance(context.Context, *QueryDenomComplianceRequest) (*QueryDenomComplianceResponse, error)
+	// FrozenAddresses defines a gRPC query method for fetching the frozen
+	// addresses of a particular denom.
+	FrozenAddresses(context.Context, *QueryFrozenAddressesRequest) (*QueryFrozenAddressesResponse, error)
+	// BeforeSendHookAddress defines a gRPC query method for fetching the address
+	// of the before send hook contract of a particular denom.
+	BeforeSendHookAddress(context.Context, *QueryBeforeSendHookAddressRequest) (*QueryBeforeSendHookAddressResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -552,6 +894,15 @@ func (*UnimplementedQueryServer) DenomMetadata(ctx context.Context, req *QueryDe
 func (*UnimplementedQueryServer) DenomsFromCreator(ctx context.Context, req *QueryDenomsFromCreatorRequest) (*QueryDenomsFromCreatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DenomsFromCreator not implemented")
 }
+func (*UnimplementedQueryServer) DenomCompliance(ctx context.Context, req *QueryDenomComplianceRequest) (*QueryDenomComplianceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomCompliance not implemented")
+}
+func (*UnimplementedQueryServer) FrozenAddresses(ctx context.Context, req *QueryFrozenAddressesRequest) (*QueryFrozenAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FrozenAddresses not implemented")
+}
+func (*UnimplementedQueryServer) BeforeSendHookAddress(ctx context.Context, req *QueryBeforeSendHookAddressRequest) (*QueryBeforeSendHookAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeforeSendHookAddress not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -629,6 +980,60 @@ func _Query_DenomsFromCreator_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DenomCompliance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomComplianceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomCompliance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.tokenfactory.Query/DenomCompliance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomCompliance(ctx, req.(*QueryDenomComplianceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FrozenAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFrozenAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FrozenAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.tokenfactory.Query/FrozenAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FrozenAddresses(ctx, req.(*QueryFrozenAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BeforeSendHookAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBeforeSendHookAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BeforeSendHookAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.tokenfactory.Query/BeforeSendHookAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BeforeSendHookAddress(ctx, req.(*QueryBeforeSendHookAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "seiprotocol.seichain.tokenfactory.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -649,6 +1054,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DenomsFromCreator",
 			Handler:    _Query_DenomsFromCreator_Handler,
 		},
+		{
+			MethodName: "DenomCompliance",
+			Handler:    _Query_DenomCompliance_Handler,
+		},
+		{
+			MethodName: "FrozenAddresses",
+			Handler:    _Query_FrozenAddresses_Handler,
+		},
+		{
+			MethodName: "BeforeSendHookAddress",
+			Handler:    _Query_BeforeSendHookAddress_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "tokenfactory/query.proto",
@@ -898,30 +1315,215 @@ func (m *QueryDenomMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryDenomComplianceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryDenomComplianceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDenomComplianceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomComplianceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomComplianceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomComplianceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Compliance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFrozenAddressesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrozenAddressesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrozenAddressesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFrozenAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrozenAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrozenAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBeforeSendHookAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBeforeSendHookAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBeforeSendHookAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBeforeSendHookAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBeforeSendHookAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBeforeSendHookAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CosmwasmAddress) > 0 {
+		i -= len(m.CosmwasmAddress)
+		copy(dAtA[i:], m.CosmwasmAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CosmwasmAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = m.Params.Size()
@@ -946,72 +1548,612 @@ func (m *QueryDenomAuthorityMetadataResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	l = m.AuthorityMetadata.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
+	var l int
+	_ = l
+	l = m.AuthorityMetadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDenomsFromCreatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomsFromCreatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDenomMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Metadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDenomComplianceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomComplianceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Compliance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFrozenAddressesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFrozenAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBeforeSendHookAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBeforeSendHookAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmwasmAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorityMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AuthorityMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryDenomsFromCreatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryDenomsFromCreatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Denoms) > 0 {
-		for _, s := range m.Denoms {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *QueryDenomMetadataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryDenomMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Metadata.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1034,12 +2176,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomMetadataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1061,7 +2235,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1084,15 +2258,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1119,7 +2293,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1144,7 +2318,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomComplianceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1167,10 +2341,10 @@ func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomComplianceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomComplianceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1226,7 +2400,7 @@ func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomComplianceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1249,15 +2423,15 @@ func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomComplianceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomComplianceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthorityMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Compliance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1284,7 +2458,7 @@ func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AuthorityMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Compliance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1309,7 +2483,7 @@ func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFrozenAddressesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1332,15 +2506,15 @@ func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFrozenAddressesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFrozenAddressesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1368,7 +2542,7 @@ func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1391,7 +2565,7 @@ func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFrozenAddressesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1414,15 +2588,15 @@ func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFrozenAddressesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFrozenAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1450,7 +2624,7 @@ func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1473,7 +2647,7 @@ func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBeforeSendHookAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1496,10 +2670,10 @@ func (m *QueryDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBeforeSendHookAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBeforeSendHookAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1555,7 +2729,7 @@ func (m *QueryDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBeforeSendHookAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1578,17 +2752,17 @@ func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBeforeSendHookAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBeforeSendHookAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmwasmAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1598,24 +2772,23 @@ func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CosmwasmAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex